@@ -31,6 +31,7 @@ const (
 	AdminErrorReason_INVALID_USERID     AdminErrorReason = 2 // 用户ID无效
 	AdminErrorReason_INVALID_TOKEN      AdminErrorReason = 3 // token无效
 	AdminErrorReason_INVALID_PASSWORD   AdminErrorReason = 4 // 密码无效
+	AdminErrorReason_MFA_NOT_ENROLLED   AdminErrorReason = 5 // 未绑定两步验证
 	// 401
 	AdminErrorReason_UNAUTHORIZED            AdminErrorReason = 100 // 未授权
 	AdminErrorReason_USER_FREEZE             AdminErrorReason = 101 // 用户被冻结
@@ -40,13 +41,19 @@ const (
 	AdminErrorReason_INCORRECT_REFRESH_TOKEN AdminErrorReason = 105 // 刷新令牌错误
 	AdminErrorReason_TOKEN_EXPIRED           AdminErrorReason = 106 // token过期
 	AdminErrorReason_TOKEN_NOT_EXIST         AdminErrorReason = 107 // token不存在
+	AdminErrorReason_TENANT_EXPIRED          AdminErrorReason = 108 // 租户已过期
+	AdminErrorReason_TENANT_DISABLED         AdminErrorReason = 109 // 租户已停用
+	AdminErrorReason_INCORRECT_MFA_CODE      AdminErrorReason = 110 // 两步验证码错误
+	AdminErrorReason_INCORRECT_MFA_TOKEN     AdminErrorReason = 111 // 两步验证令牌无效或已过期
 	// 402
 	AdminErrorReason_PAYMENT_REQUIRED AdminErrorReason = 200 // 需要支付
 	// 403
-	AdminErrorReason_FORBIDDEN AdminErrorReason = 300 // 禁止访问
+	AdminErrorReason_FORBIDDEN    AdminErrorReason = 300 // 禁止访问
+	AdminErrorReason_MFA_REQUIRED AdminErrorReason = 301 // 租户要求两步验证，不能停用
 	// 404
-	AdminErrorReason_NOT_FOUND      AdminErrorReason = 400 // 找不到资源
-	AdminErrorReason_USER_NOT_FOUND AdminErrorReason = 401 // 用户不存在
+	AdminErrorReason_NOT_FOUND         AdminErrorReason = 400 // 找不到资源
+	AdminErrorReason_USER_NOT_FOUND    AdminErrorReason = 401 // 用户不存在
+	AdminErrorReason_SESSION_NOT_FOUND AdminErrorReason = 402 // 会话不存在或已过期
 	// 405
 	AdminErrorReason_METHOD_NOT_ALLOWED AdminErrorReason = 500 // 方法不允许
 	// 406
@@ -56,7 +63,8 @@ const (
 	// 408
 	AdminErrorReason_REQUEST_TIMEOUT AdminErrorReason = 800 // 请求超时
 	// 409
-	AdminErrorReason_CONFLICT AdminErrorReason = 900 // 冲突
+	AdminErrorReason_CONFLICT            AdminErrorReason = 900 // 冲突
+	AdminErrorReason_MFA_ALREADY_ENABLED AdminErrorReason = 901 // 已启用两步验证
 	// 410
 	AdminErrorReason_GONE AdminErrorReason = 1000 // 已删除
 	// 411
@@ -131,6 +139,7 @@ var (
 		2:    "INVALID_USERID",
 		3:    "INVALID_TOKEN",
 		4:    "INVALID_PASSWORD",
+		5:    "MFA_NOT_ENROLLED",
 		100:  "UNAUTHORIZED",
 		101:  "USER_FREEZE",
 		102:  "INCORRECT_PASSWORD",
@@ -139,15 +148,22 @@ var (
 		105:  "INCORRECT_REFRESH_TOKEN",
 		106:  "TOKEN_EXPIRED",
 		107:  "TOKEN_NOT_EXIST",
+		108:  "TENANT_EXPIRED",
+		109:  "TENANT_DISABLED",
+		110:  "INCORRECT_MFA_CODE",
+		111:  "INCORRECT_MFA_TOKEN",
 		200:  "PAYMENT_REQUIRED",
 		300:  "FORBIDDEN",
+		301:  "MFA_REQUIRED",
 		400:  "NOT_FOUND",
 		401:  "USER_NOT_FOUND",
+		402:  "SESSION_NOT_FOUND",
 		500:  "METHOD_NOT_ALLOWED",
 		600:  "NOT_ACCEPTABLE",
 		700:  "PROXY_AUTHENTICATION_REQUIRED",
 		800:  "REQUEST_TIMEOUT",
 		900:  "CONFLICT",
+		901:  "MFA_ALREADY_ENABLED",
 		1000: "GONE",
 		1010: "LENGTH_REQUIRED",
 		1020: "PRECONDITION_FAILED",
@@ -187,6 +203,7 @@ var (
 		"INVALID_USERID":                  2,
 		"INVALID_TOKEN":                   3,
 		"INVALID_PASSWORD":                4,
+		"MFA_NOT_ENROLLED":                5,
 		"UNAUTHORIZED":                    100,
 		"USER_FREEZE":                     101,
 		"INCORRECT_PASSWORD":              102,
@@ -195,15 +212,22 @@ var (
 		"INCORRECT_REFRESH_TOKEN":         105,
 		"TOKEN_EXPIRED":                   106,
 		"TOKEN_NOT_EXIST":                 107,
+		"TENANT_EXPIRED":                  108,
+		"TENANT_DISABLED":                 109,
+		"INCORRECT_MFA_CODE":              110,
+		"INCORRECT_MFA_TOKEN":             111,
 		"PAYMENT_REQUIRED":                200,
 		"FORBIDDEN":                       300,
+		"MFA_REQUIRED":                    301,
 		"NOT_FOUND":                       400,
 		"USER_NOT_FOUND":                  401,
+		"SESSION_NOT_FOUND":               402,
 		"METHOD_NOT_ALLOWED":              500,
 		"NOT_ACCEPTABLE":                  600,
 		"PROXY_AUTHENTICATION_REQUIRED":   700,
 		"REQUEST_TIMEOUT":                 800,
 		"CONFLICT":                        900,
+		"MFA_ALREADY_ENABLED":             901,
 		"GONE":                            1000,
 		"LENGTH_REQUIRED":                 1010,
 		"PRECONDITION_FAILED":             1020,
@@ -270,13 +294,14 @@ var File_admin_v1_error_reason_proto protoreflect.FileDescriptor
 
 const file_admin_v1_error_reason_proto_rawDesc = "" +
 	"\n" +
-	"\x1badmin/v1/error_reason.proto\x12\badmin.v1\x1a\x13errors/errors.proto*\xe4\f\n" +
+	"\x1badmin/v1/error_reason.proto\x12\badmin.v1\x1a\x13errors/errors.proto*\xc9\x0e\n" +
 	"\x10AdminErrorReason\x12\x15\n" +
 	"\vBAD_REQUEST\x10\x00\x1a\x04\xa8E\x90\x03\x12\x1c\n" +
 	"\x12INVALID_GRANT_TYPE\x10\x01\x1a\x04\xa8E\x90\x03\x12\x18\n" +
 	"\x0eINVALID_USERID\x10\x02\x1a\x04\xa8E\x90\x03\x12\x17\n" +
 	"\rINVALID_TOKEN\x10\x03\x1a\x04\xa8E\x90\x03\x12\x1a\n" +
-	"\x10INVALID_PASSWORD\x10\x04\x1a\x04\xa8E\x90\x03\x12\x16\n" +
+	"\x10INVALID_PASSWORD\x10\x04\x1a\x04\xa8E\x90\x03\x12\x1a\n" +
+	"\x10MFA_NOT_ENROLLED\x10\x05\x1a\x04\xa8E\x90\x03\x12\x16\n" +
 	"\fUNAUTHORIZED\x10d\x1a\x04\xa8E\x91\x03\x12\x15\n" +
 	"\vUSER_FREEZE\x10e\x1a\x04\xa8E\x91\x03\x12\x1c\n" +
 	"\x12INCORRECT_PASSWORD\x10f\x1a\x04\xa8E\x91\x03\x12\x1e\n" +
@@ -284,16 +309,23 @@ const file_admin_v1_error_reason_proto_rawDesc = "" +
 	"\x16INCORRECT_ACCESS_TOKEN\x10h\x1a\x04\xa8E\x91\x03\x12!\n" +
 	"\x17INCORRECT_REFRESH_TOKEN\x10i\x1a\x04\xa8E\x91\x03\x12\x17\n" +
 	"\rTOKEN_EXPIRED\x10j\x1a\x04\xa8E\x91\x03\x12\x19\n" +
-	"\x0fTOKEN_NOT_EXIST\x10k\x1a\x04\xa8E\x91\x03\x12\x1b\n" +
+	"\x0fTOKEN_NOT_EXIST\x10k\x1a\x04\xa8E\x91\x03\x12\x18\n" +
+	"\x0eTENANT_EXPIRED\x10l\x1a\x04\xa8E\x91\x03\x12\x19\n" +
+	"\x0fTENANT_DISABLED\x10m\x1a\x04\xa8E\x91\x03\x12\x1c\n" +
+	"\x12INCORRECT_MFA_CODE\x10n\x1a\x04\xa8E\x91\x03\x12\x1d\n" +
+	"\x13INCORRECT_MFA_TOKEN\x10o\x1a\x04\xa8E\x91\x03\x12\x1b\n" +
 	"\x10PAYMENT_REQUIRED\x10\xc8\x01\x1a\x04\xa8E\x92\x03\x12\x14\n" +
-	"\tFORBIDDEN\x10\xac\x02\x1a\x04\xa8E\x93\x03\x12\x14\n" +
+	"\tFORBIDDEN\x10\xac\x02\x1a\x04\xa8E\x93\x03\x12\x17\n" +
+	"\fMFA_REQUIRED\x10\xad\x02\x1a\x04\xa8E\x93\x03\x12\x14\n" +
 	"\tNOT_FOUND\x10\x90\x03\x1a\x04\xa8E\x94\x03\x12\x19\n" +
-	"\x0eUSER_NOT_FOUND\x10\x91\x03\x1a\x04\xa8E\x94\x03\x12\x1d\n" +
+	"\x0eUSER_NOT_FOUND\x10\x91\x03\x1a\x04\xa8E\x94\x03\x12\x1c\n" +
+	"\x11SESSION_NOT_FOUND\x10\x92\x03\x1a\x04\xa8E\x94\x03\x12\x1d\n" +
 	"\x12METHOD_NOT_ALLOWED\x10\xf4\x03\x1a\x04\xa8E\x95\x03\x12\x19\n" +
 	"\x0eNOT_ACCEPTABLE\x10\xd8\x04\x1a\x04\xa8E\x96\x03\x12(\n" +
 	"\x1dPROXY_AUTHENTICATION_REQUIRED\x10\xbc\x05\x1a\x04\xa8E\x97\x03\x12\x1a\n" +
 	"\x0fREQUEST_TIMEOUT\x10\xa0\x06\x1a\x04\xa8E\x98\x03\x12\x13\n" +
-	"\bCONFLICT\x10\x84\a\x1a\x04\xa8E\x99\x03\x12\x0f\n" +
+	"\bCONFLICT\x10\x84\a\x1a\x04\xa8E\x99\x03\x12\x1e\n" +
+	"\x13MFA_ALREADY_ENABLED\x10\x85\a\x1a\x04\xa8E\x99\x03\x12\x0f\n" +
 	"\x04GONE\x10\xe8\a\x1a\x04\xa8E\x9a\x03\x12\x1a\n" +
 	"\x0fLENGTH_REQUIRED\x10\xf2\a\x1a\x04\xa8E\x9b\x03\x12\x1e\n" +
 	"\x13PRECONDITION_FAILED\x10\xfc\a\x1a\x04\xa8E\x9c\x03\x12\x1c\n" +
@@ -81,6 +81,20 @@ func ErrorInvalidPassword(format string, args ...interface{}) *errors.Error {
 	return errors.New(400, AdminErrorReason_INVALID_PASSWORD.String(), fmt.Sprintf(format, args...))
 }
 
+// 未绑定两步验证
+func IsMfaNotEnrolled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AdminErrorReason_MFA_NOT_ENROLLED.String() && e.Code == 400
+}
+
+// 未绑定两步验证
+func ErrorMfaNotEnrolled(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, AdminErrorReason_MFA_NOT_ENROLLED.String(), fmt.Sprintf(format, args...))
+}
+
 // 401
 func IsUnauthorized(err error) bool {
 	if err == nil {
@@ -193,6 +207,62 @@ func ErrorTokenNotExist(format string, args ...interface{}) *errors.Error {
 	return errors.New(401, AdminErrorReason_TOKEN_NOT_EXIST.String(), fmt.Sprintf(format, args...))
 }
 
+// 租户已过期
+func IsTenantExpired(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AdminErrorReason_TENANT_EXPIRED.String() && e.Code == 401
+}
+
+// 租户已过期
+func ErrorTenantExpired(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, AdminErrorReason_TENANT_EXPIRED.String(), fmt.Sprintf(format, args...))
+}
+
+// 租户已停用
+func IsTenantDisabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AdminErrorReason_TENANT_DISABLED.String() && e.Code == 401
+}
+
+// 租户已停用
+func ErrorTenantDisabled(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, AdminErrorReason_TENANT_DISABLED.String(), fmt.Sprintf(format, args...))
+}
+
+// 两步验证码错误
+func IsIncorrectMfaCode(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AdminErrorReason_INCORRECT_MFA_CODE.String() && e.Code == 401
+}
+
+// 两步验证码错误
+func ErrorIncorrectMfaCode(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, AdminErrorReason_INCORRECT_MFA_CODE.String(), fmt.Sprintf(format, args...))
+}
+
+// 两步验证令牌无效或已过期
+func IsIncorrectMfaToken(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AdminErrorReason_INCORRECT_MFA_TOKEN.String() && e.Code == 401
+}
+
+// 两步验证令牌无效或已过期
+func ErrorIncorrectMfaToken(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, AdminErrorReason_INCORRECT_MFA_TOKEN.String(), fmt.Sprintf(format, args...))
+}
+
 // 402
 func IsPaymentRequired(err error) bool {
 	if err == nil {
@@ -221,6 +291,20 @@ func ErrorForbidden(format string, args ...interface{}) *errors.Error {
 	return errors.New(403, AdminErrorReason_FORBIDDEN.String(), fmt.Sprintf(format, args...))
 }
 
+// 租户要求两步验证，不能停用
+func IsMfaRequired(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AdminErrorReason_MFA_REQUIRED.String() && e.Code == 403
+}
+
+// 租户要求两步验证，不能停用
+func ErrorMfaRequired(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, AdminErrorReason_MFA_REQUIRED.String(), fmt.Sprintf(format, args...))
+}
+
 // 404
 func IsNotFound(err error) bool {
 	if err == nil {
@@ -249,6 +333,20 @@ func ErrorUserNotFound(format string, args ...interface{}) *errors.Error {
 	return errors.New(404, AdminErrorReason_USER_NOT_FOUND.String(), fmt.Sprintf(format, args...))
 }
 
+// 会话不存在或已过期
+func IsSessionNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AdminErrorReason_SESSION_NOT_FOUND.String() && e.Code == 404
+}
+
+// 会话不存在或已过期
+func ErrorSessionNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, AdminErrorReason_SESSION_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
 // 405
 func IsMethodNotAllowed(err error) bool {
 	if err == nil {
@@ -319,6 +417,20 @@ func ErrorConflict(format string, args ...interface{}) *errors.Error {
 	return errors.New(409, AdminErrorReason_CONFLICT.String(), fmt.Sprintf(format, args...))
 }
 
+// 已启用两步验证
+func IsMfaAlreadyEnabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AdminErrorReason_MFA_ALREADY_ENABLED.String() && e.Code == 409
+}
+
+// 已启用两步验证
+func ErrorMfaAlreadyEnabled(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, AdminErrorReason_MFA_ALREADY_ENABLED.String(), fmt.Sprintf(format, args...))
+}
+
 // 410
 func IsGone(err error) bool {
 	if err == nil {
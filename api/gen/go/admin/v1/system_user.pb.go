@@ -26,25 +26,27 @@ const (
 
 // 用户信息
 type UserInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Account       string                 `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
-	Nickname      string                 `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Remark        string                 `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark,omitempty"`
-	DeptId        string                 `protobuf:"bytes,5,opt,name=dept_id,json=deptId,proto3" json:"dept_id,omitempty"`
-	PostIds       string                 `protobuf:"bytes,6,opt,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
-	Email         string                 `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
-	Mobile        string                 `protobuf:"bytes,8,opt,name=mobile,proto3" json:"mobile,omitempty"`
-	Sex           int32                  `protobuf:"varint,9,opt,name=sex,proto3" json:"sex,omitempty"`
-	Avatar        string                 `protobuf:"bytes,10,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	Status        int32                  `protobuf:"varint,11,opt,name=status,proto3" json:"status,omitempty"`
-	LoginIp       string                 `protobuf:"bytes,12,opt,name=login_ip,json=loginIp,proto3" json:"login_ip,omitempty"`
-	LoginDate     string                 `protobuf:"bytes,13,opt,name=login_date,json=loginDate,proto3" json:"login_date,omitempty"`
-	TenantId      string                 `protobuf:"bytes,19,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,22,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	UpdatedBy     string                 `protobuf:"bytes,23,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Account   string                 `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Nickname  string                 `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Remark    string                 `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark,omitempty"`
+	DeptId    string                 `protobuf:"bytes,5,opt,name=dept_id,json=deptId,proto3" json:"dept_id,omitempty"`
+	Email     string                 `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
+	Mobile    string                 `protobuf:"bytes,8,opt,name=mobile,proto3" json:"mobile,omitempty"`
+	Sex       int32                  `protobuf:"varint,9,opt,name=sex,proto3" json:"sex,omitempty"`
+	Avatar    string                 `protobuf:"bytes,10,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Status    int32                  `protobuf:"varint,11,opt,name=status,proto3" json:"status,omitempty"`
+	LoginIp   string                 `protobuf:"bytes,12,opt,name=login_ip,json=loginIp,proto3" json:"login_ip,omitempty"`
+	LoginDate string                 `protobuf:"bytes,13,opt,name=login_date,json=loginDate,proto3" json:"login_date,omitempty"`
+	// 岗位，按显示顺序排列
+	Posts         []*PostRef `protobuf:"bytes,14,rep,name=posts,proto3" json:"posts,omitempty"`
+	TenantId      string     `protobuf:"bytes,19,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	CreatedAt     string     `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string     `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string     `protobuf:"bytes,22,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string     `protobuf:"bytes,23,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	DeletedAt     string     `protobuf:"bytes,24,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -114,13 +116,6 @@ func (x *UserInfo) GetDeptId() string {
 	return ""
 }
 
-func (x *UserInfo) GetPostIds() string {
-	if x != nil {
-		return x.PostIds
-	}
-	return ""
-}
-
 func (x *UserInfo) GetEmail() string {
 	if x != nil {
 		return x.Email
@@ -170,6 +165,13 @@ func (x *UserInfo) GetLoginDate() string {
 	return ""
 }
 
+func (x *UserInfo) GetPosts() []*PostRef {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
 func (x *UserInfo) GetTenantId() string {
 	if x != nil {
 		return x.TenantId
@@ -205,22 +207,30 @@ func (x *UserInfo) GetUpdatedBy() string {
 	return ""
 }
 
+func (x *UserInfo) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
 // 创建用户请求
 type CreateUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Account       string                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	Nickname      *string                `protobuf:"bytes,3,opt,name=nickname,proto3,oneof" json:"nickname,omitempty"`
-	Remark        *string                `protobuf:"bytes,4,opt,name=remark,proto3,oneof" json:"remark,omitempty"`
-	DeptId        *string                `protobuf:"bytes,5,opt,name=dept_id,json=deptId,proto3,oneof" json:"dept_id,omitempty"`
-	PostIds       *string                `protobuf:"bytes,6,opt,name=post_ids,json=postIds,proto3,oneof" json:"post_ids,omitempty"`
-	Email         *string                `protobuf:"bytes,7,opt,name=email,proto3,oneof" json:"email,omitempty"`
-	Mobile        *string                `protobuf:"bytes,8,opt,name=mobile,proto3,oneof" json:"mobile,omitempty"`
-	Sex           *int32                 `protobuf:"varint,9,opt,name=sex,proto3,oneof" json:"sex,omitempty"`
-	Avatar        *string                `protobuf:"bytes,10,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"`
-	LoginIp       *string                `protobuf:"bytes,11,opt,name=login_ip,json=loginIp,proto3,oneof" json:"login_ip,omitempty"`
-	LoginDate     *string                `protobuf:"bytes,12,opt,name=login_date,json=loginDate,proto3,oneof" json:"login_date,omitempty"`
-	TenantId      *string                `protobuf:"bytes,15,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Account  string                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Password string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Nickname *string                `protobuf:"bytes,3,opt,name=nickname,proto3,oneof" json:"nickname,omitempty"`
+	Remark   *string                `protobuf:"bytes,4,opt,name=remark,proto3,oneof" json:"remark,omitempty"`
+	DeptId   *string                `protobuf:"bytes,5,opt,name=dept_id,json=deptId,proto3,oneof" json:"dept_id,omitempty"`
+	// 岗位ID数组
+	PostIds       []string `protobuf:"bytes,13,rep,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
+	Email         *string  `protobuf:"bytes,7,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	Mobile        *string  `protobuf:"bytes,8,opt,name=mobile,proto3,oneof" json:"mobile,omitempty"`
+	Sex           *int32   `protobuf:"varint,9,opt,name=sex,proto3,oneof" json:"sex,omitempty"`
+	Avatar        *string  `protobuf:"bytes,10,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"`
+	LoginIp       *string  `protobuf:"bytes,11,opt,name=login_ip,json=loginIp,proto3,oneof" json:"login_ip,omitempty"`
+	LoginDate     *string  `protobuf:"bytes,12,opt,name=login_date,json=loginDate,proto3,oneof" json:"login_date,omitempty"`
+	TenantId      *string  `protobuf:"bytes,15,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -290,11 +300,11 @@ func (x *CreateUserRequest) GetDeptId() string {
 	return ""
 }
 
-func (x *CreateUserRequest) GetPostIds() string {
-	if x != nil && x.PostIds != nil {
-		return *x.PostIds
+func (x *CreateUserRequest) GetPostIds() []string {
+	if x != nil {
+		return x.PostIds
 	}
-	return ""
+	return nil
 }
 
 func (x *CreateUserRequest) GetEmail() string {
@@ -483,20 +493,21 @@ func (x *GetUserReply) GetUser() *UserInfo {
 
 // 更新用户请求
 type UpdateUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nickname      *string                `protobuf:"bytes,2,opt,name=nickname,proto3,oneof" json:"nickname,omitempty"`
-	Remark        *string                `protobuf:"bytes,3,opt,name=remark,proto3,oneof" json:"remark,omitempty"`
-	DeptId        *string                `protobuf:"bytes,4,opt,name=dept_id,json=deptId,proto3,oneof" json:"dept_id,omitempty"`
-	PostIds       *string                `protobuf:"bytes,5,opt,name=post_ids,json=postIds,proto3,oneof" json:"post_ids,omitempty"`
-	Email         *string                `protobuf:"bytes,6,opt,name=email,proto3,oneof" json:"email,omitempty"`
-	Mobile        *string                `protobuf:"bytes,7,opt,name=mobile,proto3,oneof" json:"mobile,omitempty"`
-	Sex           *int32                 `protobuf:"varint,8,opt,name=sex,proto3,oneof" json:"sex,omitempty"`
-	Avatar        *string                `protobuf:"bytes,9,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"`
-	Status        *int32                 `protobuf:"varint,10,opt,name=status,proto3,oneof" json:"status,omitempty"`
-	LoginIp       *string                `protobuf:"bytes,11,opt,name=login_ip,json=loginIp,proto3,oneof" json:"login_ip,omitempty"`
-	LoginDate     *string                `protobuf:"bytes,12,opt,name=login_date,json=loginDate,proto3,oneof" json:"login_date,omitempty"`
-	TenantId      *string                `protobuf:"bytes,14,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nickname *string                `protobuf:"bytes,2,opt,name=nickname,proto3,oneof" json:"nickname,omitempty"`
+	Remark   *string                `protobuf:"bytes,3,opt,name=remark,proto3,oneof" json:"remark,omitempty"`
+	DeptId   *string                `protobuf:"bytes,4,opt,name=dept_id,json=deptId,proto3,oneof" json:"dept_id,omitempty"`
+	// 岗位ID数组，为空时不修改岗位，清空岗位请使用 ReplaceUserPosts
+	PostIds       []string `protobuf:"bytes,13,rep,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
+	Email         *string  `protobuf:"bytes,6,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	Mobile        *string  `protobuf:"bytes,7,opt,name=mobile,proto3,oneof" json:"mobile,omitempty"`
+	Sex           *int32   `protobuf:"varint,8,opt,name=sex,proto3,oneof" json:"sex,omitempty"`
+	Avatar        *string  `protobuf:"bytes,9,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"`
+	Status        *int32   `protobuf:"varint,10,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	LoginIp       *string  `protobuf:"bytes,11,opt,name=login_ip,json=loginIp,proto3,oneof" json:"login_ip,omitempty"`
+	LoginDate     *string  `protobuf:"bytes,12,opt,name=login_date,json=loginDate,proto3,oneof" json:"login_date,omitempty"`
+	TenantId      *string  `protobuf:"bytes,14,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -559,11 +570,11 @@ func (x *UpdateUserRequest) GetDeptId() string {
 	return ""
 }
 
-func (x *UpdateUserRequest) GetPostIds() string {
-	if x != nil && x.PostIds != nil {
-		return *x.PostIds
+func (x *UpdateUserRequest) GetPostIds() []string {
+	if x != nil {
+		return x.PostIds
 	}
-	return ""
+	return nil
 }
 
 func (x *UpdateUserRequest) GetEmail() string {
@@ -1486,18 +1497,499 @@ func (x *GetUserStatsReply) GetStats() *UserStats {
 	return nil
 }
 
+// 已删除用户列表请求
+type ListDeletedUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *int32                 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	Account       *string                `protobuf:"bytes,3,opt,name=account,proto3,oneof" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedUsersRequest) Reset() {
+	*x = ListDeletedUsersRequest{}
+	mi := &file_admin_v1_system_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedUsersRequest) ProtoMessage() {}
+
+func (x *ListDeletedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_system_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_system_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListDeletedUsersRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListDeletedUsersRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeletedUsersRequest) GetAccount() string {
+	if x != nil && x.Account != nil {
+		return *x.Account
+	}
+	return ""
+}
+
+// 已删除用户列表响应
+type ListDeletedUsersReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserInfo            `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedUsersReply) Reset() {
+	*x = ListDeletedUsersReply{}
+	mi := &file_admin_v1_system_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedUsersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedUsersReply) ProtoMessage() {}
+
+func (x *ListDeletedUsersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_system_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedUsersReply.ProtoReflect.Descriptor instead.
+func (*ListDeletedUsersReply) Descriptor() ([]byte, []int) {
+	return file_admin_v1_system_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListDeletedUsersReply) GetUsers() []*UserInfo {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListDeletedUsersReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 恢复已删除用户请求
+type RestoreUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUserRequest) Reset() {
+	*x = RestoreUserRequest{}
+	mi := &file_admin_v1_system_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserRequest) ProtoMessage() {}
+
+func (x *RestoreUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_system_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserRequest.ProtoReflect.Descriptor instead.
+func (*RestoreUserRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_system_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RestoreUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 恢复已删除用户响应
+type RestoreUserReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUserReply) Reset() {
+	*x = RestoreUserReply{}
+	mi := &file_admin_v1_system_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUserReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserReply) ProtoMessage() {}
+
+func (x *RestoreUserReply) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_system_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserReply.ProtoReflect.Descriptor instead.
+func (*RestoreUserReply) Descriptor() ([]byte, []int) {
+	return file_admin_v1_system_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RestoreUserReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 彻底删除用户请求
+type PurgeUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeUserRequest) Reset() {
+	*x = PurgeUserRequest{}
+	mi := &file_admin_v1_system_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeUserRequest) ProtoMessage() {}
+
+func (x *PurgeUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_system_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeUserRequest.ProtoReflect.Descriptor instead.
+func (*PurgeUserRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_system_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PurgeUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 彻底删除用户响应
+type PurgeUserReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeUserReply) Reset() {
+	*x = PurgeUserReply{}
+	mi := &file_admin_v1_system_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeUserReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeUserReply) ProtoMessage() {}
+
+func (x *PurgeUserReply) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_system_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeUserReply.ProtoReflect.Descriptor instead.
+func (*PurgeUserReply) Descriptor() ([]byte, []int) {
+	return file_admin_v1_system_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PurgeUserReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 替换用户岗位请求
+type ReplaceUserPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PostIds       []string               `protobuf:"bytes,2,rep,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceUserPostsRequest) Reset() {
+	*x = ReplaceUserPostsRequest{}
+	mi := &file_admin_v1_system_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceUserPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceUserPostsRequest) ProtoMessage() {}
+
+func (x *ReplaceUserPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_system_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceUserPostsRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceUserPostsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_system_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReplaceUserPostsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReplaceUserPostsRequest) GetPostIds() []string {
+	if x != nil {
+		return x.PostIds
+	}
+	return nil
+}
+
+// 替换用户岗位响应
+type ReplaceUserPostsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceUserPostsReply) Reset() {
+	*x = ReplaceUserPostsReply{}
+	mi := &file_admin_v1_system_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceUserPostsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceUserPostsReply) ProtoMessage() {}
+
+func (x *ReplaceUserPostsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_system_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceUserPostsReply.ProtoReflect.Descriptor instead.
+func (*ReplaceUserPostsReply) Descriptor() ([]byte, []int) {
+	return file_admin_v1_system_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ReplaceUserPostsReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 强制下线用户请求
+type KickUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickUserRequest) Reset() {
+	*x = KickUserRequest{}
+	mi := &file_admin_v1_system_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickUserRequest) ProtoMessage() {}
+
+func (x *KickUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_system_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickUserRequest.ProtoReflect.Descriptor instead.
+func (*KickUserRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_system_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *KickUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 强制下线用户响应
+type KickUserReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickUserReply) Reset() {
+	*x = KickUserReply{}
+	mi := &file_admin_v1_system_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickUserReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickUserReply) ProtoMessage() {}
+
+func (x *KickUserReply) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_system_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickUserReply.ProtoReflect.Descriptor instead.
+func (*KickUserReply) Descriptor() ([]byte, []int) {
+	return file_admin_v1_system_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *KickUserReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
 var File_admin_v1_system_user_proto protoreflect.FileDescriptor
 
 const file_admin_v1_system_user_proto_rawDesc = "" +
 	"\n" +
-	"\x1aadmin/v1/system_user.proto\x12\badmin.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xdf\x03\n" +
+	"\x1aadmin/v1/system_user.proto\x12\badmin.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\x1a\x1aadmin/v1/annotations.proto\x1a\x1aadmin/v1/system_post.proto\"\x9c\x04\n" +
 	"\bUserInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\aaccount\x18\x02 \x01(\tR\aaccount\x12\x1a\n" +
 	"\bnickname\x18\x03 \x01(\tR\bnickname\x12\x16\n" +
 	"\x06remark\x18\x04 \x01(\tR\x06remark\x12\x17\n" +
-	"\adept_id\x18\x05 \x01(\tR\x06deptId\x12\x19\n" +
-	"\bpost_ids\x18\x06 \x01(\tR\apostIds\x12\x14\n" +
+	"\adept_id\x18\x05 \x01(\tR\x06deptId\x12\x14\n" +
 	"\x05email\x18\a \x01(\tR\x05email\x12\x16\n" +
 	"\x06mobile\x18\b \x01(\tR\x06mobile\x12\x10\n" +
 	"\x03sex\x18\t \x01(\x05R\x03sex\x12\x16\n" +
@@ -1506,7 +1998,8 @@ const file_admin_v1_system_user_proto_rawDesc = "" +
 	"\x06status\x18\v \x01(\x05R\x06status\x12\x19\n" +
 	"\blogin_ip\x18\f \x01(\tR\aloginIp\x12\x1d\n" +
 	"\n" +
-	"login_date\x18\r \x01(\tR\tloginDate\x12\x1b\n" +
+	"login_date\x18\r \x01(\tR\tloginDate\x12'\n" +
+	"\x05posts\x18\x0e \x03(\v2\x11.admin.v1.PostRefR\x05posts\x12\x1b\n" +
 	"\ttenant_id\x18\x13 \x01(\tR\btenantId\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\x14 \x01(\tR\tcreatedAt\x12\x1d\n" +
@@ -1515,30 +2008,30 @@ const file_admin_v1_system_user_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\x16 \x01(\tR\tcreatedBy\x12\x1d\n" +
 	"\n" +
-	"updated_by\x18\x17 \x01(\tR\tupdatedBy\"\xb9\x05\n" +
+	"updated_by\x18\x17 \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x18 \x01(\tR\tdeletedAtJ\x04\b\x06\x10\aR\bpost_ids\"\xb7\x05\n" +
 	"\x11CreateUserRequest\x124\n" +
 	"\aaccount\x18\x01 \x01(\tB\x1a\xfaB\x17r\x15\x10\x03\x1822\x0f^[a-zA-Z0-9_]+$R\aaccount\x12&\n" +
 	"\bpassword\x18\x02 \x01(\tB\n" +
 	"\xfaB\ar\x05\x10\x06\x18\x80\x01R\bpassword\x12(\n" +
 	"\bnickname\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x182H\x00R\bnickname\x88\x01\x01\x12%\n" +
 	"\x06remark\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01H\x01R\x06remark\x88\x01\x01\x12\x1c\n" +
-	"\adept_id\x18\x05 \x01(\tH\x02R\x06deptId\x88\x01\x01\x12\x1e\n" +
-	"\bpost_ids\x18\x06 \x01(\tH\x03R\apostIds\x88\x01\x01\x12R\n" +
-	"\x05email\x18\a \x01(\tB7\xfaB4r220^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\\.[a-zA-Z]{2,}$H\x04R\x05email\x88\x01\x01\x121\n" +
-	"\x06mobile\x18\b \x01(\tB\x14\xfaB\x11r\x0f2\r^1[3-9]\\d{9}$H\x05R\x06mobile\x88\x01\x01\x12 \n" +
-	"\x03sex\x18\t \x01(\x05B\t\xfaB\x06\x1a\x040\x000\x01H\x06R\x03sex\x88\x01\x01\x12%\n" +
+	"\adept_id\x18\x05 \x01(\tH\x02R\x06deptId\x88\x01\x01\x12#\n" +
+	"\bpost_ids\x18\r \x03(\tB\b\xfaB\x05\x92\x01\x02\x10\x14R\apostIds\x12R\n" +
+	"\x05email\x18\a \x01(\tB7\xfaB4r220^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\\.[a-zA-Z]{2,}$H\x03R\x05email\x88\x01\x01\x121\n" +
+	"\x06mobile\x18\b \x01(\tB\x14\xfaB\x11r\x0f2\r^1[3-9]\\d{9}$H\x04R\x06mobile\x88\x01\x01\x12 \n" +
+	"\x03sex\x18\t \x01(\x05B\t\xfaB\x06\x1a\x040\x000\x01H\x05R\x03sex\x88\x01\x01\x12%\n" +
 	"\x06avatar\x18\n" +
-	" \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01H\aR\x06avatar\x88\x01\x01\x12\x1e\n" +
-	"\blogin_ip\x18\v \x01(\tH\bR\aloginIp\x88\x01\x01\x12\"\n" +
+	" \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01H\x06R\x06avatar\x88\x01\x01\x12\x1e\n" +
+	"\blogin_ip\x18\v \x01(\tH\aR\aloginIp\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"login_date\x18\f \x01(\tH\tR\tloginDate\x88\x01\x01\x12 \n" +
-	"\ttenant_id\x18\x0f \x01(\tH\n" +
-	"R\btenantId\x88\x01\x01B\v\n" +
+	"login_date\x18\f \x01(\tH\bR\tloginDate\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x0f \x01(\tH\tR\btenantId\x88\x01\x01B\v\n" +
 	"\t_nicknameB\t\n" +
 	"\a_remarkB\n" +
 	"\n" +
-	"\b_dept_idB\v\n" +
-	"\t_post_idsB\b\n" +
+	"\b_dept_idB\b\n" +
 	"\x06_emailB\t\n" +
 	"\a_mobileB\x06\n" +
 	"\x04_sexB\t\n" +
@@ -1546,35 +2039,34 @@ const file_admin_v1_system_user_proto_rawDesc = "" +
 	"\t_login_ipB\r\n" +
 	"\v_login_dateB\f\n" +
 	"\n" +
-	"_tenant_id\"9\n" +
+	"_tenant_idJ\x04\b\x06\x10\a\"9\n" +
 	"\x0fCreateUserReply\x12&\n" +
 	"\x04user\x18\x01 \x01(\v2\x12.admin.v1.UserInfoR\x04user\")\n" +
 	"\x0eGetUserRequest\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\"6\n" +
 	"\fGetUserReply\x12&\n" +
-	"\x04user\x18\x01 \x01(\v2\x12.admin.v1.UserInfoR\x04user\"\xa7\x05\n" +
+	"\x04user\x18\x01 \x01(\v2\x12.admin.v1.UserInfoR\x04user\"\xa5\x05\n" +
 	"\x11UpdateUserRequest\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\x12(\n" +
 	"\bnickname\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x182H\x00R\bnickname\x88\x01\x01\x12%\n" +
 	"\x06remark\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01H\x01R\x06remark\x88\x01\x01\x12\x1c\n" +
-	"\adept_id\x18\x04 \x01(\tH\x02R\x06deptId\x88\x01\x01\x12\x1e\n" +
-	"\bpost_ids\x18\x05 \x01(\tH\x03R\apostIds\x88\x01\x01\x12R\n" +
-	"\x05email\x18\x06 \x01(\tB7\xfaB4r220^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\\.[a-zA-Z]{2,}$H\x04R\x05email\x88\x01\x01\x121\n" +
-	"\x06mobile\x18\a \x01(\tB\x14\xfaB\x11r\x0f2\r^1[3-9]\\d{9}$H\x05R\x06mobile\x88\x01\x01\x12 \n" +
-	"\x03sex\x18\b \x01(\x05B\t\xfaB\x06\x1a\x040\x000\x01H\x06R\x03sex\x88\x01\x01\x12%\n" +
-	"\x06avatar\x18\t \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01H\aR\x06avatar\x88\x01\x01\x12&\n" +
+	"\adept_id\x18\x04 \x01(\tH\x02R\x06deptId\x88\x01\x01\x12#\n" +
+	"\bpost_ids\x18\r \x03(\tB\b\xfaB\x05\x92\x01\x02\x10\x14R\apostIds\x12R\n" +
+	"\x05email\x18\x06 \x01(\tB7\xfaB4r220^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\\.[a-zA-Z]{2,}$H\x03R\x05email\x88\x01\x01\x121\n" +
+	"\x06mobile\x18\a \x01(\tB\x14\xfaB\x11r\x0f2\r^1[3-9]\\d{9}$H\x04R\x06mobile\x88\x01\x01\x12 \n" +
+	"\x03sex\x18\b \x01(\x05B\t\xfaB\x06\x1a\x040\x000\x01H\x05R\x03sex\x88\x01\x01\x12%\n" +
+	"\x06avatar\x18\t \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01H\x06R\x06avatar\x88\x01\x01\x12&\n" +
 	"\x06status\x18\n" +
-	" \x01(\x05B\t\xfaB\x06\x1a\x040\x000\x01H\bR\x06status\x88\x01\x01\x12\x1e\n" +
-	"\blogin_ip\x18\v \x01(\tH\tR\aloginIp\x88\x01\x01\x12\"\n" +
+	" \x01(\x05B\t\xfaB\x06\x1a\x040\x000\x01H\aR\x06status\x88\x01\x01\x12\x1e\n" +
+	"\blogin_ip\x18\v \x01(\tH\bR\aloginIp\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"login_date\x18\f \x01(\tH\n" +
-	"R\tloginDate\x88\x01\x01\x12 \n" +
-	"\ttenant_id\x18\x0e \x01(\tH\vR\btenantId\x88\x01\x01B\v\n" +
+	"login_date\x18\f \x01(\tH\tR\tloginDate\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x0e \x01(\tH\n" +
+	"R\btenantId\x88\x01\x01B\v\n" +
 	"\t_nicknameB\t\n" +
 	"\a_remarkB\n" +
 	"\n" +
-	"\b_dept_idB\v\n" +
-	"\t_post_idsB\b\n" +
+	"\b_dept_idB\b\n" +
 	"\x06_emailB\t\n" +
 	"\a_mobileB\x06\n" +
 	"\x04_sexB\t\n" +
@@ -1583,7 +2075,7 @@ const file_admin_v1_system_user_proto_rawDesc = "" +
 	"\t_login_ipB\r\n" +
 	"\v_login_dateB\f\n" +
 	"\n" +
-	"_tenant_id\"9\n" +
+	"_tenant_idJ\x04\b\x05\x10\x06\"9\n" +
 	"\x0fUpdateUserReply\x12&\n" +
 	"\x04user\x18\x01 \x01(\v2\x12.admin.v1.UserInfoR\x04user\",\n" +
 	"\x11DeleteUserRequest\x12\x17\n" +
@@ -1652,21 +2144,55 @@ const file_admin_v1_system_user_proto_rawDesc = "" +
 	"\x14this_week_registered\x18\x05 \x01(\x05R\x12thisWeekRegistered\x122\n" +
 	"\x15this_month_registered\x18\x06 \x01(\x05R\x13thisMonthRegistered\">\n" +
 	"\x11GetUserStatsReply\x12)\n" +
-	"\x05stats\x18\x01 \x01(\v2\x13.admin.v1.UserStatsR\x05stats2\xdf\b\n" +
-	"\x04User\x12`\n" +
+	"\x05stats\x18\x01 \x01(\v2\x13.admin.v1.UserStatsR\x05stats\"\xaa\x01\n" +
+	"\x17ListDeletedUsersRequest\x12 \n" +
+	"\x04page\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01H\x00R\x04page\x88\x01\x01\x12+\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01H\x01R\bpageSize\x88\x01\x01\x12\x1d\n" +
+	"\aaccount\x18\x03 \x01(\tH\x02R\aaccount\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_sizeB\n" +
+	"\n" +
+	"\b_account\"W\n" +
+	"\x15ListDeletedUsersReply\x12(\n" +
+	"\x05users\x18\x01 \x03(\v2\x12.admin.v1.UserInfoR\x05users\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"-\n" +
+	"\x12RestoreUserRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\",\n" +
+	"\x10RestoreUserReply\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"+\n" +
+	"\x10PurgeUserRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\"*\n" +
+	"\x0ePurgeUserReply\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"W\n" +
+	"\x17ReplaceUserPostsRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\x12#\n" +
+	"\bpost_ids\x18\x02 \x03(\tB\b\xfaB\x05\x92\x01\x02\x10\x14R\apostIds\"1\n" +
+	"\x15ReplaceUserPostsReply\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"*\n" +
+	"\x0fKickUserRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\")\n" +
+	"\rKickUserReply\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess2\xf1\x0f\n" +
+	"\x04User\x12v\n" +
 	"\n" +
-	"CreateUser\x12\x1b.admin.v1.CreateUserRequest\x1a\x19.admin.v1.CreateUserReply\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/admin/v1/users\x12Y\n" +
-	"\aGetUser\x12\x18.admin.v1.GetUserRequest\x1a\x16.admin.v1.GetUserReply\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/admin/v1/users/{id}\x12e\n" +
+	"CreateUser\x12\x1b.admin.v1.CreateUserRequest\x1a\x19.admin.v1.CreateUserReply\"0\x8a\xb5\x18\x12system:user:create\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/admin/v1/users\x12n\n" +
+	"\aGetUser\x12\x18.admin.v1.GetUserRequest\x1a\x16.admin.v1.GetUserReply\"1\x8a\xb5\x18\x11system:user:query\x82\xd3\xe4\x93\x02\x16\x12\x14/admin/v1/users/{id}\x12{\n" +
 	"\n" +
-	"UpdateUser\x12\x1b.admin.v1.UpdateUserRequest\x1a\x19.admin.v1.UpdateUserReply\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/admin/v1/users/{id}\x12b\n" +
+	"UpdateUser\x12\x1b.admin.v1.UpdateUserRequest\x1a\x19.admin.v1.UpdateUserReply\"5\x8a\xb5\x18\x12system:user:update\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/admin/v1/users/{id}\x12x\n" +
 	"\n" +
-	"DeleteUser\x12\x1b.admin.v1.DeleteUserRequest\x1a\x19.admin.v1.DeleteUserReply\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/admin/v1/users/{id}\x12Z\n" +
-	"\tListUsers\x12\x1a.admin.v1.ListUsersRequest\x1a\x18.admin.v1.ListUsersReply\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/admin/v1/users\x12\x7f\n" +
-	"\x10BatchDeleteUsers\x12!.admin.v1.BatchDeleteUsersRequest\x1a\x1f.admin.v1.BatchDeleteUsersReply\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/admin/v1/users/batch-delete\x12~\n" +
-	"\x10ChangeUserStatus\x12!.admin.v1.ChangeUserStatusRequest\x1a\x1f.admin.v1.ChangeUserStatusReply\"&\x82\xd3\xe4\x93\x02 :\x01*2\x1b/admin/v1/users/{id}/status\x12w\n" +
-	"\rResetPassword\x12\x1e.admin.v1.ResetPasswordRequest\x1a\x1c.admin.v1.ResetPasswordReply\"(\x82\xd3\xe4\x93\x02\":\x01*2\x1d/admin/v1/users/{id}/password\x12\x8d\x01\n" +
-	"\x12CheckAccountExists\x12#.admin.v1.CheckAccountExistsRequest\x1a!.admin.v1.CheckAccountExistsReply\"/\x82\xd3\xe4\x93\x02)\x12'/admin/v1/users/check-account/{account}\x12i\n" +
-	"\fGetUserStats\x12\x1d.admin.v1.GetUserStatsRequest\x1a\x1b.admin.v1.GetUserStatsReply\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/admin/v1/users/statsBy\n" +
+	"DeleteUser\x12\x1b.admin.v1.DeleteUserRequest\x1a\x19.admin.v1.DeleteUserReply\"2\x8a\xb5\x18\x12system:user:delete\x82\xd3\xe4\x93\x02\x16*\x14/admin/v1/users/{id}\x12o\n" +
+	"\tListUsers\x12\x1a.admin.v1.ListUsersRequest\x1a\x18.admin.v1.ListUsersReply\",\x8a\xb5\x18\x11system:user:query\x82\xd3\xe4\x93\x02\x11\x12\x0f/admin/v1/users\x12\x95\x01\n" +
+	"\x10BatchDeleteUsers\x12!.admin.v1.BatchDeleteUsersRequest\x1a\x1f.admin.v1.BatchDeleteUsersReply\"=\x8a\xb5\x18\x12system:user:delete\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/admin/v1/users/batch-delete\x12\x94\x01\n" +
+	"\x10ChangeUserStatus\x12!.admin.v1.ChangeUserStatusRequest\x1a\x1f.admin.v1.ChangeUserStatusReply\"<\x8a\xb5\x18\x12system:user:update\x82\xd3\xe4\x93\x02 :\x01*2\x1b/admin/v1/users/{id}/status\x12\x95\x01\n" +
+	"\rResetPassword\x12\x1e.admin.v1.ResetPasswordRequest\x1a\x1c.admin.v1.ResetPasswordReply\"F\x8a\xb5\x18\x1asystem:user:reset-password\x82\xd3\xe4\x93\x02\":\x01*2\x1d/admin/v1/users/{id}/password\x12\xa2\x01\n" +
+	"\x12CheckAccountExists\x12#.admin.v1.CheckAccountExistsRequest\x1a!.admin.v1.CheckAccountExistsReply\"D\x8a\xb5\x18\x11system:user:query\x82\xd3\xe4\x93\x02)\x12'/admin/v1/users/check-account/{account}\x12~\n" +
+	"\fGetUserStats\x12\x1d.admin.v1.GetUserStatsRequest\x1a\x1b.admin.v1.GetUserStatsReply\"2\x8a\xb5\x18\x11system:user:query\x82\xd3\xe4\x93\x02\x17\x12\x15/admin/v1/users/stats\x12\x8c\x01\n" +
+	"\x10ListDeletedUsers\x12!.admin.v1.ListDeletedUsersRequest\x1a\x1f.admin.v1.ListDeletedUsersReply\"4\x8a\xb5\x18\x11system:user:query\x82\xd3\xe4\x93\x02\x19\x12\x17/admin/v1/deleted-users\x12\x8f\x01\n" +
+	"\vRestoreUser\x12\x1c.admin.v1.RestoreUserRequest\x1a\x1a.admin.v1.RestoreUserReply\"F\x8a\xb5\x18\x13system:user:restore\x82\xd3\xe4\x93\x02):\x01*\"$/admin/v1/deleted-users/{id}/restore\x12\x93\x01\n" +
+	"\x10ReplaceUserPosts\x12!.admin.v1.ReplaceUserPostsRequest\x1a\x1f.admin.v1.ReplaceUserPostsReply\";\x8a\xb5\x18\x12system:user:update\x82\xd3\xe4\x93\x02\x1f:\x01*\x1a\x1a/admin/v1/users/{id}/posts\x12|\n" +
+	"\tPurgeUser\x12\x1a.admin.v1.PurgeUserRequest\x1a\x18.admin.v1.PurgeUserReply\"9\x8a\xb5\x18\x11system:user:purge\x82\xd3\xe4\x93\x02\x1e*\x1c/admin/v1/deleted-users/{id}\x12x\n" +
+	"\bKickUser\x12\x19.admin.v1.KickUserRequest\x1a\x17.admin.v1.KickUserReply\"8\x8a\xb5\x18\x10system:user:kick\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/admin/v1/users/{id}/kickBy\n" +
 	"\fcom.admin.v1B\x0fSystemUserProtoP\x01Z\x17qn-base/api/admin/v1;v1\xa2\x02\x03AXX\xaa\x02\bAdmin.V1\xca\x02\bAdmin\\V1\xe2\x02\x14Admin\\V1\\GPBMetadata\xea\x02\tAdmin::V1b\x06proto3"
 
 var (
@@ -1681,7 +2207,7 @@ func file_admin_v1_system_user_proto_rawDescGZIP() []byte {
 	return file_admin_v1_system_user_proto_rawDescData
 }
 
-var file_admin_v1_system_user_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_admin_v1_system_user_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_admin_v1_system_user_proto_goTypes = []any{
 	(*UserInfo)(nil),                  // 0: admin.v1.UserInfo
 	(*CreateUserRequest)(nil),         // 1: admin.v1.CreateUserRequest
@@ -1705,38 +2231,61 @@ var file_admin_v1_system_user_proto_goTypes = []any{
 	(*GetUserStatsRequest)(nil),       // 19: admin.v1.GetUserStatsRequest
 	(*UserStats)(nil),                 // 20: admin.v1.UserStats
 	(*GetUserStatsReply)(nil),         // 21: admin.v1.GetUserStatsReply
+	(*ListDeletedUsersRequest)(nil),   // 22: admin.v1.ListDeletedUsersRequest
+	(*ListDeletedUsersReply)(nil),     // 23: admin.v1.ListDeletedUsersReply
+	(*RestoreUserRequest)(nil),        // 24: admin.v1.RestoreUserRequest
+	(*RestoreUserReply)(nil),          // 25: admin.v1.RestoreUserReply
+	(*PurgeUserRequest)(nil),          // 26: admin.v1.PurgeUserRequest
+	(*PurgeUserReply)(nil),            // 27: admin.v1.PurgeUserReply
+	(*ReplaceUserPostsRequest)(nil),   // 28: admin.v1.ReplaceUserPostsRequest
+	(*ReplaceUserPostsReply)(nil),     // 29: admin.v1.ReplaceUserPostsReply
+	(*KickUserRequest)(nil),           // 30: admin.v1.KickUserRequest
+	(*KickUserReply)(nil),             // 31: admin.v1.KickUserReply
+	(*PostRef)(nil),                   // 32: admin.v1.PostRef
 }
 var file_admin_v1_system_user_proto_depIdxs = []int32{
-	0,  // 0: admin.v1.CreateUserReply.user:type_name -> admin.v1.UserInfo
-	0,  // 1: admin.v1.GetUserReply.user:type_name -> admin.v1.UserInfo
-	0,  // 2: admin.v1.UpdateUserReply.user:type_name -> admin.v1.UserInfo
-	0,  // 3: admin.v1.ListUsersReply.users:type_name -> admin.v1.UserInfo
-	20, // 4: admin.v1.GetUserStatsReply.stats:type_name -> admin.v1.UserStats
-	1,  // 5: admin.v1.User.CreateUser:input_type -> admin.v1.CreateUserRequest
-	3,  // 6: admin.v1.User.GetUser:input_type -> admin.v1.GetUserRequest
-	5,  // 7: admin.v1.User.UpdateUser:input_type -> admin.v1.UpdateUserRequest
-	7,  // 8: admin.v1.User.DeleteUser:input_type -> admin.v1.DeleteUserRequest
-	9,  // 9: admin.v1.User.ListUsers:input_type -> admin.v1.ListUsersRequest
-	11, // 10: admin.v1.User.BatchDeleteUsers:input_type -> admin.v1.BatchDeleteUsersRequest
-	13, // 11: admin.v1.User.ChangeUserStatus:input_type -> admin.v1.ChangeUserStatusRequest
-	15, // 12: admin.v1.User.ResetPassword:input_type -> admin.v1.ResetPasswordRequest
-	17, // 13: admin.v1.User.CheckAccountExists:input_type -> admin.v1.CheckAccountExistsRequest
-	19, // 14: admin.v1.User.GetUserStats:input_type -> admin.v1.GetUserStatsRequest
-	2,  // 15: admin.v1.User.CreateUser:output_type -> admin.v1.CreateUserReply
-	4,  // 16: admin.v1.User.GetUser:output_type -> admin.v1.GetUserReply
-	6,  // 17: admin.v1.User.UpdateUser:output_type -> admin.v1.UpdateUserReply
-	8,  // 18: admin.v1.User.DeleteUser:output_type -> admin.v1.DeleteUserReply
-	10, // 19: admin.v1.User.ListUsers:output_type -> admin.v1.ListUsersReply
-	12, // 20: admin.v1.User.BatchDeleteUsers:output_type -> admin.v1.BatchDeleteUsersReply
-	14, // 21: admin.v1.User.ChangeUserStatus:output_type -> admin.v1.ChangeUserStatusReply
-	16, // 22: admin.v1.User.ResetPassword:output_type -> admin.v1.ResetPasswordReply
-	18, // 23: admin.v1.User.CheckAccountExists:output_type -> admin.v1.CheckAccountExistsReply
-	21, // 24: admin.v1.User.GetUserStats:output_type -> admin.v1.GetUserStatsReply
-	15, // [15:25] is the sub-list for method output_type
-	5,  // [5:15] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	32, // 0: admin.v1.UserInfo.posts:type_name -> admin.v1.PostRef
+	0,  // 1: admin.v1.CreateUserReply.user:type_name -> admin.v1.UserInfo
+	0,  // 2: admin.v1.GetUserReply.user:type_name -> admin.v1.UserInfo
+	0,  // 3: admin.v1.UpdateUserReply.user:type_name -> admin.v1.UserInfo
+	0,  // 4: admin.v1.ListUsersReply.users:type_name -> admin.v1.UserInfo
+	20, // 5: admin.v1.GetUserStatsReply.stats:type_name -> admin.v1.UserStats
+	0,  // 6: admin.v1.ListDeletedUsersReply.users:type_name -> admin.v1.UserInfo
+	1,  // 7: admin.v1.User.CreateUser:input_type -> admin.v1.CreateUserRequest
+	3,  // 8: admin.v1.User.GetUser:input_type -> admin.v1.GetUserRequest
+	5,  // 9: admin.v1.User.UpdateUser:input_type -> admin.v1.UpdateUserRequest
+	7,  // 10: admin.v1.User.DeleteUser:input_type -> admin.v1.DeleteUserRequest
+	9,  // 11: admin.v1.User.ListUsers:input_type -> admin.v1.ListUsersRequest
+	11, // 12: admin.v1.User.BatchDeleteUsers:input_type -> admin.v1.BatchDeleteUsersRequest
+	13, // 13: admin.v1.User.ChangeUserStatus:input_type -> admin.v1.ChangeUserStatusRequest
+	15, // 14: admin.v1.User.ResetPassword:input_type -> admin.v1.ResetPasswordRequest
+	17, // 15: admin.v1.User.CheckAccountExists:input_type -> admin.v1.CheckAccountExistsRequest
+	19, // 16: admin.v1.User.GetUserStats:input_type -> admin.v1.GetUserStatsRequest
+	22, // 17: admin.v1.User.ListDeletedUsers:input_type -> admin.v1.ListDeletedUsersRequest
+	24, // 18: admin.v1.User.RestoreUser:input_type -> admin.v1.RestoreUserRequest
+	28, // 19: admin.v1.User.ReplaceUserPosts:input_type -> admin.v1.ReplaceUserPostsRequest
+	26, // 20: admin.v1.User.PurgeUser:input_type -> admin.v1.PurgeUserRequest
+	30, // 21: admin.v1.User.KickUser:input_type -> admin.v1.KickUserRequest
+	2,  // 22: admin.v1.User.CreateUser:output_type -> admin.v1.CreateUserReply
+	4,  // 23: admin.v1.User.GetUser:output_type -> admin.v1.GetUserReply
+	6,  // 24: admin.v1.User.UpdateUser:output_type -> admin.v1.UpdateUserReply
+	8,  // 25: admin.v1.User.DeleteUser:output_type -> admin.v1.DeleteUserReply
+	10, // 26: admin.v1.User.ListUsers:output_type -> admin.v1.ListUsersReply
+	12, // 27: admin.v1.User.BatchDeleteUsers:output_type -> admin.v1.BatchDeleteUsersReply
+	14, // 28: admin.v1.User.ChangeUserStatus:output_type -> admin.v1.ChangeUserStatusReply
+	16, // 29: admin.v1.User.ResetPassword:output_type -> admin.v1.ResetPasswordReply
+	18, // 30: admin.v1.User.CheckAccountExists:output_type -> admin.v1.CheckAccountExistsReply
+	21, // 31: admin.v1.User.GetUserStats:output_type -> admin.v1.GetUserStatsReply
+	23, // 32: admin.v1.User.ListDeletedUsers:output_type -> admin.v1.ListDeletedUsersReply
+	25, // 33: admin.v1.User.RestoreUser:output_type -> admin.v1.RestoreUserReply
+	29, // 34: admin.v1.User.ReplaceUserPosts:output_type -> admin.v1.ReplaceUserPostsReply
+	27, // 35: admin.v1.User.PurgeUser:output_type -> admin.v1.PurgeUserReply
+	31, // 36: admin.v1.User.KickUser:output_type -> admin.v1.KickUserReply
+	22, // [22:37] is the sub-list for method output_type
+	7,  // [7:22] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_admin_v1_system_user_proto_init() }
@@ -1744,17 +2293,20 @@ func file_admin_v1_system_user_proto_init() {
 	if File_admin_v1_system_user_proto != nil {
 		return
 	}
+	file_admin_v1_annotations_proto_init()
+	file_admin_v1_system_post_proto_init()
 	file_admin_v1_system_user_proto_msgTypes[1].OneofWrappers = []any{}
 	file_admin_v1_system_user_proto_msgTypes[5].OneofWrappers = []any{}
 	file_admin_v1_system_user_proto_msgTypes[9].OneofWrappers = []any{}
 	file_admin_v1_system_user_proto_msgTypes[19].OneofWrappers = []any{}
+	file_admin_v1_system_user_proto_msgTypes[22].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_v1_system_user_proto_rawDesc), len(file_admin_v1_system_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
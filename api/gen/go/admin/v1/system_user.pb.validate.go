@@ -67,8 +67,6 @@ func (m *UserInfo) validate(all bool) error {
 
 	// no validation rules for DeptId
 
-	// no validation rules for PostIds
-
 	// no validation rules for Email
 
 	// no validation rules for Mobile
@@ -83,6 +81,40 @@ func (m *UserInfo) validate(all bool) error {
 
 	// no validation rules for LoginDate
 
+	for idx, item := range m.GetPosts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserInfoValidationError{
+						field:  fmt.Sprintf("Posts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserInfoValidationError{
+						field:  fmt.Sprintf("Posts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserInfoValidationError{
+					field:  fmt.Sprintf("Posts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	// no validation rules for TenantId
 
 	// no validation rules for CreatedAt
@@ -93,6 +125,8 @@ func (m *UserInfo) validate(all bool) error {
 
 	// no validation rules for UpdatedBy
 
+	// no validation rules for DeletedAt
+
 	if len(errors) > 0 {
 		return UserInfoMultiError(errors)
 	}
@@ -225,6 +259,17 @@ func (m *CreateUserRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if len(m.GetPostIds()) > 20 {
+		err := CreateUserRequestValidationError{
+			field:  "PostIds",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if m.Nickname != nil {
 
 		if utf8.RuneCountInString(m.GetNickname()) > 50 {
@@ -259,10 +304,6 @@ func (m *CreateUserRequest) validate(all bool) error {
 		// no validation rules for DeptId
 	}
 
-	if m.PostIds != nil {
-		// no validation rules for PostIds
-	}
-
 	if m.Email != nil {
 
 		if !_CreateUserRequest_Email_Pattern.MatchString(m.GetEmail()) {
@@ -827,6 +868,17 @@ func (m *UpdateUserRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if len(m.GetPostIds()) > 20 {
+		err := UpdateUserRequestValidationError{
+			field:  "PostIds",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if m.Nickname != nil {
 
 		if utf8.RuneCountInString(m.GetNickname()) > 50 {
@@ -861,10 +913,6 @@ func (m *UpdateUserRequest) validate(all bool) error {
 		// no validation rules for DeptId
 	}
 
-	if m.PostIds != nil {
-		// no validation rules for PostIds
-	}
-
 	if m.Email != nil {
 
 		if !_UpdateUserRequest_Email_Pattern.MatchString(m.GetEmail()) {
@@ -2957,3 +3005,1146 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetUserStatsReplyValidationError{}
+
+// Validate checks the field values on ListDeletedUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeletedUsersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeletedUsersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeletedUsersRequestMultiError, or nil if none found.
+func (m *ListDeletedUsersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeletedUsersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Page != nil {
+
+		if m.GetPage() < 1 {
+			err := ListDeletedUsersRequestValidationError{
+				field:  "Page",
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.PageSize != nil {
+
+		if val := m.GetPageSize(); val < 1 || val > 100 {
+			err := ListDeletedUsersRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Account != nil {
+		// no validation rules for Account
+	}
+
+	if len(errors) > 0 {
+		return ListDeletedUsersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeletedUsersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListDeletedUsersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeletedUsersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeletedUsersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeletedUsersRequestMultiError) AllErrors() []error { return m }
+
+// ListDeletedUsersRequestValidationError is the validation error returned by
+// ListDeletedUsersRequest.Validate if the designated constraints aren't met.
+type ListDeletedUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeletedUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeletedUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeletedUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeletedUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeletedUsersRequestValidationError) ErrorName() string {
+	return "ListDeletedUsersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeletedUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeletedUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeletedUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeletedUsersRequestValidationError{}
+
+// Validate checks the field values on ListDeletedUsersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeletedUsersReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeletedUsersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeletedUsersReplyMultiError, or nil if none found.
+func (m *ListDeletedUsersReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeletedUsersReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeletedUsersReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeletedUsersReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeletedUsersReplyValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListDeletedUsersReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeletedUsersReplyMultiError is an error wrapping multiple validation
+// errors returned by ListDeletedUsersReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeletedUsersReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeletedUsersReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeletedUsersReplyMultiError) AllErrors() []error { return m }
+
+// ListDeletedUsersReplyValidationError is the validation error returned by
+// ListDeletedUsersReply.Validate if the designated constraints aren't met.
+type ListDeletedUsersReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeletedUsersReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeletedUsersReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeletedUsersReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeletedUsersReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeletedUsersReplyValidationError) ErrorName() string {
+	return "ListDeletedUsersReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeletedUsersReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeletedUsersReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeletedUsersReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeletedUsersReplyValidationError{}
+
+// Validate checks the field values on RestoreUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreUserRequestMultiError, or nil if none found.
+func (m *RestoreUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := RestoreUserRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreUserRequestMultiError is an error wrapping multiple validation errors
+// returned by RestoreUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RestoreUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreUserRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreUserRequestMultiError) AllErrors() []error { return m }
+
+// RestoreUserRequestValidationError is the validation error returned by
+// RestoreUserRequest.Validate if the designated constraints aren't met.
+type RestoreUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreUserRequestValidationError) ErrorName() string {
+	return "RestoreUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreUserRequestValidationError{}
+
+// Validate checks the field values on RestoreUserReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RestoreUserReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreUserReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreUserReplyMultiError, or nil if none found.
+func (m *RestoreUserReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreUserReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return RestoreUserReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreUserReplyMultiError is an error wrapping multiple validation errors
+// returned by RestoreUserReply.ValidateAll() if the designated constraints
+// aren't met.
+type RestoreUserReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreUserReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreUserReplyMultiError) AllErrors() []error { return m }
+
+// RestoreUserReplyValidationError is the validation error returned by
+// RestoreUserReply.Validate if the designated constraints aren't met.
+type RestoreUserReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreUserReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreUserReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreUserReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreUserReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreUserReplyValidationError) ErrorName() string { return "RestoreUserReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RestoreUserReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreUserReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreUserReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreUserReplyValidationError{}
+
+// Validate checks the field values on PurgeUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PurgeUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeUserRequestMultiError, or nil if none found.
+func (m *PurgeUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := PurgeUserRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PurgeUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeUserRequestMultiError is an error wrapping multiple validation errors
+// returned by PurgeUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PurgeUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeUserRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeUserRequestMultiError) AllErrors() []error { return m }
+
+// PurgeUserRequestValidationError is the validation error returned by
+// PurgeUserRequest.Validate if the designated constraints aren't met.
+type PurgeUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeUserRequestValidationError) ErrorName() string { return "PurgeUserRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PurgeUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeUserRequestValidationError{}
+
+// Validate checks the field values on PurgeUserReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PurgeUserReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeUserReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PurgeUserReplyMultiError,
+// or nil if none found.
+func (m *PurgeUserReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeUserReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return PurgeUserReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeUserReplyMultiError is an error wrapping multiple validation errors
+// returned by PurgeUserReply.ValidateAll() if the designated constraints
+// aren't met.
+type PurgeUserReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeUserReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeUserReplyMultiError) AllErrors() []error { return m }
+
+// PurgeUserReplyValidationError is the validation error returned by
+// PurgeUserReply.Validate if the designated constraints aren't met.
+type PurgeUserReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeUserReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeUserReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeUserReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeUserReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeUserReplyValidationError) ErrorName() string { return "PurgeUserReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PurgeUserReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeUserReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeUserReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeUserReplyValidationError{}
+
+// Validate checks the field values on ReplaceUserPostsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplaceUserPostsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplaceUserPostsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplaceUserPostsRequestMultiError, or nil if none found.
+func (m *ReplaceUserPostsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplaceUserPostsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := ReplaceUserPostsRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetPostIds()) > 20 {
+		err := ReplaceUserPostsRequestValidationError{
+			field:  "PostIds",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReplaceUserPostsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplaceUserPostsRequestMultiError is an error wrapping multiple validation
+// errors returned by ReplaceUserPostsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReplaceUserPostsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplaceUserPostsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplaceUserPostsRequestMultiError) AllErrors() []error { return m }
+
+// ReplaceUserPostsRequestValidationError is the validation error returned by
+// ReplaceUserPostsRequest.Validate if the designated constraints aren't met.
+type ReplaceUserPostsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplaceUserPostsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplaceUserPostsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplaceUserPostsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplaceUserPostsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplaceUserPostsRequestValidationError) ErrorName() string {
+	return "ReplaceUserPostsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplaceUserPostsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplaceUserPostsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplaceUserPostsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplaceUserPostsRequestValidationError{}
+
+// Validate checks the field values on ReplaceUserPostsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplaceUserPostsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplaceUserPostsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplaceUserPostsReplyMultiError, or nil if none found.
+func (m *ReplaceUserPostsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplaceUserPostsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return ReplaceUserPostsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplaceUserPostsReplyMultiError is an error wrapping multiple validation
+// errors returned by ReplaceUserPostsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ReplaceUserPostsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplaceUserPostsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplaceUserPostsReplyMultiError) AllErrors() []error { return m }
+
+// ReplaceUserPostsReplyValidationError is the validation error returned by
+// ReplaceUserPostsReply.Validate if the designated constraints aren't met.
+type ReplaceUserPostsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplaceUserPostsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplaceUserPostsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplaceUserPostsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplaceUserPostsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplaceUserPostsReplyValidationError) ErrorName() string {
+	return "ReplaceUserPostsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplaceUserPostsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplaceUserPostsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplaceUserPostsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplaceUserPostsReplyValidationError{}
+
+// Validate checks the field values on KickUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *KickUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KickUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KickUserRequestMultiError, or nil if none found.
+func (m *KickUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KickUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := KickUserRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return KickUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// KickUserRequestMultiError is an error wrapping multiple validation errors
+// returned by KickUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type KickUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KickUserRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KickUserRequestMultiError) AllErrors() []error { return m }
+
+// KickUserRequestValidationError is the validation error returned by
+// KickUserRequest.Validate if the designated constraints aren't met.
+type KickUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KickUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KickUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KickUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KickUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KickUserRequestValidationError) ErrorName() string { return "KickUserRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KickUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKickUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KickUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KickUserRequestValidationError{}
+
+// Validate checks the field values on KickUserReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *KickUserReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KickUserReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in KickUserReplyMultiError, or
+// nil if none found.
+func (m *KickUserReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KickUserReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return KickUserReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// KickUserReplyMultiError is an error wrapping multiple validation errors
+// returned by KickUserReply.ValidateAll() if the designated constraints
+// aren't met.
+type KickUserReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KickUserReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KickUserReplyMultiError) AllErrors() []error { return m }
+
+// KickUserReplyValidationError is the validation error returned by
+// KickUserReply.Validate if the designated constraints aren't met.
+type KickUserReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KickUserReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KickUserReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KickUserReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KickUserReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KickUserReplyValidationError) ErrorName() string { return "KickUserReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KickUserReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKickUserReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KickUserReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KickUserReplyValidationError{}
@@ -29,6 +29,11 @@ const (
 	User_ResetPassword_FullMethodName      = "/admin.v1.User/ResetPassword"
 	User_CheckAccountExists_FullMethodName = "/admin.v1.User/CheckAccountExists"
 	User_GetUserStats_FullMethodName       = "/admin.v1.User/GetUserStats"
+	User_ListDeletedUsers_FullMethodName   = "/admin.v1.User/ListDeletedUsers"
+	User_RestoreUser_FullMethodName        = "/admin.v1.User/RestoreUser"
+	User_ReplaceUserPosts_FullMethodName   = "/admin.v1.User/ReplaceUserPosts"
+	User_PurgeUser_FullMethodName          = "/admin.v1.User/PurgeUser"
+	User_KickUser_FullMethodName           = "/admin.v1.User/KickUser"
 )
 
 // UserClient is the client API for User service.
@@ -57,6 +62,16 @@ type UserClient interface {
 	CheckAccountExists(ctx context.Context, in *CheckAccountExistsRequest, opts ...grpc.CallOption) (*CheckAccountExistsReply, error)
 	// 获取用户统计信息
 	GetUserStats(ctx context.Context, in *GetUserStatsRequest, opts ...grpc.CallOption) (*GetUserStatsReply, error)
+	// 已删除用户列表
+	ListDeletedUsers(ctx context.Context, in *ListDeletedUsersRequest, opts ...grpc.CallOption) (*ListDeletedUsersReply, error)
+	// 恢复已删除用户
+	RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserReply, error)
+	// 替换用户的全部岗位，post_ids 为空时清空岗位
+	ReplaceUserPosts(ctx context.Context, in *ReplaceUserPostsRequest, opts ...grpc.CallOption) (*ReplaceUserPostsReply, error)
+	// 彻底删除用户
+	PurgeUser(ctx context.Context, in *PurgeUserRequest, opts ...grpc.CallOption) (*PurgeUserReply, error)
+	// 强制下线用户，吊销用户全部未过期的令牌
+	KickUser(ctx context.Context, in *KickUserRequest, opts ...grpc.CallOption) (*KickUserReply, error)
 }
 
 type userClient struct {
@@ -167,6 +182,56 @@ func (c *userClient) GetUserStats(ctx context.Context, in *GetUserStatsRequest,
 	return out, nil
 }
 
+func (c *userClient) ListDeletedUsers(ctx context.Context, in *ListDeletedUsersRequest, opts ...grpc.CallOption) (*ListDeletedUsersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedUsersReply)
+	err := c.cc.Invoke(ctx, User_ListDeletedUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreUserReply)
+	err := c.cc.Invoke(ctx, User_RestoreUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ReplaceUserPosts(ctx context.Context, in *ReplaceUserPostsRequest, opts ...grpc.CallOption) (*ReplaceUserPostsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplaceUserPostsReply)
+	err := c.cc.Invoke(ctx, User_ReplaceUserPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) PurgeUser(ctx context.Context, in *PurgeUserRequest, opts ...grpc.CallOption) (*PurgeUserReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeUserReply)
+	err := c.cc.Invoke(ctx, User_PurgeUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) KickUser(ctx context.Context, in *KickUserRequest, opts ...grpc.CallOption) (*KickUserReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KickUserReply)
+	err := c.cc.Invoke(ctx, User_KickUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServer is the server API for User service.
 // All implementations must embed UnimplementedUserServer
 // for forward compatibility.
@@ -193,6 +258,16 @@ type UserServer interface {
 	CheckAccountExists(context.Context, *CheckAccountExistsRequest) (*CheckAccountExistsReply, error)
 	// 获取用户统计信息
 	GetUserStats(context.Context, *GetUserStatsRequest) (*GetUserStatsReply, error)
+	// 已删除用户列表
+	ListDeletedUsers(context.Context, *ListDeletedUsersRequest) (*ListDeletedUsersReply, error)
+	// 恢复已删除用户
+	RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserReply, error)
+	// 替换用户的全部岗位，post_ids 为空时清空岗位
+	ReplaceUserPosts(context.Context, *ReplaceUserPostsRequest) (*ReplaceUserPostsReply, error)
+	// 彻底删除用户
+	PurgeUser(context.Context, *PurgeUserRequest) (*PurgeUserReply, error)
+	// 强制下线用户，吊销用户全部未过期的令牌
+	KickUser(context.Context, *KickUserRequest) (*KickUserReply, error)
 	mustEmbedUnimplementedUserServer()
 }
 
@@ -233,6 +308,21 @@ func (UnimplementedUserServer) CheckAccountExists(context.Context, *CheckAccount
 func (UnimplementedUserServer) GetUserStats(context.Context, *GetUserStatsRequest) (*GetUserStatsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserStats not implemented")
 }
+func (UnimplementedUserServer) ListDeletedUsers(context.Context, *ListDeletedUsersRequest) (*ListDeletedUsersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedUsers not implemented")
+}
+func (UnimplementedUserServer) RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreUser not implemented")
+}
+func (UnimplementedUserServer) ReplaceUserPosts(context.Context, *ReplaceUserPostsRequest) (*ReplaceUserPostsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceUserPosts not implemented")
+}
+func (UnimplementedUserServer) PurgeUser(context.Context, *PurgeUserRequest) (*PurgeUserReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeUser not implemented")
+}
+func (UnimplementedUserServer) KickUser(context.Context, *KickUserRequest) (*KickUserReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickUser not implemented")
+}
 func (UnimplementedUserServer) mustEmbedUnimplementedUserServer() {}
 func (UnimplementedUserServer) testEmbeddedByValue()              {}
 
@@ -434,6 +524,96 @@ func _User_GetUserStats_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_ListDeletedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListDeletedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ListDeletedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListDeletedUsers(ctx, req.(*ListDeletedUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RestoreUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RestoreUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RestoreUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RestoreUser(ctx, req.(*RestoreUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ReplaceUserPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceUserPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ReplaceUserPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ReplaceUserPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ReplaceUserPosts(ctx, req.(*ReplaceUserPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_PurgeUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).PurgeUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_PurgeUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).PurgeUser(ctx, req.(*PurgeUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_KickUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).KickUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_KickUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).KickUser(ctx, req.(*KickUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // User_ServiceDesc is the grpc.ServiceDesc for User service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -481,6 +661,26 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserStats",
 			Handler:    _User_GetUserStats_Handler,
 		},
+		{
+			MethodName: "ListDeletedUsers",
+			Handler:    _User_ListDeletedUsers_Handler,
+		},
+		{
+			MethodName: "RestoreUser",
+			Handler:    _User_RestoreUser_Handler,
+		},
+		{
+			MethodName: "ReplaceUserPosts",
+			Handler:    _User_ReplaceUserPosts_Handler,
+		},
+		{
+			MethodName: "PurgeUser",
+			Handler:    _User_PurgeUser_Handler,
+		},
+		{
+			MethodName: "KickUser",
+			Handler:    _User_KickUser_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/v1/system_user.proto",
@@ -26,8 +26,13 @@ const OperationUserCreateUser = "/admin.v1.User/CreateUser"
 const OperationUserDeleteUser = "/admin.v1.User/DeleteUser"
 const OperationUserGetUser = "/admin.v1.User/GetUser"
 const OperationUserGetUserStats = "/admin.v1.User/GetUserStats"
+const OperationUserKickUser = "/admin.v1.User/KickUser"
+const OperationUserListDeletedUsers = "/admin.v1.User/ListDeletedUsers"
 const OperationUserListUsers = "/admin.v1.User/ListUsers"
+const OperationUserPurgeUser = "/admin.v1.User/PurgeUser"
+const OperationUserReplaceUserPosts = "/admin.v1.User/ReplaceUserPosts"
 const OperationUserResetPassword = "/admin.v1.User/ResetPassword"
+const OperationUserRestoreUser = "/admin.v1.User/RestoreUser"
 const OperationUserUpdateUser = "/admin.v1.User/UpdateUser"
 
 type UserHTTPServer interface {
@@ -45,10 +50,20 @@ type UserHTTPServer interface {
 	GetUser(context.Context, *GetUserRequest) (*GetUserReply, error)
 	// GetUserStats 获取用户统计信息
 	GetUserStats(context.Context, *GetUserStatsRequest) (*GetUserStatsReply, error)
+	// KickUser 强制下线用户，吊销用户全部未过期的令牌
+	KickUser(context.Context, *KickUserRequest) (*KickUserReply, error)
+	// ListDeletedUsers 已删除用户列表
+	ListDeletedUsers(context.Context, *ListDeletedUsersRequest) (*ListDeletedUsersReply, error)
 	// ListUsers 用户列表
 	ListUsers(context.Context, *ListUsersRequest) (*ListUsersReply, error)
+	// PurgeUser 彻底删除用户
+	PurgeUser(context.Context, *PurgeUserRequest) (*PurgeUserReply, error)
+	// ReplaceUserPosts 替换用户的全部岗位，post_ids 为空时清空岗位
+	ReplaceUserPosts(context.Context, *ReplaceUserPostsRequest) (*ReplaceUserPostsReply, error)
 	// ResetPassword 重置用户密码
 	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordReply, error)
+	// RestoreUser 恢复已删除用户
+	RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserReply, error)
 	// UpdateUser 更新用户信息
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserReply, error)
 }
@@ -65,6 +80,11 @@ func RegisterUserHTTPServer(s *http.Server, srv UserHTTPServer) {
 	r.PATCH("/admin/v1/users/{id}/password", _User_ResetPassword0_HTTP_Handler(srv))
 	r.GET("/admin/v1/users/check-account/{account}", _User_CheckAccountExists0_HTTP_Handler(srv))
 	r.GET("/admin/v1/users/stats", _User_GetUserStats0_HTTP_Handler(srv))
+	r.GET("/admin/v1/deleted-users", _User_ListDeletedUsers0_HTTP_Handler(srv))
+	r.POST("/admin/v1/deleted-users/{id}/restore", _User_RestoreUser0_HTTP_Handler(srv))
+	r.PUT("/admin/v1/users/{id}/posts", _User_ReplaceUserPosts0_HTTP_Handler(srv))
+	r.DELETE("/admin/v1/deleted-users/{id}", _User_PurgeUser0_HTTP_Handler(srv))
+	r.POST("/admin/v1/users/{id}/kick", _User_KickUser0_HTTP_Handler(srv))
 }
 
 func _User_CreateUser0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
@@ -290,6 +310,122 @@ func _User_GetUserStats0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context)
 	}
 }
 
+func _User_ListDeletedUsers0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListDeletedUsersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserListDeletedUsers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDeletedUsers(ctx, req.(*ListDeletedUsersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListDeletedUsersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_RestoreUser0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestoreUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserRestoreUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreUser(ctx, req.(*RestoreUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RestoreUserReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ReplaceUserPosts0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReplaceUserPostsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserReplaceUserPosts)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReplaceUserPosts(ctx, req.(*ReplaceUserPostsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReplaceUserPostsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_PurgeUser0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PurgeUserRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserPurgeUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgeUser(ctx, req.(*PurgeUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PurgeUserReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_KickUser0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in KickUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserKickUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.KickUser(ctx, req.(*KickUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*KickUserReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type UserHTTPClient interface {
 	BatchDeleteUsers(ctx context.Context, req *BatchDeleteUsersRequest, opts ...http.CallOption) (rsp *BatchDeleteUsersReply, err error)
 	ChangeUserStatus(ctx context.Context, req *ChangeUserStatusRequest, opts ...http.CallOption) (rsp *ChangeUserStatusReply, err error)
@@ -298,8 +434,13 @@ type UserHTTPClient interface {
 	DeleteUser(ctx context.Context, req *DeleteUserRequest, opts ...http.CallOption) (rsp *DeleteUserReply, err error)
 	GetUser(ctx context.Context, req *GetUserRequest, opts ...http.CallOption) (rsp *GetUserReply, err error)
 	GetUserStats(ctx context.Context, req *GetUserStatsRequest, opts ...http.CallOption) (rsp *GetUserStatsReply, err error)
+	KickUser(ctx context.Context, req *KickUserRequest, opts ...http.CallOption) (rsp *KickUserReply, err error)
+	ListDeletedUsers(ctx context.Context, req *ListDeletedUsersRequest, opts ...http.CallOption) (rsp *ListDeletedUsersReply, err error)
 	ListUsers(ctx context.Context, req *ListUsersRequest, opts ...http.CallOption) (rsp *ListUsersReply, err error)
+	PurgeUser(ctx context.Context, req *PurgeUserRequest, opts ...http.CallOption) (rsp *PurgeUserReply, err error)
+	ReplaceUserPosts(ctx context.Context, req *ReplaceUserPostsRequest, opts ...http.CallOption) (rsp *ReplaceUserPostsReply, err error)
 	ResetPassword(ctx context.Context, req *ResetPasswordRequest, opts ...http.CallOption) (rsp *ResetPasswordReply, err error)
+	RestoreUser(ctx context.Context, req *RestoreUserRequest, opts ...http.CallOption) (rsp *RestoreUserReply, err error)
 	UpdateUser(ctx context.Context, req *UpdateUserRequest, opts ...http.CallOption) (rsp *UpdateUserReply, err error)
 }
 
@@ -402,6 +543,32 @@ func (c *UserHTTPClientImpl) GetUserStats(ctx context.Context, in *GetUserStatsR
 	return &out, nil
 }
 
+func (c *UserHTTPClientImpl) KickUser(ctx context.Context, in *KickUserRequest, opts ...http.CallOption) (*KickUserReply, error) {
+	var out KickUserReply
+	pattern := "/admin/v1/users/{id}/kick"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserKickUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) ListDeletedUsers(ctx context.Context, in *ListDeletedUsersRequest, opts ...http.CallOption) (*ListDeletedUsersReply, error) {
+	var out ListDeletedUsersReply
+	pattern := "/admin/v1/deleted-users"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserListDeletedUsers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserHTTPClientImpl) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...http.CallOption) (*ListUsersReply, error) {
 	var out ListUsersReply
 	pattern := "/admin/v1/users"
@@ -415,6 +582,32 @@ func (c *UserHTTPClientImpl) ListUsers(ctx context.Context, in *ListUsersRequest
 	return &out, nil
 }
 
+func (c *UserHTTPClientImpl) PurgeUser(ctx context.Context, in *PurgeUserRequest, opts ...http.CallOption) (*PurgeUserReply, error) {
+	var out PurgeUserReply
+	pattern := "/admin/v1/deleted-users/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserPurgeUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) ReplaceUserPosts(ctx context.Context, in *ReplaceUserPostsRequest, opts ...http.CallOption) (*ReplaceUserPostsReply, error) {
+	var out ReplaceUserPostsReply
+	pattern := "/admin/v1/users/{id}/posts"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserReplaceUserPosts))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserHTTPClientImpl) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...http.CallOption) (*ResetPasswordReply, error) {
 	var out ResetPasswordReply
 	pattern := "/admin/v1/users/{id}/password"
@@ -428,6 +621,19 @@ func (c *UserHTTPClientImpl) ResetPassword(ctx context.Context, in *ResetPasswor
 	return &out, nil
 }
 
+func (c *UserHTTPClientImpl) RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...http.CallOption) (*RestoreUserReply, error) {
+	var out RestoreUserReply
+	pattern := "/admin/v1/deleted-users/{id}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserRestoreUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserHTTPClientImpl) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...http.CallOption) (*UpdateUserReply, error) {
 	var out UpdateUserReply
 	pattern := "/admin/v1/users/{id}"
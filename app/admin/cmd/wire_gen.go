@@ -9,13 +9,41 @@ package main
 import (
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
+	auth2 "qn-base/app/admin/internal/biz/auth"
+	permission2 "qn-base/app/admin/internal/biz/permission"
+	policy2 "qn-base/app/admin/internal/biz/policy"
+	systemdept2 "qn-base/app/admin/internal/biz/systemdept"
+	systemloginlog2 "qn-base/app/admin/internal/biz/systemloginlog"
+	systemmenu2 "qn-base/app/admin/internal/biz/systemmenu"
+	systempost2 "qn-base/app/admin/internal/biz/systempost"
+	systemrole2 "qn-base/app/admin/internal/biz/systemrole"
+	systemtenant2 "qn-base/app/admin/internal/biz/systemtenant"
 	systemuser2 "qn-base/app/admin/internal/biz/systemuser"
 	"qn-base/app/admin/internal/conf"
+	"qn-base/app/admin/internal/data/auth"
 	"qn-base/app/admin/internal/data/data"
 	"qn-base/app/admin/internal/data/db"
 	"qn-base/app/admin/internal/data/idgen"
+	"qn-base/app/admin/internal/data/permission"
+	"qn-base/app/admin/internal/data/policy"
+	"qn-base/app/admin/internal/data/rdb"
+	"qn-base/app/admin/internal/data/systemdept"
+	"qn-base/app/admin/internal/data/systemloginlog"
+	"qn-base/app/admin/internal/data/systemmenu"
+	"qn-base/app/admin/internal/data/systempost"
+	"qn-base/app/admin/internal/data/systemrole"
+	"qn-base/app/admin/internal/data/systemtenant"
 	"qn-base/app/admin/internal/data/systemuser"
 	"qn-base/app/admin/internal/server"
+	auth3 "qn-base/app/admin/internal/service/auth"
+	permission3 "qn-base/app/admin/internal/service/permission"
+	policy3 "qn-base/app/admin/internal/service/policy"
+	systemdept3 "qn-base/app/admin/internal/service/systemdept"
+	systemloginlog3 "qn-base/app/admin/internal/service/systemloginlog"
+	systemmenu3 "qn-base/app/admin/internal/service/systemmenu"
+	systempost3 "qn-base/app/admin/internal/service/systempost"
+	systemrole3 "qn-base/app/admin/internal/service/systemrole"
+	systemtenant3 "qn-base/app/admin/internal/service/systemtenant"
 	systemuser3 "qn-base/app/admin/internal/service/systemuser"
 )
 
@@ -28,22 +56,87 @@ import (
 // wireApp init kratos application.
 func wireApp(bootstrap *conf.Bootstrap, logger log.Logger) (*kratos.App, func(), error) {
 	database := db.NewDB(bootstrap, logger)
-	dataData, cleanup, err := data.NewData(logger, database)
+	client, cleanup, err := rdb.NewClient(bootstrap, logger)
 	if err != nil {
 		return nil, nil, err
 	}
-	idGenerator, err := idgen.NewIDGenerator(logger)
+	idGenerator, cleanup2, err := idgen.NewIDGenerator(bootstrap, client, logger)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
 	}
-	systemUserRepo := systemuser.NewSystemUserRepo(dataData, idGenerator, logger)
-	userUsecase := systemuser2.NewUserUsecase(systemUserRepo, logger)
+	dataData, cleanup3, err := data.NewData(logger, database, client, idGenerator)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	transaction := data.NewTransaction(dataData)
+	systemUserRepo := systemuser.NewSystemUserRepo(dataData, logger)
+	systemDeptRepo := systemdept.NewSystemDeptRepo(dataData, logger)
+	systemPostRepo := systempost.NewSystemPostRepo(dataData, logger)
+	permissionRepo := permission.NewPermissionRepo(dataData, logger)
+	userMFARepo := auth.NewUserMFARepo(dataData, logger)
+	systemTenantRepo := systemtenant.NewSystemTenantRepo(dataData, logger)
+	systemTenantPackageRepo := systemtenant.NewSystemTenantPackageRepo(dataData, logger)
+	systemRoleRepo := systemrole.NewSystemRoleRepo(dataData, logger)
+	systemMenuRepo := systemmenu.NewSystemMenuRepo(dataData, logger)
+	rolePermissionLoader := permission2.NewRolePermissionLoader(systemMenuRepo)
+	adapter := policy.NewAdapter(dataData)
+	syncedEnforcer, cleanup4, err := policy.NewEnforcer(bootstrap, adapter, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	policyRepo := policy.NewPolicyRepo(syncedEnforcer, logger)
+	policyUsecase := policy2.NewPolicyUsecase(policyRepo, logger)
+	authorizer, err := server.NewAuthorizer(rolePermissionLoader, policyUsecase)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	tenantUsecase := systemtenant2.NewTenantUsecase(transaction, systemTenantRepo, systemTenantPackageRepo, systemUserRepo, systemRoleRepo, permissionRepo, authorizer, logger)
+	accountQuota := systemtenant2.NewAccountQuota(tenantUsecase)
+	revocationStore := auth.NewRevocationStore(client)
+	userUsecase := systemuser2.NewUserUsecase(transaction, systemUserRepo, systemDeptRepo, systemPostRepo, permissionRepo, userMFARepo, accountQuota, revocationStore, logger)
 	userService := systemuser3.NewUserService(logger, userUsecase)
-	grpcServer := server.NewGRPCServer(bootstrap, userService, logger)
-	httpServer := server.NewHTTPServer(bootstrap, userService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	systemLoginLogRepo := systemloginlog.NewSystemLoginLogRepo(dataData, logger)
+	loginLogUsecase := systemloginlog2.NewLoginLogUsecase(bootstrap, systemLoginLogRepo, logger)
+	sessionStore := auth.NewSessionStore(client)
+	lockoutRepo := auth.NewLockoutRepo(client)
+	authUsecase := auth2.NewAuthUsecase(bootstrap, systemUserRepo, permissionRepo, systemRoleRepo, tenantUsecase, loginLogUsecase, revocationStore, sessionStore, lockoutRepo, userMFARepo, logger)
+	authService := auth3.NewAuthService(logger, authUsecase)
+	roleUsecase := systemrole2.NewRoleUsecase(systemRoleRepo, authorizer, logger)
+	roleService := systemrole3.NewRoleService(logger, roleUsecase)
+	menuUsecase := systemmenu2.NewMenuUsecase(systemMenuRepo, logger)
+	menuService := systemmenu3.NewMenuService(logger, menuUsecase)
+	tenantMenus := systemtenant2.NewTenantMenus(tenantUsecase)
+	permissionUsecase := permission2.NewPermissionUsecase(transaction, permissionRepo, systemUserRepo, systemRoleRepo, systemMenuRepo, systemDeptRepo, tenantMenus, authorizer, logger)
+	permissionService := permission3.NewPermissionService(logger, permissionUsecase)
+	policyService := policy3.NewPolicyService(logger, policyUsecase)
+	deptUsecase := systemdept2.NewDeptUsecase(transaction, systemDeptRepo, logger)
+	deptService := systemdept3.NewDeptService(logger, deptUsecase)
+	postUsecase := systempost2.NewPostUsecase(systemPostRepo, logger)
+	postService := systempost3.NewPostService(logger, postUsecase)
+	tenantService := systemtenant3.NewTenantService(logger, tenantUsecase)
+	tenantPackageUsecase := systemtenant2.NewTenantPackageUsecase(transaction, systemTenantPackageRepo, systemTenantRepo, systemMenuRepo, systemRoleRepo, permissionRepo, authorizer, logger)
+	tenantPackageService := systemtenant3.NewTenantPackageService(logger, tenantPackageUsecase)
+	loginLogService := systemloginlog3.NewLoginLogService(logger, loginLogUsecase)
+	dataScopeResolver := server.NewDataScopeResolver(permissionUsecase)
+	tenantResolver := server.NewTenantResolver(tenantUsecase)
+	grpcServer := server.NewGRPCServer(bootstrap, userService, authService, roleService, menuService, permissionService, policyService, deptService, postService, tenantService, tenantPackageService, loginLogService, authorizer, dataScopeResolver, tenantResolver, revocationStore, sessionStore, logger)
+	httpServer := server.NewHTTPServer(bootstrap, userService, authService, roleService, menuService, permissionService, policyService, deptService, postService, tenantService, tenantPackageService, loginLogService, authorizer, dataScopeResolver, tenantResolver, revocationStore, sessionStore, logger)
+	jobServer := server.NewJobServer(bootstrap, loginLogUsecase, logger)
+	app := newApp(logger, grpcServer, httpServer, jobServer)
 	return app, func() {
+		cleanup4()
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -0,0 +1,790 @@
+package auth_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/auth"
+	authmocks "qn-base/app/admin/internal/biz/auth/mocks"
+	permissionmocks "qn-base/app/admin/internal/biz/permission/mocks"
+	"qn-base/app/admin/internal/biz/systemloginlog"
+	loginlogmocks "qn-base/app/admin/internal/biz/systemloginlog/mocks"
+	"qn-base/app/admin/internal/biz/systemrole"
+	rolemocks "qn-base/app/admin/internal/biz/systemrole/mocks"
+	"qn-base/app/admin/internal/biz/systemtenant"
+	tenantmocks "qn-base/app/admin/internal/biz/systemtenant/mocks"
+	"qn-base/app/admin/internal/biz/systemuser"
+	"qn-base/app/admin/internal/biz/systemuser/mocks"
+	"qn-base/app/admin/internal/conf"
+	pkgAuth "qn-base/pkg/auth"
+	"qn-base/pkg/lang/ptr"
+	"qn-base/pkg/util/pswd"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	jwtV5 "github.com/golang-jwt/jwt/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestBootstrap() *conf.Bootstrap {
+	return &conf.Bootstrap{
+		Jwt: &conf.Jwt{
+			System: &conf.Jwt_Param{
+				Secret:        "test-secret",
+				Expire:        3600,
+				RefreshExpire: 7200,
+			},
+		},
+	}
+}
+
+// newNoLockout returns a LockoutRepo that never locks.
+func newNoLockout(ctrl *gomock.Controller) *authmocks.MockLockoutRepo {
+	m := authmocks.NewMockLockoutRepo(ctrl)
+	m.EXPECT().LockedFor(gomock.Any(), gomock.Any()).Return(time.Duration(0), nil).AnyTimes()
+	m.EXPECT().Fail(gomock.Any(), gomock.Any(), gomock.Any()).Return(time.Duration(0), nil).AnyTimes()
+	m.EXPECT().Reset(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return m
+}
+
+// newNoMFA returns a UserMFARepo in which no user has enrolled.
+func newNoMFA(ctrl *gomock.Controller) *authmocks.MockUserMFARepo {
+	m := authmocks.NewMockUserMFARepo(ctrl)
+	m.EXPECT().FindByUserID(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	return m
+}
+
+// expectLoginLog expects a login log of the result recorded in tenant1.
+func expectLoginLog(t *testing.T, m *loginlogmocks.MockLoginLogUsecase, userID string, status int8, reason string) {
+	m.EXPECT().
+		RecordLoginLog(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, l *systemloginlog.SystemLoginLog) error {
+			assert.Equal(t, systemloginlog.EventLogin, ptr.From(l.Event))
+			assert.Equal(t, "tenant1", ptr.From(l.TenantID))
+			assert.Equal(t, userID, ptr.From(l.UserID))
+			assert.Equal(t, status, ptr.From(l.Status))
+			assert.Equal(t, reason, ptr.From(l.Reason))
+			return nil
+		})
+}
+
+func TestAuthUsecase_Login(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemUserRepo(ctrl)
+	mockPermissionRepo := permissionmocks.NewMockPermissionRepo(ctrl)
+	mockRoleRepo := rolemocks.NewMockSystemRoleRepo(ctrl)
+	mockTenant := tenantmocks.NewMockTenantUsecase(ctrl)
+	mockLoginLog := loginlogmocks.NewMockLoginLogUsecase(ctrl)
+	logger := log.DefaultLogger
+	sessions := pkgAuth.NewMemorySessionStore()
+	mockTenant.EXPECT().GetTenant(gomock.Any(), gomock.Any()).Return(&systemtenant.SystemTenant{}, nil).AnyTimes()
+	uc := auth.NewAuthUsecase(newTestBootstrap(), mockRepo, mockPermissionRepo, mockRoleRepo, mockTenant, mockLoginLog, pkgAuth.NewMemoryRevocationStore(), sessions, newNoLockout(ctrl), newNoMFA(ctrl), logger)
+
+	ctx := context.Background()
+	// 登录时在全部租户中查找用户，之后的操作限定在用户的租户内
+	crossCtx := pkgAuth.CrossTenant(systemuser.WithCredentials(ctx))
+	tenantCtx := pkgAuth.WithTenant(ctx, "tenant1")
+
+	hashedPassword, err := pswd.HashPassword("password123")
+	assert.NoError(t, err)
+
+	t.Run("成功登录", func(t *testing.T) {
+		user := &systemuser.SystemUser{
+			ID:       ptr.Of("user123"),
+			Account:  ptr.Of("testuser"),
+			Password: ptr.Of(hashedPassword),
+			Status:   ptr.Of(int8(1)),
+			TenantID: ptr.Of("tenant1"),
+		}
+
+		// Mock 期望
+		mockRepo.EXPECT().
+			FindByUsername(crossCtx, "testuser").
+			Return(user, nil)
+
+		mockTenant.EXPECT().
+			CheckTenant(tenantCtx, "tenant1").
+			Return(nil)
+
+		mockPermissionRepo.EXPECT().
+			ListUserRoleIDs(tenantCtx, "user123").
+			Return([]string{"role1", "role2"}, nil)
+
+		mockRoleRepo.EXPECT().
+			ListByIDs(tenantCtx, []string{"role1", "role2"}).
+			Return([]*systemrole.SystemRole{
+				{ID: ptr.Of("role1"), Code: ptr.Of("operator"), Status: ptr.Of(int8(1))},
+				{ID: ptr.Of("role2"), Code: ptr.Of("auditor"), Status: ptr.Of(int8(0))},
+			}, nil)
+
+		mockRepo.EXPECT().
+			Update(tenantCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, u *systemuser.SystemUser) (*systemuser.SystemUser, error) {
+				assert.Equal(t, "user123", *u.ID)
+				assert.Equal(t, "127.0.0.1", *u.LoginIP)
+				assert.NotNil(t, u.LoginDate)
+				return u, nil
+			})
+
+		mockLoginLog.EXPECT().
+			RecordLoginLog(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, l *systemloginlog.SystemLoginLog) error {
+				assert.Equal(t, "tenant1", ptr.From(l.TenantID))
+				assert.Equal(t, "user123", ptr.From(l.UserID))
+				assert.Equal(t, "testuser", ptr.From(l.Account))
+				assert.Equal(t, systemloginlog.StatusSuccess, ptr.From(l.Status))
+				assert.Nil(t, l.Reason)
+				assert.Equal(t, "127.0.0.1", ptr.From(l.IP))
+				assert.Equal(t, "Mozilla/5.0", ptr.From(l.UserAgent))
+				return nil
+			})
+
+		// 执行测试
+		result, err := uc.Login(ctx, &auth.LoginRequest{
+			Account:   "testuser",
+			Password:  "password123",
+			ClientIP:  "127.0.0.1",
+			UserAgent: "Mozilla/5.0",
+		})
+
+		// 断言
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.NotEmpty(t, result.Token.AccessToken)
+		assert.NotEmpty(t, result.Token.RefreshToken)
+		assert.Equal(t, auth.TokenTypeBearer, result.Token.TokenType)
+		assert.True(t, result.Token.RefreshExpiresAt.After(result.Token.ExpiresAt))
+		assert.Nil(t, result.User.Password)
+
+		// 令牌中只携带启用角色的编码
+		claims := &pkgAuth.Claims{}
+		_, _, err = jwtV5.NewParser().ParseUnverified(result.Token.AccessToken, claims)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"operator"}, claims.Roles)
+
+		// 访问令牌和刷新令牌共用令牌ID
+		refreshClaims := &pkgAuth.Claims{}
+		_, _, err = jwtV5.NewParser().ParseUnverified(result.Token.RefreshToken, refreshClaims)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, claims.ID)
+		assert.Equal(t, claims.ID, refreshClaims.ID)
+
+		// 登记在线会话
+		session, err := sessions.Get(ctx, claims.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "user123", session.UserID)
+		assert.Equal(t, "testuser", session.Account)
+		assert.Equal(t, "tenant1", session.TenantID)
+		assert.Equal(t, pkgAuth.ClientTypeSystem, session.ClientType)
+		assert.Equal(t, "127.0.0.1", session.IP)
+		assert.Equal(t, "Mozilla/5.0", session.UserAgent)
+		assert.Equal(t, result.Token.RefreshExpiresAt, session.ExpiresAt)
+	})
+
+	t.Run("密码错误", func(t *testing.T) {
+		user := &systemuser.SystemUser{
+			ID:       ptr.Of("user123"),
+			Account:  ptr.Of("testuser"),
+			Password: ptr.Of(hashedPassword),
+			Status:   ptr.Of(int8(1)),
+			TenantID: ptr.Of("tenant1"),
+		}
+
+		// Mock 期望
+		mockRepo.EXPECT().
+			FindByUsername(crossCtx, "testuser").
+			Return(user, nil)
+		expectLoginLog(t, mockLoginLog, "user123", systemloginlog.StatusFailed, "INCORRECT_PASSWORD")
+
+		// 执行测试
+		result, err := uc.Login(ctx, &auth.LoginRequest{
+			Account:  "testuser",
+			Password: "wrongpassword",
+		})
+
+		// 断言
+		assert.Nil(t, result)
+		assert.True(t, v1.IsIncorrectPassword(err))
+	})
+
+	t.Run("用户不存在", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().
+			FindByUsername(crossCtx, "nonexistent").
+			Return(nil, nil)
+
+		// 执行测试
+		result, err := uc.Login(ctx, &auth.LoginRequest{
+			Account:  "nonexistent",
+			Password: "password123",
+		})
+
+		// 断言
+		assert.Nil(t, result)
+		assert.True(t, v1.IsIncorrectPassword(err))
+	})
+
+	t.Run("多个租户存在同名账号", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().
+			FindByUsername(crossCtx, "shared").
+			Return(nil, systemuser.ErrUserAmbiguous)
+
+		// 执行测试
+		result, err := uc.Login(ctx, &auth.LoginRequest{
+			Account:  "shared",
+			Password: "password123",
+		})
+
+		// 断言
+		assert.Nil(t, result)
+		assert.True(t, v1.IsIncorrectPassword(err))
+	})
+
+	t.Run("用户已停用", func(t *testing.T) {
+		user := &systemuser.SystemUser{
+			ID:       ptr.Of("user123"),
+			Account:  ptr.Of("testuser"),
+			Password: ptr.Of(hashedPassword),
+			Status:   ptr.Of(int8(0)),
+		}
+
+		// Mock 期望
+		mockRepo.EXPECT().
+			FindByUsername(crossCtx, "testuser").
+			Return(user, nil)
+
+		// 执行测试
+		result, err := uc.Login(ctx, &auth.LoginRequest{
+			Account:  "testuser",
+			Password: "password123",
+		})
+
+		// 断言
+		assert.Nil(t, result)
+		assert.True(t, v1.IsUserFreeze(err))
+	})
+
+	t.Run("租户已过期", func(t *testing.T) {
+		user := &systemuser.SystemUser{
+			ID:       ptr.Of("user123"),
+			Account:  ptr.Of("testuser"),
+			Password: ptr.Of(hashedPassword),
+			Status:   ptr.Of(int8(1)),
+			TenantID: ptr.Of("tenant1"),
+		}
+
+		// Mock 期望
+		mockRepo.EXPECT().
+			FindByUsername(crossCtx, "testuser").
+			Return(user, nil)
+
+		mockTenant.EXPECT().
+			CheckTenant(tenantCtx, "tenant1").
+			Return(systemtenant.ErrTenantExpired)
+		expectLoginLog(t, mockLoginLog, "user123", systemloginlog.StatusFailed, "TENANT_EXPIRED")
+
+		// 执行测试
+		result, err := uc.Login(ctx, &auth.LoginRequest{
+			Account:  "testuser",
+			Password: "password123",
+		})
+
+		// 断言
+		assert.Nil(t, result)
+		assert.True(t, v1.IsTenantExpired(err))
+	})
+
+	t.Run("租户已停用", func(t *testing.T) {
+		user := &systemuser.SystemUser{
+			ID:       ptr.Of("user123"),
+			Account:  ptr.Of("testuser"),
+			Password: ptr.Of(hashedPassword),
+			Status:   ptr.Of(int8(1)),
+			TenantID: ptr.Of("tenant1"),
+		}
+
+		// Mock 期望
+		mockRepo.EXPECT().
+			FindByUsername(crossCtx, "testuser").
+			Return(user, nil)
+
+		mockTenant.EXPECT().
+			CheckTenant(tenantCtx, "tenant1").
+			Return(systemtenant.ErrTenantDisabled)
+		expectLoginLog(t, mockLoginLog, "user123", systemloginlog.StatusFailed, "TENANT_DISABLED")
+
+		// 执行测试
+		result, err := uc.Login(ctx, &auth.LoginRequest{
+			Account:  "testuser",
+			Password: "password123",
+		})
+
+		// 断言
+		assert.Nil(t, result)
+		assert.True(t, v1.IsTenantDisabled(err))
+	})
+
+	t.Run("在解析出的租户中查找用户", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().
+			FindByUsername(systemuser.WithCredentials(tenantCtx), "testuser").
+			Return(nil, nil)
+		// 账号不存在时记录在解析出的租户中
+		expectLoginLog(t, mockLoginLog, "", systemloginlog.StatusFailed, "INCORRECT_PASSWORD")
+
+		// 执行测试
+		result, err := uc.Login(tenantCtx, &auth.LoginRequest{
+			Account:  "testuser",
+			Password: "password123",
+		})
+
+		// 断言
+		assert.Nil(t, result)
+		assert.True(t, v1.IsIncorrectPassword(err))
+	})
+}
+
+func TestAuthUsecase_RefreshToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemUserRepo(ctrl)
+	mockPermissionRepo := permissionmocks.NewMockPermissionRepo(ctrl)
+	mockRoleRepo := rolemocks.NewMockSystemRoleRepo(ctrl)
+	mockTenant := tenantmocks.NewMockTenantUsecase(ctrl)
+	logger := log.DefaultLogger
+	mockLoginLog := loginlogmocks.NewMockLoginLogUsecase(ctrl)
+	store := pkgAuth.NewMemoryRevocationStore()
+	sessions := pkgAuth.NewMemorySessionStore()
+	mockTenant.EXPECT().GetTenant(gomock.Any(), gomock.Any()).Return(&systemtenant.SystemTenant{}, nil).AnyTimes()
+	uc := auth.NewAuthUsecase(newTestBootstrap(), mockRepo, mockPermissionRepo, mockRoleRepo, mockTenant, mockLoginLog, store, sessions, newNoLockout(ctrl), newNoMFA(ctrl), logger)
+
+	ctx := context.Background()
+	// 登录时在全部租户中查找用户，之后的操作限定在用户的租户内
+	crossCtx := pkgAuth.CrossTenant(systemuser.WithCredentials(ctx))
+	tenantCtx := pkgAuth.WithTenant(ctx, "tenant1")
+
+	hashedPassword, err := pswd.HashPassword("password123")
+	assert.NoError(t, err)
+	user := &systemuser.SystemUser{
+		ID:       ptr.Of("user123"),
+		Account:  ptr.Of("testuser"),
+		Password: ptr.Of(hashedPassword),
+		Status:   ptr.Of(int8(1)),
+		TenantID: ptr.Of("tenant1"),
+	}
+
+	mockRepo.EXPECT().FindByUsername(crossCtx, "testuser").Return(user, nil)
+	mockTenant.EXPECT().CheckTenant(tenantCtx, "tenant1").Return(nil)
+	mockRepo.EXPECT().Update(tenantCtx, gomock.Any()).Return(user, nil)
+	mockPermissionRepo.EXPECT().ListUserRoleIDs(tenantCtx, "user123").Return(nil, nil).AnyTimes()
+	mockLoginLog.EXPECT().RecordLoginLog(gomock.Any(), gomock.Any()).Return(nil)
+	login, err := uc.Login(ctx, &auth.LoginRequest{Account: "testuser", Password: "password123", ClientIP: "127.0.0.1"})
+	assert.NoError(t, err)
+	loginClaims := &pkgAuth.Claims{}
+	_, _, err = jwtV5.NewParser().ParseUnverified(login.Token.RefreshToken, loginClaims)
+	assert.NoError(t, err)
+	// 刷新后的令牌，原令牌刷新后即失效
+	var refreshed *auth.TokenPair
+
+	t.Run("成功刷新令牌", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().
+			FindByID(tenantCtx, "user123").
+			Return(&systemuser.SystemUser{ID: ptr.Of("user123"), Status: ptr.Of(int8(1)), TenantID: ptr.Of("tenant1")}, nil)
+		mockTenant.EXPECT().
+			CheckTenant(tenantCtx, "tenant1").
+			Return(nil)
+
+		// 执行测试
+		token, err := uc.RefreshToken(ctx, login.Token.RefreshToken)
+
+		// 断言
+		assert.NoError(t, err)
+		assert.NotEmpty(t, token.AccessToken)
+		assert.NotEmpty(t, token.RefreshToken)
+		refreshed = token
+
+		// 新会话取代原会话，并沿用原会话的客户端信息
+		claims := &pkgAuth.Claims{}
+		_, _, err = jwtV5.NewParser().ParseUnverified(token.RefreshToken, claims)
+		assert.NoError(t, err)
+		session, err := sessions.Get(ctx, claims.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "127.0.0.1", session.IP)
+		old, err := sessions.Get(ctx, loginClaims.ID)
+		assert.NoError(t, err)
+		assert.Nil(t, old)
+
+		// 原令牌对已吊销
+		revoked, err := store.IsRevoked(ctx, loginClaims.ID)
+		assert.NoError(t, err)
+		assert.True(t, revoked)
+	})
+
+	t.Run("刷新令牌重复使用", func(t *testing.T) {
+		// 执行测试
+		token, err := uc.RefreshToken(ctx, login.Token.RefreshToken)
+
+		// 断言
+		assert.Nil(t, token)
+		assert.True(t, errors.Is(err, pkgAuth.ErrTokenRevoked))
+	})
+
+	t.Run("使用访问令牌刷新", func(t *testing.T) {
+		// 执行测试
+		token, err := uc.RefreshToken(ctx, login.Token.AccessToken)
+
+		// 断言
+		assert.Nil(t, token)
+		assert.True(t, v1.IsIncorrectRefreshToken(err))
+	})
+
+	t.Run("无效的刷新令牌", func(t *testing.T) {
+		// 执行测试
+		token, err := uc.RefreshToken(ctx, "invalid-token")
+
+		// 断言
+		assert.Nil(t, token)
+		assert.True(t, v1.IsIncorrectRefreshToken(err))
+	})
+
+	t.Run("用户已停用", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().
+			FindByID(tenantCtx, "user123").
+			Return(&systemuser.SystemUser{ID: ptr.Of("user123"), Status: ptr.Of(int8(0))}, nil)
+
+		// 执行测试
+		token, err := uc.RefreshToken(ctx, refreshed.RefreshToken)
+
+		// 断言
+		assert.Nil(t, token)
+		assert.True(t, v1.IsUserFreeze(err))
+	})
+
+	t.Run("用户的令牌已吊销", func(t *testing.T) {
+		assert.NoError(t, store.RevokeUser(ctx, "user123"))
+
+		// 执行测试
+		token, err := uc.RefreshToken(ctx, refreshed.RefreshToken)
+
+		// 断言
+		assert.Nil(t, token)
+		assert.True(t, errors.Is(err, pkgAuth.ErrTokenRevoked))
+	})
+}
+
+func TestAuthUsecase_RefreshTokenConcurrently(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemUserRepo(ctrl)
+	mockPermissionRepo := permissionmocks.NewMockPermissionRepo(ctrl)
+	mockTenant := tenantmocks.NewMockTenantUsecase(ctrl)
+	uc := auth.NewAuthUsecase(newTestBootstrap(), mockRepo, mockPermissionRepo, rolemocks.NewMockSystemRoleRepo(ctrl), mockTenant, loginlogmocks.NewMockLoginLogUsecase(ctrl), pkgAuth.NewMemoryRevocationStore(), pkgAuth.NewMemorySessionStore(), newNoLockout(ctrl), newNoMFA(ctrl), log.DefaultLogger)
+
+	ctx := context.Background()
+	now := time.Now()
+	claims := pkgAuth.NewClaims(&pkgAuth.Principal{UserID: "user123", TenantID: "tenant1"}, pkgAuth.TokenUseRefresh, now, now.Add(time.Hour))
+	refreshToken, err := jwtV5.NewWithClaims(jwtV5.SigningMethodHS256, claims).SignedString([]byte("test-secret"))
+	assert.NoError(t, err)
+
+	// Mock 期望
+	mockRepo.EXPECT().
+		FindByID(gomock.Any(), "user123").
+		Return(&systemuser.SystemUser{ID: ptr.Of("user123"), Status: ptr.Of(int8(1)), TenantID: ptr.Of("tenant1")}, nil).
+		AnyTimes()
+	mockTenant.EXPECT().CheckTenant(gomock.Any(), "tenant1").Return(nil).AnyTimes()
+	mockPermissionRepo.EXPECT().ListUserRoleIDs(gomock.Any(), "user123").Return(nil, nil).AnyTimes()
+
+	// 执行测试
+	const n = 10
+	errs := make(chan error, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := uc.RefreshToken(ctx, refreshToken)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	// 断言：同一刷新令牌只能换取一次新令牌
+	succeeded := 0
+	for err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		assert.True(t, errors.Is(err, pkgAuth.ErrTokenRevoked))
+	}
+	assert.Equal(t, 1, succeeded)
+}
+
+func TestAuthUsecase_Logout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLoginLog := loginlogmocks.NewMockLoginLogUsecase(ctrl)
+	store := pkgAuth.NewMemoryRevocationStore()
+	sessions := pkgAuth.NewMemorySessionStore()
+	uc := auth.NewAuthUsecase(newTestBootstrap(), mocks.NewMockSystemUserRepo(ctrl), permissionmocks.NewMockPermissionRepo(ctrl), rolemocks.NewMockSystemRoleRepo(ctrl), tenantmocks.NewMockTenantUsecase(ctrl), mockLoginLog, store, sessions, authmocks.NewMockLockoutRepo(ctrl), authmocks.NewMockUserMFARepo(ctrl), log.DefaultLogger)
+	ctx := pkgAuth.NewContext(context.Background(), &pkgAuth.Principal{UserID: "user123", TenantID: "tenant1", TokenID: "token1"})
+	assert.NoError(t, sessions.Save(ctx, &pkgAuth.Session{TokenID: "token1", UserID: "user123", Account: "testuser", TenantID: "tenant1", IP: "127.0.0.1", ExpiresAt: time.Now().Add(time.Hour)}))
+
+	// Mock 期望
+	mockLoginLog.EXPECT().
+		RecordLoginLog(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, l *systemloginlog.SystemLoginLog) error {
+			assert.Equal(t, systemloginlog.EventLogout, ptr.From(l.Event))
+			assert.Equal(t, "user123", ptr.From(l.UserID))
+			assert.Equal(t, "testuser", ptr.From(l.Account))
+			assert.Equal(t, "127.0.0.1", ptr.From(l.IP))
+			return nil
+		})
+
+	// 执行测试
+	err := uc.Logout(ctx)
+
+	// 断言
+	assert.NoError(t, err)
+	revoked, err := store.IsRevoked(ctx, "token1")
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+	session, err := sessions.Get(ctx, "token1")
+	assert.NoError(t, err)
+	assert.Nil(t, session)
+}
+
+func TestAuthUsecase_OnlineSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	newUsecase := func() (auth.AuthUsecase, *pkgAuth.MemoryRevocationStore, *pkgAuth.MemorySessionStore) {
+		store := pkgAuth.NewMemoryRevocationStore()
+		sessions := pkgAuth.NewMemorySessionStore()
+		uc := auth.NewAuthUsecase(newTestBootstrap(), mocks.NewMockSystemUserRepo(ctrl), permissionmocks.NewMockPermissionRepo(ctrl), rolemocks.NewMockSystemRoleRepo(ctrl), tenantmocks.NewMockTenantUsecase(ctrl), loginlogmocks.NewMockLoginLogUsecase(ctrl), store, sessions, authmocks.NewMockLockoutRepo(ctrl), authmocks.NewMockUserMFARepo(ctrl), log.DefaultLogger)
+		return uc, store, sessions
+	}
+	ctx := pkgAuth.NewContext(context.Background(), &pkgAuth.Principal{UserID: "admin", TenantID: "tenant1", TokenID: "admin-token"})
+	now := time.Now()
+	seed := func(t *testing.T, sessions *pkgAuth.MemorySessionStore) {
+		for i, s := range []*pkgAuth.Session{
+			{TokenID: "t1", UserID: "u1", Account: "alice", TenantID: "tenant1", IP: "10.0.0.1"},
+			{TokenID: "t2", UserID: "u2", Account: "bob", TenantID: "tenant1", IP: "10.0.1.2"},
+			{TokenID: "t3", UserID: "u1", Account: "alice", TenantID: "tenant1", IP: "192.168.0.1"},
+			{TokenID: "t4", UserID: "u3", Account: "carol", TenantID: "tenant2", IP: "10.0.0.3"},
+		} {
+			s.IssuedAt = now.Add(time.Duration(i) * time.Minute)
+			s.ExpiresAt = now.Add(time.Hour)
+			assert.NoError(t, sessions.Save(ctx, s))
+		}
+	}
+
+	t.Run("列出当前租户的在线会话", func(t *testing.T) {
+		uc, _, sessions := newUsecase()
+		seed(t, sessions)
+
+		// 执行测试
+		list, total, err := uc.ListOnlineSessions(ctx, &auth.ListOnlineSessionsRequest{Page: 1, PageSize: 2})
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Equal(t, int32(3), total)
+		assert.Len(t, list, 2)
+		assert.Equal(t, "t3", list[0].TokenID)
+		assert.Equal(t, "t2", list[1].TokenID)
+	})
+
+	t.Run("按条件过滤会话", func(t *testing.T) {
+		uc, _, sessions := newUsecase()
+		seed(t, sessions)
+
+		// 执行测试
+		byUser, total, err := uc.ListOnlineSessions(ctx, &auth.ListOnlineSessionsRequest{UserID: "u1"})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), total)
+		assert.Len(t, byUser, 2)
+		byIP, total, err := uc.ListOnlineSessions(ctx, &auth.ListOnlineSessionsRequest{IP: "10.0."})
+		assert.NoError(t, err)
+
+		// 断言
+		assert.Equal(t, int32(2), total)
+		assert.Equal(t, "t2", byIP[0].TokenID)
+		assert.Equal(t, "t1", byIP[1].TokenID)
+	})
+
+	t.Run("不返回已吊销的会话", func(t *testing.T) {
+		uc, store, sessions := newUsecase()
+		seed(t, sessions)
+		assert.NoError(t, store.Track(ctx, "u1", "t1", now.Add(time.Hour)))
+		assert.NoError(t, store.Track(ctx, "u1", "t3", now.Add(time.Hour)))
+		assert.NoError(t, store.RevokeUser(ctx, "u1"))
+
+		// 执行测试
+		list, total, err := uc.ListOnlineSessions(ctx, &auth.ListOnlineSessionsRequest{})
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), total)
+		assert.Equal(t, "t2", list[0].TokenID)
+		session, _ := sessions.Get(ctx, "t1")
+		assert.Nil(t, session)
+	})
+
+	t.Run("终止会话", func(t *testing.T) {
+		uc, store, sessions := newUsecase()
+		seed(t, sessions)
+
+		// 执行测试
+		err := uc.TerminateSession(ctx, "t2")
+
+		// 断言
+		assert.NoError(t, err)
+		revoked, err := store.IsRevoked(ctx, "t2")
+		assert.NoError(t, err)
+		assert.True(t, revoked)
+		session, _ := sessions.Get(ctx, "t2")
+		assert.Nil(t, session)
+	})
+
+	t.Run("不能终止其他租户的会话", func(t *testing.T) {
+		uc, store, sessions := newUsecase()
+		seed(t, sessions)
+
+		// 执行测试
+		err := uc.TerminateSession(ctx, "t4")
+
+		// 断言
+		assert.True(t, errors.Is(err, auth.ErrSessionNotFound))
+		revoked, _ := store.IsRevoked(ctx, "t4")
+		assert.False(t, revoked)
+		assert.True(t, errors.Is(uc.TerminateSession(ctx, "missing"), auth.ErrSessionNotFound))
+	})
+}
+
+func TestAuthUsecase_Lockout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	hashedPassword, err := pswd.HashPassword("password123")
+	assert.NoError(t, err)
+	ctx := context.Background()
+	crossCtx := pkgAuth.CrossTenant(systemuser.WithCredentials(ctx))
+	user := &systemuser.SystemUser{
+		ID:       ptr.Of("user123"),
+		Account:  ptr.Of("testuser"),
+		Password: ptr.Of(hashedPassword),
+		Status:   ptr.Of(int8(1)),
+		TenantID: ptr.Of("tenant1"),
+	}
+
+	newUsecase := func() (auth.AuthUsecase, *mocks.MockSystemUserRepo, *authmocks.MockLockoutRepo) {
+		mockRepo := mocks.NewMockSystemUserRepo(ctrl)
+		mockLockout := authmocks.NewMockLockoutRepo(ctrl)
+		mockLoginLog := loginlogmocks.NewMockLoginLogUsecase(ctrl)
+		mockLoginLog.EXPECT().RecordLoginLog(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		bootstrap := newTestBootstrap()
+		bootstrap.Lockout = &conf.Lockout{AccountThreshold: 3, IpThreshold: 10}
+		uc := auth.NewAuthUsecase(bootstrap, mockRepo, permissionmocks.NewMockPermissionRepo(ctrl), rolemocks.NewMockSystemRoleRepo(ctrl),
+			tenantmocks.NewMockTenantUsecase(ctrl), mockLoginLog, pkgAuth.NewMemoryRevocationStore(), pkgAuth.NewMemorySessionStore(), mockLockout, newNoMFA(ctrl), log.DefaultLogger)
+		return uc, mockRepo, mockLockout
+	}
+
+	t.Run("密码错误时累计账号和IP的失败次数", func(t *testing.T) {
+		uc, mockRepo, mockLockout := newUsecase()
+
+		// Mock 期望
+		mockLockout.EXPECT().LockedFor(ctx, "ip:10.0.0.1").Return(time.Duration(0), nil)
+		mockRepo.EXPECT().FindByUsername(crossCtx, "testuser").Return(user, nil)
+		mockLockout.EXPECT().LockedFor(ctx, "account:tenant1:testuser").Return(time.Duration(0), nil)
+		mockLockout.EXPECT().Fail(gomock.Any(), "account:tenant1:testuser", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, p auth.LockoutPolicy) (time.Duration, error) {
+				assert.Equal(t, 3, p.Threshold)
+				assert.Equal(t, 5*time.Minute, p.LockDuration)
+				return 5 * time.Minute, nil
+			})
+		mockLockout.EXPECT().Fail(gomock.Any(), "ip:10.0.0.1", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, p auth.LockoutPolicy) (time.Duration, error) {
+				assert.Equal(t, 10, p.Threshold)
+				return 0, nil
+			})
+
+		// 执行测试
+		result, err := uc.Login(ctx, &auth.LoginRequest{Account: "testuser", Password: "wrongpassword", ClientIP: "10.0.0.1"})
+
+		// 断言：触发锁定的这次仍返回密码错误
+		assert.Nil(t, result)
+		assert.True(t, v1.IsIncorrectPassword(err))
+	})
+
+	t.Run("账号锁定期间返回LOCKED", func(t *testing.T) {
+		uc, mockRepo, mockLockout := newUsecase()
+
+		// Mock 期望：锁定期间不验证密码，也不累计失败次数
+		mockLockout.EXPECT().LockedFor(ctx, "ip:10.0.0.1").Return(time.Duration(0), nil)
+		mockRepo.EXPECT().FindByUsername(crossCtx, "testuser").Return(user, nil)
+		mockLockout.EXPECT().LockedFor(ctx, "account:tenant1:testuser").Return(90*time.Second, nil)
+
+		// 执行测试
+		result, err := uc.Login(ctx, &auth.LoginRequest{Account: "testuser", Password: "password123", ClientIP: "10.0.0.1"})
+
+		// 断言
+		assert.Nil(t, result)
+		assert.True(t, v1.IsLocked(err))
+		assert.Equal(t, 423, int(errors.FromError(err).Code))
+		assert.Equal(t, "90", errors.FromError(err).Metadata["retry_after"])
+	})
+
+	t.Run("IP锁定期间不查找用户", func(t *testing.T) {
+		uc, _, mockLockout := newUsecase()
+
+		// Mock 期望
+		mockLockout.EXPECT().LockedFor(ctx, "ip:10.0.0.1").Return(time.Minute, nil)
+
+		// 执行测试
+		_, err := uc.Login(ctx, &auth.LoginRequest{Account: "testuser", Password: "password123", ClientIP: "10.0.0.1"})
+
+		// 断言
+		assert.True(t, v1.IsLocked(err))
+	})
+
+	t.Run("账号不存在时同样累计失败次数", func(t *testing.T) {
+		uc, mockRepo, mockLockout := newUsecase()
+
+		// Mock 期望
+		mockRepo.EXPECT().FindByUsername(crossCtx, "nobody").Return(nil, nil)
+		mockLockout.EXPECT().LockedFor(ctx, "account::nobody").Return(time.Duration(0), nil)
+		mockLockout.EXPECT().Fail(gomock.Any(), "account::nobody", gomock.Any()).Return(time.Duration(0), nil)
+
+		// 执行测试
+		_, err := uc.Login(ctx, &auth.LoginRequest{Account: "nobody", Password: "password123"})
+
+		// 断言
+		assert.True(t, v1.IsIncorrectPassword(err))
+	})
+
+	t.Run("管理员解除锁定", func(t *testing.T) {
+		uc, _, mockLockout := newUsecase()
+		adminCtx := pkgAuth.NewContext(ctx, &pkgAuth.Principal{UserID: "admin", TenantID: "tenant1"})
+
+		// Mock 期望
+		mockLockout.EXPECT().Reset(adminCtx, "account:tenant1:testuser").Return(nil)
+		mockLockout.EXPECT().Reset(adminCtx, "ip:10.0.0.1").Return(nil)
+
+		// 执行测试 & 断言
+		assert.NoError(t, uc.UnlockLogin(adminCtx, "testuser", "10.0.0.1"))
+		assert.True(t, errors.IsBadRequest(uc.UnlockLogin(adminCtx, "", "")))
+	})
+}
@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"context"
+	"qn-base/app/admin/internal/biz/systemuser"
+	pkgAuth "qn-base/pkg/auth"
+	"time"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=./mocks/usecase_mock.go -package=mocks
+type AuthUsecase interface {
+	Login(ctx context.Context, req *LoginRequest) (*LoginResult, error)
+	Logout(ctx context.Context) error
+	RefreshToken(ctx context.Context, refreshToken string) (*TokenPair, error)
+	ListOnlineSessions(ctx context.Context, req *ListOnlineSessionsRequest) ([]*pkgAuth.Session, int32, error)
+	TerminateSession(ctx context.Context, tokenID string) error
+	UnlockLogin(ctx context.Context, account, ip string) error
+	LoginMFA(ctx context.Context, req *MFALoginRequest) (*LoginResult, error)
+	EnrollMFA(ctx context.Context, mfaToken string) (*MFAEnrollment, error)
+	ActivateMFA(ctx context.Context, code string) ([]string, error)
+	DisableMFA(ctx context.Context, code string) error
+	GetMFAStatus(ctx context.Context) (*MFAStatus, error)
+	ResetUserMFA(ctx context.Context, userID string) error
+}
+
+// LoginRequest is a login request.
+type LoginRequest struct {
+	Account   string
+	Password  string
+	ClientIP  string
+	UserAgent string
+}
+
+// MFALoginRequest is the second step of a login requiring two-factor authentication.
+type MFALoginRequest struct {
+	MFAToken  string
+	Code      string // TOTP 验证码或恢复码
+	ClientIP  string
+	UserAgent string
+}
+
+// ListOnlineSessionsRequest is a list online sessions request.
+type ListOnlineSessionsRequest struct {
+	Page     int32
+	PageSize int32
+	UserID   string
+	Account  string
+	IP       string
+}
+
+// TokenPair represents an access/refresh token pair.
+type TokenPair struct {
+	AccessToken      string    `json:"access_token"`
+	RefreshToken     string    `json:"refresh_token"`
+	TokenType        string    `json:"token_type"`
+	ExpiresAt        time.Time `json:"expires_at"`
+	RefreshExpiresAt time.Time `json:"refresh_expires_at"`
+}
+
+// LoginResult represents login result.
+// 需要两步验证时只返回 MFA，不返回令牌
+type LoginResult struct {
+	Token         *TokenPair             `json:"token"`
+	User          *systemuser.SystemUser `json:"user"`
+	MFA           *MFAChallenge          `json:"mfa,omitempty"`
+	RecoveryCodes []string               `json:"recovery_codes,omitempty"` // 登录时启用两步验证后生成的恢复码
+}
+
+// MFAChallenge is returned by the login requiring two-factor authentication.
+type MFAChallenge struct {
+	Token          string    `json:"token"`           // 待验证令牌
+	ExpiresAt      time.Time `json:"expires_at"`      // 待验证令牌过期时间
+	EnrollRequired bool      `json:"enroll_required"` // 租户要求两步验证而用户尚未启用，需先绑定
+}
+
+// MFAEnrollment is the secret enrolled for two-factor authentication.
+type MFAEnrollment struct {
+	Secret string `json:"secret"` // base32 编码的密钥
+	URI    string `json:"uri"`    // otpauth URI
+}
+
+// MFAStatus is the two-factor authentication status of a user.
+type MFAStatus struct {
+	Enabled                bool `json:"enabled"`
+	Required               bool `json:"required"` // 租户是否要求两步验证
+	RecoveryCodesRemaining int  `json:"recovery_codes_remaining"`
+}
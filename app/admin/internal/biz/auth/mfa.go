@@ -0,0 +1,425 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"strings"
+	"time"
+
+	v1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/systemuser"
+	pkgAuth "qn-base/pkg/auth"
+	"qn-base/pkg/lang/ptr"
+	"qn-base/pkg/util/pswd"
+	"qn-base/pkg/util/totp"
+
+	jwtV5 "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrIncorrectMFACode is the two-factor code or recovery code incorrect.
+	ErrIncorrectMFACode = v1.ErrorIncorrectMfaCode("两步验证码错误")
+	// ErrIncorrectMFAToken is the mfa pending token invalid or expired.
+	ErrIncorrectMFAToken = v1.ErrorIncorrectMfaToken("两步验证令牌无效或已过期")
+	// ErrMFANotEnrolled is the user has not enrolled two-factor authentication.
+	ErrMFANotEnrolled = v1.ErrorMfaNotEnrolled("未绑定两步验证")
+	// ErrMFAAlreadyEnabled is the user has enabled two-factor authentication.
+	ErrMFAAlreadyEnabled = v1.ErrorMfaAlreadyEnabled("已启用两步验证")
+	// ErrMFARequired is the tenant requires two-factor authentication, which cannot be disabled.
+	ErrMFARequired = v1.ErrorMfaRequired("租户要求两步验证，不能停用")
+)
+
+const (
+	// defaultMFAIssuer 验证器应用中显示的默认签发方
+	defaultMFAIssuer = "qn-base"
+	// defaultMFATokenExpire 待验证令牌默认有效期
+	defaultMFATokenExpire = 5 * time.Minute
+
+	// recoveryCodeCount 每次生成的恢复码数量
+	recoveryCodeCount = 10
+	// recoveryCodeAlphabet 恢复码字符集，去掉了易混淆的字符，共 32 个字符，按随机字节的低 5 位取字符不会产生偏差
+	recoveryCodeAlphabet = "abcdefghijkmnpqrstuvwxyz23456789"
+)
+
+// UserMFA is the two-factor authentication setting of a user.
+type UserMFA struct {
+	ID            *string    `json:"id,omitempty"`             // id
+	TenantID      *string    `json:"tenant_id,omitempty"`      // 租户编号
+	UserID        *string    `json:"user_id,omitempty"`        // 用户ID
+	Secret        *string    `json:"-"`                        // TOTP 密钥(base32)
+	Enabled       *bool      `json:"enabled,omitempty"`        // 是否已启用
+	RecoveryCodes []string   `json:"-"`                        // 恢复码哈希
+	LastUsedStep  *int64     `json:"last_used_step,omitempty"` // 最近使用的验证码时间步
+	EnabledAt     *time.Time `json:"enabled_at,omitempty"`     // 启用时间
+}
+
+// UserMFARepo keeps the two-factor authentication settings of the users in the current tenant.
+//
+//go:generate mockgen -source=mfa.go -destination=./mocks/mock_user_mfa_repo.go -package=mocks
+type UserMFARepo interface {
+	// FindByUserID returns the setting of the user, nil if the user has not enrolled.
+	FindByUserID(ctx context.Context, userID string) (*UserMFA, error)
+	// Save creates the pending setting of the user, replacing the existing one.
+	Save(ctx context.Context, m *UserMFA) (*UserMFA, error)
+	// Activate enables the setting with the recovery code hashes, and records the time step of the code used.
+	Activate(ctx context.Context, id string, recoveryCodes []string, step int64) error
+	// UseStep records the time step of the code used, returns false if it is not after the last used one.
+	UseStep(ctx context.Context, id string, step int64) (bool, error)
+	// UseRecoveryCode removes the recovery code hash, returns false if it has been used.
+	UseRecoveryCode(ctx context.Context, id, hash string) (bool, error)
+	// DeleteByUserID deletes the setting of the user.
+	DeleteByUserID(ctx context.Context, userID string) error
+}
+
+// LoginMFA completes the login with the mfa pending token and the code or recovery code.
+// 用户尚未启用时（租户要求两步验证），验证通过即启用，并返回恢复码
+func (uc *authUsecase) LoginMFA(ctx context.Context, req *MFALoginRequest) (*LoginResult, error) {
+	claims, err := uc.parseMFAToken(ctx, req.MFAToken)
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("LoginMFA: userID=%s, ip=%s", claims.Subject, req.ClientIP)
+	ctx = pkgAuth.WithTenant(ctx, claims.TenantID)
+
+	user, err := uc.repo.FindByID(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrIncorrectMFAToken
+	}
+	result, err := uc.loginMFA(ctx, req, user, claims)
+	uc.recordLogin(ctx, &LoginRequest{
+		Account:   ptr.From(user.Account),
+		ClientIP:  req.ClientIP,
+		UserAgent: req.UserAgent,
+	}, user, err)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// loginMFA verifies the code of the user, and issues a token pair.
+// 验证码错误同样累计账号和IP的失败次数
+func (uc *authUsecase) loginMFA(ctx context.Context, req *MFALoginRequest, user *systemuser.SystemUser, claims *pkgAuth.Claims) (*LoginResult, error) {
+	if req.ClientIP != "" {
+		if err := uc.checkLocked(ctx, ipLockKey(req.ClientIP)); err != nil {
+			return nil, err
+		}
+	}
+	accountKey := accountLockKey(ptr.From(user.TenantID), ptr.From(user.Account))
+	if err := uc.checkLocked(ctx, accountKey); err != nil {
+		return nil, err
+	}
+
+	// 签发待验证令牌后用户可能已被停用
+	if ptr.From(user.Status) != 1 {
+		return nil, ErrUserFreeze
+	}
+	if err := uc.tenant.CheckTenant(ctx, ptr.From(user.TenantID)); err != nil {
+		return nil, err
+	}
+
+	m, err := uc.mfaRepo.FindByUserID(ctx, ptr.From(user.ID))
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, ErrMFANotEnrolled
+	}
+	var recoveryCodes []string
+	if ptr.From(m.Enabled) {
+		ok, err := uc.verifyMFACode(ctx, m, req.Code)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, uc.loginFailed(ctx, ptr.From(user.Account), req.ClientIP, accountKey, ErrIncorrectMFACode)
+		}
+	} else {
+		step, ok := totp.Validate(ptr.From(m.Secret), req.Code, time.Now())
+		if !ok {
+			return nil, uc.loginFailed(ctx, ptr.From(user.Account), req.ClientIP, accountKey, ErrIncorrectMFACode)
+		}
+		if recoveryCodes, err = uc.activateMFA(ctx, m, step); err != nil {
+			return nil, err
+		}
+	}
+
+	// 待验证令牌只能使用一次
+	if err := uc.revocation.Revoke(ctx, claims.ID, claims.ExpiresAt.Time); err != nil {
+		return nil, err
+	}
+	result, err := uc.completeLogin(ctx, user, accountKey, req.ClientIP, req.UserAgent)
+	if err != nil {
+		return nil, err
+	}
+	result.RecoveryCodes = recoveryCodes
+	return result, nil
+}
+
+// EnrollMFA generates a new secret for the user, which is enabled after verified.
+// 指定 mfaToken 时为待验证令牌的用户绑定，用于租户要求两步验证而用户尚未启用时；否则为当前用户绑定
+func (uc *authUsecase) EnrollMFA(ctx context.Context, mfaToken string) (*MFAEnrollment, error) {
+	userID := pkgAuth.UserID(ctx)
+	if mfaToken != "" {
+		claims, err := uc.parseMFAToken(ctx, mfaToken)
+		if err != nil {
+			return nil, err
+		}
+		userID = claims.Subject
+		ctx = pkgAuth.WithTenant(ctx, claims.TenantID)
+	}
+	uc.log.WithContext(ctx).Infof("EnrollMFA: userID=%s", userID)
+
+	user, err := uc.repo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, systemuser.ErrUserNotFound
+	}
+	m, err := uc.mfaRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if m != nil && ptr.From(m.Enabled) {
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.mfaRepo.Save(ctx, &UserMFA{
+		UserID:  ptr.Of(userID),
+		Secret:  ptr.Of(secret),
+		Enabled: ptr.Of(false),
+	}); err != nil {
+		return nil, err
+	}
+	return &MFAEnrollment{
+		Secret: secret,
+		URI:    totp.URI(uc.mfaIssuer(), ptr.From(user.Account), secret),
+	}, nil
+}
+
+// ActivateMFA verifies the code of the secret enrolled by the current user, and enables two-factor authentication.
+func (uc *authUsecase) ActivateMFA(ctx context.Context, code string) ([]string, error) {
+	userID := pkgAuth.UserID(ctx)
+	uc.log.WithContext(ctx).Infof("ActivateMFA: userID=%s", userID)
+
+	m, err := uc.mfaRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, ErrMFANotEnrolled
+	}
+	if ptr.From(m.Enabled) {
+		return nil, ErrMFAAlreadyEnabled
+	}
+	step, ok := totp.Validate(ptr.From(m.Secret), code, time.Now())
+	if !ok {
+		return nil, ErrIncorrectMFACode
+	}
+	return uc.activateMFA(ctx, m, step)
+}
+
+// DisableMFA disables two-factor authentication of the current user after verifying the code.
+// 租户要求两步验证时不能关闭
+func (uc *authUsecase) DisableMFA(ctx context.Context, code string) error {
+	userID := pkgAuth.UserID(ctx)
+	uc.log.WithContext(ctx).Infof("DisableMFA: userID=%s", userID)
+
+	m, err := uc.mfaRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if m == nil || !ptr.From(m.Enabled) {
+		return ErrMFANotEnrolled
+	}
+	required, err := uc.mfaRequired(ctx, pkgAuth.TenantID(ctx))
+	if err != nil {
+		return err
+	}
+	if required {
+		return ErrMFARequired
+	}
+	ok, err := uc.verifyMFACode(ctx, m, code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrIncorrectMFACode
+	}
+	return uc.mfaRepo.DeleteByUserID(ctx, userID)
+}
+
+// GetMFAStatus returns the two-factor authentication status of the current user.
+func (uc *authUsecase) GetMFAStatus(ctx context.Context) (*MFAStatus, error) {
+	m, err := uc.mfaRepo.FindByUserID(ctx, pkgAuth.UserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	required, err := uc.mfaRequired(ctx, pkgAuth.TenantID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	status := &MFAStatus{Required: required}
+	if m != nil && ptr.From(m.Enabled) {
+		status.Enabled = true
+		status.RecoveryCodesRemaining = len(m.RecoveryCodes)
+	}
+	return status, nil
+}
+
+// ResetUserMFA removes two-factor authentication of the user in the current tenant,
+// the user enrolls again on next login if the tenant requires it.
+func (uc *authUsecase) ResetUserMFA(ctx context.Context, userID string) error {
+	uc.log.WithContext(ctx).Infof("ResetUserMFA: userID=%s", userID)
+	return uc.mfaRepo.DeleteByUserID(ctx, userID)
+}
+
+// mfaChallenge returns the challenge if the user has enabled two-factor authentication
+// or the tenant requires it, nil if the user logs in with the password alone.
+func (uc *authUsecase) mfaChallenge(ctx context.Context, user *systemuser.SystemUser) (*MFAChallenge, error) {
+	m, err := uc.mfaRepo.FindByUserID(ctx, ptr.From(user.ID))
+	if err != nil {
+		return nil, err
+	}
+	enabled := m != nil && ptr.From(m.Enabled)
+	if !enabled {
+		required, err := uc.mfaRequired(ctx, ptr.From(user.TenantID))
+		if err != nil {
+			return nil, err
+		}
+		if !required {
+			return nil, nil
+		}
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(uc.mfaTokenExpire())
+	token, err := uc.signToken(&pkgAuth.Principal{
+		UserID:     ptr.From(user.ID),
+		TenantID:   ptr.From(user.TenantID),
+		TokenID:    uuid.NewString(),
+		ClientType: pkgAuth.ClientTypeSystem,
+	}, pkgAuth.TokenUseMFA, now, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return &MFAChallenge{
+		Token:          token,
+		ExpiresAt:      expiresAt,
+		EnrollRequired: !enabled,
+	}, nil
+}
+
+// mfaRequired reports whether the tenant requires two-factor authentication.
+func (uc *authUsecase) mfaRequired(ctx context.Context, tenantID string) (bool, error) {
+	tenant, err := uc.tenant.GetTenant(ctx, tenantID)
+	if err != nil {
+		return false, err
+	}
+	return ptr.From(tenant.MFARequired), nil
+}
+
+// parseMFAToken parses the mfa pending token, which is valid only once.
+func (uc *authUsecase) parseMFAToken(ctx context.Context, tokenString string) (*pkgAuth.Claims, error) {
+	claims := &pkgAuth.Claims{}
+	_, err := jwtV5.ParseWithClaims(tokenString, claims, func(*jwtV5.Token) (interface{}, error) {
+		return []byte(uc.jwt.GetSecret()), nil
+	}, jwtV5.WithValidMethods([]string{jwtV5.SigningMethodHS256.Alg()}))
+	if err != nil || claims.Use != pkgAuth.TokenUseMFA || claims.Subject == "" {
+		return nil, ErrIncorrectMFAToken
+	}
+	revoked, err := uc.revocation.IsRevoked(ctx, claims.ID)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, ErrIncorrectMFAToken
+	}
+	return claims, nil
+}
+
+// verifyMFACode verifies the code of the enabled setting, the code is either a TOTP code or a recovery code,
+// each of them can be used only once.
+func (uc *authUsecase) verifyMFACode(ctx context.Context, m *UserMFA, code string) (bool, error) {
+	code = strings.TrimSpace(code)
+	if len(code) == totp.Digits {
+		step, ok := totp.Validate(ptr.From(m.Secret), code, time.Now())
+		if !ok {
+			return false, nil
+		}
+		return uc.mfaRepo.UseStep(ctx, ptr.From(m.ID), step)
+	}
+
+	code = normalizeRecoveryCode(code)
+	for _, hash := range m.RecoveryCodes {
+		ok, err := pswd.VerifyPassword(code, hash)
+		if err != nil {
+			uc.log.WithContext(ctx).Warnf("verifyMFACode: verify recovery code failed, id=%s, err=%v", ptr.From(m.ID), err)
+			continue
+		}
+		if ok {
+			return uc.mfaRepo.UseRecoveryCode(ctx, ptr.From(m.ID), hash)
+		}
+	}
+	return false, nil
+}
+
+// activateMFA enables the setting with new recovery codes, and returns the codes in plain text.
+func (uc *authUsecase) activateMFA(ctx context.Context, m *UserMFA, step int64) ([]string, error) {
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.mfaRepo.Activate(ctx, ptr.From(m.ID), hashes, step); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+func (uc *authUsecase) mfaIssuer() string {
+	if uc.mfa.GetIssuer() != "" {
+		return uc.mfa.GetIssuer()
+	}
+	return defaultMFAIssuer
+}
+
+func (uc *authUsecase) mfaTokenExpire() time.Duration {
+	if uc.mfa.GetTokenExpire() > 0 {
+		return time.Duration(uc.mfa.GetTokenExpire()) * time.Second
+	}
+	return defaultMFATokenExpire
+}
+
+// generateRecoveryCodes generates the recovery codes in the form of xxxxx-xxxxx, and their hashes.
+func generateRecoveryCodes() (codes, hashes []string, err error) {
+	codes = make([]string, recoveryCodeCount)
+	hashes = make([]string, recoveryCodeCount)
+	b := make([]byte, 10)
+	for i := range codes {
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		for j := range b {
+			b[j] = recoveryCodeAlphabet[b[j]&31]
+		}
+		codes[i] = string(b[:5]) + "-" + string(b[5:])
+		if hashes[i], err = pswd.HashPassword(normalizeRecoveryCode(codes[i])); err != nil {
+			return nil, nil, err
+		}
+	}
+	return codes, hashes, nil
+}
+
+// normalizeRecoveryCode ignores the case and the separators of the recovery code.
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
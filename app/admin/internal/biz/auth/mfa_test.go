@@ -0,0 +1,376 @@
+package auth_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/auth"
+	authmocks "qn-base/app/admin/internal/biz/auth/mocks"
+	permissionmocks "qn-base/app/admin/internal/biz/permission/mocks"
+	"qn-base/app/admin/internal/biz/systemloginlog"
+	loginlogmocks "qn-base/app/admin/internal/biz/systemloginlog/mocks"
+	rolemocks "qn-base/app/admin/internal/biz/systemrole/mocks"
+	"qn-base/app/admin/internal/biz/systemtenant"
+	tenantmocks "qn-base/app/admin/internal/biz/systemtenant/mocks"
+	"qn-base/app/admin/internal/biz/systemuser"
+	"qn-base/app/admin/internal/biz/systemuser/mocks"
+	pkgAuth "qn-base/pkg/auth"
+	"qn-base/pkg/lang/ptr"
+	"qn-base/pkg/util/pswd"
+	"qn-base/pkg/util/totp"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mfaDeps 两步验证测试的依赖
+type mfaDeps struct {
+	uc       auth.AuthUsecase
+	repo     *mocks.MockSystemUserRepo
+	tenant   *tenantmocks.MockTenantUsecase
+	loginLog *loginlogmocks.MockLoginLogUsecase
+	lockout  *authmocks.MockLockoutRepo
+	mfaRepo  *authmocks.MockUserMFARepo
+}
+
+func newMFADeps(ctrl *gomock.Controller) *mfaDeps {
+	d := &mfaDeps{
+		repo:     mocks.NewMockSystemUserRepo(ctrl),
+		tenant:   tenantmocks.NewMockTenantUsecase(ctrl),
+		loginLog: loginlogmocks.NewMockLoginLogUsecase(ctrl),
+		lockout:  authmocks.NewMockLockoutRepo(ctrl),
+		mfaRepo:  authmocks.NewMockUserMFARepo(ctrl),
+	}
+	permissionRepo := permissionmocks.NewMockPermissionRepo(ctrl)
+	permissionRepo.EXPECT().ListUserRoleIDs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	d.tenant.EXPECT().CheckTenant(gomock.Any(), "tenant1").Return(nil).AnyTimes()
+	d.lockout.EXPECT().LockedFor(gomock.Any(), gomock.Any()).Return(time.Duration(0), nil).AnyTimes()
+	d.repo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	d.uc = auth.NewAuthUsecase(newTestBootstrap(), d.repo, permissionRepo, rolemocks.NewMockSystemRoleRepo(ctrl), d.tenant, d.loginLog,
+		pkgAuth.NewMemoryRevocationStore(), pkgAuth.NewMemorySessionStore(), d.lockout, d.mfaRepo, log.DefaultLogger)
+	return d
+}
+
+// expectMFARequired expects the tenant1 requiring two-factor authentication or not.
+func (d *mfaDeps) expectMFARequired(required bool) {
+	d.tenant.EXPECT().GetTenant(gomock.Any(), "tenant1").Return(&systemtenant.SystemTenant{MFARequired: ptr.Of(required)}, nil)
+}
+
+// challenge logs in with the password, and returns the two-factor challenge.
+func (d *mfaDeps) challenge(t *testing.T, ctx context.Context, user *systemuser.SystemUser) *auth.MFAChallenge {
+	d.repo.EXPECT().FindByUsername(gomock.Any(), "testuser").Return(user, nil)
+	result, err := d.uc.Login(ctx, &auth.LoginRequest{Account: "testuser", Password: "password123", ClientIP: "10.0.0.1"})
+	require.NoError(t, err)
+	require.NotNil(t, result.MFA)
+	return result.MFA
+}
+
+func TestAuthUsecase_MFA(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	hashedPassword, err := pswd.HashPassword("password123")
+	require.NoError(t, err)
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+	recoveryHash, err := pswd.HashPassword("abcde23456")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	newUser := func() *systemuser.SystemUser {
+		return &systemuser.SystemUser{
+			ID:       ptr.Of("user123"),
+			Account:  ptr.Of("testuser"),
+			Password: ptr.Of(hashedPassword),
+			Status:   ptr.Of(int8(1)),
+			TenantID: ptr.Of("tenant1"),
+		}
+	}
+	enabled := &auth.UserMFA{
+		ID:            ptr.Of("mfa1"),
+		UserID:        ptr.Of("user123"),
+		Secret:        ptr.Of(secret),
+		Enabled:       ptr.Of(true),
+		RecoveryCodes: []string{recoveryHash},
+	}
+	code := func(t *testing.T) string {
+		c, err := totp.Code(secret, totp.Step(time.Now()))
+		require.NoError(t, err)
+		return c
+	}
+
+	t.Run("启用两步验证时登录返回待验证令牌", func(t *testing.T) {
+		d := newMFADeps(ctrl)
+
+		// Mock 期望：不签发令牌，不记录登录日志，不清除失败次数
+		d.mfaRepo.EXPECT().FindByUserID(gomock.Any(), "user123").Return(enabled, nil)
+
+		// 执行测试
+		challenge := d.challenge(t, ctx, newUser())
+
+		// 断言
+		assert.NotEmpty(t, challenge.Token)
+		assert.False(t, challenge.EnrollRequired)
+		assert.WithinDuration(t, time.Now().Add(5*time.Minute), challenge.ExpiresAt, 5*time.Second)
+	})
+
+	t.Run("使用验证码完成登录，待验证令牌只能使用一次", func(t *testing.T) {
+		d := newMFADeps(ctrl)
+		d.mfaRepo.EXPECT().FindByUserID(gomock.Any(), "user123").Return(enabled, nil).Times(2)
+		challenge := d.challenge(t, ctx, newUser())
+
+		// Mock 期望
+		d.repo.EXPECT().FindByID(gomock.Any(), "user123").Return(newUser(), nil)
+		d.mfaRepo.EXPECT().UseStep(gomock.Any(), "mfa1", totp.Step(time.Now())).Return(true, nil)
+		d.lockout.EXPECT().Reset(gomock.Any(), "account:tenant1:testuser").Return(nil)
+		expectLoginLog(t, d.loginLog, "user123", systemloginlog.StatusSuccess, "")
+
+		// 执行测试
+		result, err := d.uc.LoginMFA(ctx, &auth.MFALoginRequest{MFAToken: challenge.Token, Code: code(t), ClientIP: "10.0.0.1"})
+
+		// 断言
+		require.NoError(t, err)
+		assert.NotEmpty(t, result.Token.AccessToken)
+		assert.Nil(t, result.MFA)
+		assert.Empty(t, result.RecoveryCodes)
+
+		_, err = d.uc.LoginMFA(ctx, &auth.MFALoginRequest{MFAToken: challenge.Token, Code: code(t)})
+		assert.True(t, v1.IsIncorrectMfaToken(err))
+	})
+
+	t.Run("验证码错误时累计失败次数", func(t *testing.T) {
+		d := newMFADeps(ctrl)
+		d.mfaRepo.EXPECT().FindByUserID(gomock.Any(), "user123").Return(enabled, nil).Times(2)
+		challenge := d.challenge(t, ctx, newUser())
+
+		// Mock 期望
+		d.repo.EXPECT().FindByID(gomock.Any(), "user123").Return(newUser(), nil)
+		d.lockout.EXPECT().Fail(gomock.Any(), "account:tenant1:testuser", gomock.Any()).Return(time.Duration(0), nil)
+		d.lockout.EXPECT().Fail(gomock.Any(), "ip:10.0.0.1", gomock.Any()).Return(time.Duration(0), nil)
+		expectLoginLog(t, d.loginLog, "user123", systemloginlog.StatusFailed, v1.AdminErrorReason_INCORRECT_MFA_CODE.String())
+
+		// 执行测试
+		_, err := d.uc.LoginMFA(ctx, &auth.MFALoginRequest{MFAToken: challenge.Token, Code: "wrong-code", ClientIP: "10.0.0.1"})
+
+		// 断言
+		assert.True(t, v1.IsIncorrectMfaCode(err))
+	})
+
+	t.Run("已使用的验证码不能重放", func(t *testing.T) {
+		d := newMFADeps(ctrl)
+		d.mfaRepo.EXPECT().FindByUserID(gomock.Any(), "user123").Return(enabled, nil).Times(2)
+		challenge := d.challenge(t, ctx, newUser())
+
+		// Mock 期望
+		d.repo.EXPECT().FindByID(gomock.Any(), "user123").Return(newUser(), nil)
+		d.mfaRepo.EXPECT().UseStep(gomock.Any(), "mfa1", gomock.Any()).Return(false, nil)
+		d.lockout.EXPECT().Fail(gomock.Any(), gomock.Any(), gomock.Any()).Return(time.Duration(0), nil)
+		d.loginLog.EXPECT().RecordLoginLog(gomock.Any(), gomock.Any()).Return(nil)
+
+		// 执行测试
+		_, err := d.uc.LoginMFA(ctx, &auth.MFALoginRequest{MFAToken: challenge.Token, Code: code(t)})
+
+		// 断言
+		assert.True(t, v1.IsIncorrectMfaCode(err))
+	})
+
+	t.Run("使用恢复码完成登录", func(t *testing.T) {
+		d := newMFADeps(ctrl)
+		d.mfaRepo.EXPECT().FindByUserID(gomock.Any(), "user123").Return(enabled, nil).Times(2)
+		challenge := d.challenge(t, ctx, newUser())
+
+		// Mock 期望：恢复码忽略大小写和分隔符
+		d.repo.EXPECT().FindByID(gomock.Any(), "user123").Return(newUser(), nil)
+		d.mfaRepo.EXPECT().UseRecoveryCode(gomock.Any(), "mfa1", recoveryHash).Return(true, nil)
+		d.lockout.EXPECT().Reset(gomock.Any(), gomock.Any()).Return(nil)
+		d.loginLog.EXPECT().RecordLoginLog(gomock.Any(), gomock.Any()).Return(nil)
+
+		// 执行测试
+		result, err := d.uc.LoginMFA(ctx, &auth.MFALoginRequest{MFAToken: challenge.Token, Code: "ABCDE-23456"})
+
+		// 断言
+		require.NoError(t, err)
+		assert.NotNil(t, result.Token)
+	})
+
+	t.Run("租户要求两步验证时登录需先绑定", func(t *testing.T) {
+		d := newMFADeps(ctrl)
+		d.mfaRepo.EXPECT().FindByUserID(gomock.Any(), "user123").Return(nil, nil)
+		d.expectMFARequired(true)
+		challenge := d.challenge(t, ctx, newUser())
+		assert.True(t, challenge.EnrollRequired)
+
+		// Mock 期望：绑定
+		var pending *auth.UserMFA
+		d.repo.EXPECT().FindByID(gomock.Any(), "user123").Return(newUser(), nil).Times(2)
+		d.mfaRepo.EXPECT().FindByUserID(gomock.Any(), "user123").Return(nil, nil)
+		d.mfaRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, m *auth.UserMFA) (*auth.UserMFA, error) {
+				assert.Equal(t, "tenant1", pkgAuth.TenantID(ctx))
+				pending = &auth.UserMFA{ID: ptr.Of("mfa2"), UserID: m.UserID, Secret: m.Secret, Enabled: ptr.Of(false)}
+				return pending, nil
+			})
+
+		// 执行测试
+		enrollment, err := d.uc.EnrollMFA(ctx, challenge.Token)
+
+		// 断言
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(enrollment.URI, "otpauth://totp/qn-base:testuser?"))
+		assert.Contains(t, enrollment.URI, "secret="+enrollment.Secret)
+
+		// Mock 期望：验证码通过后启用并完成登录
+		d.mfaRepo.EXPECT().FindByUserID(gomock.Any(), "user123").DoAndReturn(func(context.Context, string) (*auth.UserMFA, error) {
+			return pending, nil
+		})
+		d.mfaRepo.EXPECT().Activate(gomock.Any(), "mfa2", gomock.Len(10), totp.Step(time.Now())).Return(nil)
+		d.lockout.EXPECT().Reset(gomock.Any(), gomock.Any()).Return(nil)
+		d.loginLog.EXPECT().RecordLoginLog(gomock.Any(), gomock.Any()).Return(nil)
+		c, err := totp.Code(enrollment.Secret, totp.Step(time.Now()))
+		require.NoError(t, err)
+
+		// 执行测试
+		result, err := d.uc.LoginMFA(ctx, &auth.MFALoginRequest{MFAToken: challenge.Token, Code: c})
+
+		// 断言
+		require.NoError(t, err)
+		assert.NotNil(t, result.Token)
+		assert.Len(t, result.RecoveryCodes, 10)
+		assert.Regexp(t, "^[a-z2-9]{5}-[a-z2-9]{5}$", result.RecoveryCodes[0])
+	})
+
+	t.Run("无效的待验证令牌", func(t *testing.T) {
+		d := newMFADeps(ctrl)
+
+		// 执行测试
+		_, err := d.uc.LoginMFA(ctx, &auth.MFALoginRequest{MFAToken: "invalid", Code: "123456"})
+
+		// 断言
+		assert.True(t, v1.IsIncorrectMfaToken(err))
+	})
+}
+
+func TestAuthUsecase_ManageMFA(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+	ctx := pkgAuth.NewContext(context.Background(), &pkgAuth.Principal{UserID: "user123", TenantID: "tenant1"})
+	pending := &auth.UserMFA{ID: ptr.Of("mfa1"), UserID: ptr.Of("user123"), Secret: ptr.Of(secret), Enabled: ptr.Of(false)}
+	enabled := &auth.UserMFA{ID: ptr.Of("mfa1"), UserID: ptr.Of("user123"), Secret: ptr.Of(secret), Enabled: ptr.Of(true), RecoveryCodes: []string{"h1", "h2"}}
+	code := func(t *testing.T) string {
+		c, err := totp.Code(secret, totp.Step(time.Now()))
+		require.NoError(t, err)
+		return c
+	}
+
+	t.Run("当前用户绑定两步验证", func(t *testing.T) {
+		d := newMFADeps(ctrl)
+
+		// Mock 期望
+		d.repo.EXPECT().FindByID(ctx, "user123").Return(&systemuser.SystemUser{ID: ptr.Of("user123"), Account: ptr.Of("testuser")}, nil)
+		d.mfaRepo.EXPECT().FindByUserID(ctx, "user123").Return(pending, nil)
+		d.mfaRepo.EXPECT().Save(ctx, gomock.Any()).Return(pending, nil)
+
+		// 执行测试
+		enrollment, err := d.uc.EnrollMFA(ctx, "")
+
+		// 断言
+		require.NoError(t, err)
+		assert.NotEmpty(t, enrollment.Secret)
+	})
+
+	t.Run("已启用时不能重复绑定", func(t *testing.T) {
+		d := newMFADeps(ctrl)
+
+		// Mock 期望
+		d.repo.EXPECT().FindByID(ctx, "user123").Return(&systemuser.SystemUser{ID: ptr.Of("user123")}, nil)
+		d.mfaRepo.EXPECT().FindByUserID(ctx, "user123").Return(enabled, nil)
+
+		// 执行测试
+		_, err := d.uc.EnrollMFA(ctx, "")
+
+		// 断言
+		assert.True(t, errors.IsConflict(err))
+	})
+
+	t.Run("验证后启用并返回恢复码", func(t *testing.T) {
+		d := newMFADeps(ctrl)
+
+		// Mock 期望
+		d.mfaRepo.EXPECT().FindByUserID(ctx, "user123").Return(pending, nil).Times(2)
+		d.mfaRepo.EXPECT().Activate(ctx, "mfa1", gomock.Len(10), totp.Step(time.Now())).
+			DoAndReturn(func(_ context.Context, _ string, hashes []string, _ int64) error {
+				assert.True(t, strings.HasPrefix(hashes[0], "$argon2id$"))
+				return nil
+			})
+
+		// 执行测试
+		_, err := d.uc.ActivateMFA(ctx, "000000")
+		codes, err2 := d.uc.ActivateMFA(ctx, code(t))
+
+		// 断言
+		assert.True(t, v1.IsIncorrectMfaCode(err))
+		require.NoError(t, err2)
+		assert.Len(t, codes, 10)
+	})
+
+	t.Run("查看两步验证状态", func(t *testing.T) {
+		d := newMFADeps(ctrl)
+
+		// Mock 期望
+		d.mfaRepo.EXPECT().FindByUserID(ctx, "user123").Return(enabled, nil)
+		d.expectMFARequired(true)
+
+		// 执行测试
+		status, err := d.uc.GetMFAStatus(ctx)
+
+		// 断言
+		require.NoError(t, err)
+		assert.Equal(t, &auth.MFAStatus{Enabled: true, Required: true, RecoveryCodesRemaining: 2}, status)
+	})
+
+	t.Run("租户要求两步验证时不能关闭", func(t *testing.T) {
+		d := newMFADeps(ctrl)
+
+		// Mock 期望
+		d.mfaRepo.EXPECT().FindByUserID(ctx, "user123").Return(enabled, nil)
+		d.expectMFARequired(true)
+
+		// 执行测试
+		err := d.uc.DisableMFA(ctx, code(t))
+
+		// 断言
+		assert.True(t, errors.IsForbidden(err))
+	})
+
+	t.Run("验证后关闭两步验证", func(t *testing.T) {
+		d := newMFADeps(ctrl)
+
+		// Mock 期望
+		d.mfaRepo.EXPECT().FindByUserID(ctx, "user123").Return(enabled, nil)
+		d.expectMFARequired(false)
+		d.mfaRepo.EXPECT().UseStep(ctx, "mfa1", totp.Step(time.Now())).Return(true, nil)
+		d.mfaRepo.EXPECT().DeleteByUserID(ctx, "user123").Return(nil)
+
+		// 执行测试 & 断言
+		assert.NoError(t, d.uc.DisableMFA(ctx, code(t)))
+	})
+
+	t.Run("管理员重置用户的两步验证", func(t *testing.T) {
+		d := newMFADeps(ctrl)
+
+		// Mock 期望
+		d.mfaRepo.EXPECT().DeleteByUserID(ctx, "user456").Return(nil)
+
+		// 执行测试 & 断言
+		assert.NoError(t, d.uc.ResetUserMFA(ctx, "user456"))
+	})
+}
@@ -1,15 +1,23 @@
 package biz
 
 import (
-	"context"
+	"qn-base/app/admin/internal/biz/auth"
+	"qn-base/app/admin/internal/biz/permission"
+	"qn-base/app/admin/internal/biz/policy"
+	"qn-base/app/admin/internal/biz/systemdept"
+	"qn-base/app/admin/internal/biz/systemloginlog"
+	"qn-base/app/admin/internal/biz/systemmenu"
+	"qn-base/app/admin/internal/biz/systempost"
+	"qn-base/app/admin/internal/biz/systemrole"
+	"qn-base/app/admin/internal/biz/systemtenant"
 	"qn-base/app/admin/internal/biz/systemuser"
+	"qn-base/app/admin/internal/biz/tx"
 
 	"github.com/google/wire"
 )
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(systemuser.NewUserUsecase)
+var ProviderSet = wire.NewSet(systemuser.NewUserUsecase, auth.NewAuthUsecase, systemrole.NewRoleUsecase, systemmenu.NewMenuUsecase, permission.NewPermissionUsecase, permission.NewRolePermissionLoader, policy.NewPolicyUsecase, systemdept.NewDeptUsecase, systempost.NewPostUsecase, systemtenant.NewTenantUsecase, systemtenant.NewTenantPackageUsecase, systemtenant.NewAccountQuota, systemtenant.NewTenantMenus, systemloginlog.NewLoginLogUsecase)
 
-type Transaction interface {
-	InTx(context.Context, func(ctx context.Context) error) error
-}
+// Transaction is the database transaction used by usecases.
+type Transaction = tx.Transaction
@@ -0,0 +1,21 @@
+package permission
+
+import (
+	"context"
+
+	"qn-base/app/admin/internal/biz/systemrole"
+	"qn-base/pkg/auth"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=./mocks/usecase_mock.go -package=mocks
+type PermissionUsecase interface {
+	AssignUserRoles(ctx context.Context, userID string, roleIDs []string) error
+	ReplaceUserRoles(ctx context.Context, userID string, roleIDs []string) error
+	ListUserRoles(ctx context.Context, userID string) ([]*systemrole.SystemRole, error)
+	AssignRoleMenus(ctx context.Context, roleID string, menuIDs []string) error
+	ReplaceRoleMenus(ctx context.Context, roleID string, menuIDs []string) error
+	ListRoleMenuIDs(ctx context.Context, roleID string) ([]string, error)
+	GetUserPermissions(ctx context.Context, userID string) ([]string, error)
+	// GetDataScope returns the data scope of the principal in ctx.
+	GetDataScope(ctx context.Context) (*auth.DataScope, error)
+}
@@ -0,0 +1,444 @@
+package permission
+
+import (
+	"context"
+
+	"qn-base/app/admin/internal/biz/systemdept"
+	"qn-base/app/admin/internal/biz/systemmenu"
+	"qn-base/app/admin/internal/biz/systemrole"
+	"qn-base/app/admin/internal/biz/systemuser"
+	"qn-base/app/admin/internal/biz/tx"
+	"qn-base/pkg/auth"
+	"qn-base/pkg/lang/ptr"
+	"qn-base/pkg/lang/slices"
+	"qn-base/pkg/util/validator"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrRoleNotExists is some of the roles do not exist.
+	ErrRoleNotExists = errors.BadRequest("ROLE_NOT_EXISTS", "some of the roles do not exist")
+	// ErrMenuNotExists is some of the menus do not exist.
+	ErrMenuNotExists = errors.BadRequest("MENU_NOT_EXISTS", "some of the menus do not exist")
+	// ErrMenuNotInPackage is some of the menus are not in the package of the tenant.
+	ErrMenuNotInPackage = errors.Forbidden("MENU_NOT_IN_PACKAGE", "some of the menus are not in the tenant package")
+)
+
+// PermissionRepo is the repo of user-role and role-menu assignments.
+//
+//go:generate mockgen -source=permission_biz.go -destination=./mocks/mock_permission_repo.go -package=mocks
+type PermissionRepo interface {
+	ListUserRoleIDs(context.Context, string) ([]string, error)
+	AddUserRoles(context.Context, string, []string) error
+	// RemoveUserRoles removes all roles of the user.
+	RemoveUserRoles(context.Context, string) error
+	ListRoleMenuIDs(context.Context, string) ([]string, error)
+	AddRoleMenus(context.Context, string, []string) error
+	// RemoveRoleMenus removes all menus of the role.
+	RemoveRoleMenus(context.Context, string) error
+	// RemoveMenusNotIn removes the menus not in menuIDs from all the roles of the tenant,
+	// and returns the IDs of the roles changed.
+	RemoveMenusNotIn(ctx context.Context, menuIDs []string) ([]string, error)
+}
+
+// TenantMenus limits the menus that the roles of a tenant may hold to the menus of its package.
+type TenantMenus interface {
+	// ListPackageMenuIDs returns the menu IDs of the package of the tenant.
+	ListPackageMenuIDs(ctx context.Context, tenantID string) ([]string, error)
+}
+
+// permissionUsecase 是 PermissionUsecase 接口的具体实现
+type permissionUsecase struct {
+	tx       tx.Transaction
+	repo     PermissionRepo
+	userRepo systemuser.SystemUserRepo
+	roleRepo systemrole.SystemRoleRepo
+	menuRepo systemmenu.SystemMenuRepo
+	deptRepo systemdept.SystemDeptRepo
+	// tenantMenus 限制租户的角色只能分配套餐内的菜单
+	tenantMenus TenantMenus
+	authz       auth.PermissionInvalidator
+	log         *log.Helper
+}
+
+// 确保 permissionUsecase 实现了 PermissionUsecase 接口
+var _ PermissionUsecase = (*permissionUsecase)(nil)
+
+// NewPermissionUsecase new a Permission usecase.
+func NewPermissionUsecase(
+	tx tx.Transaction,
+	repo PermissionRepo,
+	userRepo systemuser.SystemUserRepo,
+	roleRepo systemrole.SystemRoleRepo,
+	menuRepo systemmenu.SystemMenuRepo,
+	deptRepo systemdept.SystemDeptRepo,
+	tenantMenus TenantMenus,
+	authz auth.PermissionInvalidator,
+	logger log.Logger,
+) PermissionUsecase {
+	return &permissionUsecase{
+		tx:          tx,
+		repo:        repo,
+		userRepo:    userRepo,
+		roleRepo:    roleRepo,
+		menuRepo:    menuRepo,
+		deptRepo:    deptRepo,
+		tenantMenus: tenantMenus,
+		authz:       authz,
+		log:         log.NewHelper(log.With(logger, "module", "permission/biz")),
+	}
+}
+
+// NewRolePermissionLoader creates the loader of the permission strings of an enabled role used by the authorizer.
+// 直接读取菜单，使用例可以依赖鉴权器清除其缓存
+func NewRolePermissionLoader(menuRepo systemmenu.SystemMenuRepo) auth.RolePermissionLoader {
+	return func(ctx context.Context, _, role string) ([]string, error) {
+		menus, err := menuRepo.ListByRoleCodes(ctx, []string{role})
+		if err != nil {
+			return nil, err
+		}
+		return systemmenu.CollectPermissions(menus), nil
+	}
+}
+
+// AssignUserRoles adds roles to the user, keeping the roles already assigned.
+func (uc *permissionUsecase) AssignUserRoles(ctx context.Context, userID string, roleIDs []string) error {
+	uc.log.WithContext(ctx).Infof("AssignUserRoles: userID=%s, roleIDs=%v", userID, roleIDs)
+
+	if err := validator.ValidateIDs(roleIDs); err != nil {
+		return errors.BadRequest("INVALID_PARAMETER", err.Error())
+	}
+	if err := uc.checkUser(ctx, userID); err != nil {
+		return err
+	}
+	roleIDs = slices.Uniq(roleIDs)
+	if err := uc.checkRoles(ctx, roleIDs); err != nil {
+		return err
+	}
+
+	return uc.tx.InTx(ctx, func(ctx context.Context) error {
+		existing, err := uc.repo.ListUserRoleIDs(ctx, userID)
+		if err != nil {
+			return err
+		}
+		return uc.repo.AddUserRoles(ctx, userID, subtract(roleIDs, existing))
+	})
+}
+
+// ReplaceUserRoles replaces all roles of the user, an empty roleIDs clears them.
+func (uc *permissionUsecase) ReplaceUserRoles(ctx context.Context, userID string, roleIDs []string) error {
+	uc.log.WithContext(ctx).Infof("ReplaceUserRoles: userID=%s, roleIDs=%v", userID, roleIDs)
+
+	// 为空时清空，不做校验
+	if len(roleIDs) > 0 {
+		if err := validator.ValidateIDs(roleIDs); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+	}
+	if err := uc.checkUser(ctx, userID); err != nil {
+		return err
+	}
+	roleIDs = slices.Uniq(roleIDs)
+	if err := uc.checkRoles(ctx, roleIDs); err != nil {
+		return err
+	}
+
+	return uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.RemoveUserRoles(ctx, userID); err != nil {
+			return err
+		}
+		return uc.repo.AddUserRoles(ctx, userID, roleIDs)
+	})
+}
+
+// ListUserRoles lists the roles of the user.
+func (uc *permissionUsecase) ListUserRoles(ctx context.Context, userID string) ([]*systemrole.SystemRole, error) {
+	uc.log.WithContext(ctx).Infof("ListUserRoles: userID=%s", userID)
+
+	if err := uc.checkUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	roleIDs, err := uc.repo.ListUserRoleIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(roleIDs) == 0 {
+		return []*systemrole.SystemRole{}, nil
+	}
+	// 已删除的角色不返回
+	return uc.roleRepo.ListByIDs(ctx, roleIDs)
+}
+
+// AssignRoleMenus adds menus to the role, keeping the menus already assigned.
+func (uc *permissionUsecase) AssignRoleMenus(ctx context.Context, roleID string, menuIDs []string) error {
+	uc.log.WithContext(ctx).Infof("AssignRoleMenus: roleID=%s, menuIDs=%v", roleID, menuIDs)
+
+	if err := validator.ValidateIDs(menuIDs); err != nil {
+		return errors.BadRequest("INVALID_PARAMETER", err.Error())
+	}
+	role, err := uc.findRole(ctx, roleID)
+	if err != nil {
+		return err
+	}
+	menuIDs = slices.Uniq(menuIDs)
+	if err := uc.checkMenus(ctx, menuIDs); err != nil {
+		return err
+	}
+
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		existing, err := uc.repo.ListRoleMenuIDs(ctx, roleID)
+		if err != nil {
+			return err
+		}
+		return uc.repo.AddRoleMenus(ctx, roleID, subtract(menuIDs, existing))
+	})
+	if err != nil {
+		return err
+	}
+	uc.authz.Invalidate(auth.TenantID(ctx), ptr.From(role.Code))
+	return nil
+}
+
+// ReplaceRoleMenus replaces all menus of the role, an empty menuIDs clears them.
+func (uc *permissionUsecase) ReplaceRoleMenus(ctx context.Context, roleID string, menuIDs []string) error {
+	uc.log.WithContext(ctx).Infof("ReplaceRoleMenus: roleID=%s, menuIDs=%v", roleID, menuIDs)
+
+	// 为空时清空，不做校验
+	if len(menuIDs) > 0 {
+		if err := validator.ValidateIDs(menuIDs); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+	}
+	role, err := uc.findRole(ctx, roleID)
+	if err != nil {
+		return err
+	}
+	menuIDs = slices.Uniq(menuIDs)
+	if err := uc.checkMenus(ctx, menuIDs); err != nil {
+		return err
+	}
+
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.RemoveRoleMenus(ctx, roleID); err != nil {
+			return err
+		}
+		return uc.repo.AddRoleMenus(ctx, roleID, menuIDs)
+	})
+	if err != nil {
+		return err
+	}
+	uc.authz.Invalidate(auth.TenantID(ctx), ptr.From(role.Code))
+	return nil
+}
+
+// ListRoleMenuIDs lists the menu IDs of the role.
+func (uc *permissionUsecase) ListRoleMenuIDs(ctx context.Context, roleID string) ([]string, error) {
+	uc.log.WithContext(ctx).Infof("ListRoleMenuIDs: roleID=%s", roleID)
+
+	if _, err := uc.findRole(ctx, roleID); err != nil {
+		return nil, err
+	}
+	return uc.repo.ListRoleMenuIDs(ctx, roleID)
+}
+
+// GetUserPermissions returns the merged permission strings of the user's enabled roles.
+// 平台租户的超级管理员拥有全部启用菜单的权限标识
+func (uc *permissionUsecase) GetUserPermissions(ctx context.Context, userID string) ([]string, error) {
+	uc.log.WithContext(ctx).Infof("GetUserPermissions: userID=%s", userID)
+
+	roles, err := uc.ListUserRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	codes := EnabledRoleCodes(roles)
+	if len(codes) == 0 {
+		return []string{}, nil
+	}
+
+	var menus []*systemmenu.SystemMenu
+	if auth.IsPlatformTenant(auth.TenantID(ctx)) && slices.Contains(codes, auth.SuperAdminRoleCode) {
+		menus, err = uc.menuRepo.ListMenus(ctx, &systemmenu.ListMenuRequest{Status: ptr.Of(int8(1))})
+	} else {
+		menus, err = uc.menuRepo.ListByRoleCodes(ctx, codes)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return systemmenu.CollectPermissions(menus), nil
+}
+
+// GetDataScope returns the data scope of the principal in ctx, which is the union of the
+// data scopes of its enabled roles. 超级管理员不限制数据范围
+func (uc *permissionUsecase) GetDataScope(ctx context.Context) (*auth.DataScope, error) {
+	p, ok := auth.FromContext(ctx)
+	if !ok {
+		return &auth.DataScope{}, nil
+	}
+	if p.IsSuperAdmin() {
+		return &auth.DataScope{All: true}, nil
+	}
+
+	scope := &auth.DataScope{UserID: p.UserID}
+	var ownDept, ownDeptAndChild bool
+	for _, code := range p.Roles {
+		role, err := uc.roleRepo.FindByCode(ctx, code)
+		if err != nil {
+			return nil, err
+		}
+		if role == nil || ptr.From(role.Status) != 1 {
+			continue
+		}
+		switch ptr.From(role.DataScope) {
+		case systemrole.DataScopeAll:
+			return &auth.DataScope{All: true, UserID: p.UserID}, nil
+		case systemrole.DataScopeCustom:
+			scope.DeptIDs = append(scope.DeptIDs, role.DataScopeDeptIDs...)
+		case systemrole.DataScopeDept:
+			ownDept = true
+		case systemrole.DataScopeDeptAndChild:
+			ownDeptAndChild = true
+		}
+	}
+
+	if ownDept || ownDeptAndChild {
+		deptIDs, err := uc.ownDeptIDs(ctx, p.UserID, ownDeptAndChild)
+		if err != nil {
+			return nil, err
+		}
+		scope.DeptIDs = append(scope.DeptIDs, deptIDs...)
+	}
+	scope.DeptIDs = slices.Uniq(scope.DeptIDs)
+	return scope, nil
+}
+
+// ownDeptIDs returns the dept of the user, and its descendants if withChild.
+func (uc *permissionUsecase) ownDeptIDs(ctx context.Context, userID string, withChild bool) ([]string, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || ptr.From(user.DeptID) == "" {
+		return nil, nil
+	}
+	deptIDs := []string{*user.DeptID}
+	if !withChild {
+		return deptIDs, nil
+	}
+
+	dept, err := uc.deptRepo.FindByID(ctx, *user.DeptID)
+	if err != nil {
+		return nil, err
+	}
+	if dept == nil {
+		return deptIDs, nil
+	}
+	descendants, err := uc.deptRepo.ListDescendants(ctx, systemdept.ChildAncestors(dept))
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range descendants {
+		deptIDs = append(deptIDs, ptr.From(d.ID))
+	}
+	return deptIDs, nil
+}
+
+// EnabledRoleCodes returns the codes of the enabled roles.
+func EnabledRoleCodes(roles []*systemrole.SystemRole) []string {
+	codes := make([]string, 0, len(roles))
+	for _, role := range roles {
+		if ptr.From(role.Status) == 1 && ptr.From(role.Code) != "" {
+			codes = append(codes, *role.Code)
+		}
+	}
+	return codes
+}
+
+// checkUser checks that the user exists.
+func (uc *permissionUsecase) checkUser(ctx context.Context, userID string) error {
+	if err := validator.ValidateRequiredString(userID, "用户ID"); err != nil {
+		return errors.BadRequest("INVALID_PARAMETER", err.Error())
+	}
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return systemuser.ErrUserNotFound
+	}
+	return nil
+}
+
+// findRole returns the role, or ErrRoleNotFound if it does not exist.
+func (uc *permissionUsecase) findRole(ctx context.Context, roleID string) (*systemrole.SystemRole, error) {
+	if err := validator.ValidateRequiredString(roleID, "角色ID"); err != nil {
+		return nil, errors.BadRequest("INVALID_PARAMETER", err.Error())
+	}
+	role, err := uc.roleRepo.FindByID(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+	if role == nil {
+		return nil, systemrole.ErrRoleNotFound
+	}
+	return role, nil
+}
+
+// checkRoles checks that all the roles exist.
+func (uc *permissionUsecase) checkRoles(ctx context.Context, roleIDs []string) error {
+	if len(roleIDs) == 0 {
+		return nil
+	}
+	roles, err := uc.roleRepo.ListByIDs(ctx, roleIDs)
+	if err != nil {
+		return err
+	}
+	if len(roles) != len(roleIDs) {
+		return ErrRoleNotExists
+	}
+	return nil
+}
+
+// checkMenus checks that all the menus exist and are in the package of the current tenant.
+func (uc *permissionUsecase) checkMenus(ctx context.Context, menuIDs []string) error {
+	if len(menuIDs) == 0 {
+		return nil
+	}
+	menus, err := uc.menuRepo.ListByIDs(ctx, menuIDs)
+	if err != nil {
+		return err
+	}
+	if len(menus) != len(menuIDs) {
+		return ErrMenuNotExists
+	}
+
+	// 平台租户不受套餐限制
+	tenantID := auth.TenantID(ctx)
+	if auth.IsPlatformTenant(tenantID) {
+		return nil
+	}
+	allowed, err := uc.tenantMenus.ListPackageMenuIDs(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	if len(subtract(menuIDs, allowed)) > 0 {
+		return ErrMenuNotInPackage
+	}
+	return nil
+}
+
+// subtract returns the IDs in ids but not in exclude.
+func subtract(ids, exclude []string) []string {
+	excluded := make(map[string]struct{}, len(exclude))
+	for _, id := range exclude {
+		excluded[id] = struct{}{}
+	}
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := excluded[id]; !ok {
+			result = append(result, id)
+		}
+	}
+	return result
+}
@@ -0,0 +1,432 @@
+package permission_test
+
+import (
+	"context"
+	"testing"
+
+	"qn-base/app/admin/internal/biz/permission"
+	"qn-base/app/admin/internal/biz/permission/mocks"
+	"qn-base/app/admin/internal/biz/systemdept"
+	deptmocks "qn-base/app/admin/internal/biz/systemdept/mocks"
+	"qn-base/app/admin/internal/biz/systemmenu"
+	menumocks "qn-base/app/admin/internal/biz/systemmenu/mocks"
+	"qn-base/app/admin/internal/biz/systemrole"
+	rolemocks "qn-base/app/admin/internal/biz/systemrole/mocks"
+	"qn-base/app/admin/internal/biz/systemuser"
+	usermocks "qn-base/app/admin/internal/biz/systemuser/mocks"
+	"qn-base/pkg/auth"
+	"qn-base/pkg/lang/ptr"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeTx runs the function directly and records the calls.
+type fakeTx struct {
+	calls int
+}
+
+func (t *fakeTx) InTx(ctx context.Context, f func(ctx context.Context) error) error {
+	t.calls++
+	return f(ctx)
+}
+
+// fakeInvalidator records the roles whose cached permissions were dropped.
+type fakeInvalidator struct {
+	roles []string
+}
+
+func (f *fakeInvalidator) Invalidate(_ string, roles ...string) {
+	f.roles = append(f.roles, roles...)
+}
+
+type testDeps struct {
+	tx          *fakeTx
+	authz       *fakeInvalidator
+	repo        *mocks.MockPermissionRepo
+	userRepo    *usermocks.MockSystemUserRepo
+	roleRepo    *rolemocks.MockSystemRoleRepo
+	menuRepo    *menumocks.MockSystemMenuRepo
+	deptRepo    *deptmocks.MockSystemDeptRepo
+	tenantMenus *mocks.MockTenantMenus
+	uc          permission.PermissionUsecase
+}
+
+func newTestDeps(ctrl *gomock.Controller) *testDeps {
+	d := &testDeps{
+		tx:          &fakeTx{},
+		authz:       &fakeInvalidator{},
+		repo:        mocks.NewMockPermissionRepo(ctrl),
+		userRepo:    usermocks.NewMockSystemUserRepo(ctrl),
+		roleRepo:    rolemocks.NewMockSystemRoleRepo(ctrl),
+		menuRepo:    menumocks.NewMockSystemMenuRepo(ctrl),
+		deptRepo:    deptmocks.NewMockSystemDeptRepo(ctrl),
+		tenantMenus: mocks.NewMockTenantMenus(ctrl),
+	}
+	d.uc = permission.NewPermissionUsecase(d.tx, d.repo, d.userRepo, d.roleRepo, d.menuRepo, d.deptRepo, d.tenantMenus, d.authz, log.DefaultLogger)
+	return d
+}
+
+func newRole(id, code string, status int8) *systemrole.SystemRole {
+	return &systemrole.SystemRole{ID: ptr.Of(id), Code: ptr.Of(code), Status: ptr.Of(status)}
+}
+
+func TestPermissionUsecase_AssignUserRoles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	d := newTestDeps(ctrl)
+	ctx := context.Background()
+	user := &systemuser.SystemUser{ID: ptr.Of("user1")}
+
+	t.Run("只追加未分配的角色", func(t *testing.T) {
+		// Mock 期望
+		d.userRepo.EXPECT().FindByID(ctx, "user1").Return(user, nil)
+		d.roleRepo.EXPECT().ListByIDs(ctx, []string{"r1", "r2"}).Return([]*systemrole.SystemRole{
+			newRole("r1", "operator", 1), newRole("r2", "auditor", 1),
+		}, nil)
+		d.repo.EXPECT().ListUserRoleIDs(ctx, "user1").Return([]string{"r1"}, nil)
+		d.repo.EXPECT().AddUserRoles(ctx, "user1", []string{"r2"}).Return(nil)
+
+		// 执行测试
+		err := d.uc.AssignUserRoles(ctx, "user1", []string{"r1", "r2", "r2"})
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Equal(t, 1, d.tx.calls)
+	})
+
+	t.Run("角色不存在", func(t *testing.T) {
+		// Mock 期望
+		d.userRepo.EXPECT().FindByID(ctx, "user1").Return(user, nil)
+		d.roleRepo.EXPECT().ListByIDs(ctx, []string{"r1", "none"}).Return([]*systemrole.SystemRole{
+			newRole("r1", "operator", 1),
+		}, nil)
+
+		// 执行测试
+		err := d.uc.AssignUserRoles(ctx, "user1", []string{"r1", "none"})
+
+		// 断言
+		assert.True(t, errors.Is(err, permission.ErrRoleNotExists))
+	})
+
+	t.Run("用户不存在", func(t *testing.T) {
+		// Mock 期望
+		d.userRepo.EXPECT().FindByID(ctx, "none").Return(nil, nil)
+
+		// 执行测试
+		err := d.uc.AssignUserRoles(ctx, "none", []string{"r1"})
+
+		// 断言
+		assert.True(t, errors.Is(err, systemuser.ErrUserNotFound))
+	})
+}
+
+func TestPermissionUsecase_ReplaceUserRoles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	d := newTestDeps(ctrl)
+	ctx := context.Background()
+	user := &systemuser.SystemUser{ID: ptr.Of("user1")}
+
+	t.Run("清空用户角色", func(t *testing.T) {
+		// Mock 期望
+		d.userRepo.EXPECT().FindByID(ctx, "user1").Return(user, nil)
+		d.repo.EXPECT().RemoveUserRoles(ctx, "user1").Return(nil)
+		d.repo.EXPECT().AddUserRoles(ctx, "user1", []string{}).Return(nil)
+
+		// 执行测试
+		err := d.uc.ReplaceUserRoles(ctx, "user1", nil)
+
+		// 断言
+		assert.NoError(t, err)
+	})
+
+	t.Run("角色ID为空字符串", func(t *testing.T) {
+		// 执行测试
+		err := d.uc.ReplaceUserRoles(ctx, "user1", []string{""})
+
+		// 断言
+		assert.True(t, errors.IsBadRequest(err))
+	})
+}
+
+func TestPermissionUsecase_ReplaceRoleMenus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	d := newTestDeps(ctrl)
+	ctx := auth.WithTenant(context.Background(), "tenant1")
+
+	t.Run("在同一事务中先清空再分配", func(t *testing.T) {
+		// Mock 期望
+		d.roleRepo.EXPECT().FindByID(ctx, "r1").Return(newRole("r1", "operator", 1), nil)
+		d.menuRepo.EXPECT().ListByIDs(ctx, []string{"m1", "m2"}).Return([]*systemmenu.SystemMenu{
+			{ID: ptr.Of("m1")}, {ID: ptr.Of("m2")},
+		}, nil)
+		d.tenantMenus.EXPECT().ListPackageMenuIDs(ctx, "tenant1").Return([]string{"m1", "m2", "m3"}, nil)
+		gomock.InOrder(
+			d.repo.EXPECT().RemoveRoleMenus(ctx, "r1").Return(nil),
+			d.repo.EXPECT().AddRoleMenus(ctx, "r1", []string{"m1", "m2"}).Return(nil),
+		)
+
+		// 执行测试
+		err := d.uc.ReplaceRoleMenus(ctx, "r1", []string{"m1", "m2"})
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Equal(t, 1, d.tx.calls)
+		assert.Equal(t, []string{"operator"}, d.authz.roles)
+	})
+
+	t.Run("清空角色菜单", func(t *testing.T) {
+		// Mock 期望
+		d.roleRepo.EXPECT().FindByID(ctx, "r1").Return(newRole("r1", "operator", 1), nil)
+		d.repo.EXPECT().RemoveRoleMenus(ctx, "r1").Return(nil)
+		d.repo.EXPECT().AddRoleMenus(ctx, "r1", []string{}).Return(nil)
+
+		// 执行测试
+		err := d.uc.ReplaceRoleMenus(ctx, "r1", nil)
+
+		// 断言
+		assert.NoError(t, err)
+	})
+
+	t.Run("菜单ID为空字符串", func(t *testing.T) {
+		// 执行测试
+		err := d.uc.ReplaceRoleMenus(ctx, "r1", []string{"m1", " "})
+
+		// 断言
+		assert.True(t, errors.IsBadRequest(err))
+	})
+
+	t.Run("不能分配套餐外的菜单", func(t *testing.T) {
+		// Mock 期望
+		d.roleRepo.EXPECT().FindByID(ctx, "r1").Return(newRole("r1", "operator", 1), nil)
+		d.menuRepo.EXPECT().ListByIDs(ctx, []string{"m1", "m9"}).Return([]*systemmenu.SystemMenu{
+			{ID: ptr.Of("m1")}, {ID: ptr.Of("m9")},
+		}, nil)
+		d.tenantMenus.EXPECT().ListPackageMenuIDs(ctx, "tenant1").Return([]string{"m1", "m2"}, nil)
+
+		// 执行测试
+		err := d.uc.ReplaceRoleMenus(ctx, "r1", []string{"m1", "m9"})
+
+		// 断言
+		assert.True(t, errors.Is(err, permission.ErrMenuNotInPackage))
+	})
+
+	t.Run("平台租户不受套餐限制", func(t *testing.T) {
+		platformCtx := auth.WithTenant(context.Background(), auth.PlatformTenantID)
+
+		// Mock 期望
+		d.roleRepo.EXPECT().FindByID(platformCtx, "r1").Return(newRole("r1", "operator", 1), nil)
+		d.menuRepo.EXPECT().ListByIDs(platformCtx, []string{"m9"}).Return([]*systemmenu.SystemMenu{{ID: ptr.Of("m9")}}, nil)
+		d.repo.EXPECT().RemoveRoleMenus(platformCtx, "r1").Return(nil)
+		d.repo.EXPECT().AddRoleMenus(platformCtx, "r1", []string{"m9"}).Return(nil)
+
+		// 执行测试
+		err := d.uc.ReplaceRoleMenus(platformCtx, "r1", []string{"m9"})
+
+		// 断言
+		assert.NoError(t, err)
+	})
+}
+
+func TestPermissionUsecase_GetUserPermissions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	d := newTestDeps(ctrl)
+	ctx := context.Background()
+	user := &systemuser.SystemUser{ID: ptr.Of("user1")}
+
+	t.Run("合并启用角色的权限", func(t *testing.T) {
+		// Mock 期望
+		d.userRepo.EXPECT().FindByID(ctx, "user1").Return(user, nil)
+		d.repo.EXPECT().ListUserRoleIDs(ctx, "user1").Return([]string{"r1", "r2"}, nil)
+		d.roleRepo.EXPECT().ListByIDs(ctx, []string{"r1", "r2"}).Return([]*systemrole.SystemRole{
+			newRole("r1", "operator", 1), newRole("r2", "auditor", 0),
+		}, nil)
+		d.menuRepo.EXPECT().ListByRoleCodes(ctx, []string{"operator"}).Return([]*systemmenu.SystemMenu{
+			{ID: ptr.Of("m1"), Permission: ptr.Of("system:user:list")},
+			{ID: ptr.Of("m2"), Permission: ptr.Of("system:user:add")},
+			{ID: ptr.Of("m3"), Permission: ptr.Of("system:user:list")},
+			{ID: ptr.Of("m4"), Permission: ptr.Of("")},
+		}, nil)
+
+		// 执行测试
+		permissions, err := d.uc.GetUserPermissions(ctx, "user1")
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"system:user:add", "system:user:list"}, permissions)
+	})
+
+	t.Run("超级管理员拥有全部权限", func(t *testing.T) {
+		ctx := auth.WithTenant(ctx, auth.PlatformTenantID)
+
+		// Mock 期望
+		d.userRepo.EXPECT().FindByID(ctx, "user1").Return(user, nil)
+		d.repo.EXPECT().ListUserRoleIDs(ctx, "user1").Return([]string{"admin"}, nil)
+		d.roleRepo.EXPECT().ListByIDs(ctx, []string{"admin"}).Return([]*systemrole.SystemRole{
+			newRole("admin", auth.SuperAdminRoleCode, 1),
+		}, nil)
+		d.menuRepo.EXPECT().ListMenus(ctx, gomock.Any()).Return([]*systemmenu.SystemMenu{
+			{ID: ptr.Of("m1"), Permission: ptr.Of("system:role:list")},
+		}, nil)
+
+		// 执行测试
+		permissions, err := d.uc.GetUserPermissions(ctx, "user1")
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"system:role:list"}, permissions)
+	})
+
+	t.Run("其他租户的超级管理员编码不生效", func(t *testing.T) {
+		ctx := auth.WithTenant(ctx, "t1")
+
+		// Mock 期望
+		d.userRepo.EXPECT().FindByID(ctx, "user1").Return(user, nil)
+		d.repo.EXPECT().ListUserRoleIDs(ctx, "user1").Return([]string{"admin"}, nil)
+		d.roleRepo.EXPECT().ListByIDs(ctx, []string{"admin"}).Return([]*systemrole.SystemRole{
+			newRole("admin", auth.SuperAdminRoleCode, 1),
+		}, nil)
+		d.menuRepo.EXPECT().ListByRoleCodes(ctx, []string{auth.SuperAdminRoleCode}).Return(nil, nil)
+
+		// 执行测试
+		permissions, err := d.uc.GetUserPermissions(ctx, "user1")
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Empty(t, permissions)
+	})
+
+	t.Run("没有角色", func(t *testing.T) {
+		// Mock 期望
+		d.userRepo.EXPECT().FindByID(ctx, "user1").Return(user, nil)
+		d.repo.EXPECT().ListUserRoleIDs(ctx, "user1").Return(nil, nil)
+
+		// 执行测试
+		permissions, err := d.uc.GetUserPermissions(ctx, "user1")
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Empty(t, permissions)
+	})
+}
+
+func TestPermissionUsecase_GetDataScope(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	d := newTestDeps(ctrl)
+	newScopeRole := func(code string, scope int8, deptIDs ...string) *systemrole.SystemRole {
+		role := newRole(code, code, 1)
+		role.DataScope = ptr.Of(scope)
+		role.DataScopeDeptIDs = deptIDs
+		return role
+	}
+	withRoles := func(roles ...string) context.Context {
+		return auth.NewContext(context.Background(), &auth.Principal{UserID: "user1", TenantID: "t1", Roles: roles})
+	}
+	user := &systemuser.SystemUser{ID: ptr.Of("user1"), DeptID: ptr.Of("d2")}
+
+	t.Run("超级管理员不限制", func(t *testing.T) {
+		ctx := auth.WithTenant(withRoles(auth.SuperAdminRoleCode), auth.PlatformTenantID)
+
+		// 执行测试
+		scope, err := d.uc.GetDataScope(ctx)
+
+		// 断言
+		assert.NoError(t, err)
+		assert.True(t, scope.All)
+	})
+
+	t.Run("全部数据权限", func(t *testing.T) {
+		ctx := withRoles("manager")
+
+		// Mock 期望
+		d.roleRepo.EXPECT().FindByCode(ctx, "manager").Return(newScopeRole("manager", systemrole.DataScopeAll), nil)
+
+		// 执行测试
+		scope, err := d.uc.GetDataScope(ctx)
+
+		// 断言
+		assert.NoError(t, err)
+		assert.True(t, scope.All)
+	})
+
+	t.Run("自定数据权限", func(t *testing.T) {
+		ctx := withRoles("auditor")
+
+		// Mock 期望
+		d.roleRepo.EXPECT().FindByCode(ctx, "auditor").Return(newScopeRole("auditor", systemrole.DataScopeCustom, "d5", "d6"), nil)
+
+		// 执行测试
+		scope, err := d.uc.GetDataScope(ctx)
+
+		// 断言
+		assert.NoError(t, err)
+		assert.False(t, scope.All)
+		assert.Equal(t, "user1", scope.UserID)
+		assert.Equal(t, []string{"d5", "d6"}, scope.DeptIDs)
+	})
+
+	t.Run("本部门数据权限", func(t *testing.T) {
+		ctx := withRoles("clerk")
+
+		// Mock 期望
+		d.roleRepo.EXPECT().FindByCode(ctx, "clerk").Return(newScopeRole("clerk", systemrole.DataScopeDept), nil)
+		d.userRepo.EXPECT().FindByID(ctx, "user1").Return(user, nil)
+
+		// 执行测试
+		scope, err := d.uc.GetDataScope(ctx)
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"d2"}, scope.DeptIDs)
+	})
+
+	t.Run("本部门及以下数据权限", func(t *testing.T) {
+		ctx := withRoles("leader")
+
+		// Mock 期望
+		d.roleRepo.EXPECT().FindByCode(ctx, "leader").Return(newScopeRole("leader", systemrole.DataScopeDeptAndChild), nil)
+		d.userRepo.EXPECT().FindByID(ctx, "user1").Return(user, nil)
+		d.deptRepo.EXPECT().FindByID(ctx, "d2").Return(&systemdept.SystemDept{ID: ptr.Of("d2"), Ancestors: ptr.Of("0,d1")}, nil)
+		d.deptRepo.EXPECT().ListDescendants(ctx, "0,d1,d2").Return([]*systemdept.SystemDept{
+			{ID: ptr.Of("d3")}, {ID: ptr.Of("d4")},
+		}, nil)
+
+		// 执行测试
+		scope, err := d.uc.GetDataScope(ctx)
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"d2", "d3", "d4"}, scope.DeptIDs)
+	})
+
+	t.Run("合并多个角色并忽略停用角色", func(t *testing.T) {
+		ctx := withRoles("auditor", "clerk", "disabled")
+		disabled := newScopeRole("disabled", systemrole.DataScopeAll)
+		disabled.Status = ptr.Of(int8(0))
+
+		// Mock 期望
+		d.roleRepo.EXPECT().FindByCode(ctx, "auditor").Return(newScopeRole("auditor", systemrole.DataScopeCustom, "d2", "d5"), nil)
+		d.roleRepo.EXPECT().FindByCode(ctx, "clerk").Return(newScopeRole("clerk", systemrole.DataScopeDept), nil)
+		d.roleRepo.EXPECT().FindByCode(ctx, "disabled").Return(disabled, nil)
+		d.userRepo.EXPECT().FindByID(ctx, "user1").Return(user, nil)
+
+		// 执行测试
+		scope, err := d.uc.GetDataScope(ctx)
+
+		// 断言
+		assert.NoError(t, err)
+		assert.False(t, scope.All)
+		assert.Equal(t, []string{"d2", "d5"}, scope.DeptIDs)
+	})
+}
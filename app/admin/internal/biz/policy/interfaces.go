@@ -0,0 +1,37 @@
+package policy
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=./mocks/usecase_mock.go -package=mocks
+type PolicyUsecase interface {
+	ListPolicies(ctx context.Context, req *ListPolicyRequest) ([]*Policy, error)
+	AddPolicy(ctx context.Context, p *Policy) error
+	RemovePolicy(ctx context.Context, p *Policy) error
+	// Enabled reports whether the policy engine is enabled.
+	Enabled() bool
+	Enforce(ctx context.Context, subject, domain, object, action string) (bool, error)
+}
+
+const (
+	// AllDomains 对所有租户生效的域
+	AllDomains = "*"
+	// AnyAction 匹配任意权限标识的动作
+	AnyAction = "*"
+)
+
+// Policy is a casbin policy rule of the RBAC with domains model.
+type Policy struct {
+	Subject string `json:"subject"` // 主体，用户ID或角色编码
+	Domain  string `json:"domain"`  // 域，即租户ID
+	Object  string `json:"object"`  // 对象，接口操作，支持 keyMatch 通配，如 /admin.v1.User/*
+	Action  string `json:"action"`  // 动作，权限标识，* 表示任意
+}
+
+// ListPolicyRequest is a list policy request, empty fields match all.
+type ListPolicyRequest struct {
+	Subject string
+	Domain  string
+	Object  string
+}
@@ -0,0 +1,160 @@
+package policy
+
+import (
+	"context"
+
+	"qn-base/pkg/auth"
+	"qn-base/pkg/util/validator"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrPolicyEngineDisabled is the policy engine is disabled.
+	ErrPolicyEngineDisabled = errors.ServiceUnavailable("POLICY_ENGINE_DISABLED", "policy engine is disabled")
+	// ErrPolicyAlreadyExists is policy already exists.
+	ErrPolicyAlreadyExists = errors.Conflict("POLICY_ALREADY_EXISTS", "policy already exists")
+	// ErrPolicyNotFound is policy not found.
+	ErrPolicyNotFound = errors.NotFound("POLICY_NOT_FOUND", "policy not found")
+	// ErrPolicyDomainForbidden is the policies of other tenants cannot be managed.
+	ErrPolicyDomainForbidden = errors.Forbidden("POLICY_DOMAIN_FORBIDDEN", "cannot manage policies of other tenants")
+)
+
+// PolicyRepo is the policy engine and its storage.
+//
+//go:generate mockgen -source=policy_biz.go -destination=./mocks/mock_policy_repo.go -package=mocks
+type PolicyRepo interface {
+	// Enabled reports whether the policy engine is enabled.
+	Enabled() bool
+	ListPolicies(context.Context, *ListPolicyRequest) ([]*Policy, error)
+	// AddPolicy adds the policy, returns false if it already exists.
+	AddPolicy(context.Context, *Policy) (bool, error)
+	// RemovePolicy removes the policy, returns false if it does not exist.
+	RemovePolicy(context.Context, *Policy) (bool, error)
+	Enforce(ctx context.Context, subject, domain, object, action string) (bool, error)
+}
+
+// policyUsecase 是 PolicyUsecase 接口的具体实现
+type policyUsecase struct {
+	repo PolicyRepo
+	log  *log.Helper
+}
+
+// 确保 policyUsecase 实现了 PolicyUsecase 接口
+var _ PolicyUsecase = (*policyUsecase)(nil)
+
+// NewPolicyUsecase new a Policy usecase.
+func NewPolicyUsecase(repo PolicyRepo, logger log.Logger) PolicyUsecase {
+	return &policyUsecase{repo: repo, log: log.NewHelper(log.With(logger, "module", "policy/biz"))}
+}
+
+// ListPolicies lists the policies matching the request.
+// 非超级管理员只能查看本租户的策略
+func (uc *policyUsecase) ListPolicies(ctx context.Context, req *ListPolicyRequest) ([]*Policy, error) {
+	uc.log.WithContext(ctx).Infof("ListPolicies: %+v", req)
+
+	if !uc.repo.Enabled() {
+		return nil, ErrPolicyEngineDisabled
+	}
+	if !auth.IsSuperAdmin(ctx) {
+		domain, err := uc.domain(ctx, req.Domain)
+		if err != nil {
+			return nil, err
+		}
+		req.Domain = domain
+	}
+	return uc.repo.ListPolicies(ctx, req)
+}
+
+// AddPolicy grants the action on the object to the subject in the domain.
+func (uc *policyUsecase) AddPolicy(ctx context.Context, p *Policy) error {
+	uc.log.WithContext(ctx).Infof("AddPolicy: %+v", p)
+
+	if err := uc.normalize(ctx, p); err != nil {
+		return err
+	}
+	added, err := uc.repo.AddPolicy(ctx, p)
+	if err != nil {
+		return err
+	}
+	if !added {
+		return ErrPolicyAlreadyExists
+	}
+	return nil
+}
+
+// RemovePolicy revokes the policy.
+func (uc *policyUsecase) RemovePolicy(ctx context.Context, p *Policy) error {
+	uc.log.WithContext(ctx).Infof("RemovePolicy: %+v", p)
+
+	if err := uc.normalize(ctx, p); err != nil {
+		return err
+	}
+	removed, err := uc.repo.RemovePolicy(ctx, p)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return ErrPolicyNotFound
+	}
+	return nil
+}
+
+// Enabled reports whether the policy engine is enabled.
+func (uc *policyUsecase) Enabled() bool {
+	return uc.repo.Enabled()
+}
+
+// Enforce decides whether the subject may perform the action on the object in the domain.
+func (uc *policyUsecase) Enforce(ctx context.Context, subject, domain, object, action string) (bool, error) {
+	if !uc.repo.Enabled() {
+		return false, nil
+	}
+	return uc.repo.Enforce(ctx, subject, domain, object, action)
+}
+
+// normalize validates the policy and fills in the default domain and action.
+func (uc *policyUsecase) normalize(ctx context.Context, p *Policy) error {
+	if !uc.repo.Enabled() {
+		return ErrPolicyEngineDisabled
+	}
+	if err := validator.ValidateRequiredString(p.Subject, "主体"); err != nil {
+		return errors.BadRequest("INVALID_PARAMETER", err.Error())
+	}
+	if err := validator.ValidateRequiredString(p.Object, "对象"); err != nil {
+		return errors.BadRequest("INVALID_PARAMETER", err.Error())
+	}
+	if err := validator.ValidateStringLength(p.Subject, "主体", 1, 128); err != nil {
+		return errors.BadRequest("INVALID_PARAMETER", err.Error())
+	}
+	if err := validator.ValidateStringLength(p.Object, "对象", 1, 256); err != nil {
+		return errors.BadRequest("INVALID_PARAMETER", err.Error())
+	}
+	if err := validator.ValidateStringLength(p.Action, "动作", 1, 128); err != nil {
+		return errors.BadRequest("INVALID_PARAMETER", err.Error())
+	}
+
+	domain, err := uc.domain(ctx, p.Domain)
+	if err != nil {
+		return err
+	}
+	p.Domain = domain
+	if p.Action == "" {
+		p.Action = AnyAction
+	}
+	return nil
+}
+
+// domain returns the domain the principal may manage, defaulting to its own tenant.
+// 只有超级管理员可以管理其他租户或全部租户的策略
+func (uc *policyUsecase) domain(ctx context.Context, domain string) (string, error) {
+	tenantID := auth.TenantID(ctx)
+	if domain == "" {
+		return tenantID, nil
+	}
+	if domain != tenantID && !auth.IsSuperAdmin(ctx) {
+		return "", ErrPolicyDomainForbidden
+	}
+	return domain, nil
+}
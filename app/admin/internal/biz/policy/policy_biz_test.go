@@ -0,0 +1,160 @@
+package policy_test
+
+import (
+	"context"
+	"testing"
+
+	"qn-base/app/admin/internal/biz/policy"
+	"qn-base/app/admin/internal/biz/policy/mocks"
+	"qn-base/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyUsecase_AddPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockPolicyRepo(ctrl)
+	uc := policy.NewPolicyUsecase(repo, log.DefaultLogger)
+	ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "u1", TenantID: "t1", Roles: []string{"admin"}})
+
+	t.Run("默认为当前租户和任意动作", func(t *testing.T) {
+		// Mock 期望
+		repo.EXPECT().Enabled().Return(true)
+		repo.EXPECT().AddPolicy(ctx, &policy.Policy{
+			Subject: "operator", Domain: "t1", Object: "/admin.v1.User/*", Action: policy.AnyAction,
+		}).Return(true, nil)
+
+		// 执行测试
+		err := uc.AddPolicy(ctx, &policy.Policy{Subject: "operator", Object: "/admin.v1.User/*"})
+
+		// 断言
+		assert.NoError(t, err)
+	})
+
+	t.Run("策略已存在", func(t *testing.T) {
+		// Mock 期望
+		repo.EXPECT().Enabled().Return(true)
+		repo.EXPECT().AddPolicy(ctx, gomock.Any()).Return(false, nil)
+
+		// 执行测试
+		err := uc.AddPolicy(ctx, &policy.Policy{Subject: "operator", Object: "/admin.v1.User/*"})
+
+		// 断言
+		assert.True(t, errors.Is(err, policy.ErrPolicyAlreadyExists))
+	})
+
+	t.Run("不能管理其他租户的策略", func(t *testing.T) {
+		// Mock 期望
+		repo.EXPECT().Enabled().Return(true)
+
+		// 执行测试
+		err := uc.AddPolicy(ctx, &policy.Policy{Subject: "operator", Domain: "t2", Object: "/admin.v1.User/*"})
+
+		// 断言
+		assert.True(t, errors.Is(err, policy.ErrPolicyDomainForbidden))
+	})
+
+	t.Run("超级管理员可以添加全局策略", func(t *testing.T) {
+		ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "root", TenantID: auth.PlatformTenantID, Roles: []string{auth.SuperAdminRoleCode}})
+
+		// Mock 期望
+		repo.EXPECT().Enabled().Return(true)
+		repo.EXPECT().AddPolicy(ctx, &policy.Policy{
+			Subject: "auditor", Domain: policy.AllDomains, Object: "/admin.v1.Policy/ListPolicies", Action: "system:policy:query",
+		}).Return(true, nil)
+
+		// 执行测试
+		err := uc.AddPolicy(ctx, &policy.Policy{
+			Subject: "auditor", Domain: policy.AllDomains, Object: "/admin.v1.Policy/ListPolicies", Action: "system:policy:query",
+		})
+
+		// 断言
+		assert.NoError(t, err)
+	})
+
+	t.Run("参数校验", func(t *testing.T) {
+		// Mock 期望
+		repo.EXPECT().Enabled().Return(true)
+
+		// 执行测试
+		err := uc.AddPolicy(ctx, &policy.Policy{Subject: "operator"})
+
+		// 断言
+		assert.True(t, errors.IsBadRequest(err))
+	})
+
+	t.Run("策略引擎未启用", func(t *testing.T) {
+		// Mock 期望
+		repo.EXPECT().Enabled().Return(false)
+
+		// 执行测试
+		err := uc.AddPolicy(ctx, &policy.Policy{Subject: "operator", Object: "/admin.v1.User/*"})
+
+		// 断言
+		assert.True(t, errors.Is(err, policy.ErrPolicyEngineDisabled))
+	})
+}
+
+func TestPolicyUsecase_RemovePolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockPolicyRepo(ctrl)
+	uc := policy.NewPolicyUsecase(repo, log.DefaultLogger)
+	ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "u1", TenantID: "t1"})
+
+	t.Run("策略不存在", func(t *testing.T) {
+		// Mock 期望
+		repo.EXPECT().Enabled().Return(true)
+		repo.EXPECT().RemovePolicy(ctx, gomock.Any()).Return(false, nil)
+
+		// 执行测试
+		err := uc.RemovePolicy(ctx, &policy.Policy{Subject: "operator", Object: "/admin.v1.User/*"})
+
+		// 断言
+		assert.True(t, errors.Is(err, policy.ErrPolicyNotFound))
+	})
+}
+
+func TestPolicyUsecase_ListPolicies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockPolicyRepo(ctrl)
+	uc := policy.NewPolicyUsecase(repo, log.DefaultLogger)
+
+	t.Run("限定为当前租户", func(t *testing.T) {
+		ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "u1", TenantID: "t1"})
+
+		// Mock 期望
+		repo.EXPECT().Enabled().Return(true)
+		repo.EXPECT().ListPolicies(ctx, &policy.ListPolicyRequest{Subject: "operator", Domain: "t1"}).
+			Return([]*policy.Policy{{Subject: "operator", Domain: "t1", Object: "/admin.v1.User/*", Action: "*"}}, nil)
+
+		// 执行测试
+		policies, err := uc.ListPolicies(ctx, &policy.ListPolicyRequest{Subject: "operator"})
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Len(t, policies, 1)
+	})
+
+	t.Run("超级管理员查看全部租户", func(t *testing.T) {
+		ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "root", TenantID: auth.PlatformTenantID, Roles: []string{auth.SuperAdminRoleCode}})
+
+		// Mock 期望
+		repo.EXPECT().Enabled().Return(true)
+		repo.EXPECT().ListPolicies(ctx, &policy.ListPolicyRequest{}).Return(nil, nil)
+
+		// 执行测试
+		_, err := uc.ListPolicies(ctx, &policy.ListPolicyRequest{})
+
+		// 断言
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,49 @@
+package systemdept
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=./mocks/usecase_mock.go -package=mocks
+type DeptUsecase interface {
+	CreateDept(ctx context.Context, d *SystemDept) (*SystemDept, error)
+	GetDept(ctx context.Context, id string) (*SystemDept, error)
+	UpdateDept(ctx context.Context, d *SystemDept) (*SystemDept, error)
+	DeleteDept(ctx context.Context, id string) error
+	ListDepts(ctx context.Context, req *ListDeptRequest) ([]*SystemDept, error)
+	GetDeptTree(ctx context.Context, req *ListDeptRequest) ([]*SystemDept, error)
+	// MoveDept moves the department and its subtree under the parent.
+	MoveDept(ctx context.Context, id, parentID string) error
+	// ListDescendantIDs lists the IDs of all descendants of the department, excluding itself.
+	ListDescendantIDs(ctx context.Context, id string) ([]string, error)
+}
+
+// RootDeptID 顶级部门的父部门ID
+const RootDeptID = "0"
+
+// SystemDept is a SystemDept model.
+type SystemDept struct {
+	ID           *string    `json:"id,omitempty"`             // id
+	CreateBy     *string    `json:"create_by,omitempty"`      // 创建人
+	CreatedAt    *time.Time `json:"created_at,omitempty"`     // 创建时间
+	UpdateBy     *string    `json:"update_by,omitempty"`      // 更新人
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`     // 更新时间
+	TenantID     *string    `json:"tenant_id,omitempty"`      // 租户ID
+	Name         *string    `json:"name,omitempty"`           // 部门名称
+	ParentID     *string    `json:"parent_id,omitempty"`      // 父部门ID
+	Ancestors    *string    `json:"ancestors,omitempty"`      // 祖级列表
+	Sort         *int32     `json:"sort,omitempty"`           // 显示顺序
+	LeaderUserID *string    `json:"leader_user_id,omitempty"` // 负责人
+	Phone        *string    `json:"phone,omitempty"`          // 联系电话
+	Email        *string    `json:"email,omitempty"`          // 邮箱
+	Status       *int8      `json:"status,omitempty"`         // 部门状态(0:停用 1:正常)
+
+	Children []*SystemDept `json:"children,omitempty"` // 子部门，仅部门树中填充
+}
+
+// ListDeptRequest is a list dept request.
+type ListDeptRequest struct {
+	Name   string
+	Status *int8
+}
@@ -0,0 +1,342 @@
+package systemdept
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"qn-base/app/admin/internal/biz/tx"
+	"qn-base/pkg/auth"
+	"qn-base/pkg/lang/ptr"
+	"qn-base/pkg/util/validator"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrDeptNotFound is dept not found.
+	ErrDeptNotFound = errors.NotFound("DEPT_NOT_FOUND", "dept not found")
+	// ErrParentDeptNotFound is parent dept not found.
+	ErrParentDeptNotFound = errors.BadRequest("PARENT_DEPT_NOT_FOUND", "parent dept not found")
+	// ErrParentDeptDisabled is parent dept is disabled.
+	ErrParentDeptDisabled = errors.BadRequest("PARENT_DEPT_DISABLED", "parent dept is disabled")
+	// ErrDeptParentCycle is parent dept cannot be itself or its descendant.
+	ErrDeptParentCycle = errors.BadRequest("DEPT_PARENT_CYCLE", "parent dept cannot be itself or its descendant")
+	// ErrDeptHasChildren is dept still has children.
+	ErrDeptHasChildren = errors.BadRequest("DEPT_HAS_CHILDREN", "dept has children")
+	// ErrDeptHasUsers is dept still has users.
+	ErrDeptHasUsers = errors.BadRequest("DEPT_HAS_USERS", "dept has users")
+)
+
+// ancestorsSeparator 祖级列表中部门ID的分隔符
+const ancestorsSeparator = ","
+
+// SystemDeptRepo is a SystemDept repo.
+//
+//go:generate mockgen -source=system_dept_biz.go -destination=./mocks/mock_dept_repo.go -package=mocks
+type SystemDeptRepo interface {
+	Save(context.Context, *SystemDept) (*SystemDept, error)
+	Update(context.Context, *SystemDept) (*SystemDept, error)
+	Delete(context.Context, string) error
+	FindByID(context.Context, string) (*SystemDept, error)
+	ListDepts(context.Context, *ListDeptRequest) ([]*SystemDept, error)
+	CountChildren(context.Context, string) (int, error)
+	// CountUsers counts the users of the department.
+	CountUsers(context.Context, string) (int, error)
+	// ListDescendants lists the departments whose ancestors are path or start with path.
+	ListDescendants(ctx context.Context, path string) ([]*SystemDept, error)
+	// Move sets the parent and ancestors of the department, and rewrites the
+	// ancestors of its descendants accordingly.
+	Move(ctx context.Context, id, parentID, ancestors string) error
+}
+
+// deptUsecase 是 DeptUsecase 接口的具体实现
+type deptUsecase struct {
+	tx   tx.Transaction
+	repo SystemDeptRepo
+	log  *log.Helper
+}
+
+// 确保 deptUsecase 实现了 DeptUsecase 接口
+var _ DeptUsecase = (*deptUsecase)(nil)
+
+// NewDeptUsecase new a SystemDept usecase.
+func NewDeptUsecase(tx tx.Transaction, repo SystemDeptRepo, logger log.Logger) DeptUsecase {
+	return &deptUsecase{tx: tx, repo: repo, log: log.NewHelper(log.With(logger, "module", "systemdept/biz"))}
+}
+
+// CreateDept creates a SystemDept, and returns the new SystemDept.
+func (uc *deptUsecase) CreateDept(ctx context.Context, d *SystemDept) (*SystemDept, error) {
+	uc.log.WithContext(ctx).Infof("CreateDept: %v", ptr.From(d.Name))
+
+	// 参数校验
+	if err := uc.validateDept(d, true); err != nil {
+		return nil, err
+	}
+
+	// 设置默认值
+	if d.ParentID == nil || *d.ParentID == "" {
+		d.ParentID = ptr.Of(RootDeptID)
+	}
+	if d.Status == nil {
+		d.Status = ptr.Of(int8(1))
+	}
+	if d.Sort == nil {
+		d.Sort = ptr.Of(int32(0))
+	}
+	if d.TenantID == nil {
+		if tenantID := auth.TenantID(ctx); tenantID != "" {
+			d.TenantID = &tenantID
+		}
+	}
+
+	// 检查父部门并计算祖级列表
+	ancestors, err := uc.parentAncestors(ctx, *d.ParentID)
+	if err != nil {
+		return nil, err
+	}
+	d.Ancestors = &ancestors
+
+	return uc.repo.Save(ctx, d)
+}
+
+// GetDept gets a SystemDept by ID.
+func (uc *deptUsecase) GetDept(ctx context.Context, id string) (*SystemDept, error) {
+	uc.log.WithContext(ctx).Infof("GetDept: %s", id)
+	dept, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if dept == nil {
+		return nil, ErrDeptNotFound
+	}
+	return dept, nil
+}
+
+// UpdateDept updates a SystemDept, a changed parent moves its subtree as well.
+func (uc *deptUsecase) UpdateDept(ctx context.Context, d *SystemDept) (*SystemDept, error) {
+	uc.log.WithContext(ctx).Infof("UpdateDept: %s", ptr.From(d.ID))
+
+	// 参数校验
+	if d.ID == nil {
+		return nil, errors.BadRequest("INVALID_PARAMETER", "部门ID不能为空")
+	}
+	if err := uc.validateDept(d, false); err != nil {
+		return nil, err
+	}
+
+	// 检查部门是否存在
+	existingDept, err := uc.GetDept(ctx, *d.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 父部门由 MoveDept 的逻辑处理，不直接更新
+	parentID := ptr.From(d.ParentID)
+	d.ParentID = nil
+	d.Ancestors = nil
+	if parentID == "" {
+		parentID = ptr.From(existingDept.ParentID)
+	}
+
+	var updated *SystemDept
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if parentID != ptr.From(existingDept.ParentID) {
+			if err := uc.move(ctx, existingDept, parentID); err != nil {
+				return err
+			}
+		}
+		var err error
+		updated, err = uc.repo.Update(ctx, d)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// DeleteDept deletes a SystemDept by ID.
+func (uc *deptUsecase) DeleteDept(ctx context.Context, id string) error {
+	uc.log.WithContext(ctx).Infof("DeleteDept: %s", id)
+
+	if _, err := uc.GetDept(ctx, id); err != nil {
+		return err
+	}
+
+	// 存在子部门或用户时不允许删除
+	count, err := uc.repo.CountChildren(ctx, id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrDeptHasChildren
+	}
+	count, err = uc.repo.CountUsers(ctx, id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrDeptHasUsers
+	}
+
+	return uc.repo.Delete(ctx, id)
+}
+
+// ListDepts lists depts.
+func (uc *deptUsecase) ListDepts(ctx context.Context, req *ListDeptRequest) ([]*SystemDept, error) {
+	uc.log.WithContext(ctx).Infof("ListDepts: name=%s", req.Name)
+	return uc.repo.ListDepts(ctx, req)
+}
+
+// GetDeptTree returns the depts as a tree.
+// 过滤后父部门不在结果中的部门作为顶级节点返回
+func (uc *deptUsecase) GetDeptTree(ctx context.Context, req *ListDeptRequest) ([]*SystemDept, error) {
+	uc.log.WithContext(ctx).Infof("GetDeptTree: name=%s", req.Name)
+
+	depts, err := uc.repo.ListDepts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return BuildDeptTree(depts), nil
+}
+
+// MoveDept moves the department and its subtree under the parent.
+func (uc *deptUsecase) MoveDept(ctx context.Context, id, parentID string) error {
+	uc.log.WithContext(ctx).Infof("MoveDept: id=%s, parentID=%s", id, parentID)
+
+	if parentID == "" {
+		parentID = RootDeptID
+	}
+	dept, err := uc.GetDept(ctx, id)
+	if err != nil {
+		return err
+	}
+	if parentID == ptr.From(dept.ParentID) {
+		return nil
+	}
+	return uc.tx.InTx(ctx, func(ctx context.Context) error {
+		return uc.move(ctx, dept, parentID)
+	})
+}
+
+// ListDescendantIDs lists the IDs of all descendants of the department, excluding itself.
+func (uc *deptUsecase) ListDescendantIDs(ctx context.Context, id string) ([]string, error) {
+	dept, err := uc.GetDept(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	descendants, err := uc.repo.ListDescendants(ctx, ChildAncestors(dept))
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(descendants))
+	for _, descendant := range descendants {
+		ids = append(ids, ptr.From(descendant.ID))
+	}
+	return ids, nil
+}
+
+// ChildAncestors returns the ancestors of the children of the department.
+func ChildAncestors(d *SystemDept) string {
+	return ptr.From(d.Ancestors) + ancestorsSeparator + ptr.From(d.ID)
+}
+
+// BuildDeptTree builds a tree from the flat depts, keeping their order among siblings.
+// 父部门不在列表中的部门作为顶级节点
+func BuildDeptTree(depts []*SystemDept) []*SystemDept {
+	nodes := make(map[string]*SystemDept, len(depts))
+	for _, dept := range depts {
+		dept.Children = nil
+		nodes[ptr.From(dept.ID)] = dept
+	}
+
+	roots := make([]*SystemDept, 0)
+	for _, dept := range depts {
+		if parent, ok := nodes[ptr.From(dept.ParentID)]; ok && parent != dept {
+			parent.Children = append(parent.Children, dept)
+			continue
+		}
+		roots = append(roots, dept)
+	}
+	return roots
+}
+
+// move checks the new parent and moves the department under it.
+func (uc *deptUsecase) move(ctx context.Context, dept *SystemDept, parentID string) error {
+	id := ptr.From(dept.ID)
+	if parentID == id {
+		return ErrDeptParentCycle
+	}
+	ancestors, err := uc.parentAncestors(ctx, parentID)
+	if err != nil {
+		return err
+	}
+	// 新的父部门是自身的子孙部门时会形成环
+	for _, ancestor := range strings.Split(ancestors, ancestorsSeparator) {
+		if ancestor == id {
+			return ErrDeptParentCycle
+		}
+	}
+	return uc.repo.Move(ctx, id, parentID, ancestors)
+}
+
+// parentAncestors checks that the parent dept exists and is enabled,
+// and returns the ancestors of its children.
+func (uc *deptUsecase) parentAncestors(ctx context.Context, parentID string) (string, error) {
+	if parentID == RootDeptID {
+		return RootDeptID, nil
+	}
+	parent, err := uc.repo.FindByID(ctx, parentID)
+	if err != nil {
+		return "", err
+	}
+	if parent == nil {
+		return "", ErrParentDeptNotFound
+	}
+	if ptr.From(parent.Status) != 1 {
+		return "", ErrParentDeptDisabled
+	}
+	return ChildAncestors(parent), nil
+}
+
+// validateDept validates dept parameters.
+func (uc *deptUsecase) validateDept(d *SystemDept, create bool) error {
+	if create && d.Name == nil {
+		return errors.BadRequest("INVALID_PARAMETER", "部门名称不能为空")
+	}
+
+	if d.Name != nil {
+		if err := validator.ValidateRequiredString(*d.Name, "部门名称"); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+		if utf8.RuneCountInString(*d.Name) > 32 {
+			return errors.BadRequest("INVALID_PARAMETER", "部门名称长度不能超过32个字符")
+		}
+	}
+
+	if d.Phone != nil {
+		if err := validator.ValidateStringLength(*d.Phone, "联系电话", 1, 16); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+	}
+
+	if d.Email != nil && *d.Email != "" {
+		if err := validator.ValidateEmail(*d.Email); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+	}
+
+	if d.Sort != nil && *d.Sort < 0 {
+		return errors.BadRequest("INVALID_PARAMETER", "显示顺序不能小于0")
+	}
+
+	if d.Status != nil {
+		if err := validator.ValidateStatus(*d.Status); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+	}
+
+	return nil
+}
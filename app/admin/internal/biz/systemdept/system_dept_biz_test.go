@@ -0,0 +1,277 @@
+package systemdept_test
+
+import (
+	"context"
+	"testing"
+
+	"qn-base/app/admin/internal/biz/systemdept"
+	"qn-base/app/admin/internal/biz/systemdept/mocks"
+	"qn-base/pkg/lang/ptr"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeTx runs the function directly and records the calls.
+type fakeTx struct {
+	calls int
+}
+
+func (t *fakeTx) InTx(ctx context.Context, f func(ctx context.Context) error) error {
+	t.calls++
+	return f(ctx)
+}
+
+func newDept(id, parentID, ancestors string, status int8) *systemdept.SystemDept {
+	return &systemdept.SystemDept{
+		ID:        ptr.Of(id),
+		Name:      ptr.Of("dept-" + id),
+		ParentID:  ptr.Of(parentID),
+		Ancestors: ptr.Of(ancestors),
+		Status:    ptr.Of(status),
+	}
+}
+
+func TestDeptUsecase_CreateDept(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemDeptRepo(ctrl)
+	uc := systemdept.NewDeptUsecase(&fakeTx{}, mockRepo, log.DefaultLogger)
+	ctx := context.Background()
+
+	t.Run("顶级部门", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().Save(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, d *systemdept.SystemDept) (*systemdept.SystemDept, error) {
+				assert.Equal(t, systemdept.RootDeptID, ptr.From(d.ParentID))
+				assert.Equal(t, "0", ptr.From(d.Ancestors))
+				assert.Equal(t, int8(1), ptr.From(d.Status))
+				return d, nil
+			})
+
+		// 执行测试
+		_, err := uc.CreateDept(ctx, &systemdept.SystemDept{Name: ptr.Of("总部")})
+
+		// 断言
+		assert.NoError(t, err)
+	})
+
+	t.Run("子部门继承祖级列表", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "2").Return(newDept("2", "1", "0,1", 1), nil)
+		mockRepo.EXPECT().Save(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, d *systemdept.SystemDept) (*systemdept.SystemDept, error) {
+				assert.Equal(t, "0,1,2", ptr.From(d.Ancestors))
+				return d, nil
+			})
+
+		// 执行测试
+		_, err := uc.CreateDept(ctx, &systemdept.SystemDept{Name: ptr.Of("研发部"), ParentID: ptr.Of("2")})
+
+		// 断言
+		assert.NoError(t, err)
+	})
+
+	t.Run("父部门已停用", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "3").Return(newDept("3", "0", "0", 0), nil)
+
+		// 执行测试
+		_, err := uc.CreateDept(ctx, &systemdept.SystemDept{Name: ptr.Of("研发部"), ParentID: ptr.Of("3")})
+
+		// 断言
+		assert.True(t, errors.Is(err, systemdept.ErrParentDeptDisabled))
+	})
+
+	t.Run("父部门不存在", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "none").Return(nil, nil)
+
+		// 执行测试
+		_, err := uc.CreateDept(ctx, &systemdept.SystemDept{Name: ptr.Of("研发部"), ParentID: ptr.Of("none")})
+
+		// 断言
+		assert.True(t, errors.Is(err, systemdept.ErrParentDeptNotFound))
+	})
+}
+
+func TestDeptUsecase_MoveDept(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemDeptRepo(ctrl)
+	tx := &fakeTx{}
+	uc := systemdept.NewDeptUsecase(tx, mockRepo, log.DefaultLogger)
+	ctx := context.Background()
+
+	// 1 -> 2 -> 3，4 为另一个顶级部门
+	dept2 := newDept("2", "1", "0,1", 1)
+
+	t.Run("移动到其他部门下", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "2").Return(dept2, nil)
+		mockRepo.EXPECT().FindByID(ctx, "4").Return(newDept("4", "0", "0", 1), nil)
+		mockRepo.EXPECT().Move(ctx, "2", "4", "0,4").Return(nil)
+
+		// 执行测试
+		err := uc.MoveDept(ctx, "2", "4")
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Equal(t, 1, tx.calls)
+	})
+
+	t.Run("移动为顶级部门", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "2").Return(dept2, nil)
+		mockRepo.EXPECT().Move(ctx, "2", systemdept.RootDeptID, "0").Return(nil)
+
+		// 执行测试
+		err := uc.MoveDept(ctx, "2", "")
+
+		// 断言
+		assert.NoError(t, err)
+	})
+
+	t.Run("不能移动到子孙部门下", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "2").Return(dept2, nil)
+		mockRepo.EXPECT().FindByID(ctx, "3").Return(newDept("3", "2", "0,1,2", 1), nil)
+
+		// 执行测试
+		err := uc.MoveDept(ctx, "2", "3")
+
+		// 断言
+		assert.True(t, errors.Is(err, systemdept.ErrDeptParentCycle))
+	})
+
+	t.Run("不能移动到自身下", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "2").Return(dept2, nil)
+
+		// 执行测试
+		err := uc.MoveDept(ctx, "2", "2")
+
+		// 断言
+		assert.True(t, errors.Is(err, systemdept.ErrDeptParentCycle))
+	})
+
+	t.Run("父部门未变化", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "2").Return(dept2, nil)
+
+		// 执行测试
+		err := uc.MoveDept(ctx, "2", "1")
+
+		// 断言
+		assert.NoError(t, err)
+	})
+}
+
+func TestDeptUsecase_UpdateDept(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemDeptRepo(ctrl)
+	uc := systemdept.NewDeptUsecase(&fakeTx{}, mockRepo, log.DefaultLogger)
+	ctx := context.Background()
+
+	t.Run("修改父部门时移动子树", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "2").Return(newDept("2", "1", "0,1", 1), nil)
+		mockRepo.EXPECT().FindByID(ctx, "4").Return(newDept("4", "0", "0", 1), nil)
+		gomock.InOrder(
+			mockRepo.EXPECT().Move(ctx, "2", "4", "0,4").Return(nil),
+			mockRepo.EXPECT().Update(ctx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, d *systemdept.SystemDept) (*systemdept.SystemDept, error) {
+					// 父部门和祖级列表已由 Move 更新
+					assert.Nil(t, d.ParentID)
+					assert.Nil(t, d.Ancestors)
+					return d, nil
+				}),
+		)
+
+		// 执行测试
+		_, err := uc.UpdateDept(ctx, &systemdept.SystemDept{ID: ptr.Of("2"), Name: ptr.Of("新名称"), ParentID: ptr.Of("4")})
+
+		// 断言
+		assert.NoError(t, err)
+	})
+}
+
+func TestDeptUsecase_DeleteDept(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemDeptRepo(ctrl)
+	uc := systemdept.NewDeptUsecase(&fakeTx{}, mockRepo, log.DefaultLogger)
+	ctx := context.Background()
+
+	t.Run("存在子部门", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "1").Return(newDept("1", "0", "0", 1), nil)
+		mockRepo.EXPECT().CountChildren(ctx, "1").Return(1, nil)
+
+		// 执行测试
+		err := uc.DeleteDept(ctx, "1")
+
+		// 断言
+		assert.True(t, errors.Is(err, systemdept.ErrDeptHasChildren))
+	})
+
+	t.Run("存在用户", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "1").Return(newDept("1", "0", "0", 1), nil)
+		mockRepo.EXPECT().CountChildren(ctx, "1").Return(0, nil)
+		mockRepo.EXPECT().CountUsers(ctx, "1").Return(2, nil)
+
+		// 执行测试
+		err := uc.DeleteDept(ctx, "1")
+
+		// 断言
+		assert.True(t, errors.Is(err, systemdept.ErrDeptHasUsers))
+	})
+}
+
+func TestDeptUsecase_ListDescendantIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemDeptRepo(ctrl)
+	uc := systemdept.NewDeptUsecase(&fakeTx{}, mockRepo, log.DefaultLogger)
+	ctx := context.Background()
+
+	// Mock 期望
+	mockRepo.EXPECT().FindByID(ctx, "2").Return(newDept("2", "1", "0,1", 1), nil)
+	mockRepo.EXPECT().ListDescendants(ctx, "0,1,2").Return([]*systemdept.SystemDept{
+		newDept("3", "2", "0,1,2", 1), newDept("5", "3", "0,1,2,3", 1),
+	}, nil)
+
+	// 执行测试
+	ids, err := uc.ListDescendantIDs(ctx, "2")
+
+	// 断言
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"3", "5"}, ids)
+}
+
+func TestBuildDeptTree(t *testing.T) {
+	depts := []*systemdept.SystemDept{
+		newDept("1", "0", "0", 1),
+		newDept("2", "1", "0,1", 1),
+		newDept("3", "2", "0,1,2", 1),
+		newDept("9", "8", "0,8", 1),
+	}
+
+	tree := systemdept.BuildDeptTree(depts)
+
+	// 父部门不在列表中的部门作为顶级节点
+	assert.Len(t, tree, 2)
+	assert.Equal(t, "1", ptr.From(tree[0].ID))
+	assert.Equal(t, "2", ptr.From(tree[0].Children[0].ID))
+	assert.Equal(t, "3", ptr.From(tree[0].Children[0].Children[0].ID))
+	assert.Equal(t, "9", ptr.From(tree[1].ID))
+}
@@ -0,0 +1,41 @@
+package systemloginlog
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=./mocks/usecase_mock.go -package=mocks
+type LoginLogUsecase interface {
+	RecordLoginLog(ctx context.Context, l *SystemLoginLog) error
+	ListLoginLogs(ctx context.Context, req *ListLoginLogRequest) ([]*SystemLoginLog, int32, error)
+	PurgeExpiredLoginLogs(ctx context.Context) (int, error)
+}
+
+// SystemLoginLog is a SystemLoginLog model.
+type SystemLoginLog struct {
+	ID        *string    `json:"id,omitempty"`         // id
+	CreatedAt *time.Time `json:"created_at,omitempty"` // 登录时间
+	TenantID  *string    `json:"tenant_id,omitempty"`  // 租户ID
+	Event     *string    `json:"event,omitempty"`      // 事件类型(LOGIN:登录 LOGOUT:登出)
+	UserID    *string    `json:"user_id,omitempty"`    // 用户ID，账号不存在时为空
+	Account   *string    `json:"account,omitempty"`    // 登录账号
+	Status    *int8      `json:"status,omitempty"`     // 结果(0:失败 1:成功)
+	Reason    *string    `json:"reason,omitempty"`     // 失败原因
+	IP        *string    `json:"ip,omitempty"`         // 登录IP
+	UserAgent *string    `json:"user_agent,omitempty"` // 浏览器 User-Agent
+}
+
+// ListLoginLogRequest is a list login log request.
+type ListLoginLogRequest struct {
+	Page      int32
+	PageSize  int32
+	Event     string
+	UserID    string
+	Account   string
+	IP        string
+	Status    *int8
+	Reason    string
+	StartDate string
+	EndDate   string
+}
@@ -0,0 +1,114 @@
+package systemloginlog_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"qn-base/app/admin/internal/biz/systemloginlog"
+	"qn-base/app/admin/internal/biz/systemloginlog/mocks"
+	"qn-base/app/admin/internal/conf"
+	"qn-base/pkg/auth"
+	"qn-base/pkg/lang/ptr"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoginLogUsecase_RecordLoginLog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemLoginLogRepo(ctrl)
+	uc := systemloginlog.NewLoginLogUsecase(&conf.Bootstrap{}, mockRepo, log.DefaultLogger)
+
+	t.Run("在日志的租户中记录", func(t *testing.T) {
+		// 登录时请求尚未解析出租户
+		ctx := context.Background()
+
+		// Mock 期望
+		mockRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, l *systemloginlog.SystemLoginLog) (*systemloginlog.SystemLoginLog, error) {
+				assert.Equal(t, "tenant1", auth.TenantID(ctx))
+				assert.Equal(t, systemloginlog.EventLogin, ptr.From(l.Event))
+				return l, nil
+			})
+
+		// 执行测试
+		err := uc.RecordLoginLog(ctx, &systemloginlog.SystemLoginLog{
+			TenantID: ptr.Of("tenant1"),
+			Account:  ptr.Of("admin"),
+			Status:   ptr.Of(systemloginlog.StatusFailed),
+			Reason:   ptr.Of("INCORRECT_PASSWORD"),
+		})
+
+		// 断言
+		assert.NoError(t, err)
+	})
+}
+
+func TestLoginLogUsecase_ListLoginLogs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemLoginLogRepo(ctrl)
+	uc := systemloginlog.NewLoginLogUsecase(&conf.Bootstrap{}, mockRepo, log.DefaultLogger)
+	ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "admin1", TenantID: "tenant1"})
+
+	t.Run("设置默认分页参数", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().ListLoginLogs(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *systemloginlog.ListLoginLogRequest) ([]*systemloginlog.SystemLoginLog, int32, error) {
+				assert.Equal(t, int32(1), req.Page)
+				assert.Equal(t, int32(100), req.PageSize)
+				return []*systemloginlog.SystemLoginLog{{ID: ptr.Of("1")}}, 1, nil
+			})
+
+		// 执行测试
+		logs, total, err := uc.ListLoginLogs(ctx, &systemloginlog.ListLoginLogRequest{PageSize: 500, Account: "admin"})
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Len(t, logs, 1)
+		assert.Equal(t, int32(1), total)
+	})
+}
+
+func TestLoginLogUsecase_PurgeExpiredLoginLogs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		conf *conf.Bootstrap
+		want time.Duration
+	}{
+		{name: "默认保留180天", conf: &conf.Bootstrap{}, want: 180 * 24 * time.Hour},
+		{name: "按配置的天数保留", conf: &conf.Bootstrap{LoginLog: &conf.LoginLog{RetentionDays: 30}}, want: 30 * 24 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockSystemLoginLogRepo(ctrl)
+			uc := systemloginlog.NewLoginLogUsecase(tt.conf, mockRepo, log.DefaultLogger)
+
+			// Mock 期望：清理全部租户的日志
+			mockRepo.EXPECT().DeleteBefore(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, before time.Time) (int, error) {
+					assert.True(t, auth.IsCrossTenant(ctx))
+					assert.WithinDuration(t, time.Now().Add(-tt.want), before, time.Minute)
+					return 3, nil
+				})
+
+			// 执行测试
+			n, err := uc.PurgeExpiredLoginLogs(ctx)
+
+			// 断言
+			assert.NoError(t, err)
+			assert.Equal(t, 3, n)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package systemmenu
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=./mocks/usecase_mock.go -package=mocks
+type MenuUsecase interface {
+	CreateMenu(ctx context.Context, m *SystemMenu) (*SystemMenu, error)
+	GetMenu(ctx context.Context, id string) (*SystemMenu, error)
+	UpdateMenu(ctx context.Context, m *SystemMenu) (*SystemMenu, error)
+	DeleteMenu(ctx context.Context, id string) error
+	ListMenus(ctx context.Context, req *ListMenuRequest) ([]*SystemMenu, error)
+	GetMenuTree(ctx context.Context, req *ListMenuRequest) ([]*SystemMenu, error)
+	GetUserRoutes(ctx context.Context) (*UserRoutes, error)
+}
+
+const (
+	// MenuTypeDir 目录
+	MenuTypeDir int8 = 1
+	// MenuTypeMenu 菜单
+	MenuTypeMenu int8 = 2
+	// MenuTypeButton 按钮
+	MenuTypeButton int8 = 3
+)
+
+// RootMenuID 顶级菜单的父菜单ID
+const RootMenuID = "0"
+
+// SystemMenu is a SystemMenu model.
+type SystemMenu struct {
+	ID            *string    `json:"id,omitempty"`             // id
+	CreateBy      *string    `json:"create_by,omitempty"`      // 创建人
+	CreatedAt     *time.Time `json:"created_at,omitempty"`     // 创建时间
+	UpdateBy      *string    `json:"update_by,omitempty"`      // 更新人
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`     // 更新时间
+	Name          *string    `json:"name,omitempty"`           // 菜单名称
+	Permission    *string    `json:"permission,omitempty"`     // 权限标识
+	Type          *int8      `json:"type,omitempty"`           // 菜单类型(1:目录 2:菜单 3:按钮)
+	Sort          *int32     `json:"sort,omitempty"`           // 显示顺序
+	ParentID      *string    `json:"parent_id,omitempty"`      // 父菜单ID
+	Path          *string    `json:"path,omitempty"`           // 路由地址
+	Icon          *string    `json:"icon,omitempty"`           // 菜单图标
+	Component     *string    `json:"component,omitempty"`      // 组件路径
+	ComponentName *string    `json:"component_name,omitempty"` // 组件名
+	Status        *int8      `json:"status,omitempty"`         // 菜单状态(0:停用 1:正常)
+	Visible       *bool      `json:"visible,omitempty"`        // 是否可见
+	KeepAlive     *bool      `json:"keep_alive,omitempty"`     // 是否缓存
+	AlwaysShow    *bool      `json:"always_show,omitempty"`    // 是否总是显示
+
+	Children []*SystemMenu `json:"children,omitempty"` // 子菜单，仅菜单树中填充
+}
+
+// ListMenuRequest is a list menu request.
+type ListMenuRequest struct {
+	Name   string
+	Status *int8
+	Type   *int8
+}
+
+// UserRoutes is the routes and permissions of the current user.
+type UserRoutes struct {
+	Routes      []*SystemMenu `json:"routes"`      // 目录和菜单组成的路由树
+	Permissions []string      `json:"permissions"` // 权限标识
+}
@@ -0,0 +1,369 @@
+package systemmenu
+
+import (
+	"context"
+	"sort"
+	"unicode/utf8"
+
+	"qn-base/pkg/auth"
+	"qn-base/pkg/lang/ptr"
+	"qn-base/pkg/util/validator"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrMenuNotFound is menu not found.
+	ErrMenuNotFound = errors.NotFound("MENU_NOT_FOUND", "menu not found")
+	// ErrParentMenuNotFound is parent menu not found.
+	ErrParentMenuNotFound = errors.BadRequest("PARENT_MENU_NOT_FOUND", "parent menu not found")
+	// ErrParentMenuInvalid is parent menu cannot be a button.
+	ErrParentMenuInvalid = errors.BadRequest("PARENT_MENU_INVALID", "parent menu must be a directory or menu")
+	// ErrMenuParentCycle is parent menu cannot be itself or its descendant.
+	ErrMenuParentCycle = errors.BadRequest("MENU_PARENT_CYCLE", "parent menu cannot be itself or its descendant")
+	// ErrMenuHasChildren is menu still has children.
+	ErrMenuHasChildren = errors.BadRequest("MENU_HAS_CHILDREN", "menu has children")
+)
+
+// SystemMenuRepo is a SystemMenu repo.
+//
+//go:generate mockgen -source=system_menu_biz.go -destination=./mocks/mock_menu_repo.go -package=mocks
+type SystemMenuRepo interface {
+	Save(context.Context, *SystemMenu) (*SystemMenu, error)
+	Update(context.Context, *SystemMenu) (*SystemMenu, error)
+	Delete(context.Context, string) error
+	FindByID(context.Context, string) (*SystemMenu, error)
+	ListByIDs(context.Context, []string) ([]*SystemMenu, error)
+	ListMenus(context.Context, *ListMenuRequest) ([]*SystemMenu, error)
+	CountChildren(context.Context, string) (int, error)
+	// ListByRoleCodes lists the enabled menus granted to the enabled roles with the given codes.
+	ListByRoleCodes(context.Context, []string) ([]*SystemMenu, error)
+}
+
+// menuUsecase 是 MenuUsecase 接口的具体实现
+type menuUsecase struct {
+	repo SystemMenuRepo
+	log  *log.Helper
+}
+
+// 确保 menuUsecase 实现了 MenuUsecase 接口
+var _ MenuUsecase = (*menuUsecase)(nil)
+
+// NewMenuUsecase new a SystemMenu usecase.
+func NewMenuUsecase(repo SystemMenuRepo, logger log.Logger) MenuUsecase {
+	return &menuUsecase{repo: repo, log: log.NewHelper(log.With(logger, "module", "systemmenu/biz"))}
+}
+
+// CreateMenu creates a SystemMenu, and returns the new SystemMenu.
+func (uc *menuUsecase) CreateMenu(ctx context.Context, m *SystemMenu) (*SystemMenu, error) {
+	uc.log.WithContext(ctx).Infof("CreateMenu: %v", ptr.From(m.Name))
+
+	// 参数校验
+	if err := uc.validateMenu(m, true); err != nil {
+		return nil, err
+	}
+
+	// 设置默认值
+	if m.ParentID == nil || *m.ParentID == "" {
+		m.ParentID = ptr.Of(RootMenuID)
+	}
+	if m.Status == nil {
+		m.Status = ptr.Of(int8(1))
+	}
+	if m.Sort == nil {
+		m.Sort = ptr.Of(int32(0))
+	}
+
+	// 检查父菜单
+	if err := uc.checkParent(ctx, *m.ParentID); err != nil {
+		return nil, err
+	}
+
+	return uc.repo.Save(ctx, m)
+}
+
+// GetMenu gets a SystemMenu by ID.
+func (uc *menuUsecase) GetMenu(ctx context.Context, id string) (*SystemMenu, error) {
+	uc.log.WithContext(ctx).Infof("GetMenu: %s", id)
+	menu, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if menu == nil {
+		return nil, ErrMenuNotFound
+	}
+	return menu, nil
+}
+
+// UpdateMenu updates a SystemMenu.
+func (uc *menuUsecase) UpdateMenu(ctx context.Context, m *SystemMenu) (*SystemMenu, error) {
+	uc.log.WithContext(ctx).Infof("UpdateMenu: %s", ptr.From(m.ID))
+
+	// 参数校验
+	if m.ID == nil {
+		return nil, errors.BadRequest("INVALID_PARAMETER", "菜单ID不能为空")
+	}
+	if err := uc.validateMenu(m, false); err != nil {
+		return nil, err
+	}
+
+	// 检查菜单是否存在
+	existingMenu, err := uc.GetMenu(ctx, *m.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 修改父菜单时检查父菜单是否有效，且不能形成环
+	if m.ParentID != nil {
+		if *m.ParentID == "" {
+			m.ParentID = ptr.Of(RootMenuID)
+		}
+		if *m.ParentID != ptr.From(existingMenu.ParentID) {
+			if err := uc.checkParent(ctx, *m.ParentID); err != nil {
+				return nil, err
+			}
+			if err := uc.checkCycle(ctx, *m.ID, *m.ParentID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// 存在子菜单时不能改为按钮
+	if m.Type != nil && *m.Type == MenuTypeButton && ptr.From(existingMenu.Type) != MenuTypeButton {
+		count, err := uc.repo.CountChildren(ctx, *m.ID)
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			return nil, ErrMenuHasChildren
+		}
+	}
+
+	return uc.repo.Update(ctx, m)
+}
+
+// DeleteMenu deletes a SystemMenu by ID.
+func (uc *menuUsecase) DeleteMenu(ctx context.Context, id string) error {
+	uc.log.WithContext(ctx).Infof("DeleteMenu: %s", id)
+
+	if _, err := uc.GetMenu(ctx, id); err != nil {
+		return err
+	}
+
+	// 存在子菜单时不允许删除
+	count, err := uc.repo.CountChildren(ctx, id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrMenuHasChildren
+	}
+
+	return uc.repo.Delete(ctx, id)
+}
+
+// ListMenus lists menus.
+func (uc *menuUsecase) ListMenus(ctx context.Context, req *ListMenuRequest) ([]*SystemMenu, error) {
+	uc.log.WithContext(ctx).Infof("ListMenus: name=%s", req.Name)
+	return uc.repo.ListMenus(ctx, req)
+}
+
+// GetMenuTree returns the menus as a tree.
+// 过滤后父菜单不在结果中的菜单作为顶级节点返回
+func (uc *menuUsecase) GetMenuTree(ctx context.Context, req *ListMenuRequest) ([]*SystemMenu, error) {
+	uc.log.WithContext(ctx).Infof("GetMenuTree: name=%s", req.Name)
+
+	menus, err := uc.repo.ListMenus(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return BuildMenuTree(menus, true), nil
+}
+
+// GetUserRoutes returns the routes and permissions of the current user.
+// 超级管理员拥有全部启用的菜单，其他用户按所属角色过滤
+func (uc *menuUsecase) GetUserRoutes(ctx context.Context) (*UserRoutes, error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, auth.ErrMissingClaims
+	}
+	uc.log.WithContext(ctx).Infof("GetUserRoutes: userID=%s", principal.UserID)
+
+	var (
+		menus []*SystemMenu
+		err   error
+	)
+	if principal.IsSuperAdmin() {
+		menus, err = uc.repo.ListMenus(ctx, &ListMenuRequest{Status: ptr.Of(int8(1))})
+	} else if len(principal.Roles) > 0 {
+		menus, err = uc.repo.ListByRoleCodes(ctx, principal.Roles)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	routes := make([]*SystemMenu, 0, len(menus))
+	for _, menu := range menus {
+		if ptr.From(menu.Type) != MenuTypeButton {
+			routes = append(routes, menu)
+		}
+	}
+
+	return &UserRoutes{
+		// 父菜单不可用时其子菜单也不可访问
+		Routes:      BuildMenuTree(routes, false),
+		Permissions: CollectPermissions(menus),
+	}, nil
+}
+
+// CollectPermissions returns the sorted, de-duplicated permission strings of the menus.
+func CollectPermissions(menus []*SystemMenu) []string {
+	permissionSet := make(map[string]struct{})
+	for _, menu := range menus {
+		if p := ptr.From(menu.Permission); p != "" {
+			permissionSet[p] = struct{}{}
+		}
+	}
+	permissions := make([]string, 0, len(permissionSet))
+	for p := range permissionSet {
+		permissions = append(permissions, p)
+	}
+	sort.Strings(permissions)
+	return permissions
+}
+
+// BuildMenuTree builds a tree from the flat menus, keeping their order among siblings.
+// orphanAsRoot 为 true 时，父菜单不在列表中的菜单作为顶级节点，否则丢弃
+func BuildMenuTree(menus []*SystemMenu, orphanAsRoot bool) []*SystemMenu {
+	nodes := make(map[string]*SystemMenu, len(menus))
+	for _, menu := range menus {
+		menu.Children = nil
+		nodes[ptr.From(menu.ID)] = menu
+	}
+
+	children := make(map[string][]*SystemMenu)
+	var roots []*SystemMenu
+	for _, menu := range menus {
+		parentID := ptr.From(menu.ParentID)
+		if _, ok := nodes[parentID]; ok {
+			children[parentID] = append(children[parentID], menu)
+			continue
+		}
+		if parentID == RootMenuID || parentID == "" || orphanAsRoot {
+			roots = append(roots, menu)
+		}
+	}
+
+	// 从顶级节点向下挂载，数据中存在环时环上的节点不会被挂载
+	var attach func(nodes []*SystemMenu)
+	attach = func(nodes []*SystemMenu) {
+		for _, node := range nodes {
+			node.Children = children[ptr.From(node.ID)]
+			attach(node.Children)
+		}
+	}
+	attach(roots)
+
+	if roots == nil {
+		return []*SystemMenu{}
+	}
+	return roots
+}
+
+// checkParent checks that the parent menu exists and is not a button.
+func (uc *menuUsecase) checkParent(ctx context.Context, parentID string) error {
+	if parentID == RootMenuID {
+		return nil
+	}
+	parent, err := uc.repo.FindByID(ctx, parentID)
+	if err != nil {
+		return err
+	}
+	if parent == nil {
+		return ErrParentMenuNotFound
+	}
+	if ptr.From(parent.Type) == MenuTypeButton {
+		return ErrParentMenuInvalid
+	}
+	return nil
+}
+
+// checkCycle checks that parentID is neither id itself nor one of its descendants,
+// by walking up the ancestors of parentID.
+func (uc *menuUsecase) checkCycle(ctx context.Context, id, parentID string) error {
+	menus, err := uc.repo.ListMenus(ctx, &ListMenuRequest{})
+	if err != nil {
+		return err
+	}
+	parents := make(map[string]string, len(menus))
+	for _, menu := range menus {
+		parents[ptr.From(menu.ID)] = ptr.From(menu.ParentID)
+	}
+
+	visited := make(map[string]struct{})
+	for current := parentID; current != RootMenuID && current != ""; current = parents[current] {
+		if current == id {
+			return ErrMenuParentCycle
+		}
+		// 已有数据中存在环时避免死循环
+		if _, ok := visited[current]; ok {
+			return ErrMenuParentCycle
+		}
+		visited[current] = struct{}{}
+	}
+	return nil
+}
+
+// validateMenu validates menu parameters.
+func (uc *menuUsecase) validateMenu(m *SystemMenu, create bool) error {
+	if create {
+		if m.Name == nil {
+			return errors.BadRequest("INVALID_PARAMETER", "菜单名称不能为空")
+		}
+		if m.Type == nil {
+			return errors.BadRequest("INVALID_PARAMETER", "菜单类型不能为空")
+		}
+		// 目录和菜单需要路由地址
+		if *m.Type != MenuTypeButton && ptr.From(m.Path) == "" {
+			return errors.BadRequest("INVALID_PARAMETER", "路由地址不能为空")
+		}
+	}
+
+	if m.Name != nil {
+		if err := validator.ValidateRequiredString(*m.Name, "菜单名称"); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+		if utf8.RuneCountInString(*m.Name) > 64 {
+			return errors.BadRequest("INVALID_PARAMETER", "菜单名称长度不能超过64个字符")
+		}
+	}
+
+	if m.Type != nil && (*m.Type < MenuTypeDir || *m.Type > MenuTypeButton) {
+		return errors.BadRequest("INVALID_PARAMETER", "菜单类型无效")
+	}
+
+	if m.Permission != nil {
+		if err := validator.ValidateStringLength(*m.Permission, "权限标识", 1, 128); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+	}
+
+	if m.Path != nil {
+		if err := validator.ValidateStringLength(*m.Path, "路由地址", 1, 200); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+	}
+
+	if m.Sort != nil && *m.Sort < 0 {
+		return errors.BadRequest("INVALID_PARAMETER", "显示顺序不能小于0")
+	}
+
+	if m.Status != nil {
+		if err := validator.ValidateStatus(*m.Status); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+	}
+
+	return nil
+}
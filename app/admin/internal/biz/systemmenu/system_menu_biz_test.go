@@ -0,0 +1,268 @@
+package systemmenu_test
+
+import (
+	"context"
+	"testing"
+
+	"qn-base/app/admin/internal/biz/systemmenu"
+	"qn-base/app/admin/internal/biz/systemmenu/mocks"
+	"qn-base/pkg/auth"
+	"qn-base/pkg/lang/ptr"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func newMenu(id, parentID string, menuType int8, permission string) *systemmenu.SystemMenu {
+	return &systemmenu.SystemMenu{
+		ID:         ptr.Of(id),
+		Name:       ptr.Of(id),
+		ParentID:   ptr.Of(parentID),
+		Type:       ptr.Of(menuType),
+		Permission: ptr.Of(permission),
+		Status:     ptr.Of(int8(1)),
+	}
+}
+
+func TestMenuUsecase_CreateMenu(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemMenuRepo(ctrl)
+	uc := systemmenu.NewMenuUsecase(mockRepo, log.DefaultLogger)
+
+	ctx := context.Background()
+
+	t.Run("成功创建顶级目录", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().
+			Save(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, m *systemmenu.SystemMenu) (*systemmenu.SystemMenu, error) {
+				m.ID = ptr.Of("menu123")
+				return m, nil
+			})
+
+		// 执行测试
+		result, err := uc.CreateMenu(ctx, &systemmenu.SystemMenu{
+			Name: ptr.Of("系统管理"),
+			Type: ptr.Of(systemmenu.MenuTypeDir),
+			Path: ptr.Of("/system"),
+		})
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Equal(t, systemmenu.RootMenuID, *result.ParentID)
+		assert.Equal(t, int8(1), *result.Status)
+	})
+
+	t.Run("父菜单为按钮", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "btn").Return(newMenu("btn", "menu", systemmenu.MenuTypeButton, "system:user:add"), nil)
+
+		// 执行测试
+		result, err := uc.CreateMenu(ctx, &systemmenu.SystemMenu{
+			Name:     ptr.Of("新增"),
+			Type:     ptr.Of(systemmenu.MenuTypeButton),
+			ParentID: ptr.Of("btn"),
+		})
+
+		// 断言
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, systemmenu.ErrParentMenuInvalid))
+	})
+
+	t.Run("菜单缺少路由地址", func(t *testing.T) {
+		// 执行测试
+		result, err := uc.CreateMenu(ctx, &systemmenu.SystemMenu{
+			Name: ptr.Of("用户管理"),
+			Type: ptr.Of(systemmenu.MenuTypeMenu),
+		})
+
+		// 断言
+		assert.Nil(t, result)
+		assert.True(t, errors.IsBadRequest(err))
+	})
+}
+
+func TestMenuUsecase_UpdateMenu(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemMenuRepo(ctrl)
+	uc := systemmenu.NewMenuUsecase(mockRepo, log.DefaultLogger)
+
+	ctx := context.Background()
+
+	// dir -> menu -> btn
+	all := []*systemmenu.SystemMenu{
+		newMenu("dir", systemmenu.RootMenuID, systemmenu.MenuTypeDir, ""),
+		newMenu("menu", "dir", systemmenu.MenuTypeMenu, "system:user:list"),
+		newMenu("other", systemmenu.RootMenuID, systemmenu.MenuTypeDir, ""),
+	}
+
+	t.Run("父菜单为自身的子孙", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "dir").Return(all[0], nil)
+		mockRepo.EXPECT().FindByID(ctx, "menu").Return(all[1], nil)
+		mockRepo.EXPECT().ListMenus(ctx, gomock.Any()).Return(all, nil)
+
+		// 执行测试
+		result, err := uc.UpdateMenu(ctx, &systemmenu.SystemMenu{ID: ptr.Of("dir"), ParentID: ptr.Of("menu")})
+
+		// 断言
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, systemmenu.ErrMenuParentCycle))
+	})
+
+	t.Run("父菜单为自身", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "dir").Return(all[0], nil).Times(2)
+		mockRepo.EXPECT().ListMenus(ctx, gomock.Any()).Return(all, nil)
+
+		// 执行测试
+		result, err := uc.UpdateMenu(ctx, &systemmenu.SystemMenu{ID: ptr.Of("dir"), ParentID: ptr.Of("dir")})
+
+		// 断言
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, systemmenu.ErrMenuParentCycle))
+	})
+
+	t.Run("成功移动菜单", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "menu").Return(all[1], nil)
+		mockRepo.EXPECT().FindByID(ctx, "other").Return(all[2], nil)
+		mockRepo.EXPECT().ListMenus(ctx, gomock.Any()).Return(all, nil)
+		mockRepo.EXPECT().
+			Update(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, m *systemmenu.SystemMenu) (*systemmenu.SystemMenu, error) {
+				return m, nil
+			})
+
+		// 执行测试
+		result, err := uc.UpdateMenu(ctx, &systemmenu.SystemMenu{ID: ptr.Of("menu"), ParentID: ptr.Of("other")})
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Equal(t, "other", *result.ParentID)
+	})
+}
+
+func TestMenuUsecase_DeleteMenu(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemMenuRepo(ctrl)
+	uc := systemmenu.NewMenuUsecase(mockRepo, log.DefaultLogger)
+
+	ctx := context.Background()
+
+	t.Run("存在子菜单", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "dir").Return(newMenu("dir", systemmenu.RootMenuID, systemmenu.MenuTypeDir, ""), nil)
+		mockRepo.EXPECT().CountChildren(ctx, "dir").Return(1, nil)
+
+		// 执行测试
+		err := uc.DeleteMenu(ctx, "dir")
+
+		// 断言
+		assert.True(t, errors.Is(err, systemmenu.ErrMenuHasChildren))
+	})
+
+	t.Run("成功删除", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "btn").Return(newMenu("btn", "menu", systemmenu.MenuTypeButton, "system:user:add"), nil)
+		mockRepo.EXPECT().CountChildren(ctx, "btn").Return(0, nil)
+		mockRepo.EXPECT().Delete(ctx, "btn").Return(nil)
+
+		// 执行测试
+		err := uc.DeleteMenu(ctx, "btn")
+
+		// 断言
+		assert.NoError(t, err)
+	})
+
+	t.Run("菜单不存在", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "none").Return(nil, nil)
+
+		// 执行测试
+		err := uc.DeleteMenu(ctx, "none")
+
+		// 断言
+		assert.True(t, errors.Is(err, systemmenu.ErrMenuNotFound))
+	})
+}
+
+func TestMenuUsecase_GetUserRoutes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemMenuRepo(ctrl)
+	uc := systemmenu.NewMenuUsecase(mockRepo, log.DefaultLogger)
+
+	t.Run("按角色过滤", func(t *testing.T) {
+		ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "u1", Roles: []string{"operator"}})
+
+		// Mock 期望，孤立的菜单（父菜单未授权）不返回
+		mockRepo.EXPECT().ListByRoleCodes(ctx, []string{"operator"}).Return([]*systemmenu.SystemMenu{
+			newMenu("dir", systemmenu.RootMenuID, systemmenu.MenuTypeDir, ""),
+			newMenu("menu", "dir", systemmenu.MenuTypeMenu, "system:user:list"),
+			newMenu("btn", "menu", systemmenu.MenuTypeButton, "system:user:add"),
+			newMenu("orphan", "missing", systemmenu.MenuTypeMenu, ""),
+		}, nil)
+
+		// 执行测试
+		result, err := uc.GetUserRoutes(ctx)
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Len(t, result.Routes, 1)
+		assert.Equal(t, "dir", *result.Routes[0].ID)
+		assert.Len(t, result.Routes[0].Children, 1)
+		assert.Empty(t, result.Routes[0].Children[0].Children)
+		assert.Equal(t, []string{"system:user:add", "system:user:list"}, result.Permissions)
+	})
+
+	t.Run("超级管理员", func(t *testing.T) {
+		ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "u1", TenantID: auth.PlatformTenantID, Roles: []string{auth.SuperAdminRoleCode}})
+
+		// Mock 期望
+		mockRepo.EXPECT().ListMenus(ctx, &systemmenu.ListMenuRequest{Status: ptr.Of(int8(1))}).Return([]*systemmenu.SystemMenu{
+			newMenu("dir", systemmenu.RootMenuID, systemmenu.MenuTypeDir, ""),
+		}, nil)
+
+		// 执行测试
+		result, err := uc.GetUserRoutes(ctx)
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Len(t, result.Routes, 1)
+	})
+
+	t.Run("未登录", func(t *testing.T) {
+		// 执行测试
+		result, err := uc.GetUserRoutes(context.Background())
+
+		// 断言
+		assert.Nil(t, result)
+		assert.True(t, errors.IsUnauthorized(err))
+	})
+}
+
+func TestBuildMenuTree(t *testing.T) {
+	menus := []*systemmenu.SystemMenu{
+		newMenu("dir", systemmenu.RootMenuID, systemmenu.MenuTypeDir, ""),
+		newMenu("menu", "dir", systemmenu.MenuTypeMenu, ""),
+		newMenu("orphan", "missing", systemmenu.MenuTypeMenu, ""),
+	}
+
+	tree := systemmenu.BuildMenuTree(menus, true)
+	assert.Len(t, tree, 2)
+	assert.Equal(t, "menu", *tree[0].Children[0].ID)
+	assert.Equal(t, "orphan", *tree[1].ID)
+
+	tree = systemmenu.BuildMenuTree(menus, false)
+	assert.Len(t, tree, 1)
+}
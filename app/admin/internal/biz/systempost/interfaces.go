@@ -0,0 +1,41 @@
+package systempost
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=./mocks/usecase_mock.go -package=mocks
+type PostUsecase interface {
+	CreatePost(ctx context.Context, p *SystemPost) (*SystemPost, error)
+	GetPost(ctx context.Context, id string) (*SystemPost, error)
+	UpdatePost(ctx context.Context, p *SystemPost) (*SystemPost, error)
+	DeletePost(ctx context.Context, id string) error
+	ListPosts(ctx context.Context, req *ListPostRequest) ([]*SystemPost, int32, error)
+	ChangePostStatus(ctx context.Context, id string, status int8) error
+	UpdatePostSort(ctx context.Context, id string, sort int32) error
+}
+
+// SystemPost is a SystemPost model.
+type SystemPost struct {
+	ID        *string    `json:"id,omitempty"`         // id
+	CreateBy  *string    `json:"create_by,omitempty"`  // 创建人
+	CreatedAt *time.Time `json:"created_at,omitempty"` // 创建时间
+	UpdateBy  *string    `json:"update_by,omitempty"`  // 更新人
+	UpdatedAt *time.Time `json:"updated_at,omitempty"` // 更新时间
+	TenantID  *string    `json:"tenant_id,omitempty"`  // 租户ID
+	Code      *string    `json:"code,omitempty"`       // 岗位编码
+	Name      *string    `json:"name,omitempty"`       // 岗位名称
+	Sort      *int32     `json:"sort,omitempty"`       // 显示顺序
+	Status    *int8      `json:"status,omitempty"`     // 状态(0:停用 1:正常)
+	Remark    *string    `json:"remark,omitempty"`     // 备注
+}
+
+// ListPostRequest is a list post request.
+type ListPostRequest struct {
+	Page     int32
+	PageSize int32
+	Code     string
+	Name     string
+	Status   *int8
+}
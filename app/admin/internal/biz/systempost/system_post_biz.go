@@ -0,0 +1,263 @@
+package systempost
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"qn-base/pkg/auth"
+	"qn-base/pkg/lang/ptr"
+	"qn-base/pkg/util/validator"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrPostNotFound is post not found.
+	ErrPostNotFound = errors.NotFound("POST_NOT_FOUND", "post not found")
+	// ErrPostCodeAlreadyExists is post code already exists.
+	ErrPostCodeAlreadyExists = errors.Conflict("POST_CODE_ALREADY_EXISTS", "post code already exists")
+	// ErrPostNameAlreadyExists is post name already exists.
+	ErrPostNameAlreadyExists = errors.Conflict("POST_NAME_ALREADY_EXISTS", "post name already exists")
+	// ErrPostHasUsers is the post is still assigned to users.
+	ErrPostHasUsers = errors.BadRequest("POST_HAS_USERS", "post is assigned to users")
+)
+
+// SystemPostRepo is a SystemPost repo, it also maintains the user-post relations.
+//
+//go:generate mockgen -source=system_post_biz.go -destination=./mocks/mock_post_repo.go -package=mocks
+type SystemPostRepo interface {
+	Save(context.Context, *SystemPost) (*SystemPost, error)
+	Update(context.Context, *SystemPost) (*SystemPost, error)
+	Delete(context.Context, string) error
+	FindByID(context.Context, string) (*SystemPost, error)
+	FindByCode(context.Context, string) (*SystemPost, error)
+	FindByName(context.Context, string) (*SystemPost, error)
+	ListByIDs(context.Context, []string) ([]*SystemPost, error)
+	ListPosts(context.Context, *ListPostRequest) ([]*SystemPost, int32, error)
+	ChangeStatus(context.Context, string, int8) error
+	UpdateSort(context.Context, string, int32) error
+	// CountUsers counts the users of the post.
+	CountUsers(context.Context, string) (int, error)
+	// ListUserPosts lists the posts of the users, keyed by user ID.
+	ListUserPosts(context.Context, []string) (map[string][]*SystemPost, error)
+	// ReplaceUserPosts replaces all posts of the user, an empty postIDs clears them.
+	ReplaceUserPosts(ctx context.Context, userID string, postIDs []string) error
+}
+
+// postUsecase 是 PostUsecase 接口的具体实现
+type postUsecase struct {
+	repo SystemPostRepo
+	log  *log.Helper
+}
+
+// 确保 postUsecase 实现了 PostUsecase 接口
+var _ PostUsecase = (*postUsecase)(nil)
+
+// NewPostUsecase new a SystemPost usecase.
+func NewPostUsecase(repo SystemPostRepo, logger log.Logger) PostUsecase {
+	return &postUsecase{repo: repo, log: log.NewHelper(log.With(logger, "module", "systempost/biz"))}
+}
+
+// CreatePost creates a SystemPost, and returns the new SystemPost.
+func (uc *postUsecase) CreatePost(ctx context.Context, p *SystemPost) (*SystemPost, error) {
+	uc.log.WithContext(ctx).Infof("CreatePost: %v", ptr.From(p.Code))
+
+	// 参数校验
+	if err := uc.validatePost(p, true); err != nil {
+		return nil, err
+	}
+
+	// 检查岗位编码和名称是否已存在
+	if err := uc.checkUnique(ctx, "", p); err != nil {
+		return nil, err
+	}
+
+	// 设置默认值
+	if p.Status == nil {
+		p.Status = ptr.Of(int8(1))
+	}
+	if p.Sort == nil {
+		p.Sort = ptr.Of(int32(0))
+	}
+	if tenantID := auth.TenantID(ctx); p.TenantID == nil && tenantID != "" {
+		p.TenantID = &tenantID
+	}
+
+	return uc.repo.Save(ctx, p)
+}
+
+// GetPost gets a SystemPost by ID.
+func (uc *postUsecase) GetPost(ctx context.Context, id string) (*SystemPost, error) {
+	uc.log.WithContext(ctx).Infof("GetPost: %s", id)
+	post, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if post == nil {
+		return nil, ErrPostNotFound
+	}
+	return post, nil
+}
+
+// UpdatePost updates a SystemPost.
+func (uc *postUsecase) UpdatePost(ctx context.Context, p *SystemPost) (*SystemPost, error) {
+	uc.log.WithContext(ctx).Infof("UpdatePost: %s", ptr.From(p.ID))
+
+	// 参数校验
+	if p.ID == nil {
+		return nil, errors.BadRequest("INVALID_PARAMETER", "岗位ID不能为空")
+	}
+	if err := uc.validatePost(p, false); err != nil {
+		return nil, err
+	}
+
+	// 检查岗位是否存在
+	if _, err := uc.GetPost(ctx, *p.ID); err != nil {
+		return nil, err
+	}
+
+	// 检查岗位编码和名称是否被其他岗位使用
+	if err := uc.checkUnique(ctx, *p.ID, p); err != nil {
+		return nil, err
+	}
+
+	return uc.repo.Update(ctx, p)
+}
+
+// DeletePost deletes a SystemPost by ID, posts assigned to users cannot be deleted.
+func (uc *postUsecase) DeletePost(ctx context.Context, id string) error {
+	uc.log.WithContext(ctx).Infof("DeletePost: %s", id)
+
+	if _, err := uc.GetPost(ctx, id); err != nil {
+		return err
+	}
+	count, err := uc.repo.CountUsers(ctx, id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrPostHasUsers
+	}
+
+	return uc.repo.Delete(ctx, id)
+}
+
+// ListPosts lists posts.
+func (uc *postUsecase) ListPosts(ctx context.Context, req *ListPostRequest) ([]*SystemPost, int32, error) {
+	uc.log.WithContext(ctx).Infof("ListPosts: page=%d, page_size=%d, name=%s", req.Page, req.PageSize, req.Name)
+
+	// 设置默认分页参数
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 15
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+
+	return uc.repo.ListPosts(ctx, req)
+}
+
+// ChangePostStatus changes post status.
+func (uc *postUsecase) ChangePostStatus(ctx context.Context, id string, status int8) error {
+	uc.log.WithContext(ctx).Infof("ChangePostStatus: id=%s, status=%d", id, status)
+
+	// 参数校验
+	if err := validator.ValidateRequiredString(id, "岗位ID"); err != nil {
+		return errors.BadRequest("INVALID_PARAMETER", err.Error())
+	}
+	if err := validator.ValidateStatus(status); err != nil {
+		return errors.BadRequest("INVALID_PARAMETER", err.Error())
+	}
+
+	return uc.repo.ChangeStatus(ctx, id, status)
+}
+
+// UpdatePostSort updates post sort.
+func (uc *postUsecase) UpdatePostSort(ctx context.Context, id string, sort int32) error {
+	uc.log.WithContext(ctx).Infof("UpdatePostSort: id=%s, sort=%d", id, sort)
+
+	// 参数校验
+	if err := validator.ValidateRequiredString(id, "岗位ID"); err != nil {
+		return errors.BadRequest("INVALID_PARAMETER", err.Error())
+	}
+	if sort < 0 {
+		return errors.BadRequest("INVALID_PARAMETER", "显示顺序不能小于0")
+	}
+
+	return uc.repo.UpdateSort(ctx, id, sort)
+}
+
+// checkUnique checks that the post code and name are not used by other posts.
+func (uc *postUsecase) checkUnique(ctx context.Context, id string, p *SystemPost) error {
+	if p.Code != nil {
+		existing, err := uc.repo.FindByCode(ctx, *p.Code)
+		if err != nil {
+			return err
+		}
+		if existing != nil && ptr.From(existing.ID) != id {
+			return ErrPostCodeAlreadyExists
+		}
+	}
+	if p.Name != nil {
+		existing, err := uc.repo.FindByName(ctx, *p.Name)
+		if err != nil {
+			return err
+		}
+		if existing != nil && ptr.From(existing.ID) != id {
+			return ErrPostNameAlreadyExists
+		}
+	}
+	return nil
+}
+
+// validatePost validates post parameters.
+func (uc *postUsecase) validatePost(p *SystemPost, create bool) error {
+	if create {
+		if p.Code == nil {
+			return errors.BadRequest("INVALID_PARAMETER", "岗位编码不能为空")
+		}
+		if p.Name == nil {
+			return errors.BadRequest("INVALID_PARAMETER", "岗位名称不能为空")
+		}
+	}
+
+	if p.Code != nil {
+		if err := validator.ValidateRequiredString(*p.Code, "岗位编码"); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+		if err := validator.ValidateStringLength(*p.Code, "岗位编码", 1, 64); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+	}
+
+	if p.Name != nil {
+		if err := validator.ValidateRequiredString(*p.Name, "岗位名称"); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+		if utf8.RuneCountInString(*p.Name) > 64 {
+			return errors.BadRequest("INVALID_PARAMETER", "岗位名称长度不能超过64个字符")
+		}
+	}
+
+	if p.Sort != nil && *p.Sort < 0 {
+		return errors.BadRequest("INVALID_PARAMETER", "显示顺序不能小于0")
+	}
+
+	if p.Status != nil {
+		if err := validator.ValidateStatus(*p.Status); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+	}
+
+	if p.Remark != nil {
+		if err := validator.ValidateRemark(*p.Remark); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+	}
+
+	return nil
+}
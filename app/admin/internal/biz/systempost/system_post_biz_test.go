@@ -0,0 +1,173 @@
+package systempost_test
+
+import (
+	"context"
+	"testing"
+
+	"qn-base/app/admin/internal/biz/systempost"
+	"qn-base/app/admin/internal/biz/systempost/mocks"
+	"qn-base/pkg/auth"
+	"qn-base/pkg/lang/ptr"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostUsecase_CreatePost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemPostRepo(ctrl)
+	uc := systempost.NewPostUsecase(mockRepo, log.DefaultLogger)
+	ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "admin1", TenantID: "tenant1"})
+
+	t.Run("成功创建岗位", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByCode(ctx, "ceo").Return(nil, nil)
+		mockRepo.EXPECT().FindByName(ctx, "董事长").Return(nil, nil)
+		mockRepo.EXPECT().Save(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, p *systempost.SystemPost) (*systempost.SystemPost, error) {
+				assert.Equal(t, int8(1), ptr.From(p.Status))
+				assert.Equal(t, int32(0), ptr.From(p.Sort))
+				assert.Equal(t, "tenant1", ptr.From(p.TenantID))
+				return p, nil
+			})
+
+		// 执行测试
+		_, err := uc.CreatePost(ctx, &systempost.SystemPost{Code: ptr.Of("ceo"), Name: ptr.Of("董事长")})
+
+		// 断言
+		assert.NoError(t, err)
+	})
+
+	t.Run("岗位编码已存在", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByCode(ctx, "ceo").Return(&systempost.SystemPost{ID: ptr.Of("post1")}, nil)
+
+		// 执行测试
+		_, err := uc.CreatePost(ctx, &systempost.SystemPost{Code: ptr.Of("ceo"), Name: ptr.Of("董事长")})
+
+		// 断言
+		assert.True(t, errors.Is(err, systempost.ErrPostCodeAlreadyExists))
+	})
+
+	t.Run("岗位名称为空", func(t *testing.T) {
+		// 执行测试
+		_, err := uc.CreatePost(ctx, &systempost.SystemPost{Code: ptr.Of("ceo"), Name: ptr.Of(" ")})
+
+		// 断言
+		assert.True(t, errors.IsBadRequest(err))
+	})
+}
+
+func TestPostUsecase_UpdatePost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemPostRepo(ctrl)
+	uc := systempost.NewPostUsecase(mockRepo, log.DefaultLogger)
+	ctx := context.Background()
+
+	t.Run("名称未被其他岗位使用", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "post1").Return(&systempost.SystemPost{ID: ptr.Of("post1")}, nil)
+		mockRepo.EXPECT().FindByName(ctx, "总经理").Return(&systempost.SystemPost{ID: ptr.Of("post1")}, nil)
+		mockRepo.EXPECT().Update(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, p *systempost.SystemPost) (*systempost.SystemPost, error) {
+				return p, nil
+			})
+
+		// 执行测试
+		_, err := uc.UpdatePost(ctx, &systempost.SystemPost{ID: ptr.Of("post1"), Name: ptr.Of("总经理")})
+
+		// 断言
+		assert.NoError(t, err)
+	})
+
+	t.Run("名称被其他岗位使用", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "post1").Return(&systempost.SystemPost{ID: ptr.Of("post1")}, nil)
+		mockRepo.EXPECT().FindByName(ctx, "总经理").Return(&systempost.SystemPost{ID: ptr.Of("post2")}, nil)
+
+		// 执行测试
+		_, err := uc.UpdatePost(ctx, &systempost.SystemPost{ID: ptr.Of("post1"), Name: ptr.Of("总经理")})
+
+		// 断言
+		assert.True(t, errors.Is(err, systempost.ErrPostNameAlreadyExists))
+	})
+
+	t.Run("岗位不存在", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "none").Return(nil, nil)
+
+		// 执行测试
+		_, err := uc.UpdatePost(ctx, &systempost.SystemPost{ID: ptr.Of("none")})
+
+		// 断言
+		assert.True(t, errors.Is(err, systempost.ErrPostNotFound))
+	})
+}
+
+func TestPostUsecase_DeletePost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemPostRepo(ctrl)
+	uc := systempost.NewPostUsecase(mockRepo, log.DefaultLogger)
+	ctx := context.Background()
+
+	t.Run("岗位已分配给用户", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "post1").Return(&systempost.SystemPost{ID: ptr.Of("post1")}, nil)
+		mockRepo.EXPECT().CountUsers(ctx, "post1").Return(2, nil)
+
+		// 执行测试
+		err := uc.DeletePost(ctx, "post1")
+
+		// 断言
+		assert.True(t, errors.Is(err, systempost.ErrPostHasUsers))
+	})
+
+	t.Run("成功删除岗位", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "post1").Return(&systempost.SystemPost{ID: ptr.Of("post1")}, nil)
+		mockRepo.EXPECT().CountUsers(ctx, "post1").Return(0, nil)
+		mockRepo.EXPECT().Delete(ctx, "post1").Return(nil)
+
+		// 执行测试
+		err := uc.DeletePost(ctx, "post1")
+
+		// 断言
+		assert.NoError(t, err)
+	})
+}
+
+func TestPostUsecase_ChangePostStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemPostRepo(ctrl)
+	uc := systempost.NewPostUsecase(mockRepo, log.DefaultLogger)
+	ctx := context.Background()
+
+	t.Run("状态无效", func(t *testing.T) {
+		// 执行测试
+		err := uc.ChangePostStatus(ctx, "post1", 3)
+
+		// 断言
+		assert.True(t, errors.IsBadRequest(err))
+	})
+
+	t.Run("成功停用岗位", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().ChangeStatus(ctx, "post1", int8(0)).Return(nil)
+
+		// 执行测试
+		err := uc.ChangePostStatus(ctx, "post1", 0)
+
+		// 断言
+		assert.NoError(t, err)
+	})
+}
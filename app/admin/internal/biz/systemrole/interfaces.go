@@ -0,0 +1,63 @@
+package systemrole
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=./mocks/usecase_mock.go -package=mocks
+type RoleUsecase interface {
+	CreateRole(ctx context.Context, r *SystemRole) (*SystemRole, error)
+	GetRole(ctx context.Context, id string) (*SystemRole, error)
+	UpdateRole(ctx context.Context, r *SystemRole) (*SystemRole, error)
+	DeleteRole(ctx context.Context, id string) error
+	ListRoles(ctx context.Context, req *ListRoleRequest) ([]*SystemRole, int32, error)
+	ChangeRoleStatus(ctx context.Context, id string, status int8) error
+	UpdateRoleSort(ctx context.Context, id string, sort int32) error
+}
+
+const (
+	// RoleTypeSystem 系统内置角色，不允许删除
+	RoleTypeSystem int8 = 1
+	// RoleTypeCustom 自定义角色
+	RoleTypeCustom int8 = 2
+)
+
+const (
+	// DataScopeAll 全部数据权限
+	DataScopeAll int8 = 1
+	// DataScopeCustom 自定数据权限
+	DataScopeCustom int8 = 2
+	// DataScopeDept 本部门数据权限
+	DataScopeDept int8 = 3
+	// DataScopeDeptAndChild 本部门及以下数据权限
+	DataScopeDeptAndChild int8 = 4
+)
+
+// SystemRole is a SystemRole model.
+type SystemRole struct {
+	ID               *string    `json:"id,omitempty"`                  // id
+	CreateBy         *string    `json:"create_by,omitempty"`           // 创建人
+	CreatedAt        *time.Time `json:"created_at,omitempty"`          // 创建时间
+	UpdateBy         *string    `json:"update_by,omitempty"`           // 更新人
+	UpdatedAt        *time.Time `json:"updated_at,omitempty"`          // 更新时间
+	TenantID         *string    `json:"tenant_id,omitempty"`           // 租户ID
+	Name             *string    `json:"name,omitempty"`                // 角色名称
+	Code             *string    `json:"code,omitempty"`                // 角色权限字符串
+	Sort             *int32     `json:"sort,omitempty"`                // 显示顺序
+	DataScope        *int8      `json:"data_scope,omitempty"`          // 数据范围
+	DataScopeDeptIDs []string   `json:"data_scope_dept_ids,omitempty"` // 数据范围(指定部门数组)
+	Status           *int8      `json:"status,omitempty"`              // 角色状态(0:停用 1:正常)
+	Type             *int8      `json:"type,omitempty"`                // 角色类型(1:系统内置 2:自定义)
+	Remark           *string    `json:"remark,omitempty"`              // 备注
+}
+
+// ListRoleRequest is a list role request.
+type ListRoleRequest struct {
+	Page     int32
+	PageSize int32
+	Name     string
+	Code     string
+	Status   *int8
+	Type     *int8
+}
@@ -0,0 +1,190 @@
+package systemrole_test
+
+import (
+	"context"
+	"testing"
+
+	"qn-base/app/admin/internal/biz/systemrole"
+	"qn-base/app/admin/internal/biz/systemrole/mocks"
+	"qn-base/pkg/auth"
+	"qn-base/pkg/lang/ptr"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeInvalidator records the roles whose cached permissions were dropped.
+type fakeInvalidator struct {
+	roles []string
+}
+
+func (f *fakeInvalidator) Invalidate(_ string, roles ...string) {
+	f.roles = append(f.roles, roles...)
+}
+
+func TestRoleUsecase_CreateRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemRoleRepo(ctrl)
+	logger := log.DefaultLogger
+	uc := systemrole.NewRoleUsecase(mockRepo, &fakeInvalidator{}, logger)
+
+	ctx := context.Background()
+
+	t.Run("成功创建角色", func(t *testing.T) {
+		role := &systemrole.SystemRole{
+			Name:             ptr.Of("运营"),
+			Code:             ptr.Of("operator"),
+			Type:             ptr.Of(systemrole.RoleTypeSystem),
+			DataScopeDeptIDs: []string{"dept1"},
+		}
+
+		// Mock 期望
+		mockRepo.EXPECT().FindByCode(ctx, "operator").Return(nil, nil)
+		mockRepo.EXPECT().FindByName(ctx, "运营").Return(nil, nil)
+		mockRepo.EXPECT().
+			Save(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, r *systemrole.SystemRole) (*systemrole.SystemRole, error) {
+				r.ID = ptr.Of("role123")
+				return r, nil
+			})
+
+		// 执行测试
+		result, err := uc.CreateRole(ctx, role)
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Equal(t, "role123", *result.ID)
+		assert.Equal(t, systemrole.RoleTypeCustom, *result.Type)
+		assert.Equal(t, int8(1), *result.Status)
+		assert.Equal(t, systemrole.DataScopeAll, *result.DataScope)
+		assert.Nil(t, result.DataScopeDeptIDs)
+	})
+
+	t.Run("角色编码已存在", func(t *testing.T) {
+		role := &systemrole.SystemRole{
+			Name: ptr.Of("运营"),
+			Code: ptr.Of("operator"),
+		}
+
+		// Mock 期望
+		mockRepo.EXPECT().
+			FindByCode(ctx, "operator").
+			Return(&systemrole.SystemRole{ID: ptr.Of("role456")}, nil)
+
+		// 执行测试
+		result, err := uc.CreateRole(ctx, role)
+
+		// 断言
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, systemrole.ErrRoleCodeAlreadyExists))
+	})
+
+	t.Run("超级管理员编码为保留编码", func(t *testing.T) {
+		role := &systemrole.SystemRole{
+			Name: ptr.Of("超级管理员"),
+			Code: ptr.Of(auth.SuperAdminRoleCode),
+		}
+
+		// 执行测试
+		result, err := uc.CreateRole(ctx, role)
+
+		// 断言
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, systemrole.ErrRoleCodeReserved))
+	})
+
+	t.Run("数据范围无效", func(t *testing.T) {
+		role := &systemrole.SystemRole{
+			Name:      ptr.Of("运营"),
+			Code:      ptr.Of("operator"),
+			DataScope: ptr.Of(int8(9)),
+		}
+
+		// 执行测试
+		result, err := uc.CreateRole(ctx, role)
+
+		// 断言
+		assert.Nil(t, result)
+		assert.True(t, errors.IsBadRequest(err))
+	})
+}
+
+func TestRoleUsecase_DeleteRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemRoleRepo(ctrl)
+	logger := log.DefaultLogger
+	authz := &fakeInvalidator{}
+	uc := systemrole.NewRoleUsecase(mockRepo, authz, logger)
+
+	ctx := context.Background()
+
+	t.Run("成功删除自定义角色", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().
+			FindByID(ctx, "role123").
+			Return(&systemrole.SystemRole{ID: ptr.Of("role123"), Code: ptr.Of("operator"), Type: ptr.Of(systemrole.RoleTypeCustom)}, nil)
+		mockRepo.EXPECT().Delete(ctx, "role123").Return(nil)
+
+		// 执行测试
+		err := uc.DeleteRole(ctx, "role123")
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"operator"}, authz.roles)
+	})
+
+	t.Run("禁止删除系统角色", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().
+			FindByID(ctx, "admin").
+			Return(&systemrole.SystemRole{ID: ptr.Of("admin"), Type: ptr.Of(systemrole.RoleTypeSystem)}, nil)
+
+		// 执行测试
+		err := uc.DeleteRole(ctx, "admin")
+
+		// 断言
+		assert.True(t, errors.Is(err, systemrole.ErrSystemRoleImmutable))
+	})
+
+	t.Run("角色不存在", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "nonexistent").Return(nil, nil)
+
+		// 执行测试
+		err := uc.DeleteRole(ctx, "nonexistent")
+
+		// 断言
+		assert.True(t, errors.Is(err, systemrole.ErrRoleNotFound))
+	})
+}
+
+func TestRoleUsecase_UpdateRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemRoleRepo(ctrl)
+	logger := log.DefaultLogger
+	uc := systemrole.NewRoleUsecase(mockRepo, &fakeInvalidator{}, logger)
+
+	ctx := context.Background()
+
+	t.Run("不能改为超级管理员编码", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().
+			FindByID(ctx, "role123").
+			Return(&systemrole.SystemRole{ID: ptr.Of("role123"), Code: ptr.Of("operator"), Type: ptr.Of(systemrole.RoleTypeCustom)}, nil)
+
+		// 执行测试
+		result, err := uc.UpdateRole(ctx, &systemrole.SystemRole{ID: ptr.Of("role123"), Code: ptr.Of(auth.SuperAdminRoleCode)})
+
+		// 断言
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, systemrole.ErrRoleCodeReserved))
+	})
+}
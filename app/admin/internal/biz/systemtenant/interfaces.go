@@ -0,0 +1,94 @@
+package systemtenant
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=./mocks/usecase_mock.go -package=mocks
+type TenantUsecase interface {
+	CreateTenant(ctx context.Context, t *SystemTenant, admin *TenantAdmin) (*SystemTenant, error)
+	GetTenant(ctx context.Context, id string) (*SystemTenant, error)
+	UpdateTenant(ctx context.Context, t *SystemTenant) (*SystemTenant, error)
+	DeleteTenant(ctx context.Context, id string) error
+	ListTenants(ctx context.Context, req *ListTenantRequest) ([]*SystemTenant, int32, error)
+	ChangeTenantStatus(ctx context.Context, id string, status int8) error
+	// CheckTenant checks that the tenant exists, is enabled and not expired.
+	CheckTenant(ctx context.Context, id string) error
+	// CheckAccountQuota checks that the tenant can hold one more account.
+	CheckAccountQuota(ctx context.Context, tenantID string) error
+	// ResolveTenantByWebsite returns the ID of the tenant bound to the website, or "" if none.
+	ResolveTenantByWebsite(ctx context.Context, website string) (string, error)
+	// ListPackageMenuIDs returns the menu IDs of the package of the tenant.
+	ListPackageMenuIDs(ctx context.Context, tenantID string) ([]string, error)
+}
+
+type TenantPackageUsecase interface {
+	CreateTenantPackage(ctx context.Context, p *SystemTenantPackage) (*SystemTenantPackage, error)
+	GetTenantPackage(ctx context.Context, id string) (*SystemTenantPackage, error)
+	UpdateTenantPackage(ctx context.Context, p *SystemTenantPackage) (*SystemTenantPackage, error)
+	DeleteTenantPackage(ctx context.Context, id string) error
+	ListTenantPackages(ctx context.Context, req *ListTenantPackageRequest) ([]*SystemTenantPackage, int32, error)
+	ChangeTenantPackageStatus(ctx context.Context, id string, status int8) error
+}
+
+// TenantAdminRoleCode is the role code of the administrator provisioned with the tenant,
+// which holds all the menus of the tenant package.
+const TenantAdminRoleCode = "tenant_admin"
+
+// SystemTenant is a SystemTenant model.
+type SystemTenant struct {
+	ID            *string    `json:"id,omitempty"`              // id
+	CreateBy      *string    `json:"create_by,omitempty"`       // 创建人
+	CreatedAt     *time.Time `json:"created_at,omitempty"`      // 创建时间
+	UpdateBy      *string    `json:"update_by,omitempty"`       // 更新人
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`      // 更新时间
+	Name          *string    `json:"name,omitempty"`            // 租户名
+	ContactUserID *string    `json:"contact_user_id,omitempty"` // 联系人的用户编号，即租户管理员
+	ContactName   *string    `json:"contact_name,omitempty"`    // 联系人
+	ContactMobile *string    `json:"contact_mobile,omitempty"`  // 联系手机
+	Status        *int8      `json:"status,omitempty"`          // 租户状态(0:停用 1:正常)
+	Website       *string    `json:"website,omitempty"`         // 绑定域名
+	PackageID     *string    `json:"package_id,omitempty"`      // 租户套餐编号
+	ExpireTime    *time.Time `json:"expire_time,omitempty"`     // 过期时间
+	AccountCount  *int32     `json:"account_count,omitempty"`   // 账号数量
+	MFARequired   *bool      `json:"mfa_required,omitempty"`    // 是否要求两步验证
+}
+
+// TenantAdmin is the administrator account provisioned with the tenant.
+type TenantAdmin struct {
+	Account  string `json:"account"`  // 管理员账号
+	Password string `json:"password"` // 管理员密码
+}
+
+// SystemTenantPackage is a SystemTenantPackage model.
+type SystemTenantPackage struct {
+	ID        *string    `json:"id,omitempty"`         // id
+	CreateBy  *string    `json:"create_by,omitempty"`  // 创建人
+	CreatedAt *time.Time `json:"created_at,omitempty"` // 创建时间
+	UpdateBy  *string    `json:"update_by,omitempty"`  // 更新人
+	UpdatedAt *time.Time `json:"updated_at,omitempty"` // 更新时间
+	Name      *string    `json:"name,omitempty"`       // 套餐名
+	Status    *int8      `json:"status,omitempty"`     // 套餐状态(0:停用 1:正常)
+	MenuIDs   []string   `json:"menu_ids,omitempty"`   // 关联的菜单编号，为 nil 时不修改
+	Remark    *string    `json:"remark,omitempty"`     // 备注
+}
+
+// ListTenantRequest is a list tenant request.
+type ListTenantRequest struct {
+	Page          int32
+	PageSize      int32
+	Name          string
+	ContactName   string
+	ContactMobile string
+	Status        *int8
+	PackageID     string
+}
+
+// ListTenantPackageRequest is a list tenant package request.
+type ListTenantPackageRequest struct {
+	Page     int32
+	PageSize int32
+	Name     string
+	Status   *int8
+}
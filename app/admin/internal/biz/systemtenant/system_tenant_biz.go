@@ -0,0 +1,617 @@
+package systemtenant
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	v1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/permission"
+	"qn-base/app/admin/internal/biz/systemrole"
+	"qn-base/app/admin/internal/biz/systemuser"
+	"qn-base/app/admin/internal/biz/tx"
+	"qn-base/pkg/auth"
+	"qn-base/pkg/lang/ptr"
+	"qn-base/pkg/lang/slices"
+	"qn-base/pkg/util/pswd"
+	"qn-base/pkg/util/validator"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrTenantNotFound is tenant not found.
+	ErrTenantNotFound = errors.NotFound("TENANT_NOT_FOUND", "tenant not found")
+	// ErrTenantNameAlreadyExists is tenant name already exists.
+	ErrTenantNameAlreadyExists = errors.Conflict("TENANT_NAME_ALREADY_EXISTS", "tenant name already exists")
+	// ErrTenantWebsiteAlreadyExists is tenant website is bound to another tenant.
+	ErrTenantWebsiteAlreadyExists = errors.Conflict("TENANT_WEBSITE_ALREADY_EXISTS", "tenant website already exists")
+	// ErrCurrentTenantImmutable is the tenant of the current user cannot be deleted or disabled.
+	ErrCurrentTenantImmutable = errors.Forbidden("CURRENT_TENANT_IMMUTABLE", "current tenant cannot be deleted or disabled")
+	// ErrTenantAccountLimit is the account quota of the tenant is used up.
+	ErrTenantAccountLimit = errors.Forbidden("TENANT_ACCOUNT_LIMIT", "tenant account quota exceeded")
+	// ErrTenantExpired is the tenant has expired.
+	ErrTenantExpired = v1.ErrorTenantExpired("租户已过期")
+	// ErrTenantDisabled is the tenant has been disabled or deleted.
+	ErrTenantDisabled = v1.ErrorTenantDisabled("租户已被停用")
+	// ErrPlatformAdminRequired is tenants and packages are managed only by the super administrators of the platform tenant.
+	ErrPlatformAdminRequired = errors.Forbidden("PLATFORM_ADMIN_REQUIRED", "platform administrator is required")
+)
+
+// SystemTenantRepo is a SystemTenant repo.
+//
+//go:generate mockgen -source=system_tenant_biz.go -destination=./mocks/mock_tenant_repo.go -package=mocks
+type SystemTenantRepo interface {
+	Save(context.Context, *SystemTenant) (*SystemTenant, error)
+	Update(context.Context, *SystemTenant) (*SystemTenant, error)
+	Delete(context.Context, string) error
+	FindByID(context.Context, string) (*SystemTenant, error)
+	FindByName(context.Context, string) (*SystemTenant, error)
+	FindByWebsite(context.Context, string) (*SystemTenant, error)
+	ListTenants(context.Context, *ListTenantRequest) ([]*SystemTenant, int32, error)
+	ChangeStatus(context.Context, string, int8) error
+	// ListByPackageID lists the tenants of the package.
+	ListByPackageID(context.Context, string) ([]*SystemTenant, error)
+}
+
+// tenantUsecase 是 TenantUsecase 接口的具体实现
+type tenantUsecase struct {
+	tx             tx.Transaction
+	repo           SystemTenantRepo
+	packageRepo    SystemTenantPackageRepo
+	userRepo       systemuser.SystemUserRepo
+	roleRepo       systemrole.SystemRoleRepo
+	permissionRepo permission.PermissionRepo
+	// authz 更换套餐后清除角色缓存的权限
+	authz auth.PermissionInvalidator
+	log   *log.Helper
+}
+
+// 确保 tenantUsecase 实现了 TenantUsecase 接口
+var _ TenantUsecase = (*tenantUsecase)(nil)
+
+// NewTenantUsecase new a SystemTenant usecase.
+func NewTenantUsecase(
+	tx tx.Transaction,
+	repo SystemTenantRepo,
+	packageRepo SystemTenantPackageRepo,
+	userRepo systemuser.SystemUserRepo,
+	roleRepo systemrole.SystemRoleRepo,
+	permissionRepo permission.PermissionRepo,
+	authz auth.PermissionInvalidator,
+	logger log.Logger,
+) TenantUsecase {
+	return &tenantUsecase{
+		tx:             tx,
+		repo:           repo,
+		packageRepo:    packageRepo,
+		userRepo:       userRepo,
+		roleRepo:       roleRepo,
+		permissionRepo: permissionRepo,
+		authz:          authz,
+		log:            log.NewHelper(log.With(logger, "module", "systemtenant/biz")),
+	}
+}
+
+// NewAccountQuota provides the tenant usecase as the account quota of users.
+func NewAccountQuota(uc TenantUsecase) systemuser.AccountQuota {
+	return uc
+}
+
+// NewTenantMenus provides the tenant usecase as the menu limit of roles.
+func NewTenantMenus(uc TenantUsecase) permission.TenantMenus {
+	return uc
+}
+
+// CreateTenant creates a SystemTenant, and provisions its administrator role and user.
+func (uc *tenantUsecase) CreateTenant(ctx context.Context, t *SystemTenant, admin *TenantAdmin) (*SystemTenant, error) {
+	uc.log.WithContext(ctx).Infof("CreateTenant: %v", ptr.From(t.Name))
+
+	if err := checkPlatformAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// 参数校验
+	if err := uc.validateTenant(t, true); err != nil {
+		return nil, err
+	}
+	if admin == nil {
+		return nil, errors.BadRequest("INVALID_PARAMETER", "租户管理员不能为空")
+	}
+	if err := validator.ValidateUsername(admin.Account); err != nil {
+		return nil, errors.BadRequest("INVALID_PARAMETER", err.Error())
+	}
+	if err := validator.ValidatePassword(admin.Password); err != nil {
+		return nil, errors.BadRequest("INVALID_PARAMETER", err.Error())
+	}
+
+	// 检查租户名和绑定域名是否已存在
+	if err := uc.checkUnique(ctx, "", t); err != nil {
+		return nil, err
+	}
+
+	// 检查套餐
+	pkg, err := checkPackage(ctx, uc.packageRepo, ptr.From(t.PackageID))
+	if err != nil {
+		return nil, err
+	}
+
+	hashedPassword, err := pswd.HashPassword(admin.Password)
+	if err != nil {
+		return nil, fmt.Errorf("密码加密失败: %w", err)
+	}
+
+	// 设置默认值，联系人在创建管理员后回填
+	if t.Status == nil {
+		t.Status = ptr.Of(int8(1))
+	}
+	t.ContactUserID = nil
+
+	var tenant *SystemTenant
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		tenant, err = uc.repo.Save(ctx, t)
+		if err != nil {
+			return err
+		}
+		tenantID := ptr.From(tenant.ID)
+		userID, err := uc.provisionAdmin(ctx, tenantID, ptr.From(t.ContactName), admin.Account, hashedPassword, pkg.MenuIDs)
+		if err != nil {
+			return err
+		}
+		_, err = uc.repo.Update(ctx, &SystemTenant{ID: &tenantID, ContactUserID: &userID})
+		tenant.ContactUserID = &userID
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tenant, nil
+}
+
+// provisionAdmin creates the administrator role holding the package menus and the administrator user of the tenant,
+// and returns the ID of the user.
+func (uc *tenantUsecase) provisionAdmin(ctx context.Context, tenantID, nickname, account, hashedPassword string, menuIDs []string) (string, error) {
+	// 在新租户下创建，不受当前用户数据范围的限制
+	ctx = auth.SkipDataScope(auth.WithTenant(ctx, tenantID))
+
+	role, err := uc.roleRepo.Save(ctx, &systemrole.SystemRole{
+		TenantID:  &tenantID,
+		Name:      ptr.Of("租户管理员"),
+		Code:      ptr.Of(TenantAdminRoleCode),
+		Sort:      ptr.Of(int32(0)),
+		DataScope: ptr.Of(systemrole.DataScopeAll),
+		Status:    ptr.Of(int8(1)),
+		Type:      ptr.Of(systemrole.RoleTypeSystem),
+		Remark:    ptr.Of("系统自动生成"),
+	})
+	if err != nil {
+		return "", err
+	}
+	if err := uc.permissionRepo.AddRoleMenus(ctx, ptr.From(role.ID), menuIDs); err != nil {
+		return "", err
+	}
+
+	existingUser, err := uc.userRepo.FindByUsername(ctx, account)
+	if err != nil {
+		return "", err
+	}
+	if existingUser != nil {
+		return "", systemuser.ErrUserAlreadyExists
+	}
+	user, err := uc.userRepo.Save(ctx, &systemuser.SystemUser{
+		TenantID: &tenantID,
+		Account:  &account,
+		Password: &hashedPassword,
+		Nickname: &nickname,
+		Status:   ptr.Of(int8(1)),
+	})
+	if err != nil {
+		return "", err
+	}
+	userID := ptr.From(user.ID)
+	if err := uc.permissionRepo.AddUserRoles(ctx, userID, []string{ptr.From(role.ID)}); err != nil {
+		return "", err
+	}
+	return userID, nil
+}
+
+// GetTenant gets a SystemTenant by ID, users other than the platform administrators can only get their own tenant.
+func (uc *tenantUsecase) GetTenant(ctx context.Context, id string) (*SystemTenant, error) {
+	uc.log.WithContext(ctx).Infof("GetTenant: %s", id)
+	if id != auth.TenantID(ctx) {
+		if err := checkPlatformAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return uc.findTenant(ctx, id)
+}
+
+// findTenant finds the tenant by ID, returns ErrTenantNotFound if it does not exist.
+func (uc *tenantUsecase) findTenant(ctx context.Context, id string) (*SystemTenant, error) {
+	tenant, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if tenant == nil {
+		return nil, ErrTenantNotFound
+	}
+	return tenant, nil
+}
+
+// UpdateTenant updates a SystemTenant, changing the package applies the menus of the new package to the roles of the tenant.
+func (uc *tenantUsecase) UpdateTenant(ctx context.Context, t *SystemTenant) (*SystemTenant, error) {
+	uc.log.WithContext(ctx).Infof("UpdateTenant: %s", ptr.From(t.ID))
+
+	if err := checkPlatformAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// 参数校验
+	if t.ID == nil {
+		return nil, errors.BadRequest("INVALID_PARAMETER", "租户ID不能为空")
+	}
+	if err := uc.validateTenant(t, false); err != nil {
+		return nil, err
+	}
+
+	// 检查租户是否存在
+	existingTenant, err := uc.findTenant(ctx, *t.ID)
+	if err != nil {
+		return nil, err
+	}
+	if ptr.From(t.Status) == 0 && *t.ID == auth.TenantID(ctx) {
+		return nil, ErrCurrentTenantImmutable
+	}
+
+	// 检查租户名和绑定域名是否被其他租户使用
+	if err := uc.checkUnique(ctx, *t.ID, t); err != nil {
+		return nil, err
+	}
+
+	// 联系人即租户管理员，不允许修改
+	t.ContactUserID = nil
+
+	if t.PackageID == nil || *t.PackageID == ptr.From(existingTenant.PackageID) {
+		return uc.repo.Update(ctx, t)
+	}
+
+	// 更换套餐
+	pkg, err := checkPackage(ctx, uc.packageRepo, *t.PackageID)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		tenant *SystemTenant
+		codes  []string
+	)
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		tenant, err = uc.repo.Update(ctx, t)
+		if err != nil {
+			return err
+		}
+		codes, err = applyPackageMenus(ctx, uc.roleRepo, uc.permissionRepo, tenant, pkg.MenuIDs)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	invalidateRoles(uc.authz, *t.ID, codes)
+	return tenant, nil
+}
+
+// DeleteTenant deletes a SystemTenant by ID.
+func (uc *tenantUsecase) DeleteTenant(ctx context.Context, id string) error {
+	uc.log.WithContext(ctx).Infof("DeleteTenant: %s", id)
+
+	if err := checkPlatformAdmin(ctx); err != nil {
+		return err
+	}
+
+	if _, err := uc.findTenant(ctx, id); err != nil {
+		return err
+	}
+	if id == auth.TenantID(ctx) {
+		return ErrCurrentTenantImmutable
+	}
+
+	return uc.repo.Delete(ctx, id)
+}
+
+// ListTenants lists tenants.
+func (uc *tenantUsecase) ListTenants(ctx context.Context, req *ListTenantRequest) ([]*SystemTenant, int32, error) {
+	uc.log.WithContext(ctx).Infof("ListTenants: page=%d, page_size=%d, name=%s", req.Page, req.PageSize, req.Name)
+
+	if err := checkPlatformAdmin(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	// 设置默认分页参数
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 15
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+
+	return uc.repo.ListTenants(ctx, req)
+}
+
+// ChangeTenantStatus changes tenant status.
+func (uc *tenantUsecase) ChangeTenantStatus(ctx context.Context, id string, status int8) error {
+	uc.log.WithContext(ctx).Infof("ChangeTenantStatus: id=%s, status=%d", id, status)
+
+	if err := checkPlatformAdmin(ctx); err != nil {
+		return err
+	}
+
+	// 参数校验
+	if err := validator.ValidateRequiredString(id, "租户ID"); err != nil {
+		return errors.BadRequest("INVALID_PARAMETER", err.Error())
+	}
+	if err := validator.ValidateStatus(status); err != nil {
+		return errors.BadRequest("INVALID_PARAMETER", err.Error())
+	}
+
+	if _, err := uc.findTenant(ctx, id); err != nil {
+		return err
+	}
+	if status != 1 && id == auth.TenantID(ctx) {
+		return ErrCurrentTenantImmutable
+	}
+
+	return uc.repo.ChangeStatus(ctx, id, status)
+}
+
+// ResolveTenantByWebsite returns the ID of the tenant bound to the website, or "" if none,
+// the status of the tenant is checked on login.
+func (uc *tenantUsecase) ResolveTenantByWebsite(ctx context.Context, website string) (string, error) {
+	if website == "" {
+		return "", nil
+	}
+	tenant, err := uc.repo.FindByWebsite(ctx, website)
+	if err != nil {
+		return "", err
+	}
+	if tenant == nil {
+		return "", nil
+	}
+	return ptr.From(tenant.ID), nil
+}
+
+// CheckTenant checks that the tenant exists, is enabled and not expired,
+// a deleted tenant is treated as disabled.
+func (uc *tenantUsecase) CheckTenant(ctx context.Context, id string) error {
+	tenant, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if tenant == nil || ptr.From(tenant.Status) != 1 {
+		return ErrTenantDisabled
+	}
+	if tenant.ExpireTime != nil && tenant.ExpireTime.Before(time.Now()) {
+		return ErrTenantExpired
+	}
+	return nil
+}
+
+// CheckAccountQuota checks that the number of users of the tenant is less than its account count.
+func (uc *tenantUsecase) CheckAccountQuota(ctx context.Context, tenantID string) error {
+	if tenantID == "" {
+		return nil
+	}
+	tenant, err := uc.findTenant(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	// 统计租户的全部用户，不受当前用户数据范围的限制
+	stats, err := uc.userRepo.GetUserStats(auth.WithTenant(auth.SkipDataScope(ctx), tenantID), tenantID)
+	if err != nil {
+		return err
+	}
+	if stats.TotalUsers >= ptr.From(tenant.AccountCount) {
+		return ErrTenantAccountLimit
+	}
+	return nil
+}
+
+// ListPackageMenuIDs returns the menu IDs of the package of the tenant, which are the menus its roles may hold.
+// 套餐不存在时不能分配任何菜单
+func (uc *tenantUsecase) ListPackageMenuIDs(ctx context.Context, tenantID string) ([]string, error) {
+	tenant, err := uc.findTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	pkg, err := uc.packageRepo.FindByID(ctx, ptr.From(tenant.PackageID))
+	if err != nil || pkg == nil {
+		return nil, err
+	}
+	return pkg.MenuIDs, nil
+}
+
+// checkUnique checks that the tenant name and website are not used by other tenants.
+func (uc *tenantUsecase) checkUnique(ctx context.Context, id string, t *SystemTenant) error {
+	if t.Name != nil {
+		existing, err := uc.repo.FindByName(ctx, *t.Name)
+		if err != nil {
+			return err
+		}
+		if existing != nil && ptr.From(existing.ID) != id {
+			return ErrTenantNameAlreadyExists
+		}
+	}
+	if t.Website != nil && *t.Website != "" {
+		existing, err := uc.repo.FindByWebsite(ctx, *t.Website)
+		if err != nil {
+			return err
+		}
+		if existing != nil && ptr.From(existing.ID) != id {
+			return ErrTenantWebsiteAlreadyExists
+		}
+	}
+	return nil
+}
+
+// validateTenant validates tenant parameters.
+func (uc *tenantUsecase) validateTenant(t *SystemTenant, create bool) error {
+	if create {
+		if t.Name == nil {
+			return errors.BadRequest("INVALID_PARAMETER", "租户名不能为空")
+		}
+		if t.ContactName == nil {
+			return errors.BadRequest("INVALID_PARAMETER", "联系人不能为空")
+		}
+		if t.PackageID == nil {
+			return errors.BadRequest("INVALID_PARAMETER", "租户套餐不能为空")
+		}
+		if t.ExpireTime == nil {
+			return errors.BadRequest("INVALID_PARAMETER", "过期时间不能为空")
+		}
+		if t.AccountCount == nil {
+			return errors.BadRequest("INVALID_PARAMETER", "账号数量不能为空")
+		}
+	}
+
+	if t.Name != nil {
+		if err := validator.ValidateRequiredString(*t.Name, "租户名"); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+		if utf8.RuneCountInString(*t.Name) > 32 {
+			return errors.BadRequest("INVALID_PARAMETER", "租户名长度不能超过32个字符")
+		}
+	}
+
+	if t.ContactName != nil {
+		if err := validator.ValidateRequiredString(*t.ContactName, "联系人"); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+		if utf8.RuneCountInString(*t.ContactName) > 32 {
+			return errors.BadRequest("INVALID_PARAMETER", "联系人长度不能超过32个字符")
+		}
+	}
+
+	if t.ContactMobile != nil && *t.ContactMobile != "" {
+		if err := validator.ValidateMobile(*t.ContactMobile); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+	}
+
+	if t.Website != nil {
+		if err := validator.ValidateStringLength(*t.Website, "绑定域名", 0, 256); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+	}
+
+	if t.PackageID != nil {
+		if err := validator.ValidateRequiredString(*t.PackageID, "租户套餐"); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+	}
+
+	// 至少包含租户管理员的账号
+	if t.AccountCount != nil && *t.AccountCount < 1 {
+		return errors.BadRequest("INVALID_PARAMETER", "账号数量不能小于1")
+	}
+
+	if t.Status != nil {
+		if err := validator.ValidateStatus(*t.Status); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// checkPlatformAdmin checks that the principal is a super administrator of the platform tenant,
+// tenants are not scoped by tenant, a permission granted in other tenants is not enough.
+func checkPlatformAdmin(ctx context.Context) error {
+	if !auth.IsSuperAdmin(ctx) {
+		return ErrPlatformAdminRequired
+	}
+	return nil
+}
+
+// checkPackage checks that the package exists and is enabled.
+func checkPackage(ctx context.Context, repo SystemTenantPackageRepo, id string) (*SystemTenantPackage, error) {
+	pkg, err := repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if pkg == nil {
+		return nil, ErrTenantPackageNotFound
+	}
+	if ptr.From(pkg.Status) != 1 {
+		return nil, ErrTenantPackageDisabled
+	}
+	return pkg, nil
+}
+
+// applyPackageMenus applies the menus of the package to the roles of the tenant: the menus out of the package
+// are removed from all the roles, and the administrator role is given all the menus of the package.
+// Returns the codes of the roles changed, whose cached permissions are to be dropped after the transaction.
+// 管理员角色通过联系人（即租户管理员）查找，其他角色的菜单由租户在套餐内自行维护
+func applyPackageMenus(
+	ctx context.Context,
+	roleRepo systemrole.SystemRoleRepo,
+	permissionRepo permission.PermissionRepo,
+	tenant *SystemTenant,
+	menuIDs []string,
+) ([]string, error) {
+	tenantID := ptr.From(tenant.ID)
+	ctx = auth.WithTenant(ctx, tenantID)
+
+	var codes []string
+	// 平台租户不受套餐限制
+	if !auth.IsPlatformTenant(tenantID) {
+		prunedIDs, err := permissionRepo.RemoveMenusNotIn(ctx, menuIDs)
+		if err != nil {
+			return nil, err
+		}
+		if len(prunedIDs) > 0 {
+			pruned, err := roleRepo.ListByIDs(ctx, prunedIDs)
+			if err != nil {
+				return nil, err
+			}
+			for _, role := range pruned {
+				codes = append(codes, ptr.From(role.Code))
+			}
+		}
+	}
+	if tenant.ContactUserID == nil {
+		return codes, nil
+	}
+
+	roleIDs, err := permissionRepo.ListUserRoleIDs(ctx, *tenant.ContactUserID)
+	if err != nil || len(roleIDs) == 0 {
+		return codes, err
+	}
+	roles, err := roleRepo.ListByIDs(ctx, roleIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range roles {
+		if ptr.From(role.Code) != TenantAdminRoleCode {
+			continue
+		}
+		roleID := ptr.From(role.ID)
+		if err := permissionRepo.RemoveRoleMenus(ctx, roleID); err != nil {
+			return nil, err
+		}
+		if err := permissionRepo.AddRoleMenus(ctx, roleID, menuIDs); err != nil {
+			return nil, err
+		}
+		codes = append(codes, TenantAdminRoleCode)
+	}
+	return slices.Uniq(codes), nil
+}
+
+// invalidateRoles drops the cached permissions of the roles of the tenant, nothing if no role is given.
+func invalidateRoles(authz auth.PermissionInvalidator, tenantID string, codes []string) {
+	if len(codes) > 0 {
+		authz.Invalidate(tenantID, codes...)
+	}
+}
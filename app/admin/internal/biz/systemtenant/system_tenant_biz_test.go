@@ -0,0 +1,470 @@
+package systemtenant_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "qn-base/api/gen/go/admin/v1"
+	permissionmocks "qn-base/app/admin/internal/biz/permission/mocks"
+	"qn-base/app/admin/internal/biz/systemrole"
+	rolemocks "qn-base/app/admin/internal/biz/systemrole/mocks"
+	"qn-base/app/admin/internal/biz/systemtenant"
+	"qn-base/app/admin/internal/biz/systemtenant/mocks"
+	"qn-base/app/admin/internal/biz/systemuser"
+	usermocks "qn-base/app/admin/internal/biz/systemuser/mocks"
+	"qn-base/pkg/auth"
+	"qn-base/pkg/lang/ptr"
+	"qn-base/pkg/util/pswd"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeTx runs the function directly and records the calls.
+type fakeTx struct {
+	calls int
+}
+
+func (t *fakeTx) InTx(ctx context.Context, f func(ctx context.Context) error) error {
+	t.calls++
+	return f(ctx)
+}
+
+// fakeInvalidator records the roles whose cached permissions were dropped by tenant.
+type fakeInvalidator struct {
+	roles map[string][]string
+}
+
+func (f *fakeInvalidator) Invalidate(tenantID string, roles ...string) {
+	if f.roles == nil {
+		f.roles = make(map[string][]string)
+	}
+	f.roles[tenantID] = append(f.roles[tenantID], roles...)
+}
+
+type tenantDeps struct {
+	tx             *fakeTx
+	authz          *fakeInvalidator
+	repo           *mocks.MockSystemTenantRepo
+	packageRepo    *mocks.MockSystemTenantPackageRepo
+	userRepo       *usermocks.MockSystemUserRepo
+	roleRepo       *rolemocks.MockSystemRoleRepo
+	permissionRepo *permissionmocks.MockPermissionRepo
+	uc             systemtenant.TenantUsecase
+}
+
+func newTenantDeps(ctrl *gomock.Controller) *tenantDeps {
+	d := &tenantDeps{
+		tx:             &fakeTx{},
+		authz:          &fakeInvalidator{},
+		repo:           mocks.NewMockSystemTenantRepo(ctrl),
+		packageRepo:    mocks.NewMockSystemTenantPackageRepo(ctrl),
+		userRepo:       usermocks.NewMockSystemUserRepo(ctrl),
+		roleRepo:       rolemocks.NewMockSystemRoleRepo(ctrl),
+		permissionRepo: permissionmocks.NewMockPermissionRepo(ctrl),
+	}
+	d.uc = systemtenant.NewTenantUsecase(d.tx, d.repo, d.packageRepo, d.userRepo, d.roleRepo, d.permissionRepo, d.authz, log.DefaultLogger)
+	return d
+}
+
+// tenantMatcher matches the contexts of the tenant.
+type tenantMatcher string
+
+func (m tenantMatcher) Matches(x any) bool {
+	ctx, ok := x.(context.Context)
+	return ok && auth.TenantID(ctx) == string(m)
+}
+
+func (m tenantMatcher) String() string { return "context of tenant " + string(m) }
+
+// inTenant returns a matcher of the contexts of the tenant.
+func inTenant(tenantID string) gomock.Matcher {
+	return tenantMatcher(tenantID)
+}
+
+// platformAdminContext returns the context of a super administrator of the platform tenant.
+func platformAdminContext() context.Context {
+	return auth.NewContext(context.Background(), &auth.Principal{
+		UserID:   "admin1",
+		TenantID: auth.PlatformTenantID,
+		Roles:    []string{auth.SuperAdminRoleCode},
+	})
+}
+
+func TestTenantUsecase_CreateTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	d := newTenantDeps(ctrl)
+	ctx := platformAdminContext()
+	newTenant := func() *systemtenant.SystemTenant {
+		return &systemtenant.SystemTenant{
+			Name:         ptr.Of("测试租户"),
+			ContactName:  ptr.Of("张三"),
+			PackageID:    ptr.Of("pkg1"),
+			ExpireTime:   ptr.Of(time.Now().AddDate(1, 0, 0)),
+			AccountCount: ptr.Of(int32(10)),
+		}
+	}
+	admin := &systemtenant.TenantAdmin{Account: "tenantadmin", Password: "password123"}
+
+	t.Run("创建租户并初始化管理员", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByName(ctx, "测试租户").Return(nil, nil)
+		d.packageRepo.EXPECT().FindByID(ctx, "pkg1").
+			Return(&systemtenant.SystemTenantPackage{ID: ptr.Of("pkg1"), Status: ptr.Of(int8(1)), MenuIDs: []string{"m1", "m2"}}, nil)
+		d.repo.EXPECT().Save(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, tenant *systemtenant.SystemTenant) (*systemtenant.SystemTenant, error) {
+				assert.Equal(t, int8(1), ptr.From(tenant.Status))
+				tenant.ID = ptr.Of("tenant1")
+				return tenant, nil
+			})
+		d.roleRepo.EXPECT().Save(inTenant("tenant1"), gomock.Any()).
+			DoAndReturn(func(_ context.Context, r *systemrole.SystemRole) (*systemrole.SystemRole, error) {
+				assert.Equal(t, systemtenant.TenantAdminRoleCode, ptr.From(r.Code))
+				assert.Equal(t, systemrole.RoleTypeSystem, ptr.From(r.Type))
+				assert.Equal(t, "tenant1", ptr.From(r.TenantID))
+				r.ID = ptr.Of("role1")
+				return r, nil
+			})
+		d.permissionRepo.EXPECT().AddRoleMenus(inTenant("tenant1"), "role1", []string{"m1", "m2"}).Return(nil)
+		d.userRepo.EXPECT().FindByUsername(inTenant("tenant1"), "tenantadmin").Return(nil, nil)
+		d.userRepo.EXPECT().Save(inTenant("tenant1"), gomock.Any()).
+			DoAndReturn(func(_ context.Context, u *systemuser.SystemUser) (*systemuser.SystemUser, error) {
+				assert.Equal(t, "tenant1", ptr.From(u.TenantID))
+				assert.Equal(t, "张三", ptr.From(u.Nickname))
+				ok, err := pswd.VerifyPassword("password123", ptr.From(u.Password))
+				assert.NoError(t, err)
+				assert.True(t, ok)
+				u.ID = ptr.Of("user1")
+				return u, nil
+			})
+		d.permissionRepo.EXPECT().AddUserRoles(inTenant("tenant1"), "user1", []string{"role1"}).Return(nil)
+		d.repo.EXPECT().Update(ctx, &systemtenant.SystemTenant{ID: ptr.Of("tenant1"), ContactUserID: ptr.Of("user1")}).Return(nil, nil)
+
+		// 执行测试
+		tenant, err := d.uc.CreateTenant(ctx, newTenant(), admin)
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Equal(t, "user1", ptr.From(tenant.ContactUserID))
+		assert.Equal(t, 1, d.tx.calls)
+	})
+
+	t.Run("套餐已停用", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByName(ctx, "测试租户").Return(nil, nil)
+		d.packageRepo.EXPECT().FindByID(ctx, "pkg1").
+			Return(&systemtenant.SystemTenantPackage{ID: ptr.Of("pkg1"), Status: ptr.Of(int8(0))}, nil)
+
+		// 执行测试
+		_, err := d.uc.CreateTenant(ctx, newTenant(), admin)
+
+		// 断言
+		assert.True(t, errors.Is(err, systemtenant.ErrTenantPackageDisabled))
+	})
+
+	t.Run("租户名已存在", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByName(ctx, "测试租户").Return(&systemtenant.SystemTenant{ID: ptr.Of("tenant2")}, nil)
+
+		// 执行测试
+		_, err := d.uc.CreateTenant(ctx, newTenant(), admin)
+
+		// 断言
+		assert.True(t, errors.Is(err, systemtenant.ErrTenantNameAlreadyExists))
+	})
+
+	t.Run("账号数量小于1", func(t *testing.T) {
+		tenant := newTenant()
+		tenant.AccountCount = ptr.Of(int32(0))
+
+		// 执行测试
+		_, err := d.uc.CreateTenant(ctx, tenant, admin)
+
+		// 断言
+		assert.True(t, errors.IsBadRequest(err))
+	})
+
+	t.Run("管理员密码无效", func(t *testing.T) {
+		// 执行测试
+		_, err := d.uc.CreateTenant(ctx, newTenant(), &systemtenant.TenantAdmin{Account: "tenantadmin", Password: "1"})
+
+		// 断言
+		assert.True(t, errors.IsBadRequest(err))
+	})
+}
+
+func TestTenantUsecase_UpdateTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	d := newTenantDeps(ctrl)
+	ctx := platformAdminContext()
+	existing := &systemtenant.SystemTenant{ID: ptr.Of("tenant1"), PackageID: ptr.Of("pkg1"), ContactUserID: ptr.Of("user1")}
+
+	t.Run("更换套餐时移除套餐外的菜单并替换管理员菜单", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByID(ctx, "tenant1").Return(existing, nil)
+		d.packageRepo.EXPECT().FindByID(ctx, "pkg2").
+			Return(&systemtenant.SystemTenantPackage{ID: ptr.Of("pkg2"), Status: ptr.Of(int8(1)), MenuIDs: []string{"m3"}}, nil)
+		d.repo.EXPECT().Update(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, tenant *systemtenant.SystemTenant) (*systemtenant.SystemTenant, error) {
+				assert.Nil(t, tenant.ContactUserID)
+				return &systemtenant.SystemTenant{ID: ptr.Of("tenant1"), PackageID: ptr.Of("pkg2"), ContactUserID: ptr.Of("user1")}, nil
+			})
+		d.permissionRepo.EXPECT().RemoveMenusNotIn(inTenant("tenant1"), []string{"m3"}).Return([]string{"role2"}, nil)
+		d.roleRepo.EXPECT().ListByIDs(inTenant("tenant1"), []string{"role2"}).
+			Return([]*systemrole.SystemRole{{ID: ptr.Of("role2"), Code: ptr.Of("operator")}}, nil)
+		d.permissionRepo.EXPECT().ListUserRoleIDs(inTenant("tenant1"), "user1").Return([]string{"role1", "role2"}, nil)
+		d.roleRepo.EXPECT().ListByIDs(inTenant("tenant1"), []string{"role1", "role2"}).Return([]*systemrole.SystemRole{
+			{ID: ptr.Of("role1"), Code: ptr.Of(systemtenant.TenantAdminRoleCode)},
+			{ID: ptr.Of("role2"), Code: ptr.Of("operator")},
+		}, nil)
+		d.permissionRepo.EXPECT().RemoveRoleMenus(inTenant("tenant1"), "role1").Return(nil)
+		d.permissionRepo.EXPECT().AddRoleMenus(inTenant("tenant1"), "role1", []string{"m3"}).Return(nil)
+
+		// 执行测试
+		_, err := d.uc.UpdateTenant(ctx, &systemtenant.SystemTenant{ID: ptr.Of("tenant1"), PackageID: ptr.Of("pkg2"), ContactUserID: ptr.Of("user9")})
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Equal(t, map[string][]string{"tenant1": {"operator", systemtenant.TenantAdminRoleCode}}, d.authz.roles)
+	})
+
+	t.Run("停用当前租户", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByID(ctx, auth.PlatformTenantID).Return(&systemtenant.SystemTenant{ID: ptr.Of(auth.PlatformTenantID)}, nil)
+
+		// 执行测试
+		_, err := d.uc.UpdateTenant(ctx, &systemtenant.SystemTenant{ID: ptr.Of(auth.PlatformTenantID), Status: ptr.Of(int8(0))})
+
+		// 断言
+		assert.True(t, errors.Is(err, systemtenant.ErrCurrentTenantImmutable))
+	})
+
+	t.Run("其他租户的用户不能管理租户", func(t *testing.T) {
+		tenantCtx := auth.NewContext(context.Background(), &auth.Principal{UserID: "admin2", TenantID: "tenant1", Roles: []string{auth.SuperAdminRoleCode}})
+
+		// 执行测试
+		_, err := d.uc.UpdateTenant(tenantCtx, &systemtenant.SystemTenant{ID: ptr.Of("tenant2"), Status: ptr.Of(int8(0))})
+
+		// 断言
+		assert.True(t, errors.Is(err, systemtenant.ErrPlatformAdminRequired))
+	})
+}
+
+func TestTenantUsecase_ListPackageMenuIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	d := newTenantDeps(ctrl)
+	ctx := auth.WithTenant(context.Background(), "tenant1")
+
+	t.Run("返回租户套餐的菜单", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByID(ctx, "tenant1").Return(&systemtenant.SystemTenant{ID: ptr.Of("tenant1"), PackageID: ptr.Of("pkg1")}, nil)
+		d.packageRepo.EXPECT().FindByID(ctx, "pkg1").Return(&systemtenant.SystemTenantPackage{ID: ptr.Of("pkg1"), MenuIDs: []string{"m1", "m2"}}, nil)
+
+		// 执行测试
+		menuIDs, err := d.uc.ListPackageMenuIDs(ctx, "tenant1")
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"m1", "m2"}, menuIDs)
+	})
+
+	t.Run("套餐不存在", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByID(ctx, "tenant1").Return(&systemtenant.SystemTenant{ID: ptr.Of("tenant1"), PackageID: ptr.Of("pkg9")}, nil)
+		d.packageRepo.EXPECT().FindByID(ctx, "pkg9").Return(nil, nil)
+
+		// 执行测试
+		menuIDs, err := d.uc.ListPackageMenuIDs(ctx, "tenant1")
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Empty(t, menuIDs)
+	})
+}
+
+func TestTenantUsecase_GetTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	d := newTenantDeps(ctrl)
+	ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "admin2", TenantID: "tenant1"})
+
+	t.Run("查看本租户", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByID(ctx, "tenant1").Return(&systemtenant.SystemTenant{ID: ptr.Of("tenant1")}, nil)
+
+		// 执行测试
+		tenant, err := d.uc.GetTenant(ctx, "tenant1")
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Equal(t, "tenant1", ptr.From(tenant.ID))
+	})
+
+	t.Run("不能查看其他租户", func(t *testing.T) {
+		// 执行测试
+		_, err := d.uc.GetTenant(ctx, "tenant2")
+
+		// 断言
+		assert.True(t, errors.Is(err, systemtenant.ErrPlatformAdminRequired))
+	})
+}
+
+func TestTenantUsecase_DeleteTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	d := newTenantDeps(ctrl)
+	ctx := platformAdminContext()
+
+	t.Run("成功删除租户", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByID(ctx, "tenant1").Return(&systemtenant.SystemTenant{ID: ptr.Of("tenant1")}, nil)
+		d.repo.EXPECT().Delete(ctx, "tenant1").Return(nil)
+
+		// 执行测试
+		err := d.uc.DeleteTenant(ctx, "tenant1")
+
+		// 断言
+		assert.NoError(t, err)
+	})
+
+	t.Run("删除当前租户", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByID(ctx, auth.PlatformTenantID).Return(&systemtenant.SystemTenant{ID: ptr.Of(auth.PlatformTenantID)}, nil)
+
+		// 执行测试
+		err := d.uc.DeleteTenant(ctx, auth.PlatformTenantID)
+
+		// 断言
+		assert.True(t, errors.Is(err, systemtenant.ErrCurrentTenantImmutable))
+	})
+}
+
+func TestTenantUsecase_CheckTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	d := newTenantDeps(ctrl)
+	ctx := context.Background()
+
+	t.Run("租户正常", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByID(ctx, "tenant1").
+			Return(&systemtenant.SystemTenant{Status: ptr.Of(int8(1)), ExpireTime: ptr.Of(time.Now().Add(time.Hour))}, nil)
+
+		// 执行测试
+		err := d.uc.CheckTenant(ctx, "tenant1")
+
+		// 断言
+		assert.NoError(t, err)
+	})
+
+	t.Run("租户已过期", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByID(ctx, "tenant1").
+			Return(&systemtenant.SystemTenant{Status: ptr.Of(int8(1)), ExpireTime: ptr.Of(time.Now().Add(-time.Hour))}, nil)
+
+		// 执行测试
+		err := d.uc.CheckTenant(ctx, "tenant1")
+
+		// 断言
+		assert.True(t, v1.IsTenantExpired(err))
+	})
+
+	t.Run("租户已停用", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByID(ctx, "tenant1").
+			Return(&systemtenant.SystemTenant{Status: ptr.Of(int8(0)), ExpireTime: ptr.Of(time.Now().Add(time.Hour))}, nil)
+
+		// 执行测试
+		err := d.uc.CheckTenant(ctx, "tenant1")
+
+		// 断言
+		assert.True(t, v1.IsTenantDisabled(err))
+	})
+
+	t.Run("租户已删除", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByID(ctx, "tenant1").Return(nil, nil)
+
+		// 执行测试
+		err := d.uc.CheckTenant(ctx, "tenant1")
+
+		// 断言
+		assert.True(t, v1.IsTenantDisabled(err))
+	})
+}
+
+func TestTenantUsecase_ResolveTenantByWebsite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	d := newTenantDeps(ctrl)
+	ctx := context.Background()
+
+	t.Run("域名已绑定", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByWebsite(ctx, "a.example.com").Return(&systemtenant.SystemTenant{ID: ptr.Of("tenant1")}, nil)
+
+		// 执行测试
+		tenantID, err := d.uc.ResolveTenantByWebsite(ctx, "a.example.com")
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Equal(t, "tenant1", tenantID)
+	})
+
+	t.Run("域名未绑定", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByWebsite(ctx, "b.example.com").Return(nil, nil)
+
+		// 执行测试
+		tenantID, err := d.uc.ResolveTenantByWebsite(ctx, "b.example.com")
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Empty(t, tenantID)
+	})
+}
+
+func TestTenantUsecase_CheckAccountQuota(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	d := newTenantDeps(ctrl)
+	ctx := context.Background()
+
+	t.Run("未达到账号数量", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByID(ctx, "tenant1").Return(&systemtenant.SystemTenant{AccountCount: ptr.Of(int32(2))}, nil)
+		d.userRepo.EXPECT().GetUserStats(gomock.Any(), "tenant1").Return(&systemuser.UserStats{TotalUsers: 1}, nil)
+
+		// 执行测试
+		err := d.uc.CheckAccountQuota(ctx, "tenant1")
+
+		// 断言
+		assert.NoError(t, err)
+	})
+
+	t.Run("达到账号数量", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByID(ctx, "tenant1").Return(&systemtenant.SystemTenant{AccountCount: ptr.Of(int32(2))}, nil)
+		d.userRepo.EXPECT().GetUserStats(gomock.Any(), "tenant1").Return(&systemuser.UserStats{TotalUsers: 2}, nil)
+
+		// 执行测试
+		err := d.uc.CheckAccountQuota(ctx, "tenant1")
+
+		// 断言
+		assert.True(t, errors.Is(err, systemtenant.ErrTenantAccountLimit))
+	})
+}
@@ -0,0 +1,343 @@
+package systemtenant
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"qn-base/app/admin/internal/biz/permission"
+	"qn-base/app/admin/internal/biz/systemmenu"
+	"qn-base/app/admin/internal/biz/systemrole"
+	"qn-base/app/admin/internal/biz/tx"
+	"qn-base/pkg/auth"
+	"qn-base/pkg/lang/ptr"
+	"qn-base/pkg/lang/slices"
+	"qn-base/pkg/util/validator"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrTenantPackageNotFound is tenant package not found.
+	ErrTenantPackageNotFound = errors.NotFound("TENANT_PACKAGE_NOT_FOUND", "tenant package not found")
+	// ErrTenantPackageNameAlreadyExists is tenant package name already exists.
+	ErrTenantPackageNameAlreadyExists = errors.Conflict("TENANT_PACKAGE_NAME_ALREADY_EXISTS", "tenant package name already exists")
+	// ErrTenantPackageDisabled is the tenant package has been disabled.
+	ErrTenantPackageDisabled = errors.BadRequest("TENANT_PACKAGE_DISABLED", "tenant package is disabled")
+	// ErrTenantPackageInUse is the tenant package is still used by tenants.
+	ErrTenantPackageInUse = errors.BadRequest("TENANT_PACKAGE_IN_USE", "tenant package is used by tenants")
+	// ErrTenantPackageMenuInvalid is some of the menus of the package do not exist.
+	ErrTenantPackageMenuInvalid = errors.BadRequest("TENANT_PACKAGE_MENU_INVALID", "some of the menus do not exist")
+)
+
+// SystemTenantPackageRepo is a SystemTenantPackage repo.
+//
+//go:generate mockgen -source=system_tenant_package_biz.go -destination=./mocks/mock_tenant_package_repo.go -package=mocks
+type SystemTenantPackageRepo interface {
+	Save(context.Context, *SystemTenantPackage) (*SystemTenantPackage, error)
+	Update(context.Context, *SystemTenantPackage) (*SystemTenantPackage, error)
+	Delete(context.Context, string) error
+	FindByID(context.Context, string) (*SystemTenantPackage, error)
+	FindByName(context.Context, string) (*SystemTenantPackage, error)
+	ListTenantPackages(context.Context, *ListTenantPackageRequest) ([]*SystemTenantPackage, int32, error)
+	ChangeStatus(context.Context, string, int8) error
+}
+
+// tenantPackageUsecase 是 TenantPackageUsecase 接口的具体实现
+type tenantPackageUsecase struct {
+	tx             tx.Transaction
+	repo           SystemTenantPackageRepo
+	tenantRepo     SystemTenantRepo
+	menuRepo       systemmenu.SystemMenuRepo
+	roleRepo       systemrole.SystemRoleRepo
+	permissionRepo permission.PermissionRepo
+	// authz 修改套餐菜单后清除角色缓存的权限
+	authz auth.PermissionInvalidator
+	log   *log.Helper
+}
+
+// 确保 tenantPackageUsecase 实现了 TenantPackageUsecase 接口
+var _ TenantPackageUsecase = (*tenantPackageUsecase)(nil)
+
+// NewTenantPackageUsecase new a SystemTenantPackage usecase.
+func NewTenantPackageUsecase(
+	tx tx.Transaction,
+	repo SystemTenantPackageRepo,
+	tenantRepo SystemTenantRepo,
+	menuRepo systemmenu.SystemMenuRepo,
+	roleRepo systemrole.SystemRoleRepo,
+	permissionRepo permission.PermissionRepo,
+	authz auth.PermissionInvalidator,
+	logger log.Logger,
+) TenantPackageUsecase {
+	return &tenantPackageUsecase{
+		tx:             tx,
+		repo:           repo,
+		tenantRepo:     tenantRepo,
+		menuRepo:       menuRepo,
+		roleRepo:       roleRepo,
+		permissionRepo: permissionRepo,
+		authz:          authz,
+		log:            log.NewHelper(log.With(logger, "module", "systemtenant/biz/package")),
+	}
+}
+
+// CreateTenantPackage creates a SystemTenantPackage, and returns the new SystemTenantPackage.
+func (uc *tenantPackageUsecase) CreateTenantPackage(ctx context.Context, p *SystemTenantPackage) (*SystemTenantPackage, error) {
+	uc.log.WithContext(ctx).Infof("CreateTenantPackage: %v", ptr.From(p.Name))
+
+	if err := checkPlatformAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// 参数校验
+	if err := uc.validatePackage(p, true); err != nil {
+		return nil, err
+	}
+
+	// 检查套餐名是否已存在
+	if err := uc.checkUnique(ctx, "", p); err != nil {
+		return nil, err
+	}
+
+	// 检查菜单
+	p.MenuIDs = slices.Uniq(p.MenuIDs)
+	if err := uc.checkMenus(ctx, p.MenuIDs); err != nil {
+		return nil, err
+	}
+
+	// 设置默认值
+	if p.Status == nil {
+		p.Status = ptr.Of(int8(1))
+	}
+	if p.MenuIDs == nil {
+		p.MenuIDs = []string{}
+	}
+
+	return uc.repo.Save(ctx, p)
+}
+
+// GetTenantPackage gets a SystemTenantPackage by ID.
+func (uc *tenantPackageUsecase) GetTenantPackage(ctx context.Context, id string) (*SystemTenantPackage, error) {
+	uc.log.WithContext(ctx).Infof("GetTenantPackage: %s", id)
+	if err := checkPlatformAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return uc.findPackage(ctx, id)
+}
+
+// findPackage finds the package by ID, returns ErrTenantPackageNotFound if it does not exist.
+func (uc *tenantPackageUsecase) findPackage(ctx context.Context, id string) (*SystemTenantPackage, error) {
+	pkg, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if pkg == nil {
+		return nil, ErrTenantPackageNotFound
+	}
+	return pkg, nil
+}
+
+// UpdateTenantPackage updates a SystemTenantPackage,
+// changing the menus applies them to the roles of the tenants using the package.
+func (uc *tenantPackageUsecase) UpdateTenantPackage(ctx context.Context, p *SystemTenantPackage) (*SystemTenantPackage, error) {
+	uc.log.WithContext(ctx).Infof("UpdateTenantPackage: %s", ptr.From(p.ID))
+
+	if err := checkPlatformAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// 参数校验
+	if p.ID == nil {
+		return nil, errors.BadRequest("INVALID_PARAMETER", "套餐ID不能为空")
+	}
+	if err := uc.validatePackage(p, false); err != nil {
+		return nil, err
+	}
+
+	// 检查套餐是否存在
+	if _, err := uc.findPackage(ctx, *p.ID); err != nil {
+		return nil, err
+	}
+
+	// 检查套餐名是否被其他套餐使用
+	if err := uc.checkUnique(ctx, *p.ID, p); err != nil {
+		return nil, err
+	}
+
+	if p.MenuIDs == nil {
+		return uc.repo.Update(ctx, p)
+	}
+
+	// 修改菜单
+	p.MenuIDs = slices.Uniq(p.MenuIDs)
+	if err := uc.checkMenus(ctx, p.MenuIDs); err != nil {
+		return nil, err
+	}
+	var pkg *SystemTenantPackage
+	// 各租户菜单变化的角色，事务提交后清除其缓存的权限
+	changed := make(map[string][]string)
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		pkg, err = uc.repo.Update(ctx, p)
+		if err != nil {
+			return err
+		}
+		tenants, err := uc.tenantRepo.ListByPackageID(ctx, *p.ID)
+		if err != nil {
+			return err
+		}
+		for _, tenant := range tenants {
+			codes, err := applyPackageMenus(ctx, uc.roleRepo, uc.permissionRepo, tenant, p.MenuIDs)
+			if err != nil {
+				return err
+			}
+			changed[ptr.From(tenant.ID)] = codes
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for tenantID, codes := range changed {
+		invalidateRoles(uc.authz, tenantID, codes)
+	}
+	return pkg, nil
+}
+
+// DeleteTenantPackage deletes a SystemTenantPackage by ID.
+func (uc *tenantPackageUsecase) DeleteTenantPackage(ctx context.Context, id string) error {
+	uc.log.WithContext(ctx).Infof("DeleteTenantPackage: %s", id)
+
+	if err := checkPlatformAdmin(ctx); err != nil {
+		return err
+	}
+
+	if _, err := uc.findPackage(ctx, id); err != nil {
+		return err
+	}
+
+	// 仍被租户使用的套餐不能删除
+	tenants, err := uc.tenantRepo.ListByPackageID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(tenants) > 0 {
+		return ErrTenantPackageInUse
+	}
+
+	return uc.repo.Delete(ctx, id)
+}
+
+// ListTenantPackages lists tenant packages.
+func (uc *tenantPackageUsecase) ListTenantPackages(ctx context.Context, req *ListTenantPackageRequest) ([]*SystemTenantPackage, int32, error) {
+	uc.log.WithContext(ctx).Infof("ListTenantPackages: page=%d, page_size=%d, name=%s", req.Page, req.PageSize, req.Name)
+
+	if err := checkPlatformAdmin(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	// 设置默认分页参数
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 15
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+
+	return uc.repo.ListTenantPackages(ctx, req)
+}
+
+// ChangeTenantPackageStatus changes tenant package status,
+// a disabled package cannot be used by new tenants, the existing tenants are not affected.
+func (uc *tenantPackageUsecase) ChangeTenantPackageStatus(ctx context.Context, id string, status int8) error {
+	uc.log.WithContext(ctx).Infof("ChangeTenantPackageStatus: id=%s, status=%d", id, status)
+
+	if err := checkPlatformAdmin(ctx); err != nil {
+		return err
+	}
+
+	// 参数校验
+	if err := validator.ValidateRequiredString(id, "套餐ID"); err != nil {
+		return errors.BadRequest("INVALID_PARAMETER", err.Error())
+	}
+	if err := validator.ValidateStatus(status); err != nil {
+		return errors.BadRequest("INVALID_PARAMETER", err.Error())
+	}
+
+	if _, err := uc.findPackage(ctx, id); err != nil {
+		return err
+	}
+
+	return uc.repo.ChangeStatus(ctx, id, status)
+}
+
+// checkUnique checks that the package name is not used by other packages.
+func (uc *tenantPackageUsecase) checkUnique(ctx context.Context, id string, p *SystemTenantPackage) error {
+	if p.Name == nil {
+		return nil
+	}
+	existing, err := uc.repo.FindByName(ctx, *p.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil && ptr.From(existing.ID) != id {
+		return ErrTenantPackageNameAlreadyExists
+	}
+	return nil
+}
+
+// checkMenus checks that all the menus of the package exist.
+func (uc *tenantPackageUsecase) checkMenus(ctx context.Context, menuIDs []string) error {
+	if len(menuIDs) == 0 {
+		return nil
+	}
+	menus, err := uc.menuRepo.ListByIDs(ctx, menuIDs)
+	if err != nil {
+		return err
+	}
+	if len(menus) != len(menuIDs) {
+		return ErrTenantPackageMenuInvalid
+	}
+	return nil
+}
+
+// validatePackage validates tenant package parameters.
+func (uc *tenantPackageUsecase) validatePackage(p *SystemTenantPackage, create bool) error {
+	if create && p.Name == nil {
+		return errors.BadRequest("INVALID_PARAMETER", "套餐名不能为空")
+	}
+
+	if p.Name != nil {
+		if err := validator.ValidateRequiredString(*p.Name, "套餐名"); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+		if utf8.RuneCountInString(*p.Name) > 32 {
+			return errors.BadRequest("INVALID_PARAMETER", "套餐名长度不能超过32个字符")
+		}
+	}
+
+	for _, id := range p.MenuIDs {
+		if strings.TrimSpace(id) == "" {
+			return errors.BadRequest("INVALID_PARAMETER", "菜单ID不能为空")
+		}
+	}
+
+	if p.Status != nil {
+		if err := validator.ValidateStatus(*p.Status); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+	}
+
+	if p.Remark != nil {
+		if err := validator.ValidateRemark(*p.Remark); err != nil {
+			return errors.BadRequest("INVALID_PARAMETER", err.Error())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package systemtenant_test
+
+import (
+	"context"
+	"testing"
+
+	permissionmocks "qn-base/app/admin/internal/biz/permission/mocks"
+	"qn-base/app/admin/internal/biz/systemmenu"
+	menumocks "qn-base/app/admin/internal/biz/systemmenu/mocks"
+	"qn-base/app/admin/internal/biz/systemrole"
+	rolemocks "qn-base/app/admin/internal/biz/systemrole/mocks"
+	"qn-base/app/admin/internal/biz/systemtenant"
+	"qn-base/app/admin/internal/biz/systemtenant/mocks"
+	"qn-base/pkg/auth"
+	"qn-base/pkg/lang/ptr"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type packageDeps struct {
+	tx             *fakeTx
+	authz          *fakeInvalidator
+	repo           *mocks.MockSystemTenantPackageRepo
+	tenantRepo     *mocks.MockSystemTenantRepo
+	menuRepo       *menumocks.MockSystemMenuRepo
+	roleRepo       *rolemocks.MockSystemRoleRepo
+	permissionRepo *permissionmocks.MockPermissionRepo
+	uc             systemtenant.TenantPackageUsecase
+}
+
+func newPackageDeps(ctrl *gomock.Controller) *packageDeps {
+	d := &packageDeps{
+		tx:             &fakeTx{},
+		authz:          &fakeInvalidator{},
+		repo:           mocks.NewMockSystemTenantPackageRepo(ctrl),
+		tenantRepo:     mocks.NewMockSystemTenantRepo(ctrl),
+		menuRepo:       menumocks.NewMockSystemMenuRepo(ctrl),
+		roleRepo:       rolemocks.NewMockSystemRoleRepo(ctrl),
+		permissionRepo: permissionmocks.NewMockPermissionRepo(ctrl),
+	}
+	d.uc = systemtenant.NewTenantPackageUsecase(d.tx, d.repo, d.tenantRepo, d.menuRepo, d.roleRepo, d.permissionRepo, d.authz, log.DefaultLogger)
+	return d
+}
+
+func TestTenantPackageUsecase_CreateTenantPackage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	d := newPackageDeps(ctrl)
+	ctx := platformAdminContext()
+
+	t.Run("成功创建套餐", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByName(ctx, "基础版").Return(nil, nil)
+		d.menuRepo.EXPECT().ListByIDs(ctx, []string{"m1", "m2"}).
+			Return([]*systemmenu.SystemMenu{{ID: ptr.Of("m1")}, {ID: ptr.Of("m2")}}, nil)
+		d.repo.EXPECT().Save(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, p *systemtenant.SystemTenantPackage) (*systemtenant.SystemTenantPackage, error) {
+				assert.Equal(t, int8(1), ptr.From(p.Status))
+				return p, nil
+			})
+
+		// 执行测试
+		pkg, err := d.uc.CreateTenantPackage(ctx, &systemtenant.SystemTenantPackage{
+			Name:    ptr.Of("基础版"),
+			MenuIDs: []string{"m1", "m2", "m1"},
+		})
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"m1", "m2"}, pkg.MenuIDs)
+	})
+
+	t.Run("菜单不存在", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByName(ctx, "基础版").Return(nil, nil)
+		d.menuRepo.EXPECT().ListByIDs(ctx, []string{"none"}).Return(nil, nil)
+
+		// 执行测试
+		_, err := d.uc.CreateTenantPackage(ctx, &systemtenant.SystemTenantPackage{
+			Name:    ptr.Of("基础版"),
+			MenuIDs: []string{"none"},
+		})
+
+		// 断言
+		assert.True(t, errors.Is(err, systemtenant.ErrTenantPackageMenuInvalid))
+	})
+
+	t.Run("非平台管理员不能管理套餐", func(t *testing.T) {
+		tenantCtx := auth.NewContext(context.Background(), &auth.Principal{UserID: "admin2", TenantID: "tenant1"})
+
+		// 执行测试
+		_, err := d.uc.CreateTenantPackage(tenantCtx, &systemtenant.SystemTenantPackage{Name: ptr.Of("基础版")})
+
+		// 断言
+		assert.True(t, errors.Is(err, systemtenant.ErrPlatformAdminRequired))
+	})
+}
+
+func TestTenantPackageUsecase_UpdateTenantPackage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	d := newPackageDeps(ctrl)
+	ctx := platformAdminContext()
+
+	t.Run("修改菜单时移除套餐外的菜单并替换租户管理员菜单", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByID(ctx, "pkg1").Return(&systemtenant.SystemTenantPackage{ID: ptr.Of("pkg1")}, nil)
+		d.menuRepo.EXPECT().ListByIDs(ctx, []string{"m1"}).Return([]*systemmenu.SystemMenu{{ID: ptr.Of("m1")}}, nil)
+		d.repo.EXPECT().Update(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, p *systemtenant.SystemTenantPackage) (*systemtenant.SystemTenantPackage, error) {
+				return p, nil
+			})
+		d.tenantRepo.EXPECT().ListByPackageID(ctx, "pkg1").Return([]*systemtenant.SystemTenant{
+			{ID: ptr.Of("tenant1"), ContactUserID: ptr.Of("user1")},
+			// 没有管理员的租户只移除套餐外的菜单
+			{ID: ptr.Of("tenant2")},
+		}, nil)
+		// 全部租户移除套餐外的菜单
+		d.permissionRepo.EXPECT().RemoveMenusNotIn(inTenant("tenant1"), []string{"m1"}).Return([]string{"role2"}, nil)
+		d.roleRepo.EXPECT().ListByIDs(inTenant("tenant1"), []string{"role2"}).
+			Return([]*systemrole.SystemRole{{ID: ptr.Of("role2"), Code: ptr.Of("operator")}}, nil)
+		d.permissionRepo.EXPECT().RemoveMenusNotIn(inTenant("tenant2"), []string{"m1"}).Return(nil, nil)
+		d.permissionRepo.EXPECT().ListUserRoleIDs(inTenant("tenant1"), "user1").Return([]string{"role1"}, nil)
+		d.roleRepo.EXPECT().ListByIDs(inTenant("tenant1"), []string{"role1"}).
+			Return([]*systemrole.SystemRole{{ID: ptr.Of("role1"), Code: ptr.Of(systemtenant.TenantAdminRoleCode)}}, nil)
+		d.permissionRepo.EXPECT().RemoveRoleMenus(inTenant("tenant1"), "role1").Return(nil)
+		d.permissionRepo.EXPECT().AddRoleMenus(inTenant("tenant1"), "role1", []string{"m1"}).Return(nil)
+
+		// 执行测试
+		_, err := d.uc.UpdateTenantPackage(ctx, &systemtenant.SystemTenantPackage{ID: ptr.Of("pkg1"), MenuIDs: []string{"m1"}})
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Equal(t, 1, d.tx.calls)
+		// 提交后清除菜单变化的角色缓存的权限
+		assert.Equal(t, map[string][]string{"tenant1": {"operator", systemtenant.TenantAdminRoleCode}}, d.authz.roles)
+	})
+
+	t.Run("不修改菜单", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByID(ctx, "pkg1").Return(&systemtenant.SystemTenantPackage{ID: ptr.Of("pkg1")}, nil)
+		d.repo.EXPECT().FindByName(ctx, "专业版").Return(nil, nil)
+		d.repo.EXPECT().Update(ctx, gomock.Any()).Return(&systemtenant.SystemTenantPackage{ID: ptr.Of("pkg1")}, nil)
+
+		// 执行测试
+		_, err := d.uc.UpdateTenantPackage(ctx, &systemtenant.SystemTenantPackage{ID: ptr.Of("pkg1"), Name: ptr.Of("专业版")})
+
+		// 断言
+		assert.NoError(t, err)
+	})
+}
+
+func TestTenantPackageUsecase_DeleteTenantPackage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	d := newPackageDeps(ctrl)
+	ctx := platformAdminContext()
+
+	t.Run("套餐被租户使用", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByID(ctx, "pkg1").Return(&systemtenant.SystemTenantPackage{ID: ptr.Of("pkg1")}, nil)
+		d.tenantRepo.EXPECT().ListByPackageID(ctx, "pkg1").Return([]*systemtenant.SystemTenant{{ID: ptr.Of("tenant1")}}, nil)
+
+		// 执行测试
+		err := d.uc.DeleteTenantPackage(ctx, "pkg1")
+
+		// 断言
+		assert.True(t, errors.Is(err, systemtenant.ErrTenantPackageInUse))
+	})
+
+	t.Run("成功删除套餐", func(t *testing.T) {
+		// Mock 期望
+		d.repo.EXPECT().FindByID(ctx, "pkg1").Return(&systemtenant.SystemTenantPackage{ID: ptr.Of("pkg1")}, nil)
+		d.tenantRepo.EXPECT().ListByPackageID(ctx, "pkg1").Return(nil, nil)
+		d.repo.EXPECT().Delete(ctx, "pkg1").Return(nil)
+
+		// 执行测试
+		err := d.uc.DeleteTenantPackage(ctx, "pkg1")
+
+		// 断言
+		assert.NoError(t, err)
+	})
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: app/admin/internal/biz/systemuser/system_user_biz.go
+// Source: system_user_biz.go
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -155,6 +155,22 @@ func (mr *MockSystemUserRepoMockRecorder) GetUserStats(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserStats", reflect.TypeOf((*MockSystemUserRepo)(nil).GetUserStats), arg0, arg1)
 }
 
+// ListDeleted mocks base method.
+func (m *MockSystemUserRepo) ListDeleted(arg0 context.Context, arg1 *systemuser.ListUserRequest) ([]*systemuser.SystemUser, int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeleted", arg0, arg1)
+	ret0, _ := ret[0].([]*systemuser.SystemUser)
+	ret1, _ := ret[1].(int32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDeleted indicates an expected call of ListDeleted.
+func (mr *MockSystemUserRepoMockRecorder) ListDeleted(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockSystemUserRepo)(nil).ListDeleted), arg0, arg1)
+}
+
 // ListSystemUsers mocks base method.
 func (m *MockSystemUserRepo) ListSystemUsers(arg0 context.Context, arg1 *systemuser.ListUserRequest) ([]*systemuser.SystemUser, int32, error) {
 	m.ctrl.T.Helper()
@@ -171,6 +187,34 @@ func (mr *MockSystemUserRepoMockRecorder) ListSystemUsers(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSystemUsers", reflect.TypeOf((*MockSystemUserRepo)(nil).ListSystemUsers), arg0, arg1)
 }
 
+// Purge mocks base method.
+func (m *MockSystemUserRepo) Purge(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockSystemUserRepoMockRecorder) Purge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockSystemUserRepo)(nil).Purge), arg0, arg1)
+}
+
+// Restore mocks base method.
+func (m *MockSystemUserRepo) Restore(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockSystemUserRepoMockRecorder) Restore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockSystemUserRepo)(nil).Restore), arg0, arg1)
+}
+
 // Save mocks base method.
 func (m *MockSystemUserRepo) Save(arg0 context.Context, arg1 *systemuser.SystemUser) (*systemuser.SystemUser, error) {
 	m.ctrl.T.Helper()
@@ -200,3 +244,114 @@ func (mr *MockSystemUserRepoMockRecorder) Update(arg0, arg1 interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSystemUserRepo)(nil).Update), arg0, arg1)
 }
+
+// MockAccountQuota is a mock of AccountQuota interface.
+type MockAccountQuota struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountQuotaMockRecorder
+}
+
+// MockAccountQuotaMockRecorder is the mock recorder for MockAccountQuota.
+type MockAccountQuotaMockRecorder struct {
+	mock *MockAccountQuota
+}
+
+// NewMockAccountQuota creates a new mock instance.
+func NewMockAccountQuota(ctrl *gomock.Controller) *MockAccountQuota {
+	mock := &MockAccountQuota{ctrl: ctrl}
+	mock.recorder = &MockAccountQuotaMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountQuota) EXPECT() *MockAccountQuotaMockRecorder {
+	return m.recorder
+}
+
+// CheckAccountQuota mocks base method.
+func (m *MockAccountQuota) CheckAccountQuota(ctx context.Context, tenantID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAccountQuota", ctx, tenantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckAccountQuota indicates an expected call of CheckAccountQuota.
+func (mr *MockAccountQuotaMockRecorder) CheckAccountQuota(ctx, tenantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccountQuota", reflect.TypeOf((*MockAccountQuota)(nil).CheckAccountQuota), ctx, tenantID)
+}
+
+// MockUserRoleRepo is a mock of UserRoleRepo interface.
+type MockUserRoleRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRoleRepoMockRecorder
+}
+
+// MockUserRoleRepoMockRecorder is the mock recorder for MockUserRoleRepo.
+type MockUserRoleRepoMockRecorder struct {
+	mock *MockUserRoleRepo
+}
+
+// NewMockUserRoleRepo creates a new mock instance.
+func NewMockUserRoleRepo(ctrl *gomock.Controller) *MockUserRoleRepo {
+	mock := &MockUserRoleRepo{ctrl: ctrl}
+	mock.recorder = &MockUserRoleRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRoleRepo) EXPECT() *MockUserRoleRepoMockRecorder {
+	return m.recorder
+}
+
+// RemoveUserRoles mocks base method.
+func (m *MockUserRoleRepo) RemoveUserRoles(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUserRoles", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveUserRoles indicates an expected call of RemoveUserRoles.
+func (mr *MockUserRoleRepoMockRecorder) RemoveUserRoles(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserRoles", reflect.TypeOf((*MockUserRoleRepo)(nil).RemoveUserRoles), ctx, userID)
+}
+
+// MockUserMFARepo is a mock of UserMFARepo interface.
+type MockUserMFARepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserMFARepoMockRecorder
+}
+
+// MockUserMFARepoMockRecorder is the mock recorder for MockUserMFARepo.
+type MockUserMFARepoMockRecorder struct {
+	mock *MockUserMFARepo
+}
+
+// NewMockUserMFARepo creates a new mock instance.
+func NewMockUserMFARepo(ctrl *gomock.Controller) *MockUserMFARepo {
+	mock := &MockUserMFARepo{ctrl: ctrl}
+	mock.recorder = &MockUserMFARepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserMFARepo) EXPECT() *MockUserMFARepoMockRecorder {
+	return m.recorder
+}
+
+// DeleteByUserID mocks base method.
+func (m *MockUserMFARepo) DeleteByUserID(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserID", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserID indicates an expected call of DeleteByUserID.
+func (mr *MockUserMFARepoMockRecorder) DeleteByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserID", reflect.TypeOf((*MockUserMFARepo)(nil).DeleteByUserID), ctx, userID)
+}
@@ -138,6 +138,36 @@ func (mr *MockUserUsecaseMockRecorder) GetUserStats(ctx, tenantID interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserStats", reflect.TypeOf((*MockUserUsecase)(nil).GetUserStats), ctx, tenantID)
 }
 
+// KickUser mocks base method.
+func (m *MockUserUsecase) KickUser(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KickUser", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// KickUser indicates an expected call of KickUser.
+func (mr *MockUserUsecaseMockRecorder) KickUser(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KickUser", reflect.TypeOf((*MockUserUsecase)(nil).KickUser), ctx, id)
+}
+
+// ListDeletedUsers mocks base method.
+func (m *MockUserUsecase) ListDeletedUsers(ctx context.Context, req *systemuser.ListUserRequest) ([]*systemuser.SystemUser, int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeletedUsers", ctx, req)
+	ret0, _ := ret[0].([]*systemuser.SystemUser)
+	ret1, _ := ret[1].(int32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDeletedUsers indicates an expected call of ListDeletedUsers.
+func (mr *MockUserUsecaseMockRecorder) ListDeletedUsers(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedUsers", reflect.TypeOf((*MockUserUsecase)(nil).ListDeletedUsers), ctx, req)
+}
+
 // ListUsers mocks base method.
 func (m *MockUserUsecase) ListUsers(ctx context.Context, req *systemuser.ListUserRequest) ([]*systemuser.SystemUser, int32, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +184,34 @@ func (mr *MockUserUsecaseMockRecorder) ListUsers(ctx, req interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUserUsecase)(nil).ListUsers), ctx, req)
 }
 
+// PurgeUser mocks base method.
+func (m *MockUserUsecase) PurgeUser(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeUser", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeUser indicates an expected call of PurgeUser.
+func (mr *MockUserUsecaseMockRecorder) PurgeUser(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeUser", reflect.TypeOf((*MockUserUsecase)(nil).PurgeUser), ctx, id)
+}
+
+// ReplaceUserPosts mocks base method.
+func (m *MockUserUsecase) ReplaceUserPosts(ctx context.Context, id string, postIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceUserPosts", ctx, id, postIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceUserPosts indicates an expected call of ReplaceUserPosts.
+func (mr *MockUserUsecaseMockRecorder) ReplaceUserPosts(ctx, id, postIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceUserPosts", reflect.TypeOf((*MockUserUsecase)(nil).ReplaceUserPosts), ctx, id, postIDs)
+}
+
 // ResetPassword mocks base method.
 func (m *MockUserUsecase) ResetPassword(ctx context.Context, id, newPassword string) error {
 	m.ctrl.T.Helper()
@@ -168,6 +226,20 @@ func (mr *MockUserUsecaseMockRecorder) ResetPassword(ctx, id, newPassword interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockUserUsecase)(nil).ResetPassword), ctx, id, newPassword)
 }
 
+// RestoreUser mocks base method.
+func (m *MockUserUsecase) RestoreUser(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreUser", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreUser indicates an expected call of RestoreUser.
+func (mr *MockUserUsecaseMockRecorder) RestoreUser(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreUser", reflect.TypeOf((*MockUserUsecase)(nil).RestoreUser), ctx, id)
+}
+
 // UpdateUser mocks base method.
 func (m *MockUserUsecase) UpdateUser(ctx context.Context, u *systemuser.SystemUser) (*systemuser.SystemUser, error) {
 	m.ctrl.T.Helper()
@@ -3,11 +3,14 @@ package systemuser
 import (
 	"context"
 	"fmt"
-	"qn-base/app/admin/internal/biz/systemuser/repo"
+	"qn-base/app/admin/internal/biz/systemdept"
+	"qn-base/app/admin/internal/biz/systempost"
+	"qn-base/app/admin/internal/biz/tx"
+	"qn-base/pkg/auth"
 	"qn-base/pkg/lang/ptr"
+	"qn-base/pkg/lang/slices"
 	"qn-base/pkg/util/pswd"
 	"qn-base/pkg/util/validator"
-	"time"
 
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
@@ -26,22 +29,97 @@ var (
 	ErrInvalidParameter = errors.BadRequest("INVALID_PARAMETER", "invalid parameter")
 	// ErrPasswordVerifyFailed is password verify failed.
 	ErrPasswordVerifyFailed = errors.Unauthorized("PASSWORD_VERIFY_FAILED", "password verify failed")
+	// ErrUserDeptInvalid is the dept of the user does not exist or is disabled.
+	ErrUserDeptInvalid = errors.BadRequest("USER_DEPT_INVALID", "dept does not exist or is disabled")
+	// ErrUserPostInvalid is some of the posts of the user do not exist or are disabled.
+	ErrUserPostInvalid = errors.BadRequest("USER_POST_INVALID", "some of the posts do not exist or are disabled")
+	// ErrUserAmbiguous is more than one user matches a cross-tenant lookup, e.g. tenants sharing an account.
+	ErrUserAmbiguous = errors.Conflict("USER_AMBIGUOUS", "more than one user matches")
 )
 
+// SystemUserRepo is a SystemUser repo.
+//
+//go:generate mockgen -source=system_user_biz.go -destination=./mocks/mock_user_repo.go -package=mocks
+type SystemUserRepo interface {
+	Save(context.Context, *SystemUser) (*SystemUser, error)
+	Update(context.Context, *SystemUser) (*SystemUser, error)
+	Delete(context.Context, string) error
+	BatchDelete(context.Context, []string) (int32, int32, []string, error)
+	FindByID(context.Context, string) (*SystemUser, error)
+	FindByUsername(context.Context, string) (*SystemUser, error)
+	FindByEmail(context.Context, string) (*SystemUser, error)
+	FindByMobile(context.Context, string) (*SystemUser, error)
+	ListSystemUsers(context.Context, *ListUserRequest) ([]*SystemUser, int32, error)
+	ChangeStatus(context.Context, string, int8) error
+	GetUserStats(context.Context, string) (*UserStats, error)
+	ListDeleted(context.Context, *ListUserRequest) ([]*SystemUser, int32, error)
+	Restore(context.Context, string) error
+	Purge(context.Context, string) error
+}
+
+type credentialsKey struct{}
+
+// WithCredentials returns a new context whose user lookups read the password hash from the database,
+// e.g. for verifying the password on login.
+// 缓存中的用户不含密码，查找时绕过缓存
+func WithCredentials(parent context.Context) context.Context {
+	return context.WithValue(parent, credentialsKey{}, true)
+}
+
+// IsWithCredentials reports whether user lookups in ctx need the password hash.
+func IsWithCredentials(ctx context.Context) bool {
+	with, _ := ctx.Value(credentialsKey{}).(bool)
+	return with
+}
 
+// AccountQuota limits the number of accounts of a tenant.
+type AccountQuota interface {
+	// CheckAccountQuota checks that the tenant can hold one more account.
+	CheckAccountQuota(ctx context.Context, tenantID string) error
+}
+
+// UserRoleRepo removes the role assignments of users, implemented by the permission repo.
+type UserRoleRepo interface {
+	// RemoveUserRoles removes all roles of the user.
+	RemoveUserRoles(ctx context.Context, userID string) error
+}
+
+// UserMFARepo removes the two-factor authentication settings of users, implemented by the auth repo.
+type UserMFARepo interface {
+	// DeleteByUserID deletes the setting of the user.
+	DeleteByUserID(ctx context.Context, userID string) error
+}
 
 // userUsecase 是 UserUsecase 接口的具体实现
 type userUsecase struct {
-	repo repo.SystemUserRepo
-	log  *log.Helper
+	tx       tx.Transaction
+	repo     SystemUserRepo
+	deptRepo systemdept.SystemDeptRepo
+	postRepo systempost.SystemPostRepo
+	roleRepo UserRoleRepo
+	mfaRepo  UserMFARepo
+	quota    AccountQuota
+	// revocation 停用、删除用户和重置密码时吊销用户的令牌
+	revocation auth.RevocationStore
+	log        *log.Helper
 }
 
 // 确保 userUsecase 实现了 UserUsecase 接口
 var _ UserUsecase = (*userUsecase)(nil)
 
 // NewUserUsecase new a SystemUser usecase.
-func NewUserUsecase(repo repo.SystemUserRepo, logger log.Logger) UserUsecase {\r
-	return &userUsecase{repo: repo, log: log.NewHelper(logger)}
+func NewUserUsecase(
+	tx tx.Transaction,
+	repo SystemUserRepo,
+	deptRepo systemdept.SystemDeptRepo,
+	postRepo systempost.SystemPostRepo,
+	roleRepo UserRoleRepo,
+	mfaRepo UserMFARepo,
+	quota AccountQuota,
+	revocation auth.RevocationStore,
+	logger log.Logger,
+) UserUsecase {
+	return &userUsecase{tx: tx, repo: repo, deptRepo: deptRepo, postRepo: postRepo, roleRepo: roleRepo, mfaRepo: mfaRepo, quota: quota, revocation: revocation, log: log.NewHelper(logger)}
 }
 
 // CreateUser creates a SystemUser, and returns the new SystemUser.
@@ -53,6 +131,15 @@ func (uc *userUsecase) CreateUser(ctx context.Context, u *SystemUser) (*SystemUs
 		return nil, err
 	}
 
+	// 检查部门和岗位
+	if err := uc.checkDept(ctx, u.DeptID); err != nil {
+		return nil, err
+	}
+	posts, err := uc.checkPosts(ctx, u.PostIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	// 检查用户名是否已存在
 	existingUser, err := uc.repo.FindByUsername(ctx, ptr.From(u.Account))
 	if err != nil && !errors.IsNotFound(err) {
@@ -98,7 +185,32 @@ func (uc *userUsecase) CreateUser(ctx context.Context, u *SystemUser) (*SystemUs
 		u.Status = ptr.Of(int8(1)) // 默认正常状态
 	}
 
-	return uc.repo.Save(ctx, u)
+	// 未指定时使用当前登录用户的信息
+	if u.CreateBy == nil {
+		u.CreateBy = optionalString(auth.UserID(ctx))
+	}
+	if u.TenantID == nil {
+		u.TenantID = optionalString(auth.TenantID(ctx))
+	}
+
+	// 检查租户的账号数量
+	if err := uc.quota.CheckAccountQuota(ctx, ptr.From(u.TenantID)); err != nil {
+		return nil, err
+	}
+
+	var user *SystemUser
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		user, err = uc.repo.Save(ctx, u)
+		if err != nil || len(u.PostIDs) == 0 {
+			return err
+		}
+		return uc.postRepo.ReplaceUserPosts(ctx, ptr.From(user.ID), slices.Uniq(u.PostIDs))
+	})
+	if err != nil {
+		return nil, err
+	}
+	user.Posts = posts
+	return user, nil
 }
 
 // GetUser gets a SystemUser by ID.
@@ -111,6 +223,9 @@ func (uc *userUsecase) GetUser(ctx context.Context, id string) (*SystemUser, err
 	if user == nil {
 		return nil, ErrUserNotFound
 	}
+	if err := uc.fillPosts(ctx, user); err != nil {
+		return nil, err
+	}
 	return user, nil
 }
 
@@ -132,6 +247,18 @@ func (uc *userUsecase) UpdateUser(ctx context.Context, u *SystemUser) (*SystemUs
 		return nil, ErrUserNotFound
 	}
 
+	// 修改部门时检查部门
+	if u.DeptID != nil && *u.DeptID != ptr.From(existingUser.DeptID) {
+		if err := uc.checkDept(ctx, u.DeptID); err != nil {
+			return nil, err
+		}
+	}
+	if u.PostIDs != nil {
+		if _, err := uc.checkPosts(ctx, u.PostIDs); err != nil {
+			return nil, err
+		}
+	}
+
 	// 检查邮箱是否被其他用户使用
 	if u.Email != nil && *u.Email != "" && (existingUser.Email == nil || *existingUser.Email != *u.Email) {
 		existingEmail, err := uc.repo.FindByEmail(ctx, *u.Email)
@@ -154,31 +281,133 @@ func (uc *userUsecase) UpdateUser(ctx context.Context, u *SystemUser) (*SystemUs
 		}
 	}
 
-	return uc.repo.Update(ctx, u)
+	if u.UpdateBy == nil {
+		u.UpdateBy = optionalString(auth.UserID(ctx))
+	}
+
+	var user *SystemUser
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		user, err = uc.repo.Update(ctx, u)
+		if err != nil || u.PostIDs == nil {
+			return err
+		}
+		return uc.postRepo.ReplaceUserPosts(ctx, *u.ID, slices.Uniq(u.PostIDs))
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 停用后立即下线
+	if u.Status != nil && *u.Status == 0 && ptr.From(existingUser.Status) != 0 {
+		if err := uc.revokeSessions(ctx, *u.ID); err != nil {
+			return nil, err
+		}
+	}
+	if err := uc.fillPosts(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
 }
 
 // DeleteUser deletes a SystemUser by ID.
 func (uc *userUsecase) DeleteUser(ctx context.Context, id string) error {
 	uc.log.WithContext(ctx).Infof("DeleteUser: %s", id)
-	return uc.repo.Delete(ctx, id)
+	if err := uc.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	return uc.revokeSessions(ctx, id)
 }
 
 // ListUsers lists users.
 func (uc *userUsecase) ListUsers(ctx context.Context, req *ListUserRequest) ([]*SystemUser, int32, error) {
 	uc.log.WithContext(ctx).Infof("ListSystemUsers: page=%d, page_size=%d, username=%s", req.Page, req.PageSize, req.Username)
 
-	// 设置默认分页参数
-	if req.Page <= 0 {
-		req.Page = 1
+	users, total, err := uc.repo.ListSystemUsers(ctx, normalizePage(req))
+	if err != nil {
+		return nil, 0, err
 	}
-	if req.PageSize <= 0 {
-		req.PageSize = 15
+	if err := uc.fillPosts(ctx, users...); err != nil {
+		return nil, 0, err
 	}
-	if req.PageSize > 100 {
-		req.PageSize = 100
+	return users, total, nil
+}
+
+// ListDeletedUsers lists soft-deleted users.
+func (uc *userUsecase) ListDeletedUsers(ctx context.Context, req *ListUserRequest) ([]*SystemUser, int32, error) {
+	uc.log.WithContext(ctx).Infof("ListDeletedUsers: page=%d, page_size=%d, username=%s", req.Page, req.PageSize, req.Username)
+	users, total, err := uc.repo.ListDeleted(ctx, normalizePage(req))
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := uc.fillPosts(ctx, users...); err != nil {
+		return nil, 0, err
+	}
+	return users, total, nil
+}
+
+// RestoreUser restores a soft-deleted user.
+func (uc *userUsecase) RestoreUser(ctx context.Context, id string) error {
+	uc.log.WithContext(ctx).Infof("RestoreUser: id=%s", id)
+
+	// 参数校验
+	if err := validator.ValidateRequiredString(id, "用户ID"); err != nil {
+		return errors.BadRequest("INVALID_PARAMETER", err.Error())
+	}
+
+	// 恢复的用户重新占用租户的账号数量
+	if err := uc.quota.CheckAccountQuota(ctx, auth.TenantID(ctx)); err != nil {
+		return err
+	}
+
+	return uc.repo.Restore(ctx, id)
+}
+
+// PurgeUser permanently deletes a soft-deleted user.
+// 只能彻底删除已经被删除的用户
+func (uc *userUsecase) PurgeUser(ctx context.Context, id string) error {
+	uc.log.WithContext(ctx).Infof("PurgeUser: id=%s", id)
+
+	// 参数校验
+	if err := validator.ValidateRequiredString(id, "用户ID"); err != nil {
+		return errors.BadRequest("INVALID_PARAMETER", err.Error())
 	}
 
-	return uc.repo.ListSystemUsers(ctx, req)
+	return uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.Purge(ctx, id); err != nil {
+			return err
+		}
+		// 同时清除用户的岗位、角色和两步验证设置
+		if err := uc.postRepo.ReplaceUserPosts(ctx, id, nil); err != nil {
+			return err
+		}
+		if err := uc.roleRepo.RemoveUserRoles(ctx, id); err != nil {
+			return err
+		}
+		return uc.mfaRepo.DeleteByUserID(ctx, id)
+	})
+}
+
+// ReplaceUserPosts replaces all posts of the user, an empty postIDs clears them.
+func (uc *userUsecase) ReplaceUserPosts(ctx context.Context, id string, postIDs []string) error {
+	uc.log.WithContext(ctx).Infof("ReplaceUserPosts: id=%s, postIDs=%v", id, postIDs)
+
+	// 参数校验
+	if err := validator.ValidateRequiredString(id, "用户ID"); err != nil {
+		return errors.BadRequest("INVALID_PARAMETER", err.Error())
+	}
+
+	// 检查用户是否存在
+	existingUser, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existingUser == nil {
+		return ErrUserNotFound
+	}
+	if _, err := uc.checkPosts(ctx, postIDs); err != nil {
+		return err
+	}
+
+	return uc.postRepo.ReplaceUserPosts(ctx, id, slices.Uniq(postIDs))
 }
 
 // BatchDeleteUsers deletes multiple users.
@@ -194,6 +423,14 @@ func (uc *userUsecase) BatchDeleteUsers(ctx context.Context, ids []string) (*Bat
 	if err != nil {
 		return nil, err
 	}
+	for _, id := range ids {
+		if slices.Contains(failedIDs, id) {
+			continue
+		}
+		if err := uc.revokeSessions(ctx, id); err != nil {
+			return nil, err
+		}
+	}
 
 	return &BatchDeleteResult{
 		SuccessCount: successCount,
@@ -223,7 +460,14 @@ func (uc *userUsecase) ChangeUserStatus(ctx context.Context, id string, status i
 		return ErrUserNotFound
 	}
 
-	return uc.repo.ChangeStatus(ctx, id, status)
+	if err := uc.repo.ChangeStatus(ctx, id, status); err != nil {
+		return err
+	}
+	// 停用后立即下线
+	if status == 0 {
+		return uc.revokeSessions(ctx, id)
+	}
+	return nil
 }
 
 // ResetPassword resets user password.
@@ -257,10 +501,43 @@ func (uc *userUsecase) ResetPassword(ctx context.Context, id, newPassword string
 	updateUser := &SystemUser{
 		ID:       &id,
 		Password: &hashedPassword,
+		UpdateBy: optionalString(auth.UserID(ctx)),
 	}
 
-	_, err = uc.repo.Update(ctx, updateUser)
-	return err
+	if _, err = uc.repo.Update(ctx, updateUser); err != nil {
+		return err
+	}
+	// 使用旧密码登录的会话全部失效
+	return uc.revokeSessions(ctx, id)
+}
+
+// KickUser forces the user offline by revoking all its live tokens.
+func (uc *userUsecase) KickUser(ctx context.Context, id string) error {
+	uc.log.WithContext(ctx).Infof("KickUser: id=%s", id)
+
+	// 参数校验
+	if err := validator.ValidateRequiredString(id, "用户ID"); err != nil {
+		return errors.BadRequest("INVALID_PARAMETER", err.Error())
+	}
+
+	// 检查用户是否存在
+	existingUser, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existingUser == nil {
+		return ErrUserNotFound
+	}
+
+	return uc.revokeSessions(ctx, id)
+}
+
+// revokeSessions revokes all the live tokens of the user, the user has to log in again.
+func (uc *userUsecase) revokeSessions(ctx context.Context, id string) error {
+	if err := uc.revocation.RevokeUser(ctx, id); err != nil {
+		return fmt.Errorf("吊销用户令牌失败: %w", err)
+	}
+	return nil
 }
 
 // CheckAccountExists checks if account exists.
@@ -286,6 +563,64 @@ func (uc *userUsecase) GetUserStats(ctx context.Context, tenantID string) (*User
 	return uc.repo.GetUserStats(ctx, tenantID)
 }
 
+// checkDept checks that the dept exists and is enabled, an empty deptID means no dept.
+func (uc *userUsecase) checkDept(ctx context.Context, deptID *string) error {
+	if deptID == nil || *deptID == "" {
+		return nil
+	}
+	dept, err := uc.deptRepo.FindByID(ctx, *deptID)
+	if err != nil {
+		return err
+	}
+	if dept == nil || ptr.From(dept.Status) != 1 {
+		return ErrUserDeptInvalid
+	}
+	return nil
+}
+
+// checkPosts checks that all the posts exist and are enabled, and returns them.
+func (uc *userUsecase) checkPosts(ctx context.Context, postIDs []string) ([]*systempost.SystemPost, error) {
+	if len(postIDs) == 0 {
+		return nil, nil
+	}
+	if err := validator.ValidateIDs(postIDs); err != nil {
+		return nil, errors.BadRequest("INVALID_PARAMETER", err.Error())
+	}
+	postIDs = slices.Uniq(postIDs)
+	posts, err := uc.postRepo.ListByIDs(ctx, postIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(posts) != len(postIDs) {
+		return nil, ErrUserPostInvalid
+	}
+	for _, post := range posts {
+		if ptr.From(post.Status) != 1 {
+			return nil, ErrUserPostInvalid
+		}
+	}
+	return posts, nil
+}
+
+// fillPosts fills the posts of the users.
+func (uc *userUsecase) fillPosts(ctx context.Context, users ...*SystemUser) error {
+	if len(users) == 0 {
+		return nil
+	}
+	ids := make([]string, len(users))
+	for i, user := range users {
+		ids[i] = ptr.From(user.ID)
+	}
+	posts, err := uc.postRepo.ListUserPosts(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, user := range users {
+		user.Posts = posts[ptr.From(user.ID)]
+	}
+	return nil
+}
+
 // validateCreateUser validates create user parameters.
 func (uc *userUsecase) validateCreateUser(u *SystemUser) error {
 	if u.Account == nil {
@@ -388,3 +723,25 @@ func (uc *userUsecase) validateUpdateUser(u *SystemUser) error {
 
 	return nil
 }
+
+// normalizePage 设置默认分页参数
+func normalizePage(req *ListUserRequest) *ListUserRequest {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 15
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+	return req
+}
+
+// optionalString returns nil for an empty string.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
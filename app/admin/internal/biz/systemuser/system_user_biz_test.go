@@ -3,9 +3,15 @@ package systemuser_test
 import (
 	"context"
 	"testing"
+	"time"
 
+	"qn-base/app/admin/internal/biz/systemdept"
+	deptmocks "qn-base/app/admin/internal/biz/systemdept/mocks"
+	"qn-base/app/admin/internal/biz/systempost"
+	postmocks "qn-base/app/admin/internal/biz/systempost/mocks"
 	"qn-base/app/admin/internal/biz/systemuser"
 	"qn-base/app/admin/internal/biz/systemuser/mocks"
+	"qn-base/pkg/auth"
 	"qn-base/pkg/lang/ptr"
 
 	"github.com/go-kratos/kratos/v2/errors"
@@ -14,13 +20,28 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+// fakeTx runs the function directly and records the calls.
+type fakeTx struct {
+	calls int
+}
+
+func (t *fakeTx) InTx(ctx context.Context, f func(ctx context.Context) error) error {
+	t.calls++
+	return f(ctx)
+}
+
 func TestUserUsecase_CreateUser(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockSystemUserRepo(ctrl)
+	mockDeptRepo := deptmocks.NewMockSystemDeptRepo(ctrl)
+	mockPostRepo := postmocks.NewMockSystemPostRepo(ctrl)
+	mockQuota := mocks.NewMockAccountQuota(ctrl)
 	logger := log.DefaultLogger
-	uc := systemuser.NewUserUsecase(mockRepo, logger)
+	uc := systemuser.NewUserUsecase(&fakeTx{}, mockRepo, mockDeptRepo, mockPostRepo, mocks.NewMockUserRoleRepo(ctrl), mocks.NewMockUserMFARepo(ctrl), mockQuota, auth.NewMemoryRevocationStore(), logger)
+
+	mockQuota.EXPECT().CheckAccountQuota(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	ctx := context.Background()
 
@@ -109,6 +130,229 @@ func TestUserUsecase_CreateUser(t *testing.T) {
 		assert.Nil(t, result)
 		assert.True(t, errors.Is(err, systemuser.ErrUserAlreadyExists))
 	})
+	t.Run("从登录信息填充创建人和租户", func(t *testing.T) {
+		authCtx := auth.NewContext(ctx, &auth.Principal{UserID: "admin1", TenantID: "tenant1"})
+		user := &systemuser.SystemUser{
+			Account:  ptr.Of("newuser"),
+			Password: ptr.Of("password123"),
+		}
+
+		// Mock 期望
+		mockRepo.EXPECT().
+			FindByUsername(authCtx, "newuser").
+			Return(nil, nil)
+
+		mockRepo.EXPECT().
+			Save(authCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, u *systemuser.SystemUser) (*systemuser.SystemUser, error) {
+				assert.Equal(t, "admin1", ptr.From(u.CreateBy))
+				assert.Equal(t, "tenant1", ptr.From(u.TenantID))
+				return u, nil
+			})
+
+		// 执行测试
+		result, err := uc.CreateUser(authCtx, user)
+
+		// 断言
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+
+	t.Run("部门已停用", func(t *testing.T) {
+		user := &systemuser.SystemUser{
+			Account:  ptr.Of("deptuser"),
+			Password: ptr.Of("password123"),
+			DeptID:   ptr.Of("dept1"),
+		}
+
+		// Mock 期望
+		mockDeptRepo.EXPECT().
+			FindByID(ctx, "dept1").
+			Return(&systemdept.SystemDept{ID: ptr.Of("dept1"), Status: ptr.Of(int8(0))}, nil)
+
+		// 执行测试
+		result, err := uc.CreateUser(ctx, user)
+
+		// 断言
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, systemuser.ErrUserDeptInvalid))
+	})
+
+	t.Run("部门不存在", func(t *testing.T) {
+		user := &systemuser.SystemUser{
+			Account:  ptr.Of("deptuser"),
+			Password: ptr.Of("password123"),
+			DeptID:   ptr.Of("none"),
+		}
+
+		// Mock 期望
+		mockDeptRepo.EXPECT().
+			FindByID(ctx, "none").
+			Return(nil, nil)
+
+		// 执行测试
+		result, err := uc.CreateUser(ctx, user)
+
+		// 断言
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, systemuser.ErrUserDeptInvalid))
+	})
+
+	t.Run("部门有效", func(t *testing.T) {
+		user := &systemuser.SystemUser{
+			Account:  ptr.Of("deptuser"),
+			Password: ptr.Of("password123"),
+			DeptID:   ptr.Of("dept2"),
+		}
+
+		// Mock 期望
+		mockDeptRepo.EXPECT().
+			FindByID(ctx, "dept2").
+			Return(&systemdept.SystemDept{ID: ptr.Of("dept2"), Status: ptr.Of(int8(1))}, nil)
+		mockRepo.EXPECT().
+			FindByUsername(ctx, "deptuser").
+			Return(nil, nil)
+		mockRepo.EXPECT().
+			Save(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, u *systemuser.SystemUser) (*systemuser.SystemUser, error) {
+				return u, nil
+			})
+
+		// 执行测试
+		result, err := uc.CreateUser(ctx, user)
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Equal(t, "dept2", ptr.From(result.DeptID))
+	})
+
+	t.Run("分配岗位", func(t *testing.T) {
+		user := &systemuser.SystemUser{
+			Account:  ptr.Of("postuser"),
+			Password: ptr.Of("password123"),
+			PostIDs:  []string{"post1", "post2", "post1"},
+		}
+		posts := []*systempost.SystemPost{
+			{ID: ptr.Of("post1"), Status: ptr.Of(int8(1))},
+			{ID: ptr.Of("post2"), Status: ptr.Of(int8(1))},
+		}
+
+		// Mock 期望
+		mockPostRepo.EXPECT().
+			ListByIDs(ctx, []string{"post1", "post2"}).
+			Return(posts, nil)
+		mockRepo.EXPECT().
+			FindByUsername(ctx, "postuser").
+			Return(nil, nil)
+		mockRepo.EXPECT().
+			Save(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, u *systemuser.SystemUser) (*systemuser.SystemUser, error) {
+				u.ID = ptr.Of("user456")
+				return u, nil
+			})
+		mockPostRepo.EXPECT().
+			ReplaceUserPosts(ctx, "user456", []string{"post1", "post2"}).
+			Return(nil)
+
+		// 执行测试
+		result, err := uc.CreateUser(ctx, user)
+
+		// 断言
+		assert.NoError(t, err)
+		assert.Equal(t, posts, result.Posts)
+	})
+
+	t.Run("岗位已停用", func(t *testing.T) {
+		user := &systemuser.SystemUser{
+			Account:  ptr.Of("postuser"),
+			Password: ptr.Of("password123"),
+			PostIDs:  []string{"post3"},
+		}
+
+		// Mock 期望
+		mockPostRepo.EXPECT().
+			ListByIDs(ctx, []string{"post3"}).
+			Return([]*systempost.SystemPost{{ID: ptr.Of("post3"), Status: ptr.Of(int8(0))}}, nil)
+
+		// 执行测试
+		result, err := uc.CreateUser(ctx, user)
+
+		// 断言
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, systemuser.ErrUserPostInvalid))
+	})
+}
+
+func TestUserUsecase_CreateUser_AccountQuota(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemUserRepo(ctrl)
+	mockQuota := mocks.NewMockAccountQuota(ctrl)
+	uc := systemuser.NewUserUsecase(&fakeTx{}, mockRepo, deptmocks.NewMockSystemDeptRepo(ctrl), postmocks.NewMockSystemPostRepo(ctrl), mocks.NewMockUserRoleRepo(ctrl), mocks.NewMockUserMFARepo(ctrl), mockQuota, auth.NewMemoryRevocationStore(), log.DefaultLogger)
+	ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "admin1", TenantID: "tenant1"})
+
+	t.Run("租户账号数量已满", func(t *testing.T) {
+		quotaErr := errors.Forbidden("TENANT_ACCOUNT_LIMIT", "tenant account quota exceeded")
+		// Mock 期望
+		mockRepo.EXPECT().FindByUsername(ctx, "newuser").Return(nil, nil)
+		mockQuota.EXPECT().CheckAccountQuota(ctx, "tenant1").Return(quotaErr)
+
+		// 执行测试
+		result, err := uc.CreateUser(ctx, &systemuser.SystemUser{
+			Account:  ptr.Of("newuser"),
+			Password: ptr.Of("password123"),
+		})
+
+		// 断言
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, quotaErr))
+	})
+}
+
+func TestUserUsecase_ReplaceUserPosts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemUserRepo(ctrl)
+	mockPostRepo := postmocks.NewMockSystemPostRepo(ctrl)
+	uc := systemuser.NewUserUsecase(&fakeTx{}, mockRepo, deptmocks.NewMockSystemDeptRepo(ctrl), mockPostRepo, mocks.NewMockUserRoleRepo(ctrl), mocks.NewMockUserMFARepo(ctrl), mocks.NewMockAccountQuota(ctrl), auth.NewMemoryRevocationStore(), log.DefaultLogger)
+	ctx := context.Background()
+
+	t.Run("岗位不存在", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "user1").Return(&systemuser.SystemUser{ID: ptr.Of("user1")}, nil)
+		mockPostRepo.EXPECT().ListByIDs(ctx, []string{"none"}).Return(nil, nil)
+
+		// 执行测试
+		err := uc.ReplaceUserPosts(ctx, "user1", []string{"none"})
+
+		// 断言
+		assert.True(t, errors.Is(err, systemuser.ErrUserPostInvalid))
+	})
+
+	t.Run("清空岗位", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "user1").Return(&systemuser.SystemUser{ID: ptr.Of("user1")}, nil)
+		mockPostRepo.EXPECT().ReplaceUserPosts(ctx, "user1", gomock.Len(0)).Return(nil)
+
+		// 执行测试
+		err := uc.ReplaceUserPosts(ctx, "user1", nil)
+
+		// 断言
+		assert.NoError(t, err)
+	})
+
+	t.Run("用户不存在", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "none").Return(nil, nil)
+
+		// 执行测试
+		err := uc.ReplaceUserPosts(ctx, "none", []string{"post1"})
+
+		// 断言
+		assert.True(t, errors.Is(err, systemuser.ErrUserNotFound))
+	})
 }
 
 func TestUserUsecase_GetUser(t *testing.T) {
@@ -117,7 +361,8 @@ func TestUserUsecase_GetUser(t *testing.T) {
 
 	mockRepo := mocks.NewMockSystemUserRepo(ctrl)
 	logger := log.DefaultLogger
-	uc := systemuser.NewUserUsecase(mockRepo, logger)
+	mockPostRepo := postmocks.NewMockSystemPostRepo(ctrl)
+	uc := systemuser.NewUserUsecase(&fakeTx{}, mockRepo, deptmocks.NewMockSystemDeptRepo(ctrl), mockPostRepo, mocks.NewMockUserRoleRepo(ctrl), mocks.NewMockUserMFARepo(ctrl), mocks.NewMockAccountQuota(ctrl), auth.NewMemoryRevocationStore(), logger)
 
 	ctx := context.Background()
 
@@ -132,6 +377,11 @@ func TestUserUsecase_GetUser(t *testing.T) {
 		mockRepo.EXPECT().
 			FindByID(ctx, userID).
 			Return(expectedUser, nil)
+		mockPostRepo.EXPECT().
+			ListUserPosts(ctx, []string{userID}).
+			Return(map[string][]*systempost.SystemPost{
+				userID: {{ID: ptr.Of("post1"), Code: ptr.Of("ceo"), Name: ptr.Of("董事长")}},
+			}, nil)
 
 		// 执行测试
 		result, err := uc.GetUser(ctx, userID)
@@ -140,6 +390,8 @@ func TestUserUsecase_GetUser(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 		assert.Equal(t, userID, *result.ID)
+		assert.Len(t, result.Posts, 1)
+		assert.Equal(t, "ceo", ptr.From(result.Posts[0].Code))
 	})
 
 	t.Run("用户不存在", func(t *testing.T) {
@@ -166,7 +418,8 @@ func TestUserUsecase_BatchDeleteUsers(t *testing.T) {
 
 	mockRepo := mocks.NewMockSystemUserRepo(ctrl)
 	logger := log.DefaultLogger
-	uc := systemuser.NewUserUsecase(mockRepo, logger)
+	mockPostRepo := postmocks.NewMockSystemPostRepo(ctrl)
+	uc := systemuser.NewUserUsecase(&fakeTx{}, mockRepo, deptmocks.NewMockSystemDeptRepo(ctrl), mockPostRepo, mocks.NewMockUserRoleRepo(ctrl), mocks.NewMockUserMFARepo(ctrl), mocks.NewMockAccountQuota(ctrl), auth.NewMemoryRevocationStore(), logger)
 
 	ctx := context.Background()
 
@@ -208,7 +461,8 @@ func TestUserUsecase_CheckAccountExists(t *testing.T) {
 
 	mockRepo := mocks.NewMockSystemUserRepo(ctrl)
 	logger := log.DefaultLogger
-	uc := systemuser.NewUserUsecase(mockRepo, logger)
+	mockPostRepo := postmocks.NewMockSystemPostRepo(ctrl)
+	uc := systemuser.NewUserUsecase(&fakeTx{}, mockRepo, deptmocks.NewMockSystemDeptRepo(ctrl), mockPostRepo, mocks.NewMockUserRoleRepo(ctrl), mocks.NewMockUserMFARepo(ctrl), mocks.NewMockAccountQuota(ctrl), auth.NewMemoryRevocationStore(), logger)
 
 	ctx := context.Background()
 
@@ -260,3 +514,251 @@ func TestUserUsecase_CheckAccountExists(t *testing.T) {
 		assert.Contains(t, err.Error(), "用户名长度必须在3-50个字符之间")
 	})
 }
+
+func TestUserUsecase_RestoreUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemUserRepo(ctrl)
+	logger := log.DefaultLogger
+	mockPostRepo := postmocks.NewMockSystemPostRepo(ctrl)
+	mockQuota := mocks.NewMockAccountQuota(ctrl)
+	uc := systemuser.NewUserUsecase(&fakeTx{}, mockRepo, deptmocks.NewMockSystemDeptRepo(ctrl), mockPostRepo, mocks.NewMockUserRoleRepo(ctrl), mocks.NewMockUserMFARepo(ctrl), mockQuota, auth.NewMemoryRevocationStore(), logger)
+
+	ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "admin1", TenantID: "tenant1"})
+
+	t.Run("成功恢复用户", func(t *testing.T) {
+		// Mock 期望
+		mockQuota.EXPECT().CheckAccountQuota(ctx, "tenant1").Return(nil)
+		mockRepo.EXPECT().
+			Restore(ctx, "user123").
+			Return(nil)
+
+		// 执行测试
+		err := uc.RestoreUser(ctx, "user123")
+
+		// 断言
+		assert.NoError(t, err)
+	})
+
+	t.Run("用户未被删除", func(t *testing.T) {
+		// Mock 期望
+		mockQuota.EXPECT().CheckAccountQuota(ctx, "tenant1").Return(nil)
+		mockRepo.EXPECT().
+			Restore(ctx, "user456").
+			Return(systemuser.ErrUserNotFound)
+
+		// 执行测试
+		err := uc.RestoreUser(ctx, "user456")
+
+		// 断言
+		assert.True(t, errors.Is(err, systemuser.ErrUserNotFound))
+	})
+
+	t.Run("用户ID为空", func(t *testing.T) {
+		// 执行测试
+		err := uc.RestoreUser(ctx, "")
+
+		// 断言
+		assert.True(t, errors.IsBadRequest(err))
+	})
+
+	t.Run("租户账号数量已满", func(t *testing.T) {
+		quotaErr := errors.Forbidden("TENANT_ACCOUNT_LIMIT", "tenant account quota exceeded")
+		// Mock 期望
+		mockQuota.EXPECT().CheckAccountQuota(ctx, "tenant1").Return(quotaErr)
+
+		// 执行测试
+		err := uc.RestoreUser(ctx, "user789")
+
+		// 断言
+		assert.True(t, errors.Is(err, quotaErr))
+	})
+}
+
+func TestUserUsecase_PurgeUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSystemUserRepo(ctrl)
+	logger := log.DefaultLogger
+	mockPostRepo := postmocks.NewMockSystemPostRepo(ctrl)
+	mockRoleRepo := mocks.NewMockUserRoleRepo(ctrl)
+	mockMFARepo := mocks.NewMockUserMFARepo(ctrl)
+	uc := systemuser.NewUserUsecase(&fakeTx{}, mockRepo, deptmocks.NewMockSystemDeptRepo(ctrl), mockPostRepo, mockRoleRepo, mockMFARepo, mocks.NewMockAccountQuota(ctrl), auth.NewMemoryRevocationStore(), logger)
+
+	ctx := context.Background()
+
+	t.Run("成功彻底删除用户", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().
+			Purge(ctx, "user123").
+			Return(nil)
+		mockPostRepo.EXPECT().
+			ReplaceUserPosts(ctx, "user123", nil).
+			Return(nil)
+		mockRoleRepo.EXPECT().
+			RemoveUserRoles(ctx, "user123").
+			Return(nil)
+		mockMFARepo.EXPECT().
+			DeleteByUserID(ctx, "user123").
+			Return(nil)
+
+		// 执行测试
+		err := uc.PurgeUser(ctx, "user123")
+
+		// 断言
+		assert.NoError(t, err)
+	})
+
+	t.Run("用户不存在时不清除关联数据", func(t *testing.T) {
+		// Mock 期望
+		mockRepo.EXPECT().
+			Purge(ctx, "user404").
+			Return(systemuser.ErrUserNotFound)
+
+		// 执行测试
+		err := uc.PurgeUser(ctx, "user404")
+
+		// 断言
+		assert.True(t, errors.IsNotFound(err))
+	})
+
+	t.Run("用户ID为空", func(t *testing.T) {
+		// 执行测试
+		err := uc.PurgeUser(ctx, "")
+
+		// 断言
+		assert.True(t, errors.IsBadRequest(err))
+	})
+}
+
+func TestUserUsecase_RevokeSessions(t *testing.T) {
+	ctx := context.Background()
+	existing := &systemuser.SystemUser{ID: ptr.Of("user1"), Account: ptr.Of("alice"), Status: ptr.Of(int8(1))}
+
+	tests := []struct {
+		name        string
+		expect      func(repo *mocks.MockSystemUserRepo)
+		call        func(uc systemuser.UserUsecase) error
+		wantRevoked bool
+	}{
+		{
+			name: "停用用户",
+			expect: func(repo *mocks.MockSystemUserRepo) {
+				repo.EXPECT().FindByID(ctx, "user1").Return(existing, nil)
+				repo.EXPECT().ChangeStatus(ctx, "user1", int8(0)).Return(nil)
+			},
+			call:        func(uc systemuser.UserUsecase) error { return uc.ChangeUserStatus(ctx, "user1", 0) },
+			wantRevoked: true,
+		},
+		{
+			name: "启用用户",
+			expect: func(repo *mocks.MockSystemUserRepo) {
+				repo.EXPECT().FindByID(ctx, "user1").Return(existing, nil)
+				repo.EXPECT().ChangeStatus(ctx, "user1", int8(1)).Return(nil)
+			},
+			call:        func(uc systemuser.UserUsecase) error { return uc.ChangeUserStatus(ctx, "user1", 1) },
+			wantRevoked: false,
+		},
+		{
+			name: "修改用户时停用",
+			expect: func(repo *mocks.MockSystemUserRepo) {
+				repo.EXPECT().FindByID(ctx, "user1").Return(existing, nil)
+				repo.EXPECT().Update(ctx, gomock.Any()).Return(existing, nil)
+			},
+			call: func(uc systemuser.UserUsecase) error {
+				_, err := uc.UpdateUser(ctx, &systemuser.SystemUser{ID: ptr.Of("user1"), Status: ptr.Of(int8(0))})
+				return err
+			},
+			wantRevoked: true,
+		},
+		{
+			name: "修改用户时不修改状态",
+			expect: func(repo *mocks.MockSystemUserRepo) {
+				repo.EXPECT().FindByID(ctx, "user1").Return(existing, nil)
+				repo.EXPECT().Update(ctx, gomock.Any()).Return(existing, nil)
+			},
+			call: func(uc systemuser.UserUsecase) error {
+				_, err := uc.UpdateUser(ctx, &systemuser.SystemUser{ID: ptr.Of("user1"), Nickname: ptr.Of("Alice")})
+				return err
+			},
+			wantRevoked: false,
+		},
+		{
+			name: "重置密码",
+			expect: func(repo *mocks.MockSystemUserRepo) {
+				repo.EXPECT().FindByID(ctx, "user1").Return(existing, nil)
+				repo.EXPECT().Update(ctx, gomock.Any()).Return(existing, nil)
+			},
+			call:        func(uc systemuser.UserUsecase) error { return uc.ResetPassword(ctx, "user1", "newPassword123") },
+			wantRevoked: true,
+		},
+		{
+			name: "删除用户",
+			expect: func(repo *mocks.MockSystemUserRepo) {
+				repo.EXPECT().Delete(ctx, "user1").Return(nil)
+			},
+			call:        func(uc systemuser.UserUsecase) error { return uc.DeleteUser(ctx, "user1") },
+			wantRevoked: true,
+		},
+		{
+			name: "批量删除时跳过删除失败的用户",
+			expect: func(repo *mocks.MockSystemUserRepo) {
+				repo.EXPECT().BatchDelete(ctx, []string{"user1", "user2"}).Return(int32(1), int32(1), []string{"user1"}, nil)
+			},
+			call: func(uc systemuser.UserUsecase) error {
+				_, err := uc.BatchDeleteUsers(ctx, []string{"user1", "user2"})
+				return err
+			},
+			wantRevoked: false,
+		},
+		{
+			name: "强制下线",
+			expect: func(repo *mocks.MockSystemUserRepo) {
+				repo.EXPECT().FindByID(ctx, "user1").Return(existing, nil)
+			},
+			call:        func(uc systemuser.UserUsecase) error { return uc.KickUser(ctx, "user1") },
+			wantRevoked: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mocks.NewMockSystemUserRepo(ctrl)
+			mockPostRepo := postmocks.NewMockSystemPostRepo(ctrl)
+			mockPostRepo.EXPECT().ListUserPosts(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+			store := auth.NewMemoryRevocationStore()
+			uc := systemuser.NewUserUsecase(&fakeTx{}, mockRepo, deptmocks.NewMockSystemDeptRepo(ctrl), mockPostRepo, mocks.NewMockUserRoleRepo(ctrl), mocks.NewMockUserMFARepo(ctrl), mocks.NewMockAccountQuota(ctrl), store, log.DefaultLogger)
+			assert.NoError(t, store.Track(ctx, "user1", "token1", time.Now().Add(time.Hour)))
+
+			// Mock 期望
+			tt.expect(mockRepo)
+
+			// 执行测试
+			err := tt.call(uc)
+
+			// 断言
+			assert.NoError(t, err)
+			revoked, err := store.IsRevoked(ctx, "token1")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantRevoked, revoked)
+		})
+	}
+
+	t.Run("强制下线不存在的用户", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockSystemUserRepo(ctrl)
+		uc := systemuser.NewUserUsecase(&fakeTx{}, mockRepo, deptmocks.NewMockSystemDeptRepo(ctrl), postmocks.NewMockSystemPostRepo(ctrl), mocks.NewMockUserRoleRepo(ctrl), mocks.NewMockUserMFARepo(ctrl), mocks.NewMockAccountQuota(ctrl), auth.NewMemoryRevocationStore(), log.DefaultLogger)
+
+		// Mock 期望
+		mockRepo.EXPECT().FindByID(ctx, "missing").Return(nil, nil)
+
+		// 执行测试
+		err := uc.KickUser(ctx, "missing")
+
+		// 断言
+		assert.True(t, errors.Is(err, systemuser.ErrUserNotFound))
+	})
+}
@@ -0,0 +1,11 @@
+package tx
+
+import "context"
+
+// Transaction runs a function within a database transaction.
+// 嵌套调用时复用外层事务
+//
+// 定义在独立的包中，使 biz 的子包可以依赖它而不产生循环引用
+type Transaction interface {
+	InTx(context.Context, func(ctx context.Context) error) error
+}
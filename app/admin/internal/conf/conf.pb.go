@@ -29,6 +29,10 @@ type Bootstrap struct {
 	Log           *Log                   `protobuf:"bytes,4,opt,name=log,proto3" json:"log,omitempty"`
 	Snowflake     *Snowflake             `protobuf:"bytes,5,opt,name=snowflake,proto3" json:"snowflake,omitempty"`
 	Jwt           *Jwt                   `protobuf:"bytes,6,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Casbin        *Casbin                `protobuf:"bytes,7,opt,name=casbin,proto3" json:"casbin,omitempty"`
+	LoginLog      *LoginLog              `protobuf:"bytes,8,opt,name=login_log,json=loginLog,proto3" json:"login_log,omitempty"`
+	Lockout       *Lockout               `protobuf:"bytes,9,opt,name=lockout,proto3" json:"lockout,omitempty"`
+	Mfa           *Mfa                   `protobuf:"bytes,10,opt,name=mfa,proto3" json:"mfa,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -105,6 +109,34 @@ func (x *Bootstrap) GetJwt() *Jwt {
 	return nil
 }
 
+func (x *Bootstrap) GetCasbin() *Casbin {
+	if x != nil {
+		return x.Casbin
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetLoginLog() *LoginLog {
+	if x != nil {
+		return x.LoginLog
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetLockout() *Lockout {
+	if x != nil {
+		return x.Lockout
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetMfa() *Mfa {
+	if x != nil {
+		return x.Mfa
+	}
+	return nil
+}
+
 type Env struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Active        string                 `protobuf:"bytes,1,opt,name=active,proto3" json:"active,omitempty"`
@@ -347,6 +379,7 @@ type Snowflake struct {
 	LockKey           string                 `protobuf:"bytes,6,opt,name=LockKey,proto3" json:"LockKey,omitempty"`
 	WorkerIdKey       string                 `protobuf:"bytes,7,opt,name=WorkerIdKey,proto3" json:"WorkerIdKey,omitempty"`
 	WorkerIdIndexKey  string                 `protobuf:"bytes,8,opt,name=WorkerIdIndexKey,proto3" json:"WorkerIdIndexKey,omitempty"`
+	Generator         string                 `protobuf:"bytes,9,opt,name=Generator,proto3" json:"Generator,omitempty"` // 实体ID生成器：snowflake（默认，十进制字符串）、ulid、uuidv7
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -437,6 +470,13 @@ func (x *Snowflake) GetWorkerIdIndexKey() string {
 	return ""
 }
 
+func (x *Snowflake) GetGenerator() string {
+	if x != nil {
+		return x.Generator
+	}
+	return ""
+}
+
 type Jwt struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	System        *Jwt_Param             `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
@@ -489,6 +529,246 @@ func (x *Jwt) GetClient() *Jwt_Param {
 	return nil
 }
 
+type Casbin struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Enabled          bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                                             // 是否启用 casbin 策略引擎
+	ModelPath        string                 `protobuf:"bytes,2,opt,name=model_path,json=modelPath,proto3" json:"model_path,omitempty"`                         // 模型文件路径，为空时使用内置的 RBAC with domains 模型
+	AutoLoadInterval int32                  `protobuf:"varint,3,opt,name=auto_load_interval,json=autoLoadInterval,proto3" json:"auto_load_interval,omitempty"` // 定时从数据库重新加载策略的间隔（秒），多实例部署时使用，0 表示不自动加载
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Casbin) Reset() {
+	*x = Casbin{}
+	mi := &file_conf_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Casbin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Casbin) ProtoMessage() {}
+
+func (x *Casbin) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Casbin.ProtoReflect.Descriptor instead.
+func (*Casbin) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Casbin) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Casbin) GetModelPath() string {
+	if x != nil {
+		return x.ModelPath
+	}
+	return ""
+}
+
+func (x *Casbin) GetAutoLoadInterval() int32 {
+	if x != nil {
+		return x.AutoLoadInterval
+	}
+	return 0
+}
+
+type LoginLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RetentionDays int32                  `protobuf:"varint,1,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"` // 登录日志保留天数，0 表示使用默认值 180 天
+	PurgeInterval int32                  `protobuf:"varint,2,opt,name=purge_interval,json=purgeInterval,proto3" json:"purge_interval,omitempty"` // 清理过期登录日志的间隔（秒），0 表示使用默认值 1 小时
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginLog) Reset() {
+	*x = LoginLog{}
+	mi := &file_conf_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginLog) ProtoMessage() {}
+
+func (x *LoginLog) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginLog.ProtoReflect.Descriptor instead.
+func (*LoginLog) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LoginLog) GetRetentionDays() int32 {
+	if x != nil {
+		return x.RetentionDays
+	}
+	return 0
+}
+
+func (x *LoginLog) GetPurgeInterval() int32 {
+	if x != nil {
+		return x.PurgeInterval
+	}
+	return 0
+}
+
+type Lockout struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AccountThreshold int32                  `protobuf:"varint,1,opt,name=account_threshold,json=accountThreshold,proto3" json:"account_threshold,omitempty"` // 账号连续登录失败多少次后锁定，0 表示使用默认值 5
+	IpThreshold      int32                  `protobuf:"varint,2,opt,name=ip_threshold,json=ipThreshold,proto3" json:"ip_threshold,omitempty"`                // 同一IP登录失败多少次后锁定，0 表示使用默认值 20
+	Window           int32                  `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`                                             // 失败次数的统计窗口（秒），0 表示使用默认值 15 分钟
+	LockDuration     int32                  `protobuf:"varint,4,opt,name=lock_duration,json=lockDuration,proto3" json:"lock_duration,omitempty"`             // 首次锁定时长（秒），之后每次锁定时长翻倍，0 表示使用默认值 5 分钟
+	MaxLockDuration  int32                  `protobuf:"varint,5,opt,name=max_lock_duration,json=maxLockDuration,proto3" json:"max_lock_duration,omitempty"`  // 最长锁定时长（秒），0 表示使用默认值 24 小时
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Lockout) Reset() {
+	*x = Lockout{}
+	mi := &file_conf_conf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lockout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lockout) ProtoMessage() {}
+
+func (x *Lockout) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lockout.ProtoReflect.Descriptor instead.
+func (*Lockout) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Lockout) GetAccountThreshold() int32 {
+	if x != nil {
+		return x.AccountThreshold
+	}
+	return 0
+}
+
+func (x *Lockout) GetIpThreshold() int32 {
+	if x != nil {
+		return x.IpThreshold
+	}
+	return 0
+}
+
+func (x *Lockout) GetWindow() int32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *Lockout) GetLockDuration() int32 {
+	if x != nil {
+		return x.LockDuration
+	}
+	return 0
+}
+
+func (x *Lockout) GetMaxLockDuration() int32 {
+	if x != nil {
+		return x.MaxLockDuration
+	}
+	return 0
+}
+
+type Mfa struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issuer        string                 `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`                               // 验证器应用中显示的签发方，为空时使用默认值 qn-base
+	TokenExpire   int32                  `protobuf:"varint,2,opt,name=token_expire,json=tokenExpire,proto3" json:"token_expire,omitempty"` // 登录第二步的待验证令牌有效期（秒），0 表示使用默认值 5 分钟
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mfa) Reset() {
+	*x = Mfa{}
+	mi := &file_conf_conf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mfa) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mfa) ProtoMessage() {}
+
+func (x *Mfa) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mfa.ProtoReflect.Descriptor instead.
+func (*Mfa) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Mfa) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *Mfa) GetTokenExpire() int32 {
+	if x != nil {
+		return x.TokenExpire
+	}
+	return 0
+}
+
 type Server_HTTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -500,7 +780,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -512,7 +792,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -560,7 +840,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -572,7 +852,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -622,7 +902,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_conf_conf_proto_msgTypes[9]
+	mi := &file_conf_conf_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -634,7 +914,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[9]
+	mi := &file_conf_conf_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -686,22 +966,24 @@ func (x *Data_Database) GetConnMaxLifetime() int32 {
 }
 
 type Data_Redis struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	ReadTimeout   int32                  `protobuf:"varint,3,opt,name=read_timeout,json=readTimeout,proto3" json:"read_timeout,omitempty"`
-	WriteTimeout  int32                  `protobuf:"varint,4,opt,name=write_timeout,json=writeTimeout,proto3" json:"write_timeout,omitempty"`
-	Password      string                 `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
-	Db            int32                  `protobuf:"varint,6,opt,name=db,proto3" json:"db,omitempty"`
-	PoolSize      int32                  `protobuf:"varint,7,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
-	MinIdleConns  int32                  `protobuf:"varint,8,opt,name=min_idle_conns,json=minIdleConns,proto3" json:"min_idle_conns,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Network      string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr         string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	ReadTimeout  int32                  `protobuf:"varint,3,opt,name=read_timeout,json=readTimeout,proto3" json:"read_timeout,omitempty"`
+	WriteTimeout int32                  `protobuf:"varint,4,opt,name=write_timeout,json=writeTimeout,proto3" json:"write_timeout,omitempty"`
+	Password     string                 `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	Db           int32                  `protobuf:"varint,6,opt,name=db,proto3" json:"db,omitempty"`
+	PoolSize     int32                  `protobuf:"varint,7,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
+	MinIdleConns int32                  `protobuf:"varint,8,opt,name=min_idle_conns,json=minIdleConns,proto3" json:"min_idle_conns,omitempty"`
+	// 键前缀，多个服务共用一个 Redis 时避免键冲突
+	KeyPrefix     string `protobuf:"bytes,9,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_conf_conf_proto_msgTypes[10]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -713,7 +995,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[10]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -785,17 +1067,25 @@ func (x *Data_Redis) GetMinIdleConns() int32 {
 	return 0
 }
 
+func (x *Data_Redis) GetKeyPrefix() string {
+	if x != nil {
+		return x.KeyPrefix
+	}
+	return ""
+}
+
 type Jwt_Param struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
-	Expire        int32                  `protobuf:"varint,2,opt,name=expire,proto3" json:"expire,omitempty"`
+	Expire        int32                  `protobuf:"varint,2,opt,name=expire,proto3" json:"expire,omitempty"`                                    // 访问令牌有效期（秒）
+	RefreshExpire int32                  `protobuf:"varint,3,opt,name=refresh_expire,json=refreshExpire,proto3" json:"refresh_expire,omitempty"` // 刷新令牌有效期（秒）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Jwt_Param) Reset() {
 	*x = Jwt_Param{}
-	mi := &file_conf_conf_proto_msgTypes[11]
+	mi := &file_conf_conf_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -807,7 +1097,7 @@ func (x *Jwt_Param) String() string {
 func (*Jwt_Param) ProtoMessage() {}
 
 func (x *Jwt_Param) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[11]
+	mi := &file_conf_conf_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -837,19 +1127,31 @@ func (x *Jwt_Param) GetExpire() int32 {
 	return 0
 }
 
+func (x *Jwt_Param) GetRefreshExpire() int32 {
+	if x != nil {
+		return x.RefreshExpire
+	}
+	return 0
+}
+
 var File_conf_conf_proto protoreflect.FileDescriptor
 
 const file_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"\x0fconf/conf.proto\x12\n" +
-	"kratos.api\"\xfb\x01\n" +
+	"kratos.api\"\xac\x03\n" +
 	"\tBootstrap\x12!\n" +
 	"\x03env\x18\x01 \x01(\v2\x0f.kratos.api.EnvR\x03env\x12*\n" +
 	"\x06server\x18\x02 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
 	"\x04data\x18\x03 \x01(\v2\x10.kratos.api.DataR\x04data\x12!\n" +
 	"\x03log\x18\x04 \x01(\v2\x0f.kratos.api.LogR\x03log\x123\n" +
 	"\tsnowflake\x18\x05 \x01(\v2\x15.kratos.api.SnowflakeR\tsnowflake\x12!\n" +
-	"\x03jwt\x18\x06 \x01(\v2\x0f.kratos.api.JwtR\x03jwt\"\x1d\n" +
+	"\x03jwt\x18\x06 \x01(\v2\x0f.kratos.api.JwtR\x03jwt\x12*\n" +
+	"\x06casbin\x18\a \x01(\v2\x12.kratos.api.CasbinR\x06casbin\x121\n" +
+	"\tlogin_log\x18\b \x01(\v2\x14.kratos.api.LoginLogR\bloginLog\x12-\n" +
+	"\alockout\x18\t \x01(\v2\x13.kratos.api.LockoutR\alockout\x12!\n" +
+	"\x03mfa\x18\n" +
+	" \x01(\v2\x0f.kratos.api.MfaR\x03mfa\"\x1d\n" +
 	"\x03Env\x12\x16\n" +
 	"\x06active\x18\x01 \x01(\tR\x06active\"\x82\x02\n" +
 	"\x06Server\x12+\n" +
@@ -862,7 +1164,7 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x12\x18\n" +
-	"\atimeout\x18\x03 \x01(\x05R\atimeout\"\x8f\x04\n" +
+	"\atimeout\x18\x03 \x01(\x05R\atimeout\"\xae\x04\n" +
 	"\x04Data\x125\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
 	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x1a\xb2\x01\n" +
@@ -871,7 +1173,7 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x12$\n" +
 	"\x0emax_idle_conns\x18\x05 \x01(\x05R\fmaxIdleConns\x12$\n" +
 	"\x0emax_open_conns\x18\x06 \x01(\x05R\fmaxOpenConns\x12*\n" +
-	"\x11conn_max_lifetime\x18\a \x01(\x05R\x0fconnMaxLifetime\x1a\xec\x01\n" +
+	"\x11conn_max_lifetime\x18\a \x01(\x05R\x0fconnMaxLifetime\x1a\x8b\x02\n" +
 	"\x05Redis\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x12!\n" +
@@ -880,7 +1182,9 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\bpassword\x18\x05 \x01(\tR\bpassword\x12\x0e\n" +
 	"\x02db\x18\x06 \x01(\x05R\x02db\x12\x1b\n" +
 	"\tpool_size\x18\a \x01(\x05R\bpoolSize\x12$\n" +
-	"\x0emin_idle_conns\x18\b \x01(\x05R\fminIdleConns\"\xa1\x01\n" +
+	"\x0emin_idle_conns\x18\b \x01(\x05R\fminIdleConns\x12\x1d\n" +
+	"\n" +
+	"key_prefix\x18\t \x01(\tR\tkeyPrefix\"\xa1\x01\n" +
 	"\x03Log\x12\x14\n" +
 	"\x05level\x18\x01 \x01(\tR\x05level\x12\x1a\n" +
 	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x18\n" +
@@ -889,7 +1193,7 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"maxBackups\x18\x05 \x01(\x05R\n" +
 	"maxBackups\x12\x16\n" +
-	"\x06stdout\x18\x06 \x01(\bR\x06stdout\"\xa9\x02\n" +
+	"\x06stdout\x18\x06 \x01(\bR\x06stdout\"\xc7\x02\n" +
 	"\tSnowflake\x12\x1a\n" +
 	"\bBaseTime\x18\x01 \x01(\x03R\bBaseTime\x12,\n" +
 	"\x11WorkerIdBitLength\x18\x02 \x01(\x05R\x11WorkerIdBitLength\x12\"\n" +
@@ -898,13 +1202,32 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\fMinSeqNumber\x18\x05 \x01(\x05R\fMinSeqNumber\x12\x18\n" +
 	"\aLockKey\x18\x06 \x01(\tR\aLockKey\x12 \n" +
 	"\vWorkerIdKey\x18\a \x01(\tR\vWorkerIdKey\x12*\n" +
-	"\x10WorkerIdIndexKey\x18\b \x01(\tR\x10WorkerIdIndexKey\"\x9c\x01\n" +
+	"\x10WorkerIdIndexKey\x18\b \x01(\tR\x10WorkerIdIndexKey\x12\x1c\n" +
+	"\tGenerator\x18\t \x01(\tR\tGenerator\"\xc3\x01\n" +
 	"\x03Jwt\x12-\n" +
 	"\x06system\x18\x01 \x01(\v2\x15.kratos.api.Jwt.ParamR\x06system\x12-\n" +
-	"\x06client\x18\x02 \x01(\v2\x15.kratos.api.Jwt.ParamR\x06client\x1a7\n" +
+	"\x06client\x18\x02 \x01(\v2\x15.kratos.api.Jwt.ParamR\x06client\x1a^\n" +
 	"\x05Param\x12\x16\n" +
 	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x16\n" +
-	"\x06expire\x18\x02 \x01(\x05R\x06expireB\x18Z\x16kva/internal/conf;confb\x06proto3"
+	"\x06expire\x18\x02 \x01(\x05R\x06expire\x12%\n" +
+	"\x0erefresh_expire\x18\x03 \x01(\x05R\rrefreshExpire\"o\n" +
+	"\x06Casbin\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1d\n" +
+	"\n" +
+	"model_path\x18\x02 \x01(\tR\tmodelPath\x12,\n" +
+	"\x12auto_load_interval\x18\x03 \x01(\x05R\x10autoLoadInterval\"X\n" +
+	"\bLoginLog\x12%\n" +
+	"\x0eretention_days\x18\x01 \x01(\x05R\rretentionDays\x12%\n" +
+	"\x0epurge_interval\x18\x02 \x01(\x05R\rpurgeInterval\"\xc2\x01\n" +
+	"\aLockout\x12+\n" +
+	"\x11account_threshold\x18\x01 \x01(\x05R\x10accountThreshold\x12!\n" +
+	"\fip_threshold\x18\x02 \x01(\x05R\vipThreshold\x12\x16\n" +
+	"\x06window\x18\x03 \x01(\x05R\x06window\x12#\n" +
+	"\rlock_duration\x18\x04 \x01(\x05R\flockDuration\x12*\n" +
+	"\x11max_lock_duration\x18\x05 \x01(\x05R\x0fmaxLockDuration\"@\n" +
+	"\x03Mfa\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x12!\n" +
+	"\ftoken_expire\x18\x02 \x01(\x05R\vtokenExpireB\x18Z\x16kva/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_conf_proto_rawDescOnce sync.Once
@@ -918,7 +1241,7 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_conf_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),     // 0: kratos.api.Bootstrap
 	(*Env)(nil),           // 1: kratos.api.Env
@@ -927,11 +1250,15 @@ var file_conf_conf_proto_goTypes = []any{
 	(*Log)(nil),           // 4: kratos.api.Log
 	(*Snowflake)(nil),     // 5: kratos.api.Snowflake
 	(*Jwt)(nil),           // 6: kratos.api.Jwt
-	(*Server_HTTP)(nil),   // 7: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),   // 8: kratos.api.Server.GRPC
-	(*Data_Database)(nil), // 9: kratos.api.Data.Database
-	(*Data_Redis)(nil),    // 10: kratos.api.Data.Redis
-	(*Jwt_Param)(nil),     // 11: kratos.api.Jwt.Param
+	(*Casbin)(nil),        // 7: kratos.api.Casbin
+	(*LoginLog)(nil),      // 8: kratos.api.LoginLog
+	(*Lockout)(nil),       // 9: kratos.api.Lockout
+	(*Mfa)(nil),           // 10: kratos.api.Mfa
+	(*Server_HTTP)(nil),   // 11: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),   // 12: kratos.api.Server.GRPC
+	(*Data_Database)(nil), // 13: kratos.api.Data.Database
+	(*Data_Redis)(nil),    // 14: kratos.api.Data.Redis
+	(*Jwt_Param)(nil),     // 15: kratos.api.Jwt.Param
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.env:type_name -> kratos.api.Env
@@ -940,17 +1267,21 @@ var file_conf_conf_proto_depIdxs = []int32{
 	4,  // 3: kratos.api.Bootstrap.log:type_name -> kratos.api.Log
 	5,  // 4: kratos.api.Bootstrap.snowflake:type_name -> kratos.api.Snowflake
 	6,  // 5: kratos.api.Bootstrap.jwt:type_name -> kratos.api.Jwt
-	7,  // 6: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	8,  // 7: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	9,  // 8: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	10, // 9: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	11, // 10: kratos.api.Jwt.system:type_name -> kratos.api.Jwt.Param
-	11, // 11: kratos.api.Jwt.client:type_name -> kratos.api.Jwt.Param
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	7,  // 6: kratos.api.Bootstrap.casbin:type_name -> kratos.api.Casbin
+	8,  // 7: kratos.api.Bootstrap.login_log:type_name -> kratos.api.LoginLog
+	9,  // 8: kratos.api.Bootstrap.lockout:type_name -> kratos.api.Lockout
+	10, // 9: kratos.api.Bootstrap.mfa:type_name -> kratos.api.Mfa
+	11, // 10: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	12, // 11: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	13, // 12: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	14, // 13: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	15, // 14: kratos.api.Jwt.system:type_name -> kratos.api.Jwt.Param
+	15, // 15: kratos.api.Jwt.client:type_name -> kratos.api.Jwt.Param
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -964,7 +1295,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	bizauth "qn-base/app/admin/internal/biz/auth"
+	"qn-base/app/admin/internal/data/rdb"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// lockoutLevelTTL 锁定级别至少保留的时长，期间再次锁定的时长翻倍
+const lockoutLevelTTL = 24 * time.Hour
+
+var _ bizauth.LockoutRepo = (*lockoutRepo)(nil)
+
+// failScript 累计失败次数，达到阈值时按锁定级别加锁并清空失败次数，返回锁定时长（毫秒）
+//
+// KEYS[1] 失败次数，KEYS[2] 锁定级别，KEYS[3] 锁定标记
+// ARGV[1] 阈值，ARGV[2] 统计窗口，ARGV[3] 首次锁定时长，ARGV[4] 最长锁定时长，ARGV[5] 锁定级别保留时长（毫秒）
+var failScript = redis.NewScript(`
+local n = redis.call("INCR", KEYS[1])
+if n == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+if n < tonumber(ARGV[1]) then
+	return 0
+end
+local level = redis.call("INCR", KEYS[2])
+local d = math.min(tonumber(ARGV[3]) * 2 ^ (level - 1), tonumber(ARGV[4]))
+d = math.floor(d)
+redis.call("PEXPIRE", KEYS[2], math.max(tonumber(ARGV[5]), d * 2))
+redis.call("SET", KEYS[3], "1", "PX", d)
+redis.call("DEL", KEYS[1])
+return d`)
+
+// lockoutRepo is the LockoutRepo kept in redis, shared by all the instances.
+//
+// 失败次数、锁定级别和锁定标记分别保存在 auth:lockout:{key}:fail、:level 和 :lock
+type lockoutRepo struct {
+	client *rdb.Client
+}
+
+// NewLockoutRepo creates the LockoutRepo kept in redis.
+func NewLockoutRepo(client *rdb.Client) bizauth.LockoutRepo {
+	return &lockoutRepo{client: client}
+}
+
+// LockedFor returns the remaining lock time of the key.
+func (r *lockoutRepo) LockedFor(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := r.client.PTTL(ctx, r.key(key, "lock")).Result()
+	if err != nil {
+		return 0, err
+	}
+	// 键不存在时返回负数
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+// Fail records a login failure of the key.
+func (r *lockoutRepo) Fail(ctx context.Context, key string, policy bizauth.LockoutPolicy) (time.Duration, error) {
+	ms, err := failScript.Run(ctx, r.client,
+		[]string{r.key(key, "fail"), r.key(key, "level"), r.key(key, "lock")},
+		policy.Threshold, policy.Window.Milliseconds(), policy.LockDuration.Milliseconds(),
+		policy.MaxLockDuration.Milliseconds(), lockoutLevelTTL.Milliseconds(),
+	).Int64()
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(ms) * time.Millisecond, nil
+}
+
+// Reset clears the failures, the lock and the backoff of the key.
+func (r *lockoutRepo) Reset(ctx context.Context, key string) error {
+	return r.client.Del(ctx, r.key(key, "fail"), r.key(key, "level"), r.key(key, "lock")).Err()
+}
+
+// key returns the redis key of the part of the lockout.
+func (r *lockoutRepo) key(key, part string) string {
+	return r.client.Key("auth", "lockout", key, part)
+}
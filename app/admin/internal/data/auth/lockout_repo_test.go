@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	bizauth "qn-base/app/admin/internal/biz/auth"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockoutRepo(t *testing.T) {
+	ctx := context.Background()
+	policy := bizauth.LockoutPolicy{
+		Threshold:       3,
+		Window:          time.Minute,
+		LockDuration:    time.Minute,
+		MaxLockDuration: 3 * time.Minute,
+	}
+
+	newRepo := func(t *testing.T) *lockoutRepo {
+		store, _ := newTestStore(t)
+		return NewLockoutRepo(store.client).(*lockoutRepo)
+	}
+
+	// fail records failures until the key gets locked, and returns the lock time.
+	fail := func(t *testing.T, repo *lockoutRepo) time.Duration {
+		for i := 1; i < policy.Threshold; i++ {
+			d, err := repo.Fail(ctx, "account:t1:alice", policy)
+			require.NoError(t, err)
+			require.Zero(t, d)
+		}
+		d, err := repo.Fail(ctx, "account:t1:alice", policy)
+		require.NoError(t, err)
+		return d
+	}
+
+	t.Run("达到阈值后锁定", func(t *testing.T) {
+		repo := newRepo(t)
+
+		// 执行测试
+		d := fail(t, repo)
+
+		// 断言
+		assert.Equal(t, time.Minute, d)
+		remaining, err := repo.LockedFor(ctx, "account:t1:alice")
+		require.NoError(t, err)
+		assert.InDelta(t, time.Minute, remaining, float64(time.Second))
+		remaining, err = repo.LockedFor(ctx, "ip:127.0.0.1")
+		require.NoError(t, err)
+		assert.Zero(t, remaining)
+	})
+
+	t.Run("再次锁定时长翻倍且不超过上限", func(t *testing.T) {
+		repo := newRepo(t)
+
+		// 执行测试 & 断言
+		assert.Equal(t, time.Minute, fail(t, repo))
+		assert.Equal(t, 2*time.Minute, fail(t, repo))
+		assert.Equal(t, 3*time.Minute, fail(t, repo))
+	})
+
+	t.Run("统计窗口过期后重新计数", func(t *testing.T) {
+		store, mr := newTestStore(t)
+		repo := NewLockoutRepo(store.client).(*lockoutRepo)
+
+		// 执行测试
+		for i := 1; i < policy.Threshold; i++ {
+			_, err := repo.Fail(ctx, "ip:127.0.0.1", policy)
+			require.NoError(t, err)
+		}
+		mr.FastForward(policy.Window)
+		d, err := repo.Fail(ctx, "ip:127.0.0.1", policy)
+
+		// 断言
+		require.NoError(t, err)
+		assert.Zero(t, d)
+	})
+
+	t.Run("解锁后清除锁定级别", func(t *testing.T) {
+		repo := newRepo(t)
+		fail(t, repo)
+
+		// 执行测试
+		require.NoError(t, repo.Reset(ctx, "account:t1:alice"))
+
+		// 断言
+		remaining, err := repo.LockedFor(ctx, "account:t1:alice")
+		require.NoError(t, err)
+		assert.Zero(t, remaining)
+		assert.Equal(t, time.Minute, fail(t, repo))
+	})
+}
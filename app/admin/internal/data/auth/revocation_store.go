@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"qn-base/app/admin/internal/data/rdb"
+	pkgAuth "qn-base/pkg/auth"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var _ pkgAuth.RevocationStore = (*revocationStore)(nil)
+
+// revocationStore is the RevocationStore kept in redis, shared by all the instances.
+//
+// 用户令牌保存在 auth:user:{userID}:tokens 有序集合中，吊销记录保存在 auth:revoked:{tokenID}
+type revocationStore struct {
+	client *rdb.Client
+	now    func() time.Time
+}
+
+// NewRevocationStore creates the RevocationStore kept in redis.
+func NewRevocationStore(client *rdb.Client) pkgAuth.RevocationStore {
+	return &revocationStore{client: client, now: time.Now}
+}
+
+// Track records the token issued to the user, and drops the expired ones.
+func (s *revocationStore) Track(ctx context.Context, userID, tokenID string, expiresAt time.Time) error {
+	key := s.userKey(userID)
+	now := s.now()
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.UnixMilli(), 10))
+		pipe.ZAdd(ctx, key, redis.Z{Score: float64(expiresAt.UnixMilli()), Member: tokenID})
+		// 集合保留到最晚过期的令牌过期为止
+		pipe.ExpireNX(ctx, key, expiresAt.Sub(now))
+		pipe.ExpireGT(ctx, key, expiresAt.Sub(now))
+		return nil
+	})
+	return err
+}
+
+// Revoke revokes the token until it expires.
+func (s *revocationStore) Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	ttl := expiresAt.Sub(s.now())
+	if ttl <= 0 {
+		return nil
+	}
+	return s.client.Set(ctx, s.revokedKey(tokenID), "1", ttl).Err()
+}
+
+// TryRevoke revokes the token until it expires, and reports whether this call revoked it.
+// 通过 SET NX 保证并发调用中只有一个成功
+func (s *revocationStore) TryRevoke(ctx context.Context, tokenID string, expiresAt time.Time) (bool, error) {
+	ttl := expiresAt.Sub(s.now())
+	if ttl <= 0 {
+		return false, nil
+	}
+	return s.client.SetNX(ctx, s.revokedKey(tokenID), "1", ttl).Result()
+}
+
+// RevokeUser revokes all the live tokens of the user.
+// 吊销记录与用户令牌集合可能位于不同的集群节点，因此读取令牌后通过管道逐个写入吊销记录，不使用脚本
+func (s *revocationStore) RevokeUser(ctx context.Context, userID string) error {
+	key := s.userKey(userID)
+	now := s.now()
+	tokens, err := s.client.ZRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{
+		Min: "(" + strconv.FormatInt(now.UnixMilli(), 10),
+		Max: "+inf",
+	}).Result()
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, token := range tokens {
+			// 吊销记录在令牌过期时删除
+			ttl := time.UnixMilli(int64(token.Score)).Sub(now)
+			pipe.Set(ctx, s.revokedKey(token.Member.(string)), "1", ttl)
+		}
+		pipe.Del(ctx, key)
+		return nil
+	})
+	return err
+}
+
+// IsRevoked reports whether the token was revoked.
+func (s *revocationStore) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	n, err := s.client.Exists(ctx, s.revokedKey(tokenID)).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// userKey returns the key of the tokens of the user.
+func (s *revocationStore) userKey(userID string) string {
+	return s.client.Key("auth", "user", userID, "tokens")
+}
+
+// revokedKey returns the key of the revoked token.
+func (s *revocationStore) revokedKey(tokenID string) string {
+	return s.client.Key("auth", "revoked", tokenID)
+}
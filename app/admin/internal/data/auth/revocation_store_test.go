@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"qn-base/app/admin/internal/conf"
+	"qn-base/app/admin/internal/data/rdb"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStore(t *testing.T) (*revocationStore, *miniredis.Miniredis) {
+	mr := miniredis.RunT(t)
+	client, cleanup, err := rdb.NewClient(&conf.Bootstrap{
+		Data: &conf.Data{Redis: &conf.Data_Redis{Addr: mr.Addr(), KeyPrefix: "test"}},
+	}, log.DefaultLogger)
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+	return NewRevocationStore(client).(*revocationStore), mr
+}
+
+func TestRevocationStore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("吊销单个令牌直到过期", func(t *testing.T) {
+		store, mr := newTestStore(t)
+		require.NoError(t, store.Revoke(ctx, "t1", time.Now().Add(time.Hour)))
+
+		// 断言
+		revoked, err := store.IsRevoked(ctx, "t1")
+		require.NoError(t, err)
+		assert.True(t, revoked)
+		assert.InDelta(t, time.Hour, mr.TTL("test:auth:revoked:t1"), float64(time.Second))
+
+		mr.FastForward(time.Hour)
+		revoked, err = store.IsRevoked(ctx, "t1")
+		require.NoError(t, err)
+		assert.False(t, revoked)
+	})
+
+	t.Run("已过期的令牌无需吊销", func(t *testing.T) {
+		store, mr := newTestStore(t)
+		require.NoError(t, store.Revoke(ctx, "t1", time.Now().Add(-time.Minute)))
+
+		// 断言
+		assert.False(t, mr.Exists("test:auth:revoked:t1"))
+	})
+
+	t.Run("并发吊销时只有一次成功", func(t *testing.T) {
+		store, mr := newTestStore(t)
+		expiresAt := time.Now().Add(time.Hour)
+
+		// 执行测试
+		var wg sync.WaitGroup
+		var succeeded atomic.Int32
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ok, err := store.TryRevoke(ctx, "t1", expiresAt)
+				assert.NoError(t, err)
+				if ok {
+					succeeded.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+
+		// 断言
+		assert.Equal(t, int32(1), succeeded.Load())
+		assert.InDelta(t, time.Hour, mr.TTL("test:auth:revoked:t1"), float64(time.Second))
+		ok, err := store.TryRevoke(ctx, "t2", time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("吊销用户全部未过期的令牌", func(t *testing.T) {
+		store, mr := newTestStore(t)
+		now := time.Now()
+		require.NoError(t, store.Track(ctx, "u1", "t1", now.Add(time.Hour)))
+		require.NoError(t, store.Track(ctx, "u1", "t2", now.Add(2*time.Hour)))
+		require.NoError(t, store.Track(ctx, "u2", "t3", now.Add(time.Hour)))
+		assert.InDelta(t, 2*time.Hour, mr.TTL("test:auth:user:u1:tokens"), float64(time.Second))
+
+		// 执行测试
+		require.NoError(t, store.RevokeUser(ctx, "u1"))
+
+		// 断言
+		for tokenID, want := range map[string]bool{"t1": true, "t2": true, "t3": false} {
+			revoked, err := store.IsRevoked(ctx, tokenID)
+			require.NoError(t, err)
+			assert.Equal(t, want, revoked, tokenID)
+		}
+		assert.InDelta(t, 2*time.Hour, mr.TTL("test:auth:revoked:t2"), float64(time.Second))
+		assert.False(t, mr.Exists("test:auth:user:u1:tokens"))
+	})
+
+	t.Run("记录令牌时清除已过期的令牌", func(t *testing.T) {
+		store, mr := newTestStore(t)
+		now := time.Now()
+		require.NoError(t, store.Track(ctx, "u1", "t1", now.Add(time.Minute)))
+
+		// 执行测试
+		store.now = func() time.Time { return now.Add(2 * time.Minute) }
+		require.NoError(t, store.Track(ctx, "u1", "t2", now.Add(time.Hour)))
+
+		// 断言
+		members, err := mr.ZMembers("test:auth:user:u1:tokens")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"t2"}, members)
+	})
+}
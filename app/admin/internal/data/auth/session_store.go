@@ -0,0 +1,172 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	"qn-base/app/admin/internal/data/rdb"
+	pkgAuth "qn-base/pkg/auth"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var _ pkgAuth.SessionStore = (*sessionStore)(nil)
+
+// touchSessionScript 会话存在时更新最后活跃时间，避免为已删除的会话重建不带过期时间的哈希
+//
+// KEYS[1] 会话哈希，ARGV[1] 最后活跃时间（毫秒）
+var touchSessionScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	redis.call("HSET", KEYS[1], "last_seen_at", ARGV[1])
+	return 1
+end
+return 0`)
+
+// sessionStore is the SessionStore kept in redis, shared by all the instances.
+//
+// 会话保存在 auth:session:{tokenID} 哈希中，租户的会话ID保存在 auth:tenant:{tenantID}:sessions 有序集合中，
+// 分数为过期时间（毫秒）
+type sessionStore struct {
+	client *rdb.Client
+	now    func() time.Time
+}
+
+// NewSessionStore creates the SessionStore kept in redis.
+func NewSessionStore(client *rdb.Client) pkgAuth.SessionStore {
+	return &sessionStore{client: client, now: time.Now}
+}
+
+// Save records the session until it expires, and drops the expired ones of the tenant.
+func (s *sessionStore) Save(ctx context.Context, session *pkgAuth.Session) error {
+	now := s.now()
+	ttl := session.ExpiresAt.Sub(now)
+	if ttl <= 0 {
+		return nil
+	}
+	key := s.sessionKey(session.TokenID)
+	tenantKey := s.tenantKey(session.TenantID)
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, map[string]interface{}{
+			"user_id":      session.UserID,
+			"account":      session.Account,
+			"tenant_id":    session.TenantID,
+			"client_type":  string(session.ClientType),
+			"ip":           session.IP,
+			"user_agent":   session.UserAgent,
+			"issued_at":    session.IssuedAt.UnixMilli(),
+			"last_seen_at": session.LastSeenAt.UnixMilli(),
+			"expires_at":   session.ExpiresAt.UnixMilli(),
+		})
+		pipe.PExpire(ctx, key, ttl)
+		pipe.ZRemRangeByScore(ctx, tenantKey, "-inf", strconv.FormatInt(now.UnixMilli(), 10))
+		pipe.ZAdd(ctx, tenantKey, redis.Z{Score: float64(session.ExpiresAt.UnixMilli()), Member: session.TokenID})
+		// 集合保留到最晚过期的会话过期为止
+		pipe.ExpireNX(ctx, tenantKey, ttl)
+		pipe.ExpireGT(ctx, tenantKey, ttl)
+		return nil
+	})
+	return err
+}
+
+// Touch updates the last-seen time of the session, if it still exists.
+func (s *sessionStore) Touch(ctx context.Context, tokenID string, at time.Time) error {
+	return touchSessionScript.Run(ctx, s.client, []string{s.sessionKey(tokenID)}, at.UnixMilli()).Err()
+}
+
+// Get returns the session, or nil if it does not exist or has expired.
+func (s *sessionStore) Get(ctx context.Context, tokenID string) (*pkgAuth.Session, error) {
+	values, err := s.client.HGetAll(ctx, s.sessionKey(tokenID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	return toSession(tokenID, values), nil
+}
+
+// List returns the live sessions of the tenant, latest issued first.
+func (s *sessionStore) List(ctx context.Context, tenantID string) ([]*pkgAuth.Session, error) {
+	tokenIDs, err := s.client.ZRangeByScore(ctx, s.tenantKey(tenantID), &redis.ZRangeBy{
+		Min: "(" + strconv.FormatInt(s.now().UnixMilli(), 10),
+		Max: "+inf",
+	}).Result()
+	if err != nil || len(tokenIDs) == 0 {
+		return nil, err
+	}
+
+	cmds := make([]*redis.MapStringStringCmd, len(tokenIDs))
+	if _, err := s.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, tokenID := range tokenIDs {
+			cmds[i] = pipe.HGetAll(ctx, s.sessionKey(tokenID))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*pkgAuth.Session, 0, len(tokenIDs))
+	for i, cmd := range cmds {
+		// 已删除的会话在集合中残留到过期为止
+		if session := toSession(tokenIDs[i], cmd.Val()); session != nil {
+			sessions = append(sessions, session)
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].IssuedAt.After(sessions[j].IssuedAt)
+	})
+	return sessions, nil
+}
+
+// Delete removes the session.
+func (s *sessionStore) Delete(ctx context.Context, tokenID string) error {
+	key := s.sessionKey(tokenID)
+	tenantID, err := s.client.HGet(ctx, key, "tenant_id").Result()
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	_, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.ZRem(ctx, s.tenantKey(tenantID), tokenID)
+		return nil
+	})
+	return err
+}
+
+// sessionKey returns the key of the session.
+func (s *sessionStore) sessionKey(tokenID string) string {
+	return s.client.Key("auth", "session", tokenID)
+}
+
+// tenantKey returns the key of the sessions of the tenant.
+func (s *sessionStore) tenantKey(tenantID string) string {
+	return s.client.Key("auth", "tenant", tenantID, "sessions")
+}
+
+// toSession converts the fields of the session hash, returns nil for a missing session.
+func toSession(tokenID string, values map[string]string) *pkgAuth.Session {
+	if len(values) == 0 {
+		return nil
+	}
+	return &pkgAuth.Session{
+		TokenID:    tokenID,
+		UserID:     values["user_id"],
+		Account:    values["account"],
+		TenantID:   values["tenant_id"],
+		ClientType: pkgAuth.ClientType(values["client_type"]),
+		IP:         values["ip"],
+		UserAgent:  values["user_agent"],
+		IssuedAt:   unixMilli(values["issued_at"]),
+		LastSeenAt: unixMilli(values["last_seen_at"]),
+		ExpiresAt:  unixMilli(values["expires_at"]),
+	}
+}
+
+// unixMilli parses a unix time in milliseconds.
+func unixMilli(s string) time.Time {
+	ms, _ := strconv.ParseInt(s, 10, 64)
+	return time.UnixMilli(ms)
+}
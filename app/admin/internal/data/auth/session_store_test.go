@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"qn-base/app/admin/internal/conf"
+	"qn-base/app/admin/internal/data/rdb"
+	pkgAuth "qn-base/pkg/auth"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSessionStore(t *testing.T) (*sessionStore, *miniredis.Miniredis) {
+	mr := miniredis.RunT(t)
+	client, cleanup, err := rdb.NewClient(&conf.Bootstrap{
+		Data: &conf.Data{Redis: &conf.Data_Redis{Addr: mr.Addr(), KeyPrefix: "test"}},
+	}, log.DefaultLogger)
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+	return NewSessionStore(client).(*sessionStore), mr
+}
+
+func TestSessionStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Millisecond)
+
+	newSession := func(tokenID, tenantID string, issuedAt time.Time) *pkgAuth.Session {
+		return &pkgAuth.Session{
+			TokenID:    tokenID,
+			UserID:     "u1",
+			Account:    "alice",
+			TenantID:   tenantID,
+			ClientType: pkgAuth.ClientTypeSystem,
+			IP:         "127.0.0.1",
+			UserAgent:  "Mozilla/5.0",
+			IssuedAt:   issuedAt,
+			LastSeenAt: issuedAt,
+			ExpiresAt:  now.Add(time.Hour),
+		}
+	}
+
+	t.Run("保存会话直到过期", func(t *testing.T) {
+		store, mr := newTestSessionStore(t)
+		session := newSession("t1", "tenant1", now)
+
+		// 执行测试
+		require.NoError(t, store.Save(ctx, session))
+
+		// 断言
+		got, err := store.Get(ctx, "t1")
+		require.NoError(t, err)
+		assert.Equal(t, session.UserID, got.UserID)
+		assert.Equal(t, session.ClientType, got.ClientType)
+		assert.Equal(t, session.UserAgent, got.UserAgent)
+		assert.True(t, session.IssuedAt.Equal(got.IssuedAt))
+		assert.True(t, session.ExpiresAt.Equal(got.ExpiresAt))
+		assert.InDelta(t, time.Hour, mr.TTL("test:auth:session:t1"), float64(time.Second))
+
+		mr.FastForward(time.Hour)
+		got, err = store.Get(ctx, "t1")
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("按租户列出会话", func(t *testing.T) {
+		store, _ := newTestSessionStore(t)
+		require.NoError(t, store.Save(ctx, newSession("t1", "tenant1", now.Add(-time.Minute))))
+		require.NoError(t, store.Save(ctx, newSession("t2", "tenant1", now)))
+		require.NoError(t, store.Save(ctx, newSession("t3", "tenant2", now)))
+		require.NoError(t, store.Delete(ctx, "t1"))
+
+		// 执行测试
+		sessions, err := store.List(ctx, "tenant1")
+
+		// 断言
+		require.NoError(t, err)
+		require.Len(t, sessions, 1)
+		assert.Equal(t, "t2", sessions[0].TokenID)
+	})
+
+	t.Run("只更新存在的会话", func(t *testing.T) {
+		store, mr := newTestSessionStore(t)
+		require.NoError(t, store.Save(ctx, newSession("t1", "tenant1", now)))
+
+		// 执行测试
+		require.NoError(t, store.Touch(ctx, "t1", now.Add(time.Minute)))
+		require.NoError(t, store.Touch(ctx, "missing", now))
+
+		// 断言
+		got, err := store.Get(ctx, "t1")
+		require.NoError(t, err)
+		assert.True(t, now.Add(time.Minute).Equal(got.LastSeenAt))
+		assert.False(t, mr.Exists("test:auth:session:missing"))
+	})
+}
@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	bizauth "qn-base/app/admin/internal/biz/auth"
+	"qn-base/app/admin/internal/data/data"
+	"qn-base/app/admin/internal/data/ent"
+	"qn-base/app/admin/internal/data/ent/systemusermfa"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var _ bizauth.UserMFARepo = (*userMFARepo)(nil)
+
+type userMFARepo struct {
+	data *data.Data
+	log  *log.Helper
+}
+
+// NewUserMFARepo .
+func NewUserMFARepo(data *data.Data, logger log.Logger) bizauth.UserMFARepo {
+	return &userMFARepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "auth/mfa-repo")),
+	}
+}
+
+// convertToBizUserMFA 将数据库实体转换为业务对象
+func (r *userMFARepo) convertToBizUserMFA(entity *ent.SystemUserMFA) *bizauth.UserMFA {
+	if entity == nil {
+		return nil
+	}
+
+	return &bizauth.UserMFA{
+		ID:            &entity.ID,
+		TenantID:      &entity.TenantID,
+		UserID:        &entity.UserID,
+		Secret:        &entity.Secret,
+		Enabled:       &entity.Enabled,
+		RecoveryCodes: entity.RecoveryCodes,
+		LastUsedStep:  &entity.LastUsedStep,
+		EnabledAt:     entity.EnabledAt,
+	}
+}
+
+func (r *userMFARepo) FindByUserID(ctx context.Context, userID string) (*bizauth.UserMFA, error) {
+	entity, err := r.data.DB.SystemUserMFA(ctx).Query().
+		Where(systemusermfa.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.convertToBizUserMFA(entity), nil
+}
+
+// Save deletes the existing setting of the user, and creates the new one.
+func (r *userMFARepo) Save(ctx context.Context, m *bizauth.UserMFA) (*bizauth.UserMFA, error) {
+	var result *ent.SystemUserMFA
+	err := r.data.DB.InTx(ctx, func(ctx context.Context) error {
+		if _, err := r.data.DB.SystemUserMFA(ctx).Delete().
+			Where(systemusermfa.UserID(*m.UserID)).
+			Exec(ctx); err != nil {
+			return err
+		}
+		var err error
+		result, err = r.data.DB.SystemUserMFA(ctx).Create().
+			SetUserID(*m.UserID).
+			SetSecret(*m.Secret).
+			SetNillableEnabled(m.Enabled).
+			SetRecoveryCodes(m.RecoveryCodes).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.convertToBizUserMFA(result), nil
+}
+
+func (r *userMFARepo) Activate(ctx context.Context, id string, recoveryCodes []string, step int64) error {
+	return r.data.DB.SystemUserMFA(ctx).UpdateOneID(id).
+		SetEnabled(true).
+		SetEnabledAt(time.Now()).
+		SetRecoveryCodes(recoveryCodes).
+		SetLastUsedStep(step).
+		Exec(ctx)
+}
+
+// UseStep updates the last used step only if the step is after it, so that a code is accepted only once
+// even if used concurrently.
+func (r *userMFARepo) UseStep(ctx context.Context, id string, step int64) (bool, error) {
+	n, err := r.data.DB.SystemUserMFA(ctx).Update().
+		Where(systemusermfa.ID(id), systemusermfa.LastUsedStepLT(step)).
+		SetLastUsedStep(step).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// UseRecoveryCode removes the recovery code hash with the row locked, so that a code is accepted only once
+// even if used concurrently.
+func (r *userMFARepo) UseRecoveryCode(ctx context.Context, id, hash string) (bool, error) {
+	used := false
+	err := r.data.DB.InTx(ctx, func(ctx context.Context) error {
+		entity, err := r.data.DB.SystemUserMFA(ctx).Query().
+			Where(systemusermfa.ID(id)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		i := slices.Index(entity.RecoveryCodes, hash)
+		if i < 0 {
+			return nil
+		}
+		used = true
+		return r.data.DB.SystemUserMFA(ctx).UpdateOneID(id).
+			SetRecoveryCodes(slices.Delete(entity.RecoveryCodes, i, i+1)).
+			Exec(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+	return used, nil
+}
+
+func (r *userMFARepo) DeleteByUserID(ctx context.Context, userID string) error {
+	_, err := r.data.DB.SystemUserMFA(ctx).Delete().
+		Where(systemusermfa.UserID(userID)).
+		Exec(ctx)
+	return err
+}
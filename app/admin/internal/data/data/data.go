@@ -3,13 +3,16 @@ package data
 import (
 	"qn-base/app/admin/internal/biz"
 	"qn-base/app/admin/internal/data/ent"
+	"qn-base/app/admin/internal/data/idgen"
+	"qn-base/app/admin/internal/data/rdb"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
 
 // Data .
 type Data struct {
-	DB *ent.Database
+	DB  *ent.Database
+	RDB *rdb.Client
 }
 
 // NewTransaction .
@@ -22,11 +25,19 @@ func (d *Data) Database() *ent.Database {
 	return d.DB
 }
 
+// Redis returns the redis client.
+func (d *Data) Redis() *rdb.Client {
+	return d.RDB
+}
+
 // NewData .
-func NewData(logger log.Logger, db *ent.Database) (*Data, func(), error) {
+// Redis 客户端由 rdb.NewClient 的 cleanup 关闭
+// 依赖 ID 生成器以保证创建实体前 StringId 已使用租用了机器码的生成器
+func NewData(logger log.Logger, db *ent.Database, client *rdb.Client, _ *idgen.IDGenerator) (*Data, func(), error) {
 	helper := log.NewHelper(logger)
 	d := &Data{
-		DB: db,
+		DB:  db,
+		RDB: client,
 	}
 
 	return d, func() {
@@ -6,6 +6,8 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 	"qn-base/app/admin/internal/conf"
 	"qn-base/app/admin/internal/data/ent"
+	// 注册 schema 中定义的 hooks、interceptors 等运行时代码
+	_ "qn-base/app/admin/internal/data/ent/runtime"
 	"time"
 )
 
@@ -11,7 +11,19 @@ import (
 
 	"qn-base/app/admin/internal/data/ent/migrate"
 
+	"qn-base/app/admin/internal/data/ent/systemcasbinrule"
+	"qn-base/app/admin/internal/data/ent/systemdept"
+	"qn-base/app/admin/internal/data/ent/systemloginlog"
+	"qn-base/app/admin/internal/data/ent/systemmenu"
+	"qn-base/app/admin/internal/data/ent/systempost"
+	"qn-base/app/admin/internal/data/ent/systemrole"
+	"qn-base/app/admin/internal/data/ent/systemrolemenu"
+	"qn-base/app/admin/internal/data/ent/systemtenant"
+	"qn-base/app/admin/internal/data/ent/systemtenantpackage"
 	"qn-base/app/admin/internal/data/ent/systemuser"
+	"qn-base/app/admin/internal/data/ent/systemusermfa"
+	"qn-base/app/admin/internal/data/ent/systemuserpost"
+	"qn-base/app/admin/internal/data/ent/systemuserrole"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -23,8 +35,32 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// SystemCasbinRule is the client for interacting with the SystemCasbinRule builders.
+	SystemCasbinRule *SystemCasbinRuleClient
+	// SystemDept is the client for interacting with the SystemDept builders.
+	SystemDept *SystemDeptClient
+	// SystemLoginLog is the client for interacting with the SystemLoginLog builders.
+	SystemLoginLog *SystemLoginLogClient
+	// SystemMenu is the client for interacting with the SystemMenu builders.
+	SystemMenu *SystemMenuClient
+	// SystemPost is the client for interacting with the SystemPost builders.
+	SystemPost *SystemPostClient
+	// SystemRole is the client for interacting with the SystemRole builders.
+	SystemRole *SystemRoleClient
+	// SystemRoleMenu is the client for interacting with the SystemRoleMenu builders.
+	SystemRoleMenu *SystemRoleMenuClient
+	// SystemTenant is the client for interacting with the SystemTenant builders.
+	SystemTenant *SystemTenantClient
+	// SystemTenantPackage is the client for interacting with the SystemTenantPackage builders.
+	SystemTenantPackage *SystemTenantPackageClient
 	// SystemUser is the client for interacting with the SystemUser builders.
 	SystemUser *SystemUserClient
+	// SystemUserMFA is the client for interacting with the SystemUserMFA builders.
+	SystemUserMFA *SystemUserMFAClient
+	// SystemUserPost is the client for interacting with the SystemUserPost builders.
+	SystemUserPost *SystemUserPostClient
+	// SystemUserRole is the client for interacting with the SystemUserRole builders.
+	SystemUserRole *SystemUserRoleClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -36,7 +72,19 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.SystemCasbinRule = NewSystemCasbinRuleClient(c.config)
+	c.SystemDept = NewSystemDeptClient(c.config)
+	c.SystemLoginLog = NewSystemLoginLogClient(c.config)
+	c.SystemMenu = NewSystemMenuClient(c.config)
+	c.SystemPost = NewSystemPostClient(c.config)
+	c.SystemRole = NewSystemRoleClient(c.config)
+	c.SystemRoleMenu = NewSystemRoleMenuClient(c.config)
+	c.SystemTenant = NewSystemTenantClient(c.config)
+	c.SystemTenantPackage = NewSystemTenantPackageClient(c.config)
 	c.SystemUser = NewSystemUserClient(c.config)
+	c.SystemUserMFA = NewSystemUserMFAClient(c.config)
+	c.SystemUserPost = NewSystemUserPostClient(c.config)
+	c.SystemUserRole = NewSystemUserRoleClient(c.config)
 }
 
 type (
@@ -127,9 +175,21 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:        ctx,
-		config:     cfg,
-		SystemUser: NewSystemUserClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		SystemCasbinRule:    NewSystemCasbinRuleClient(cfg),
+		SystemDept:          NewSystemDeptClient(cfg),
+		SystemLoginLog:      NewSystemLoginLogClient(cfg),
+		SystemMenu:          NewSystemMenuClient(cfg),
+		SystemPost:          NewSystemPostClient(cfg),
+		SystemRole:          NewSystemRoleClient(cfg),
+		SystemRoleMenu:      NewSystemRoleMenuClient(cfg),
+		SystemTenant:        NewSystemTenantClient(cfg),
+		SystemTenantPackage: NewSystemTenantPackageClient(cfg),
+		SystemUser:          NewSystemUserClient(cfg),
+		SystemUserMFA:       NewSystemUserMFAClient(cfg),
+		SystemUserPost:      NewSystemUserPostClient(cfg),
+		SystemUserRole:      NewSystemUserRoleClient(cfg),
 	}, nil
 }
 
@@ -147,16 +207,28 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:        ctx,
-		config:     cfg,
-		SystemUser: NewSystemUserClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		SystemCasbinRule:    NewSystemCasbinRuleClient(cfg),
+		SystemDept:          NewSystemDeptClient(cfg),
+		SystemLoginLog:      NewSystemLoginLogClient(cfg),
+		SystemMenu:          NewSystemMenuClient(cfg),
+		SystemPost:          NewSystemPostClient(cfg),
+		SystemRole:          NewSystemRoleClient(cfg),
+		SystemRoleMenu:      NewSystemRoleMenuClient(cfg),
+		SystemTenant:        NewSystemTenantClient(cfg),
+		SystemTenantPackage: NewSystemTenantPackageClient(cfg),
+		SystemUser:          NewSystemUserClient(cfg),
+		SystemUserMFA:       NewSystemUserMFAClient(cfg),
+		SystemUserPost:      NewSystemUserPostClient(cfg),
+		SystemUserRole:      NewSystemUserRoleClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		SystemUser.
+//		SystemCasbinRule.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -178,22 +250,1272 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.SystemUser.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.SystemCasbinRule, c.SystemDept, c.SystemLoginLog, c.SystemMenu, c.SystemPost,
+		c.SystemRole, c.SystemRoleMenu, c.SystemTenant, c.SystemTenantPackage,
+		c.SystemUser, c.SystemUserMFA, c.SystemUserPost, c.SystemUserRole,
+	} {
+		n.Use(hooks...)
+	}
+}
+
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.SystemCasbinRule, c.SystemDept, c.SystemLoginLog, c.SystemMenu, c.SystemPost,
+		c.SystemRole, c.SystemRoleMenu, c.SystemTenant, c.SystemTenantPackage,
+		c.SystemUser, c.SystemUserMFA, c.SystemUserPost, c.SystemUserRole,
+	} {
+		n.Intercept(interceptors...)
+	}
+}
+
+// Mutate implements the ent.Mutator interface.
+func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
+	switch m := m.(type) {
+	case *SystemCasbinRuleMutation:
+		return c.SystemCasbinRule.mutate(ctx, m)
+	case *SystemDeptMutation:
+		return c.SystemDept.mutate(ctx, m)
+	case *SystemLoginLogMutation:
+		return c.SystemLoginLog.mutate(ctx, m)
+	case *SystemMenuMutation:
+		return c.SystemMenu.mutate(ctx, m)
+	case *SystemPostMutation:
+		return c.SystemPost.mutate(ctx, m)
+	case *SystemRoleMutation:
+		return c.SystemRole.mutate(ctx, m)
+	case *SystemRoleMenuMutation:
+		return c.SystemRoleMenu.mutate(ctx, m)
+	case *SystemTenantMutation:
+		return c.SystemTenant.mutate(ctx, m)
+	case *SystemTenantPackageMutation:
+		return c.SystemTenantPackage.mutate(ctx, m)
+	case *SystemUserMutation:
+		return c.SystemUser.mutate(ctx, m)
+	case *SystemUserMFAMutation:
+		return c.SystemUserMFA.mutate(ctx, m)
+	case *SystemUserPostMutation:
+		return c.SystemUserPost.mutate(ctx, m)
+	case *SystemUserRoleMutation:
+		return c.SystemUserRole.mutate(ctx, m)
+	default:
+		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
+	}
+}
+
+// SystemCasbinRuleClient is a client for the SystemCasbinRule schema.
+type SystemCasbinRuleClient struct {
+	config
+}
+
+// NewSystemCasbinRuleClient returns a client for the SystemCasbinRule from the given config.
+func NewSystemCasbinRuleClient(c config) *SystemCasbinRuleClient {
+	return &SystemCasbinRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `systemcasbinrule.Hooks(f(g(h())))`.
+func (c *SystemCasbinRuleClient) Use(hooks ...Hook) {
+	c.hooks.SystemCasbinRule = append(c.hooks.SystemCasbinRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `systemcasbinrule.Intercept(f(g(h())))`.
+func (c *SystemCasbinRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SystemCasbinRule = append(c.inters.SystemCasbinRule, interceptors...)
+}
+
+// Create returns a builder for creating a SystemCasbinRule entity.
+func (c *SystemCasbinRuleClient) Create() *SystemCasbinRuleCreate {
+	mutation := newSystemCasbinRuleMutation(c.config, OpCreate)
+	return &SystemCasbinRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SystemCasbinRule entities.
+func (c *SystemCasbinRuleClient) CreateBulk(builders ...*SystemCasbinRuleCreate) *SystemCasbinRuleCreateBulk {
+	return &SystemCasbinRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SystemCasbinRuleClient) MapCreateBulk(slice any, setFunc func(*SystemCasbinRuleCreate, int)) *SystemCasbinRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SystemCasbinRuleCreateBulk{err: fmt.Errorf("calling to SystemCasbinRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SystemCasbinRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SystemCasbinRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SystemCasbinRule.
+func (c *SystemCasbinRuleClient) Update() *SystemCasbinRuleUpdate {
+	mutation := newSystemCasbinRuleMutation(c.config, OpUpdate)
+	return &SystemCasbinRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SystemCasbinRuleClient) UpdateOne(_m *SystemCasbinRule) *SystemCasbinRuleUpdateOne {
+	mutation := newSystemCasbinRuleMutation(c.config, OpUpdateOne, withSystemCasbinRule(_m))
+	return &SystemCasbinRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SystemCasbinRuleClient) UpdateOneID(id string) *SystemCasbinRuleUpdateOne {
+	mutation := newSystemCasbinRuleMutation(c.config, OpUpdateOne, withSystemCasbinRuleID(id))
+	return &SystemCasbinRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SystemCasbinRule.
+func (c *SystemCasbinRuleClient) Delete() *SystemCasbinRuleDelete {
+	mutation := newSystemCasbinRuleMutation(c.config, OpDelete)
+	return &SystemCasbinRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SystemCasbinRuleClient) DeleteOne(_m *SystemCasbinRule) *SystemCasbinRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SystemCasbinRuleClient) DeleteOneID(id string) *SystemCasbinRuleDeleteOne {
+	builder := c.Delete().Where(systemcasbinrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SystemCasbinRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for SystemCasbinRule.
+func (c *SystemCasbinRuleClient) Query() *SystemCasbinRuleQuery {
+	return &SystemCasbinRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSystemCasbinRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SystemCasbinRule entity by its id.
+func (c *SystemCasbinRuleClient) Get(ctx context.Context, id string) (*SystemCasbinRule, error) {
+	return c.Query().Where(systemcasbinrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SystemCasbinRuleClient) GetX(ctx context.Context, id string) *SystemCasbinRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SystemCasbinRuleClient) Hooks() []Hook {
+	hooks := c.hooks.SystemCasbinRule
+	return append(hooks[:len(hooks):len(hooks)], systemcasbinrule.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SystemCasbinRuleClient) Interceptors() []Interceptor {
+	return c.inters.SystemCasbinRule
+}
+
+func (c *SystemCasbinRuleClient) mutate(ctx context.Context, m *SystemCasbinRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SystemCasbinRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SystemCasbinRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SystemCasbinRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SystemCasbinRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SystemCasbinRule mutation op: %q", m.Op())
+	}
+}
+
+// SystemDeptClient is a client for the SystemDept schema.
+type SystemDeptClient struct {
+	config
+}
+
+// NewSystemDeptClient returns a client for the SystemDept from the given config.
+func NewSystemDeptClient(c config) *SystemDeptClient {
+	return &SystemDeptClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `systemdept.Hooks(f(g(h())))`.
+func (c *SystemDeptClient) Use(hooks ...Hook) {
+	c.hooks.SystemDept = append(c.hooks.SystemDept, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `systemdept.Intercept(f(g(h())))`.
+func (c *SystemDeptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SystemDept = append(c.inters.SystemDept, interceptors...)
+}
+
+// Create returns a builder for creating a SystemDept entity.
+func (c *SystemDeptClient) Create() *SystemDeptCreate {
+	mutation := newSystemDeptMutation(c.config, OpCreate)
+	return &SystemDeptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SystemDept entities.
+func (c *SystemDeptClient) CreateBulk(builders ...*SystemDeptCreate) *SystemDeptCreateBulk {
+	return &SystemDeptCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SystemDeptClient) MapCreateBulk(slice any, setFunc func(*SystemDeptCreate, int)) *SystemDeptCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SystemDeptCreateBulk{err: fmt.Errorf("calling to SystemDeptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SystemDeptCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SystemDeptCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SystemDept.
+func (c *SystemDeptClient) Update() *SystemDeptUpdate {
+	mutation := newSystemDeptMutation(c.config, OpUpdate)
+	return &SystemDeptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SystemDeptClient) UpdateOne(_m *SystemDept) *SystemDeptUpdateOne {
+	mutation := newSystemDeptMutation(c.config, OpUpdateOne, withSystemDept(_m))
+	return &SystemDeptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SystemDeptClient) UpdateOneID(id string) *SystemDeptUpdateOne {
+	mutation := newSystemDeptMutation(c.config, OpUpdateOne, withSystemDeptID(id))
+	return &SystemDeptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SystemDept.
+func (c *SystemDeptClient) Delete() *SystemDeptDelete {
+	mutation := newSystemDeptMutation(c.config, OpDelete)
+	return &SystemDeptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SystemDeptClient) DeleteOne(_m *SystemDept) *SystemDeptDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SystemDeptClient) DeleteOneID(id string) *SystemDeptDeleteOne {
+	builder := c.Delete().Where(systemdept.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SystemDeptDeleteOne{builder}
+}
+
+// Query returns a query builder for SystemDept.
+func (c *SystemDeptClient) Query() *SystemDeptQuery {
+	return &SystemDeptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSystemDept},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SystemDept entity by its id.
+func (c *SystemDeptClient) Get(ctx context.Context, id string) (*SystemDept, error) {
+	return c.Query().Where(systemdept.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SystemDeptClient) GetX(ctx context.Context, id string) *SystemDept {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SystemDeptClient) Hooks() []Hook {
+	hooks := c.hooks.SystemDept
+	return append(hooks[:len(hooks):len(hooks)], systemdept.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SystemDeptClient) Interceptors() []Interceptor {
+	inters := c.inters.SystemDept
+	return append(inters[:len(inters):len(inters)], systemdept.Interceptors[:]...)
+}
+
+func (c *SystemDeptClient) mutate(ctx context.Context, m *SystemDeptMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SystemDeptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SystemDeptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SystemDeptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SystemDeptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SystemDept mutation op: %q", m.Op())
+	}
+}
+
+// SystemLoginLogClient is a client for the SystemLoginLog schema.
+type SystemLoginLogClient struct {
+	config
+}
+
+// NewSystemLoginLogClient returns a client for the SystemLoginLog from the given config.
+func NewSystemLoginLogClient(c config) *SystemLoginLogClient {
+	return &SystemLoginLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `systemloginlog.Hooks(f(g(h())))`.
+func (c *SystemLoginLogClient) Use(hooks ...Hook) {
+	c.hooks.SystemLoginLog = append(c.hooks.SystemLoginLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `systemloginlog.Intercept(f(g(h())))`.
+func (c *SystemLoginLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SystemLoginLog = append(c.inters.SystemLoginLog, interceptors...)
+}
+
+// Create returns a builder for creating a SystemLoginLog entity.
+func (c *SystemLoginLogClient) Create() *SystemLoginLogCreate {
+	mutation := newSystemLoginLogMutation(c.config, OpCreate)
+	return &SystemLoginLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SystemLoginLog entities.
+func (c *SystemLoginLogClient) CreateBulk(builders ...*SystemLoginLogCreate) *SystemLoginLogCreateBulk {
+	return &SystemLoginLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SystemLoginLogClient) MapCreateBulk(slice any, setFunc func(*SystemLoginLogCreate, int)) *SystemLoginLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SystemLoginLogCreateBulk{err: fmt.Errorf("calling to SystemLoginLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SystemLoginLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SystemLoginLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SystemLoginLog.
+func (c *SystemLoginLogClient) Update() *SystemLoginLogUpdate {
+	mutation := newSystemLoginLogMutation(c.config, OpUpdate)
+	return &SystemLoginLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SystemLoginLogClient) UpdateOne(_m *SystemLoginLog) *SystemLoginLogUpdateOne {
+	mutation := newSystemLoginLogMutation(c.config, OpUpdateOne, withSystemLoginLog(_m))
+	return &SystemLoginLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SystemLoginLogClient) UpdateOneID(id string) *SystemLoginLogUpdateOne {
+	mutation := newSystemLoginLogMutation(c.config, OpUpdateOne, withSystemLoginLogID(id))
+	return &SystemLoginLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SystemLoginLog.
+func (c *SystemLoginLogClient) Delete() *SystemLoginLogDelete {
+	mutation := newSystemLoginLogMutation(c.config, OpDelete)
+	return &SystemLoginLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SystemLoginLogClient) DeleteOne(_m *SystemLoginLog) *SystemLoginLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SystemLoginLogClient) DeleteOneID(id string) *SystemLoginLogDeleteOne {
+	builder := c.Delete().Where(systemloginlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SystemLoginLogDeleteOne{builder}
+}
+
+// Query returns a query builder for SystemLoginLog.
+func (c *SystemLoginLogClient) Query() *SystemLoginLogQuery {
+	return &SystemLoginLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSystemLoginLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SystemLoginLog entity by its id.
+func (c *SystemLoginLogClient) Get(ctx context.Context, id string) (*SystemLoginLog, error) {
+	return c.Query().Where(systemloginlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SystemLoginLogClient) GetX(ctx context.Context, id string) *SystemLoginLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SystemLoginLogClient) Hooks() []Hook {
+	hooks := c.hooks.SystemLoginLog
+	return append(hooks[:len(hooks):len(hooks)], systemloginlog.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SystemLoginLogClient) Interceptors() []Interceptor {
+	inters := c.inters.SystemLoginLog
+	return append(inters[:len(inters):len(inters)], systemloginlog.Interceptors[:]...)
+}
+
+func (c *SystemLoginLogClient) mutate(ctx context.Context, m *SystemLoginLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SystemLoginLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SystemLoginLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SystemLoginLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SystemLoginLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SystemLoginLog mutation op: %q", m.Op())
+	}
+}
+
+// SystemMenuClient is a client for the SystemMenu schema.
+type SystemMenuClient struct {
+	config
+}
+
+// NewSystemMenuClient returns a client for the SystemMenu from the given config.
+func NewSystemMenuClient(c config) *SystemMenuClient {
+	return &SystemMenuClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `systemmenu.Hooks(f(g(h())))`.
+func (c *SystemMenuClient) Use(hooks ...Hook) {
+	c.hooks.SystemMenu = append(c.hooks.SystemMenu, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `systemmenu.Intercept(f(g(h())))`.
+func (c *SystemMenuClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SystemMenu = append(c.inters.SystemMenu, interceptors...)
+}
+
+// Create returns a builder for creating a SystemMenu entity.
+func (c *SystemMenuClient) Create() *SystemMenuCreate {
+	mutation := newSystemMenuMutation(c.config, OpCreate)
+	return &SystemMenuCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SystemMenu entities.
+func (c *SystemMenuClient) CreateBulk(builders ...*SystemMenuCreate) *SystemMenuCreateBulk {
+	return &SystemMenuCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SystemMenuClient) MapCreateBulk(slice any, setFunc func(*SystemMenuCreate, int)) *SystemMenuCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SystemMenuCreateBulk{err: fmt.Errorf("calling to SystemMenuClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SystemMenuCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SystemMenuCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SystemMenu.
+func (c *SystemMenuClient) Update() *SystemMenuUpdate {
+	mutation := newSystemMenuMutation(c.config, OpUpdate)
+	return &SystemMenuUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SystemMenuClient) UpdateOne(_m *SystemMenu) *SystemMenuUpdateOne {
+	mutation := newSystemMenuMutation(c.config, OpUpdateOne, withSystemMenu(_m))
+	return &SystemMenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SystemMenuClient) UpdateOneID(id string) *SystemMenuUpdateOne {
+	mutation := newSystemMenuMutation(c.config, OpUpdateOne, withSystemMenuID(id))
+	return &SystemMenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SystemMenu.
+func (c *SystemMenuClient) Delete() *SystemMenuDelete {
+	mutation := newSystemMenuMutation(c.config, OpDelete)
+	return &SystemMenuDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SystemMenuClient) DeleteOne(_m *SystemMenu) *SystemMenuDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SystemMenuClient) DeleteOneID(id string) *SystemMenuDeleteOne {
+	builder := c.Delete().Where(systemmenu.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SystemMenuDeleteOne{builder}
+}
+
+// Query returns a query builder for SystemMenu.
+func (c *SystemMenuClient) Query() *SystemMenuQuery {
+	return &SystemMenuQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSystemMenu},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SystemMenu entity by its id.
+func (c *SystemMenuClient) Get(ctx context.Context, id string) (*SystemMenu, error) {
+	return c.Query().Where(systemmenu.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SystemMenuClient) GetX(ctx context.Context, id string) *SystemMenu {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SystemMenuClient) Hooks() []Hook {
+	hooks := c.hooks.SystemMenu
+	return append(hooks[:len(hooks):len(hooks)], systemmenu.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SystemMenuClient) Interceptors() []Interceptor {
+	inters := c.inters.SystemMenu
+	return append(inters[:len(inters):len(inters)], systemmenu.Interceptors[:]...)
+}
+
+func (c *SystemMenuClient) mutate(ctx context.Context, m *SystemMenuMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SystemMenuCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SystemMenuUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SystemMenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SystemMenuDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SystemMenu mutation op: %q", m.Op())
+	}
+}
+
+// SystemPostClient is a client for the SystemPost schema.
+type SystemPostClient struct {
+	config
+}
+
+// NewSystemPostClient returns a client for the SystemPost from the given config.
+func NewSystemPostClient(c config) *SystemPostClient {
+	return &SystemPostClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `systempost.Hooks(f(g(h())))`.
+func (c *SystemPostClient) Use(hooks ...Hook) {
+	c.hooks.SystemPost = append(c.hooks.SystemPost, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `systempost.Intercept(f(g(h())))`.
+func (c *SystemPostClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SystemPost = append(c.inters.SystemPost, interceptors...)
+}
+
+// Create returns a builder for creating a SystemPost entity.
+func (c *SystemPostClient) Create() *SystemPostCreate {
+	mutation := newSystemPostMutation(c.config, OpCreate)
+	return &SystemPostCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SystemPost entities.
+func (c *SystemPostClient) CreateBulk(builders ...*SystemPostCreate) *SystemPostCreateBulk {
+	return &SystemPostCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SystemPostClient) MapCreateBulk(slice any, setFunc func(*SystemPostCreate, int)) *SystemPostCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SystemPostCreateBulk{err: fmt.Errorf("calling to SystemPostClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SystemPostCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SystemPostCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SystemPost.
+func (c *SystemPostClient) Update() *SystemPostUpdate {
+	mutation := newSystemPostMutation(c.config, OpUpdate)
+	return &SystemPostUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SystemPostClient) UpdateOne(_m *SystemPost) *SystemPostUpdateOne {
+	mutation := newSystemPostMutation(c.config, OpUpdateOne, withSystemPost(_m))
+	return &SystemPostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SystemPostClient) UpdateOneID(id string) *SystemPostUpdateOne {
+	mutation := newSystemPostMutation(c.config, OpUpdateOne, withSystemPostID(id))
+	return &SystemPostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SystemPost.
+func (c *SystemPostClient) Delete() *SystemPostDelete {
+	mutation := newSystemPostMutation(c.config, OpDelete)
+	return &SystemPostDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SystemPostClient) DeleteOne(_m *SystemPost) *SystemPostDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SystemPostClient) DeleteOneID(id string) *SystemPostDeleteOne {
+	builder := c.Delete().Where(systempost.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SystemPostDeleteOne{builder}
+}
+
+// Query returns a query builder for SystemPost.
+func (c *SystemPostClient) Query() *SystemPostQuery {
+	return &SystemPostQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSystemPost},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SystemPost entity by its id.
+func (c *SystemPostClient) Get(ctx context.Context, id string) (*SystemPost, error) {
+	return c.Query().Where(systempost.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SystemPostClient) GetX(ctx context.Context, id string) *SystemPost {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SystemPostClient) Hooks() []Hook {
+	hooks := c.hooks.SystemPost
+	return append(hooks[:len(hooks):len(hooks)], systempost.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SystemPostClient) Interceptors() []Interceptor {
+	inters := c.inters.SystemPost
+	return append(inters[:len(inters):len(inters)], systempost.Interceptors[:]...)
+}
+
+func (c *SystemPostClient) mutate(ctx context.Context, m *SystemPostMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SystemPostCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SystemPostUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SystemPostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SystemPostDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SystemPost mutation op: %q", m.Op())
+	}
+}
+
+// SystemRoleClient is a client for the SystemRole schema.
+type SystemRoleClient struct {
+	config
+}
+
+// NewSystemRoleClient returns a client for the SystemRole from the given config.
+func NewSystemRoleClient(c config) *SystemRoleClient {
+	return &SystemRoleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `systemrole.Hooks(f(g(h())))`.
+func (c *SystemRoleClient) Use(hooks ...Hook) {
+	c.hooks.SystemRole = append(c.hooks.SystemRole, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `systemrole.Intercept(f(g(h())))`.
+func (c *SystemRoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SystemRole = append(c.inters.SystemRole, interceptors...)
+}
+
+// Create returns a builder for creating a SystemRole entity.
+func (c *SystemRoleClient) Create() *SystemRoleCreate {
+	mutation := newSystemRoleMutation(c.config, OpCreate)
+	return &SystemRoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SystemRole entities.
+func (c *SystemRoleClient) CreateBulk(builders ...*SystemRoleCreate) *SystemRoleCreateBulk {
+	return &SystemRoleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SystemRoleClient) MapCreateBulk(slice any, setFunc func(*SystemRoleCreate, int)) *SystemRoleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SystemRoleCreateBulk{err: fmt.Errorf("calling to SystemRoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SystemRoleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SystemRoleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SystemRole.
+func (c *SystemRoleClient) Update() *SystemRoleUpdate {
+	mutation := newSystemRoleMutation(c.config, OpUpdate)
+	return &SystemRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SystemRoleClient) UpdateOne(_m *SystemRole) *SystemRoleUpdateOne {
+	mutation := newSystemRoleMutation(c.config, OpUpdateOne, withSystemRole(_m))
+	return &SystemRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SystemRoleClient) UpdateOneID(id string) *SystemRoleUpdateOne {
+	mutation := newSystemRoleMutation(c.config, OpUpdateOne, withSystemRoleID(id))
+	return &SystemRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SystemRole.
+func (c *SystemRoleClient) Delete() *SystemRoleDelete {
+	mutation := newSystemRoleMutation(c.config, OpDelete)
+	return &SystemRoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SystemRoleClient) DeleteOne(_m *SystemRole) *SystemRoleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SystemRoleClient) DeleteOneID(id string) *SystemRoleDeleteOne {
+	builder := c.Delete().Where(systemrole.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SystemRoleDeleteOne{builder}
+}
+
+// Query returns a query builder for SystemRole.
+func (c *SystemRoleClient) Query() *SystemRoleQuery {
+	return &SystemRoleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSystemRole},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SystemRole entity by its id.
+func (c *SystemRoleClient) Get(ctx context.Context, id string) (*SystemRole, error) {
+	return c.Query().Where(systemrole.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SystemRoleClient) GetX(ctx context.Context, id string) *SystemRole {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SystemRoleClient) Hooks() []Hook {
+	hooks := c.hooks.SystemRole
+	return append(hooks[:len(hooks):len(hooks)], systemrole.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SystemRoleClient) Interceptors() []Interceptor {
+	inters := c.inters.SystemRole
+	return append(inters[:len(inters):len(inters)], systemrole.Interceptors[:]...)
+}
+
+func (c *SystemRoleClient) mutate(ctx context.Context, m *SystemRoleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SystemRoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SystemRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SystemRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SystemRoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SystemRole mutation op: %q", m.Op())
+	}
+}
+
+// SystemRoleMenuClient is a client for the SystemRoleMenu schema.
+type SystemRoleMenuClient struct {
+	config
+}
+
+// NewSystemRoleMenuClient returns a client for the SystemRoleMenu from the given config.
+func NewSystemRoleMenuClient(c config) *SystemRoleMenuClient {
+	return &SystemRoleMenuClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `systemrolemenu.Hooks(f(g(h())))`.
+func (c *SystemRoleMenuClient) Use(hooks ...Hook) {
+	c.hooks.SystemRoleMenu = append(c.hooks.SystemRoleMenu, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `systemrolemenu.Intercept(f(g(h())))`.
+func (c *SystemRoleMenuClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SystemRoleMenu = append(c.inters.SystemRoleMenu, interceptors...)
+}
+
+// Create returns a builder for creating a SystemRoleMenu entity.
+func (c *SystemRoleMenuClient) Create() *SystemRoleMenuCreate {
+	mutation := newSystemRoleMenuMutation(c.config, OpCreate)
+	return &SystemRoleMenuCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SystemRoleMenu entities.
+func (c *SystemRoleMenuClient) CreateBulk(builders ...*SystemRoleMenuCreate) *SystemRoleMenuCreateBulk {
+	return &SystemRoleMenuCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SystemRoleMenuClient) MapCreateBulk(slice any, setFunc func(*SystemRoleMenuCreate, int)) *SystemRoleMenuCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SystemRoleMenuCreateBulk{err: fmt.Errorf("calling to SystemRoleMenuClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SystemRoleMenuCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SystemRoleMenuCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SystemRoleMenu.
+func (c *SystemRoleMenuClient) Update() *SystemRoleMenuUpdate {
+	mutation := newSystemRoleMenuMutation(c.config, OpUpdate)
+	return &SystemRoleMenuUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SystemRoleMenuClient) UpdateOne(_m *SystemRoleMenu) *SystemRoleMenuUpdateOne {
+	mutation := newSystemRoleMenuMutation(c.config, OpUpdateOne, withSystemRoleMenu(_m))
+	return &SystemRoleMenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SystemRoleMenuClient) UpdateOneID(id string) *SystemRoleMenuUpdateOne {
+	mutation := newSystemRoleMenuMutation(c.config, OpUpdateOne, withSystemRoleMenuID(id))
+	return &SystemRoleMenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SystemRoleMenu.
+func (c *SystemRoleMenuClient) Delete() *SystemRoleMenuDelete {
+	mutation := newSystemRoleMenuMutation(c.config, OpDelete)
+	return &SystemRoleMenuDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SystemRoleMenuClient) DeleteOne(_m *SystemRoleMenu) *SystemRoleMenuDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SystemRoleMenuClient) DeleteOneID(id string) *SystemRoleMenuDeleteOne {
+	builder := c.Delete().Where(systemrolemenu.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SystemRoleMenuDeleteOne{builder}
+}
+
+// Query returns a query builder for SystemRoleMenu.
+func (c *SystemRoleMenuClient) Query() *SystemRoleMenuQuery {
+	return &SystemRoleMenuQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSystemRoleMenu},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SystemRoleMenu entity by its id.
+func (c *SystemRoleMenuClient) Get(ctx context.Context, id string) (*SystemRoleMenu, error) {
+	return c.Query().Where(systemrolemenu.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SystemRoleMenuClient) GetX(ctx context.Context, id string) *SystemRoleMenu {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SystemRoleMenuClient) Hooks() []Hook {
+	hooks := c.hooks.SystemRoleMenu
+	return append(hooks[:len(hooks):len(hooks)], systemrolemenu.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SystemRoleMenuClient) Interceptors() []Interceptor {
+	inters := c.inters.SystemRoleMenu
+	return append(inters[:len(inters):len(inters)], systemrolemenu.Interceptors[:]...)
+}
+
+func (c *SystemRoleMenuClient) mutate(ctx context.Context, m *SystemRoleMenuMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SystemRoleMenuCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SystemRoleMenuUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SystemRoleMenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SystemRoleMenuDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SystemRoleMenu mutation op: %q", m.Op())
+	}
+}
+
+// SystemTenantClient is a client for the SystemTenant schema.
+type SystemTenantClient struct {
+	config
+}
+
+// NewSystemTenantClient returns a client for the SystemTenant from the given config.
+func NewSystemTenantClient(c config) *SystemTenantClient {
+	return &SystemTenantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `systemtenant.Hooks(f(g(h())))`.
+func (c *SystemTenantClient) Use(hooks ...Hook) {
+	c.hooks.SystemTenant = append(c.hooks.SystemTenant, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `systemtenant.Intercept(f(g(h())))`.
+func (c *SystemTenantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SystemTenant = append(c.inters.SystemTenant, interceptors...)
+}
+
+// Create returns a builder for creating a SystemTenant entity.
+func (c *SystemTenantClient) Create() *SystemTenantCreate {
+	mutation := newSystemTenantMutation(c.config, OpCreate)
+	return &SystemTenantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SystemTenant entities.
+func (c *SystemTenantClient) CreateBulk(builders ...*SystemTenantCreate) *SystemTenantCreateBulk {
+	return &SystemTenantCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SystemTenantClient) MapCreateBulk(slice any, setFunc func(*SystemTenantCreate, int)) *SystemTenantCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SystemTenantCreateBulk{err: fmt.Errorf("calling to SystemTenantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SystemTenantCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SystemTenantCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SystemTenant.
+func (c *SystemTenantClient) Update() *SystemTenantUpdate {
+	mutation := newSystemTenantMutation(c.config, OpUpdate)
+	return &SystemTenantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SystemTenantClient) UpdateOne(_m *SystemTenant) *SystemTenantUpdateOne {
+	mutation := newSystemTenantMutation(c.config, OpUpdateOne, withSystemTenant(_m))
+	return &SystemTenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SystemTenantClient) UpdateOneID(id string) *SystemTenantUpdateOne {
+	mutation := newSystemTenantMutation(c.config, OpUpdateOne, withSystemTenantID(id))
+	return &SystemTenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SystemTenant.
+func (c *SystemTenantClient) Delete() *SystemTenantDelete {
+	mutation := newSystemTenantMutation(c.config, OpDelete)
+	return &SystemTenantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SystemTenantClient) DeleteOne(_m *SystemTenant) *SystemTenantDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SystemTenantClient) DeleteOneID(id string) *SystemTenantDeleteOne {
+	builder := c.Delete().Where(systemtenant.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SystemTenantDeleteOne{builder}
+}
+
+// Query returns a query builder for SystemTenant.
+func (c *SystemTenantClient) Query() *SystemTenantQuery {
+	return &SystemTenantQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSystemTenant},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SystemTenant entity by its id.
+func (c *SystemTenantClient) Get(ctx context.Context, id string) (*SystemTenant, error) {
+	return c.Query().Where(systemtenant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SystemTenantClient) GetX(ctx context.Context, id string) *SystemTenant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SystemTenantClient) Hooks() []Hook {
+	hooks := c.hooks.SystemTenant
+	return append(hooks[:len(hooks):len(hooks)], systemtenant.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SystemTenantClient) Interceptors() []Interceptor {
+	inters := c.inters.SystemTenant
+	return append(inters[:len(inters):len(inters)], systemtenant.Interceptors[:]...)
+}
+
+func (c *SystemTenantClient) mutate(ctx context.Context, m *SystemTenantMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SystemTenantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SystemTenantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SystemTenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SystemTenantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SystemTenant mutation op: %q", m.Op())
+	}
+}
+
+// SystemTenantPackageClient is a client for the SystemTenantPackage schema.
+type SystemTenantPackageClient struct {
+	config
+}
+
+// NewSystemTenantPackageClient returns a client for the SystemTenantPackage from the given config.
+func NewSystemTenantPackageClient(c config) *SystemTenantPackageClient {
+	return &SystemTenantPackageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `systemtenantpackage.Hooks(f(g(h())))`.
+func (c *SystemTenantPackageClient) Use(hooks ...Hook) {
+	c.hooks.SystemTenantPackage = append(c.hooks.SystemTenantPackage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `systemtenantpackage.Intercept(f(g(h())))`.
+func (c *SystemTenantPackageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SystemTenantPackage = append(c.inters.SystemTenantPackage, interceptors...)
+}
+
+// Create returns a builder for creating a SystemTenantPackage entity.
+func (c *SystemTenantPackageClient) Create() *SystemTenantPackageCreate {
+	mutation := newSystemTenantPackageMutation(c.config, OpCreate)
+	return &SystemTenantPackageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SystemTenantPackage entities.
+func (c *SystemTenantPackageClient) CreateBulk(builders ...*SystemTenantPackageCreate) *SystemTenantPackageCreateBulk {
+	return &SystemTenantPackageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SystemTenantPackageClient) MapCreateBulk(slice any, setFunc func(*SystemTenantPackageCreate, int)) *SystemTenantPackageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SystemTenantPackageCreateBulk{err: fmt.Errorf("calling to SystemTenantPackageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SystemTenantPackageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SystemTenantPackageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SystemTenantPackage.
+func (c *SystemTenantPackageClient) Update() *SystemTenantPackageUpdate {
+	mutation := newSystemTenantPackageMutation(c.config, OpUpdate)
+	return &SystemTenantPackageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SystemTenantPackageClient) UpdateOne(_m *SystemTenantPackage) *SystemTenantPackageUpdateOne {
+	mutation := newSystemTenantPackageMutation(c.config, OpUpdateOne, withSystemTenantPackage(_m))
+	return &SystemTenantPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SystemTenantPackageClient) UpdateOneID(id string) *SystemTenantPackageUpdateOne {
+	mutation := newSystemTenantPackageMutation(c.config, OpUpdateOne, withSystemTenantPackageID(id))
+	return &SystemTenantPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SystemTenantPackage.
+func (c *SystemTenantPackageClient) Delete() *SystemTenantPackageDelete {
+	mutation := newSystemTenantPackageMutation(c.config, OpDelete)
+	return &SystemTenantPackageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SystemTenantPackageClient) DeleteOne(_m *SystemTenantPackage) *SystemTenantPackageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SystemTenantPackageClient) DeleteOneID(id string) *SystemTenantPackageDeleteOne {
+	builder := c.Delete().Where(systemtenantpackage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SystemTenantPackageDeleteOne{builder}
+}
+
+// Query returns a query builder for SystemTenantPackage.
+func (c *SystemTenantPackageClient) Query() *SystemTenantPackageQuery {
+	return &SystemTenantPackageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSystemTenantPackage},
+		inters: c.Interceptors(),
+	}
 }
 
-// Intercept adds the query interceptors to all the entity clients.
-// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
-func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.SystemUser.Intercept(interceptors...)
+// Get returns a SystemTenantPackage entity by its id.
+func (c *SystemTenantPackageClient) Get(ctx context.Context, id string) (*SystemTenantPackage, error) {
+	return c.Query().Where(systemtenantpackage.ID(id)).Only(ctx)
 }
 
-// Mutate implements the ent.Mutator interface.
-func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
-	switch m := m.(type) {
-	case *SystemUserMutation:
-		return c.SystemUser.mutate(ctx, m)
+// GetX is like Get, but panics if an error occurs.
+func (c *SystemTenantPackageClient) GetX(ctx context.Context, id string) *SystemTenantPackage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SystemTenantPackageClient) Hooks() []Hook {
+	hooks := c.hooks.SystemTenantPackage
+	return append(hooks[:len(hooks):len(hooks)], systemtenantpackage.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SystemTenantPackageClient) Interceptors() []Interceptor {
+	inters := c.inters.SystemTenantPackage
+	return append(inters[:len(inters):len(inters)], systemtenantpackage.Interceptors[:]...)
+}
+
+func (c *SystemTenantPackageClient) mutate(ctx context.Context, m *SystemTenantPackageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SystemTenantPackageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SystemTenantPackageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SystemTenantPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SystemTenantPackageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
+		return nil, fmt.Errorf("ent: unknown SystemTenantPackage mutation op: %q", m.Op())
 	}
 }
 
@@ -332,12 +1654,421 @@ func (c *SystemUserClient) mutate(ctx context.Context, m *SystemUserMutation) (V
 	}
 }
 
+// SystemUserMFAClient is a client for the SystemUserMFA schema.
+type SystemUserMFAClient struct {
+	config
+}
+
+// NewSystemUserMFAClient returns a client for the SystemUserMFA from the given config.
+func NewSystemUserMFAClient(c config) *SystemUserMFAClient {
+	return &SystemUserMFAClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `systemusermfa.Hooks(f(g(h())))`.
+func (c *SystemUserMFAClient) Use(hooks ...Hook) {
+	c.hooks.SystemUserMFA = append(c.hooks.SystemUserMFA, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `systemusermfa.Intercept(f(g(h())))`.
+func (c *SystemUserMFAClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SystemUserMFA = append(c.inters.SystemUserMFA, interceptors...)
+}
+
+// Create returns a builder for creating a SystemUserMFA entity.
+func (c *SystemUserMFAClient) Create() *SystemUserMFACreate {
+	mutation := newSystemUserMFAMutation(c.config, OpCreate)
+	return &SystemUserMFACreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SystemUserMFA entities.
+func (c *SystemUserMFAClient) CreateBulk(builders ...*SystemUserMFACreate) *SystemUserMFACreateBulk {
+	return &SystemUserMFACreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SystemUserMFAClient) MapCreateBulk(slice any, setFunc func(*SystemUserMFACreate, int)) *SystemUserMFACreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SystemUserMFACreateBulk{err: fmt.Errorf("calling to SystemUserMFAClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SystemUserMFACreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SystemUserMFACreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SystemUserMFA.
+func (c *SystemUserMFAClient) Update() *SystemUserMFAUpdate {
+	mutation := newSystemUserMFAMutation(c.config, OpUpdate)
+	return &SystemUserMFAUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SystemUserMFAClient) UpdateOne(_m *SystemUserMFA) *SystemUserMFAUpdateOne {
+	mutation := newSystemUserMFAMutation(c.config, OpUpdateOne, withSystemUserMFA(_m))
+	return &SystemUserMFAUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SystemUserMFAClient) UpdateOneID(id string) *SystemUserMFAUpdateOne {
+	mutation := newSystemUserMFAMutation(c.config, OpUpdateOne, withSystemUserMFAID(id))
+	return &SystemUserMFAUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SystemUserMFA.
+func (c *SystemUserMFAClient) Delete() *SystemUserMFADelete {
+	mutation := newSystemUserMFAMutation(c.config, OpDelete)
+	return &SystemUserMFADelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SystemUserMFAClient) DeleteOne(_m *SystemUserMFA) *SystemUserMFADeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SystemUserMFAClient) DeleteOneID(id string) *SystemUserMFADeleteOne {
+	builder := c.Delete().Where(systemusermfa.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SystemUserMFADeleteOne{builder}
+}
+
+// Query returns a query builder for SystemUserMFA.
+func (c *SystemUserMFAClient) Query() *SystemUserMFAQuery {
+	return &SystemUserMFAQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSystemUserMFA},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SystemUserMFA entity by its id.
+func (c *SystemUserMFAClient) Get(ctx context.Context, id string) (*SystemUserMFA, error) {
+	return c.Query().Where(systemusermfa.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SystemUserMFAClient) GetX(ctx context.Context, id string) *SystemUserMFA {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SystemUserMFAClient) Hooks() []Hook {
+	hooks := c.hooks.SystemUserMFA
+	return append(hooks[:len(hooks):len(hooks)], systemusermfa.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SystemUserMFAClient) Interceptors() []Interceptor {
+	inters := c.inters.SystemUserMFA
+	return append(inters[:len(inters):len(inters)], systemusermfa.Interceptors[:]...)
+}
+
+func (c *SystemUserMFAClient) mutate(ctx context.Context, m *SystemUserMFAMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SystemUserMFACreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SystemUserMFAUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SystemUserMFAUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SystemUserMFADelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SystemUserMFA mutation op: %q", m.Op())
+	}
+}
+
+// SystemUserPostClient is a client for the SystemUserPost schema.
+type SystemUserPostClient struct {
+	config
+}
+
+// NewSystemUserPostClient returns a client for the SystemUserPost from the given config.
+func NewSystemUserPostClient(c config) *SystemUserPostClient {
+	return &SystemUserPostClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `systemuserpost.Hooks(f(g(h())))`.
+func (c *SystemUserPostClient) Use(hooks ...Hook) {
+	c.hooks.SystemUserPost = append(c.hooks.SystemUserPost, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `systemuserpost.Intercept(f(g(h())))`.
+func (c *SystemUserPostClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SystemUserPost = append(c.inters.SystemUserPost, interceptors...)
+}
+
+// Create returns a builder for creating a SystemUserPost entity.
+func (c *SystemUserPostClient) Create() *SystemUserPostCreate {
+	mutation := newSystemUserPostMutation(c.config, OpCreate)
+	return &SystemUserPostCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SystemUserPost entities.
+func (c *SystemUserPostClient) CreateBulk(builders ...*SystemUserPostCreate) *SystemUserPostCreateBulk {
+	return &SystemUserPostCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SystemUserPostClient) MapCreateBulk(slice any, setFunc func(*SystemUserPostCreate, int)) *SystemUserPostCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SystemUserPostCreateBulk{err: fmt.Errorf("calling to SystemUserPostClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SystemUserPostCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SystemUserPostCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SystemUserPost.
+func (c *SystemUserPostClient) Update() *SystemUserPostUpdate {
+	mutation := newSystemUserPostMutation(c.config, OpUpdate)
+	return &SystemUserPostUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SystemUserPostClient) UpdateOne(_m *SystemUserPost) *SystemUserPostUpdateOne {
+	mutation := newSystemUserPostMutation(c.config, OpUpdateOne, withSystemUserPost(_m))
+	return &SystemUserPostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SystemUserPostClient) UpdateOneID(id string) *SystemUserPostUpdateOne {
+	mutation := newSystemUserPostMutation(c.config, OpUpdateOne, withSystemUserPostID(id))
+	return &SystemUserPostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SystemUserPost.
+func (c *SystemUserPostClient) Delete() *SystemUserPostDelete {
+	mutation := newSystemUserPostMutation(c.config, OpDelete)
+	return &SystemUserPostDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SystemUserPostClient) DeleteOne(_m *SystemUserPost) *SystemUserPostDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SystemUserPostClient) DeleteOneID(id string) *SystemUserPostDeleteOne {
+	builder := c.Delete().Where(systemuserpost.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SystemUserPostDeleteOne{builder}
+}
+
+// Query returns a query builder for SystemUserPost.
+func (c *SystemUserPostClient) Query() *SystemUserPostQuery {
+	return &SystemUserPostQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSystemUserPost},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SystemUserPost entity by its id.
+func (c *SystemUserPostClient) Get(ctx context.Context, id string) (*SystemUserPost, error) {
+	return c.Query().Where(systemuserpost.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SystemUserPostClient) GetX(ctx context.Context, id string) *SystemUserPost {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SystemUserPostClient) Hooks() []Hook {
+	hooks := c.hooks.SystemUserPost
+	return append(hooks[:len(hooks):len(hooks)], systemuserpost.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SystemUserPostClient) Interceptors() []Interceptor {
+	inters := c.inters.SystemUserPost
+	return append(inters[:len(inters):len(inters)], systemuserpost.Interceptors[:]...)
+}
+
+func (c *SystemUserPostClient) mutate(ctx context.Context, m *SystemUserPostMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SystemUserPostCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SystemUserPostUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SystemUserPostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SystemUserPostDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SystemUserPost mutation op: %q", m.Op())
+	}
+}
+
+// SystemUserRoleClient is a client for the SystemUserRole schema.
+type SystemUserRoleClient struct {
+	config
+}
+
+// NewSystemUserRoleClient returns a client for the SystemUserRole from the given config.
+func NewSystemUserRoleClient(c config) *SystemUserRoleClient {
+	return &SystemUserRoleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `systemuserrole.Hooks(f(g(h())))`.
+func (c *SystemUserRoleClient) Use(hooks ...Hook) {
+	c.hooks.SystemUserRole = append(c.hooks.SystemUserRole, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `systemuserrole.Intercept(f(g(h())))`.
+func (c *SystemUserRoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SystemUserRole = append(c.inters.SystemUserRole, interceptors...)
+}
+
+// Create returns a builder for creating a SystemUserRole entity.
+func (c *SystemUserRoleClient) Create() *SystemUserRoleCreate {
+	mutation := newSystemUserRoleMutation(c.config, OpCreate)
+	return &SystemUserRoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SystemUserRole entities.
+func (c *SystemUserRoleClient) CreateBulk(builders ...*SystemUserRoleCreate) *SystemUserRoleCreateBulk {
+	return &SystemUserRoleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SystemUserRoleClient) MapCreateBulk(slice any, setFunc func(*SystemUserRoleCreate, int)) *SystemUserRoleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SystemUserRoleCreateBulk{err: fmt.Errorf("calling to SystemUserRoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SystemUserRoleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SystemUserRoleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SystemUserRole.
+func (c *SystemUserRoleClient) Update() *SystemUserRoleUpdate {
+	mutation := newSystemUserRoleMutation(c.config, OpUpdate)
+	return &SystemUserRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SystemUserRoleClient) UpdateOne(_m *SystemUserRole) *SystemUserRoleUpdateOne {
+	mutation := newSystemUserRoleMutation(c.config, OpUpdateOne, withSystemUserRole(_m))
+	return &SystemUserRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SystemUserRoleClient) UpdateOneID(id string) *SystemUserRoleUpdateOne {
+	mutation := newSystemUserRoleMutation(c.config, OpUpdateOne, withSystemUserRoleID(id))
+	return &SystemUserRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SystemUserRole.
+func (c *SystemUserRoleClient) Delete() *SystemUserRoleDelete {
+	mutation := newSystemUserRoleMutation(c.config, OpDelete)
+	return &SystemUserRoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SystemUserRoleClient) DeleteOne(_m *SystemUserRole) *SystemUserRoleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SystemUserRoleClient) DeleteOneID(id string) *SystemUserRoleDeleteOne {
+	builder := c.Delete().Where(systemuserrole.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SystemUserRoleDeleteOne{builder}
+}
+
+// Query returns a query builder for SystemUserRole.
+func (c *SystemUserRoleClient) Query() *SystemUserRoleQuery {
+	return &SystemUserRoleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSystemUserRole},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SystemUserRole entity by its id.
+func (c *SystemUserRoleClient) Get(ctx context.Context, id string) (*SystemUserRole, error) {
+	return c.Query().Where(systemuserrole.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SystemUserRoleClient) GetX(ctx context.Context, id string) *SystemUserRole {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SystemUserRoleClient) Hooks() []Hook {
+	hooks := c.hooks.SystemUserRole
+	return append(hooks[:len(hooks):len(hooks)], systemuserrole.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SystemUserRoleClient) Interceptors() []Interceptor {
+	inters := c.inters.SystemUserRole
+	return append(inters[:len(inters):len(inters)], systemuserrole.Interceptors[:]...)
+}
+
+func (c *SystemUserRoleClient) mutate(ctx context.Context, m *SystemUserRoleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SystemUserRoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SystemUserRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SystemUserRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SystemUserRoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SystemUserRole mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		SystemUser []ent.Hook
+		SystemCasbinRule, SystemDept, SystemLoginLog, SystemMenu, SystemPost,
+		SystemRole, SystemRoleMenu, SystemTenant, SystemTenantPackage, SystemUser,
+		SystemUserMFA, SystemUserPost, SystemUserRole []ent.Hook
 	}
 	inters struct {
-		SystemUser []ent.Interceptor
+		SystemCasbinRule, SystemDept, SystemLoginLog, SystemMenu, SystemPost,
+		SystemRole, SystemRoleMenu, SystemTenant, SystemTenantPackage, SystemUser,
+		SystemUserMFA, SystemUserPost, SystemUserRole []ent.Interceptor
 	}
 )
@@ -85,7 +85,67 @@ func (db *Database) Client(ctx context.Context) *Client {
 	return db.client
 }
 
+// SystemCasbinRule is the client for interacting with the SystemCasbinRule builders.
+func (db *Database) SystemCasbinRule(ctx context.Context) *SystemCasbinRuleClient {
+	return db.loadClient(ctx).SystemCasbinRule
+}
+
+// SystemDept is the client for interacting with the SystemDept builders.
+func (db *Database) SystemDept(ctx context.Context) *SystemDeptClient {
+	return db.loadClient(ctx).SystemDept
+}
+
+// SystemLoginLog is the client for interacting with the SystemLoginLog builders.
+func (db *Database) SystemLoginLog(ctx context.Context) *SystemLoginLogClient {
+	return db.loadClient(ctx).SystemLoginLog
+}
+
+// SystemMenu is the client for interacting with the SystemMenu builders.
+func (db *Database) SystemMenu(ctx context.Context) *SystemMenuClient {
+	return db.loadClient(ctx).SystemMenu
+}
+
+// SystemPost is the client for interacting with the SystemPost builders.
+func (db *Database) SystemPost(ctx context.Context) *SystemPostClient {
+	return db.loadClient(ctx).SystemPost
+}
+
+// SystemRole is the client for interacting with the SystemRole builders.
+func (db *Database) SystemRole(ctx context.Context) *SystemRoleClient {
+	return db.loadClient(ctx).SystemRole
+}
+
+// SystemRoleMenu is the client for interacting with the SystemRoleMenu builders.
+func (db *Database) SystemRoleMenu(ctx context.Context) *SystemRoleMenuClient {
+	return db.loadClient(ctx).SystemRoleMenu
+}
+
+// SystemTenant is the client for interacting with the SystemTenant builders.
+func (db *Database) SystemTenant(ctx context.Context) *SystemTenantClient {
+	return db.loadClient(ctx).SystemTenant
+}
+
+// SystemTenantPackage is the client for interacting with the SystemTenantPackage builders.
+func (db *Database) SystemTenantPackage(ctx context.Context) *SystemTenantPackageClient {
+	return db.loadClient(ctx).SystemTenantPackage
+}
+
 // SystemUser is the client for interacting with the SystemUser builders.
 func (db *Database) SystemUser(ctx context.Context) *SystemUserClient {
 	return db.loadClient(ctx).SystemUser
 }
+
+// SystemUserMFA is the client for interacting with the SystemUserMFA builders.
+func (db *Database) SystemUserMFA(ctx context.Context) *SystemUserMFAClient {
+	return db.loadClient(ctx).SystemUserMFA
+}
+
+// SystemUserPost is the client for interacting with the SystemUserPost builders.
+func (db *Database) SystemUserPost(ctx context.Context) *SystemUserPostClient {
+	return db.loadClient(ctx).SystemUserPost
+}
+
+// SystemUserRole is the client for interacting with the SystemUserRole builders.
+func (db *Database) SystemUserRole(ctx context.Context) *SystemUserRoleClient {
+	return db.loadClient(ctx).SystemUserRole
+}
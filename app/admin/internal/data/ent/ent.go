@@ -6,7 +6,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"qn-base/app/admin/internal/data/ent/systemcasbinrule"
+	"qn-base/app/admin/internal/data/ent/systemdept"
+	"qn-base/app/admin/internal/data/ent/systemloginlog"
+	"qn-base/app/admin/internal/data/ent/systemmenu"
+	"qn-base/app/admin/internal/data/ent/systempost"
+	"qn-base/app/admin/internal/data/ent/systemrole"
+	"qn-base/app/admin/internal/data/ent/systemrolemenu"
+	"qn-base/app/admin/internal/data/ent/systemtenant"
+	"qn-base/app/admin/internal/data/ent/systemtenantpackage"
 	"qn-base/app/admin/internal/data/ent/systemuser"
+	"qn-base/app/admin/internal/data/ent/systemusermfa"
+	"qn-base/app/admin/internal/data/ent/systemuserpost"
+	"qn-base/app/admin/internal/data/ent/systemuserrole"
 	"reflect"
 	"sync"
 
@@ -73,7 +85,19 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			systemuser.Table: systemuser.ValidColumn,
+			systemcasbinrule.Table:    systemcasbinrule.ValidColumn,
+			systemdept.Table:          systemdept.ValidColumn,
+			systemloginlog.Table:      systemloginlog.ValidColumn,
+			systemmenu.Table:          systemmenu.ValidColumn,
+			systempost.Table:          systempost.ValidColumn,
+			systemrole.Table:          systemrole.ValidColumn,
+			systemrolemenu.Table:      systemrolemenu.ValidColumn,
+			systemtenant.Table:        systemtenant.ValidColumn,
+			systemtenantpackage.Table: systemtenantpackage.ValidColumn,
+			systemuser.Table:          systemuser.ValidColumn,
+			systemusermfa.Table:       systemusermfa.ValidColumn,
+			systemuserpost.Table:      systemuserpost.ValidColumn,
+			systemuserrole.Table:      systemuserrole.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
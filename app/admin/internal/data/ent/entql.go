@@ -3,7 +3,19 @@
 package ent
 
 import (
+	"qn-base/app/admin/internal/data/ent/systemcasbinrule"
+	"qn-base/app/admin/internal/data/ent/systemdept"
+	"qn-base/app/admin/internal/data/ent/systemloginlog"
+	"qn-base/app/admin/internal/data/ent/systemmenu"
+	"qn-base/app/admin/internal/data/ent/systempost"
+	"qn-base/app/admin/internal/data/ent/systemrole"
+	"qn-base/app/admin/internal/data/ent/systemrolemenu"
+	"qn-base/app/admin/internal/data/ent/systemtenant"
+	"qn-base/app/admin/internal/data/ent/systemtenantpackage"
 	"qn-base/app/admin/internal/data/ent/systemuser"
+	"qn-base/app/admin/internal/data/ent/systemusermfa"
+	"qn-base/app/admin/internal/data/ent/systemuserpost"
+	"qn-base/app/admin/internal/data/ent/systemuserrole"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -13,8 +25,232 @@ import (
 
 // schemaGraph holds a representation of ent/schema at runtime.
 var schemaGraph = func() *sqlgraph.Schema {
-	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 1)}
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 13)}
 	graph.Nodes[0] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   systemcasbinrule.Table,
+			Columns: systemcasbinrule.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: systemcasbinrule.FieldID,
+			},
+		},
+		Type: "SystemCasbinRule",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			systemcasbinrule.FieldCreateBy:  {Type: field.TypeString, Column: systemcasbinrule.FieldCreateBy},
+			systemcasbinrule.FieldCreatedAt: {Type: field.TypeTime, Column: systemcasbinrule.FieldCreatedAt},
+			systemcasbinrule.FieldPtype:     {Type: field.TypeString, Column: systemcasbinrule.FieldPtype},
+			systemcasbinrule.FieldV0:        {Type: field.TypeString, Column: systemcasbinrule.FieldV0},
+			systemcasbinrule.FieldV1:        {Type: field.TypeString, Column: systemcasbinrule.FieldV1},
+			systemcasbinrule.FieldV2:        {Type: field.TypeString, Column: systemcasbinrule.FieldV2},
+			systemcasbinrule.FieldV3:        {Type: field.TypeString, Column: systemcasbinrule.FieldV3},
+			systemcasbinrule.FieldV4:        {Type: field.TypeString, Column: systemcasbinrule.FieldV4},
+			systemcasbinrule.FieldV5:        {Type: field.TypeString, Column: systemcasbinrule.FieldV5},
+		},
+	}
+	graph.Nodes[1] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   systemdept.Table,
+			Columns: systemdept.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: systemdept.FieldID,
+			},
+		},
+		Type: "SystemDept",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			systemdept.FieldCreateBy:     {Type: field.TypeString, Column: systemdept.FieldCreateBy},
+			systemdept.FieldCreatedAt:    {Type: field.TypeTime, Column: systemdept.FieldCreatedAt},
+			systemdept.FieldUpdateBy:     {Type: field.TypeString, Column: systemdept.FieldUpdateBy},
+			systemdept.FieldUpdatedAt:    {Type: field.TypeTime, Column: systemdept.FieldUpdatedAt},
+			systemdept.FieldTenantID:     {Type: field.TypeString, Column: systemdept.FieldTenantID},
+			systemdept.FieldDeletedAt:    {Type: field.TypeTime, Column: systemdept.FieldDeletedAt},
+			systemdept.FieldName:         {Type: field.TypeString, Column: systemdept.FieldName},
+			systemdept.FieldParentID:     {Type: field.TypeString, Column: systemdept.FieldParentID},
+			systemdept.FieldAncestors:    {Type: field.TypeString, Column: systemdept.FieldAncestors},
+			systemdept.FieldSort:         {Type: field.TypeInt32, Column: systemdept.FieldSort},
+			systemdept.FieldLeaderUserID: {Type: field.TypeString, Column: systemdept.FieldLeaderUserID},
+			systemdept.FieldPhone:        {Type: field.TypeString, Column: systemdept.FieldPhone},
+			systemdept.FieldEmail:        {Type: field.TypeString, Column: systemdept.FieldEmail},
+			systemdept.FieldStatus:       {Type: field.TypeInt8, Column: systemdept.FieldStatus},
+		},
+	}
+	graph.Nodes[2] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   systemloginlog.Table,
+			Columns: systemloginlog.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: systemloginlog.FieldID,
+			},
+		},
+		Type: "SystemLoginLog",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			systemloginlog.FieldCreatedAt: {Type: field.TypeTime, Column: systemloginlog.FieldCreatedAt},
+			systemloginlog.FieldTenantID:  {Type: field.TypeString, Column: systemloginlog.FieldTenantID},
+			systemloginlog.FieldEvent:     {Type: field.TypeString, Column: systemloginlog.FieldEvent},
+			systemloginlog.FieldUserID:    {Type: field.TypeString, Column: systemloginlog.FieldUserID},
+			systemloginlog.FieldAccount:   {Type: field.TypeString, Column: systemloginlog.FieldAccount},
+			systemloginlog.FieldStatus:    {Type: field.TypeInt8, Column: systemloginlog.FieldStatus},
+			systemloginlog.FieldReason:    {Type: field.TypeString, Column: systemloginlog.FieldReason},
+			systemloginlog.FieldIP:        {Type: field.TypeString, Column: systemloginlog.FieldIP},
+			systemloginlog.FieldUserAgent: {Type: field.TypeString, Column: systemloginlog.FieldUserAgent},
+		},
+	}
+	graph.Nodes[3] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   systemmenu.Table,
+			Columns: systemmenu.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: systemmenu.FieldID,
+			},
+		},
+		Type: "SystemMenu",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			systemmenu.FieldCreateBy:      {Type: field.TypeString, Column: systemmenu.FieldCreateBy},
+			systemmenu.FieldCreatedAt:     {Type: field.TypeTime, Column: systemmenu.FieldCreatedAt},
+			systemmenu.FieldUpdateBy:      {Type: field.TypeString, Column: systemmenu.FieldUpdateBy},
+			systemmenu.FieldUpdatedAt:     {Type: field.TypeTime, Column: systemmenu.FieldUpdatedAt},
+			systemmenu.FieldDeletedAt:     {Type: field.TypeTime, Column: systemmenu.FieldDeletedAt},
+			systemmenu.FieldName:          {Type: field.TypeString, Column: systemmenu.FieldName},
+			systemmenu.FieldPermission:    {Type: field.TypeString, Column: systemmenu.FieldPermission},
+			systemmenu.FieldType:          {Type: field.TypeInt8, Column: systemmenu.FieldType},
+			systemmenu.FieldSort:          {Type: field.TypeInt32, Column: systemmenu.FieldSort},
+			systemmenu.FieldParentID:      {Type: field.TypeString, Column: systemmenu.FieldParentID},
+			systemmenu.FieldPath:          {Type: field.TypeString, Column: systemmenu.FieldPath},
+			systemmenu.FieldIcon:          {Type: field.TypeString, Column: systemmenu.FieldIcon},
+			systemmenu.FieldComponent:     {Type: field.TypeString, Column: systemmenu.FieldComponent},
+			systemmenu.FieldComponentName: {Type: field.TypeString, Column: systemmenu.FieldComponentName},
+			systemmenu.FieldStatus:        {Type: field.TypeInt8, Column: systemmenu.FieldStatus},
+			systemmenu.FieldVisible:       {Type: field.TypeBool, Column: systemmenu.FieldVisible},
+			systemmenu.FieldKeepAlive:     {Type: field.TypeBool, Column: systemmenu.FieldKeepAlive},
+			systemmenu.FieldAlwaysShow:    {Type: field.TypeBool, Column: systemmenu.FieldAlwaysShow},
+		},
+	}
+	graph.Nodes[4] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   systempost.Table,
+			Columns: systempost.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: systempost.FieldID,
+			},
+		},
+		Type: "SystemPost",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			systempost.FieldCreateBy:  {Type: field.TypeString, Column: systempost.FieldCreateBy},
+			systempost.FieldCreatedAt: {Type: field.TypeTime, Column: systempost.FieldCreatedAt},
+			systempost.FieldUpdateBy:  {Type: field.TypeString, Column: systempost.FieldUpdateBy},
+			systempost.FieldUpdatedAt: {Type: field.TypeTime, Column: systempost.FieldUpdatedAt},
+			systempost.FieldTenantID:  {Type: field.TypeString, Column: systempost.FieldTenantID},
+			systempost.FieldDeletedAt: {Type: field.TypeTime, Column: systempost.FieldDeletedAt},
+			systempost.FieldRemark:    {Type: field.TypeString, Column: systempost.FieldRemark},
+			systempost.FieldCode:      {Type: field.TypeString, Column: systempost.FieldCode},
+			systempost.FieldName:      {Type: field.TypeString, Column: systempost.FieldName},
+			systempost.FieldSort:      {Type: field.TypeInt32, Column: systempost.FieldSort},
+			systempost.FieldStatus:    {Type: field.TypeInt8, Column: systempost.FieldStatus},
+		},
+	}
+	graph.Nodes[5] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   systemrole.Table,
+			Columns: systemrole.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: systemrole.FieldID,
+			},
+		},
+		Type: "SystemRole",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			systemrole.FieldCreateBy:         {Type: field.TypeString, Column: systemrole.FieldCreateBy},
+			systemrole.FieldCreatedAt:        {Type: field.TypeTime, Column: systemrole.FieldCreatedAt},
+			systemrole.FieldUpdateBy:         {Type: field.TypeString, Column: systemrole.FieldUpdateBy},
+			systemrole.FieldUpdatedAt:        {Type: field.TypeTime, Column: systemrole.FieldUpdatedAt},
+			systemrole.FieldTenantID:         {Type: field.TypeString, Column: systemrole.FieldTenantID},
+			systemrole.FieldDeletedAt:        {Type: field.TypeTime, Column: systemrole.FieldDeletedAt},
+			systemrole.FieldRemark:           {Type: field.TypeString, Column: systemrole.FieldRemark},
+			systemrole.FieldName:             {Type: field.TypeString, Column: systemrole.FieldName},
+			systemrole.FieldCode:             {Type: field.TypeString, Column: systemrole.FieldCode},
+			systemrole.FieldSort:             {Type: field.TypeInt32, Column: systemrole.FieldSort},
+			systemrole.FieldDataScope:        {Type: field.TypeInt8, Column: systemrole.FieldDataScope},
+			systemrole.FieldDataScopeDeptIds: {Type: field.TypeJSON, Column: systemrole.FieldDataScopeDeptIds},
+			systemrole.FieldStatus:           {Type: field.TypeInt8, Column: systemrole.FieldStatus},
+			systemrole.FieldType:             {Type: field.TypeInt8, Column: systemrole.FieldType},
+		},
+	}
+	graph.Nodes[6] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   systemrolemenu.Table,
+			Columns: systemrolemenu.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: systemrolemenu.FieldID,
+			},
+		},
+		Type: "SystemRoleMenu",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			systemrolemenu.FieldCreateBy:  {Type: field.TypeString, Column: systemrolemenu.FieldCreateBy},
+			systemrolemenu.FieldCreatedAt: {Type: field.TypeTime, Column: systemrolemenu.FieldCreatedAt},
+			systemrolemenu.FieldUpdateBy:  {Type: field.TypeString, Column: systemrolemenu.FieldUpdateBy},
+			systemrolemenu.FieldUpdatedAt: {Type: field.TypeTime, Column: systemrolemenu.FieldUpdatedAt},
+			systemrolemenu.FieldTenantID:  {Type: field.TypeString, Column: systemrolemenu.FieldTenantID},
+			systemrolemenu.FieldDeletedAt: {Type: field.TypeTime, Column: systemrolemenu.FieldDeletedAt},
+			systemrolemenu.FieldRoleID:    {Type: field.TypeString, Column: systemrolemenu.FieldRoleID},
+			systemrolemenu.FieldMenuID:    {Type: field.TypeString, Column: systemrolemenu.FieldMenuID},
+		},
+	}
+	graph.Nodes[7] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   systemtenant.Table,
+			Columns: systemtenant.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: systemtenant.FieldID,
+			},
+		},
+		Type: "SystemTenant",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			systemtenant.FieldCreateBy:      {Type: field.TypeString, Column: systemtenant.FieldCreateBy},
+			systemtenant.FieldCreatedAt:     {Type: field.TypeTime, Column: systemtenant.FieldCreatedAt},
+			systemtenant.FieldUpdateBy:      {Type: field.TypeString, Column: systemtenant.FieldUpdateBy},
+			systemtenant.FieldUpdatedAt:     {Type: field.TypeTime, Column: systemtenant.FieldUpdatedAt},
+			systemtenant.FieldDeletedAt:     {Type: field.TypeTime, Column: systemtenant.FieldDeletedAt},
+			systemtenant.FieldName:          {Type: field.TypeString, Column: systemtenant.FieldName},
+			systemtenant.FieldContactUserID: {Type: field.TypeString, Column: systemtenant.FieldContactUserID},
+			systemtenant.FieldContactName:   {Type: field.TypeString, Column: systemtenant.FieldContactName},
+			systemtenant.FieldContactMobile: {Type: field.TypeString, Column: systemtenant.FieldContactMobile},
+			systemtenant.FieldStatus:        {Type: field.TypeInt8, Column: systemtenant.FieldStatus},
+			systemtenant.FieldWebsite:       {Type: field.TypeString, Column: systemtenant.FieldWebsite},
+			systemtenant.FieldPackageID:     {Type: field.TypeString, Column: systemtenant.FieldPackageID},
+			systemtenant.FieldExpireTime:    {Type: field.TypeTime, Column: systemtenant.FieldExpireTime},
+			systemtenant.FieldAccountCount:  {Type: field.TypeInt32, Column: systemtenant.FieldAccountCount},
+			systemtenant.FieldMfaRequired:   {Type: field.TypeBool, Column: systemtenant.FieldMfaRequired},
+		},
+	}
+	graph.Nodes[8] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   systemtenantpackage.Table,
+			Columns: systemtenantpackage.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: systemtenantpackage.FieldID,
+			},
+		},
+		Type: "SystemTenantPackage",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			systemtenantpackage.FieldCreateBy:  {Type: field.TypeString, Column: systemtenantpackage.FieldCreateBy},
+			systemtenantpackage.FieldCreatedAt: {Type: field.TypeTime, Column: systemtenantpackage.FieldCreatedAt},
+			systemtenantpackage.FieldUpdateBy:  {Type: field.TypeString, Column: systemtenantpackage.FieldUpdateBy},
+			systemtenantpackage.FieldUpdatedAt: {Type: field.TypeTime, Column: systemtenantpackage.FieldUpdatedAt},
+			systemtenantpackage.FieldDeletedAt: {Type: field.TypeTime, Column: systemtenantpackage.FieldDeletedAt},
+			systemtenantpackage.FieldRemark:    {Type: field.TypeString, Column: systemtenantpackage.FieldRemark},
+			systemtenantpackage.FieldName:      {Type: field.TypeString, Column: systemtenantpackage.FieldName},
+			systemtenantpackage.FieldStatus:    {Type: field.TypeInt8, Column: systemtenantpackage.FieldStatus},
+			systemtenantpackage.FieldMenuIds:   {Type: field.TypeJSON, Column: systemtenantpackage.FieldMenuIds},
+		},
+	}
+	graph.Nodes[9] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   systemuser.Table,
 			Columns: systemuser.Columns,
@@ -29,14 +265,13 @@ var schemaGraph = func() *sqlgraph.Schema {
 			systemuser.FieldCreatedAt: {Type: field.TypeTime, Column: systemuser.FieldCreatedAt},
 			systemuser.FieldUpdateBy:  {Type: field.TypeString, Column: systemuser.FieldUpdateBy},
 			systemuser.FieldUpdatedAt: {Type: field.TypeTime, Column: systemuser.FieldUpdatedAt},
-			systemuser.FieldDeletedAt: {Type: field.TypeTime, Column: systemuser.FieldDeletedAt},
 			systemuser.FieldTenantID:  {Type: field.TypeString, Column: systemuser.FieldTenantID},
+			systemuser.FieldDeletedAt: {Type: field.TypeTime, Column: systemuser.FieldDeletedAt},
 			systemuser.FieldAccount:   {Type: field.TypeString, Column: systemuser.FieldAccount},
 			systemuser.FieldPassword:  {Type: field.TypeString, Column: systemuser.FieldPassword},
 			systemuser.FieldNickname:  {Type: field.TypeString, Column: systemuser.FieldNickname},
 			systemuser.FieldRemark:    {Type: field.TypeString, Column: systemuser.FieldRemark},
 			systemuser.FieldDeptID:    {Type: field.TypeString, Column: systemuser.FieldDeptID},
-			systemuser.FieldPostIds:   {Type: field.TypeString, Column: systemuser.FieldPostIds},
 			systemuser.FieldEmail:     {Type: field.TypeString, Column: systemuser.FieldEmail},
 			systemuser.FieldMobile:    {Type: field.TypeString, Column: systemuser.FieldMobile},
 			systemuser.FieldSex:       {Type: field.TypeInt8, Column: systemuser.FieldSex},
@@ -46,13 +281,972 @@ var schemaGraph = func() *sqlgraph.Schema {
 			systemuser.FieldLoginDate: {Type: field.TypeTime, Column: systemuser.FieldLoginDate},
 		},
 	}
+	graph.Nodes[10] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   systemusermfa.Table,
+			Columns: systemusermfa.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: systemusermfa.FieldID,
+			},
+		},
+		Type: "SystemUserMFA",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			systemusermfa.FieldCreatedAt:     {Type: field.TypeTime, Column: systemusermfa.FieldCreatedAt},
+			systemusermfa.FieldUpdatedAt:     {Type: field.TypeTime, Column: systemusermfa.FieldUpdatedAt},
+			systemusermfa.FieldTenantID:      {Type: field.TypeString, Column: systemusermfa.FieldTenantID},
+			systemusermfa.FieldUserID:        {Type: field.TypeString, Column: systemusermfa.FieldUserID},
+			systemusermfa.FieldSecret:        {Type: field.TypeString, Column: systemusermfa.FieldSecret},
+			systemusermfa.FieldEnabled:       {Type: field.TypeBool, Column: systemusermfa.FieldEnabled},
+			systemusermfa.FieldRecoveryCodes: {Type: field.TypeJSON, Column: systemusermfa.FieldRecoveryCodes},
+			systemusermfa.FieldLastUsedStep:  {Type: field.TypeInt64, Column: systemusermfa.FieldLastUsedStep},
+			systemusermfa.FieldEnabledAt:     {Type: field.TypeTime, Column: systemusermfa.FieldEnabledAt},
+		},
+	}
+	graph.Nodes[11] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   systemuserpost.Table,
+			Columns: systemuserpost.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: systemuserpost.FieldID,
+			},
+		},
+		Type: "SystemUserPost",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			systemuserpost.FieldCreateBy:  {Type: field.TypeString, Column: systemuserpost.FieldCreateBy},
+			systemuserpost.FieldCreatedAt: {Type: field.TypeTime, Column: systemuserpost.FieldCreatedAt},
+			systemuserpost.FieldUpdateBy:  {Type: field.TypeString, Column: systemuserpost.FieldUpdateBy},
+			systemuserpost.FieldUpdatedAt: {Type: field.TypeTime, Column: systemuserpost.FieldUpdatedAt},
+			systemuserpost.FieldTenantID:  {Type: field.TypeString, Column: systemuserpost.FieldTenantID},
+			systemuserpost.FieldDeletedAt: {Type: field.TypeTime, Column: systemuserpost.FieldDeletedAt},
+			systemuserpost.FieldUserID:    {Type: field.TypeString, Column: systemuserpost.FieldUserID},
+			systemuserpost.FieldPostID:    {Type: field.TypeString, Column: systemuserpost.FieldPostID},
+		},
+	}
+	graph.Nodes[12] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   systemuserrole.Table,
+			Columns: systemuserrole.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: systemuserrole.FieldID,
+			},
+		},
+		Type: "SystemUserRole",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			systemuserrole.FieldCreateBy:  {Type: field.TypeString, Column: systemuserrole.FieldCreateBy},
+			systemuserrole.FieldCreatedAt: {Type: field.TypeTime, Column: systemuserrole.FieldCreatedAt},
+			systemuserrole.FieldUpdateBy:  {Type: field.TypeString, Column: systemuserrole.FieldUpdateBy},
+			systemuserrole.FieldUpdatedAt: {Type: field.TypeTime, Column: systemuserrole.FieldUpdatedAt},
+			systemuserrole.FieldTenantID:  {Type: field.TypeString, Column: systemuserrole.FieldTenantID},
+			systemuserrole.FieldDeletedAt: {Type: field.TypeTime, Column: systemuserrole.FieldDeletedAt},
+			systemuserrole.FieldUserID:    {Type: field.TypeString, Column: systemuserrole.FieldUserID},
+			systemuserrole.FieldRoleID:    {Type: field.TypeString, Column: systemuserrole.FieldRoleID},
+		},
+	}
 	return graph
 }()
 
-// predicateAdder wraps the addPredicate method.
-// All update, update-one and query builders implement this interface.
-type predicateAdder interface {
-	addPredicate(func(s *sql.Selector))
+// predicateAdder wraps the addPredicate method.
+// All update, update-one and query builders implement this interface.
+type predicateAdder interface {
+	addPredicate(func(s *sql.Selector))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *SystemCasbinRuleQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the SystemCasbinRuleQuery builder.
+func (_q *SystemCasbinRuleQuery) Filter() *SystemCasbinRuleFilter {
+	return &SystemCasbinRuleFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *SystemCasbinRuleMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the SystemCasbinRuleMutation builder.
+func (m *SystemCasbinRuleMutation) Filter() *SystemCasbinRuleFilter {
+	return &SystemCasbinRuleFilter{config: m.config, predicateAdder: m}
+}
+
+// SystemCasbinRuleFilter provides a generic filtering capability at runtime for SystemCasbinRuleQuery.
+type SystemCasbinRuleFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *SystemCasbinRuleFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *SystemCasbinRuleFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(systemcasbinrule.FieldID))
+}
+
+// WhereCreateBy applies the entql string predicate on the create_by field.
+func (f *SystemCasbinRuleFilter) WhereCreateBy(p entql.StringP) {
+	f.Where(p.Field(systemcasbinrule.FieldCreateBy))
+}
+
+// WhereCreatedAt applies the entql times.Time predicate on the created_at field.
+func (f *SystemCasbinRuleFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(systemcasbinrule.FieldCreatedAt))
+}
+
+// WherePtype applies the entql string predicate on the ptype field.
+func (f *SystemCasbinRuleFilter) WherePtype(p entql.StringP) {
+	f.Where(p.Field(systemcasbinrule.FieldPtype))
+}
+
+// WhereV0 applies the entql string predicate on the v0 field.
+func (f *SystemCasbinRuleFilter) WhereV0(p entql.StringP) {
+	f.Where(p.Field(systemcasbinrule.FieldV0))
+}
+
+// WhereV1 applies the entql string predicate on the v1 field.
+func (f *SystemCasbinRuleFilter) WhereV1(p entql.StringP) {
+	f.Where(p.Field(systemcasbinrule.FieldV1))
+}
+
+// WhereV2 applies the entql string predicate on the v2 field.
+func (f *SystemCasbinRuleFilter) WhereV2(p entql.StringP) {
+	f.Where(p.Field(systemcasbinrule.FieldV2))
+}
+
+// WhereV3 applies the entql string predicate on the v3 field.
+func (f *SystemCasbinRuleFilter) WhereV3(p entql.StringP) {
+	f.Where(p.Field(systemcasbinrule.FieldV3))
+}
+
+// WhereV4 applies the entql string predicate on the v4 field.
+func (f *SystemCasbinRuleFilter) WhereV4(p entql.StringP) {
+	f.Where(p.Field(systemcasbinrule.FieldV4))
+}
+
+// WhereV5 applies the entql string predicate on the v5 field.
+func (f *SystemCasbinRuleFilter) WhereV5(p entql.StringP) {
+	f.Where(p.Field(systemcasbinrule.FieldV5))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *SystemDeptQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the SystemDeptQuery builder.
+func (_q *SystemDeptQuery) Filter() *SystemDeptFilter {
+	return &SystemDeptFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *SystemDeptMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the SystemDeptMutation builder.
+func (m *SystemDeptMutation) Filter() *SystemDeptFilter {
+	return &SystemDeptFilter{config: m.config, predicateAdder: m}
+}
+
+// SystemDeptFilter provides a generic filtering capability at runtime for SystemDeptQuery.
+type SystemDeptFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *SystemDeptFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *SystemDeptFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(systemdept.FieldID))
+}
+
+// WhereCreateBy applies the entql string predicate on the create_by field.
+func (f *SystemDeptFilter) WhereCreateBy(p entql.StringP) {
+	f.Where(p.Field(systemdept.FieldCreateBy))
+}
+
+// WhereCreatedAt applies the entql times.Time predicate on the created_at field.
+func (f *SystemDeptFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(systemdept.FieldCreatedAt))
+}
+
+// WhereUpdateBy applies the entql string predicate on the update_by field.
+func (f *SystemDeptFilter) WhereUpdateBy(p entql.StringP) {
+	f.Where(p.Field(systemdept.FieldUpdateBy))
+}
+
+// WhereUpdatedAt applies the entql times.Time predicate on the updated_at field.
+func (f *SystemDeptFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(systemdept.FieldUpdatedAt))
+}
+
+// WhereTenantID applies the entql string predicate on the tenant_id field.
+func (f *SystemDeptFilter) WhereTenantID(p entql.StringP) {
+	f.Where(p.Field(systemdept.FieldTenantID))
+}
+
+// WhereDeletedAt applies the entql times.Time predicate on the deleted_at field.
+func (f *SystemDeptFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(systemdept.FieldDeletedAt))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *SystemDeptFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(systemdept.FieldName))
+}
+
+// WhereParentID applies the entql string predicate on the parent_id field.
+func (f *SystemDeptFilter) WhereParentID(p entql.StringP) {
+	f.Where(p.Field(systemdept.FieldParentID))
+}
+
+// WhereAncestors applies the entql string predicate on the ancestors field.
+func (f *SystemDeptFilter) WhereAncestors(p entql.StringP) {
+	f.Where(p.Field(systemdept.FieldAncestors))
+}
+
+// WhereSort applies the entql int32 predicate on the sort field.
+func (f *SystemDeptFilter) WhereSort(p entql.Int32P) {
+	f.Where(p.Field(systemdept.FieldSort))
+}
+
+// WhereLeaderUserID applies the entql string predicate on the leader_user_id field.
+func (f *SystemDeptFilter) WhereLeaderUserID(p entql.StringP) {
+	f.Where(p.Field(systemdept.FieldLeaderUserID))
+}
+
+// WherePhone applies the entql string predicate on the phone field.
+func (f *SystemDeptFilter) WherePhone(p entql.StringP) {
+	f.Where(p.Field(systemdept.FieldPhone))
+}
+
+// WhereEmail applies the entql string predicate on the email field.
+func (f *SystemDeptFilter) WhereEmail(p entql.StringP) {
+	f.Where(p.Field(systemdept.FieldEmail))
+}
+
+// WhereStatus applies the entql int8 predicate on the status field.
+func (f *SystemDeptFilter) WhereStatus(p entql.Int8P) {
+	f.Where(p.Field(systemdept.FieldStatus))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *SystemLoginLogQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the SystemLoginLogQuery builder.
+func (_q *SystemLoginLogQuery) Filter() *SystemLoginLogFilter {
+	return &SystemLoginLogFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *SystemLoginLogMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the SystemLoginLogMutation builder.
+func (m *SystemLoginLogMutation) Filter() *SystemLoginLogFilter {
+	return &SystemLoginLogFilter{config: m.config, predicateAdder: m}
+}
+
+// SystemLoginLogFilter provides a generic filtering capability at runtime for SystemLoginLogQuery.
+type SystemLoginLogFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *SystemLoginLogFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *SystemLoginLogFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(systemloginlog.FieldID))
+}
+
+// WhereCreatedAt applies the entql times.Time predicate on the created_at field.
+func (f *SystemLoginLogFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(systemloginlog.FieldCreatedAt))
+}
+
+// WhereTenantID applies the entql string predicate on the tenant_id field.
+func (f *SystemLoginLogFilter) WhereTenantID(p entql.StringP) {
+	f.Where(p.Field(systemloginlog.FieldTenantID))
+}
+
+// WhereEvent applies the entql string predicate on the event field.
+func (f *SystemLoginLogFilter) WhereEvent(p entql.StringP) {
+	f.Where(p.Field(systemloginlog.FieldEvent))
+}
+
+// WhereUserID applies the entql string predicate on the user_id field.
+func (f *SystemLoginLogFilter) WhereUserID(p entql.StringP) {
+	f.Where(p.Field(systemloginlog.FieldUserID))
+}
+
+// WhereAccount applies the entql string predicate on the account field.
+func (f *SystemLoginLogFilter) WhereAccount(p entql.StringP) {
+	f.Where(p.Field(systemloginlog.FieldAccount))
+}
+
+// WhereStatus applies the entql int8 predicate on the status field.
+func (f *SystemLoginLogFilter) WhereStatus(p entql.Int8P) {
+	f.Where(p.Field(systemloginlog.FieldStatus))
+}
+
+// WhereReason applies the entql string predicate on the reason field.
+func (f *SystemLoginLogFilter) WhereReason(p entql.StringP) {
+	f.Where(p.Field(systemloginlog.FieldReason))
+}
+
+// WhereIP applies the entql string predicate on the ip field.
+func (f *SystemLoginLogFilter) WhereIP(p entql.StringP) {
+	f.Where(p.Field(systemloginlog.FieldIP))
+}
+
+// WhereUserAgent applies the entql string predicate on the user_agent field.
+func (f *SystemLoginLogFilter) WhereUserAgent(p entql.StringP) {
+	f.Where(p.Field(systemloginlog.FieldUserAgent))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *SystemMenuQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the SystemMenuQuery builder.
+func (_q *SystemMenuQuery) Filter() *SystemMenuFilter {
+	return &SystemMenuFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *SystemMenuMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the SystemMenuMutation builder.
+func (m *SystemMenuMutation) Filter() *SystemMenuFilter {
+	return &SystemMenuFilter{config: m.config, predicateAdder: m}
+}
+
+// SystemMenuFilter provides a generic filtering capability at runtime for SystemMenuQuery.
+type SystemMenuFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *SystemMenuFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *SystemMenuFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(systemmenu.FieldID))
+}
+
+// WhereCreateBy applies the entql string predicate on the create_by field.
+func (f *SystemMenuFilter) WhereCreateBy(p entql.StringP) {
+	f.Where(p.Field(systemmenu.FieldCreateBy))
+}
+
+// WhereCreatedAt applies the entql times.Time predicate on the created_at field.
+func (f *SystemMenuFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(systemmenu.FieldCreatedAt))
+}
+
+// WhereUpdateBy applies the entql string predicate on the update_by field.
+func (f *SystemMenuFilter) WhereUpdateBy(p entql.StringP) {
+	f.Where(p.Field(systemmenu.FieldUpdateBy))
+}
+
+// WhereUpdatedAt applies the entql times.Time predicate on the updated_at field.
+func (f *SystemMenuFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(systemmenu.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql times.Time predicate on the deleted_at field.
+func (f *SystemMenuFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(systemmenu.FieldDeletedAt))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *SystemMenuFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(systemmenu.FieldName))
+}
+
+// WherePermission applies the entql string predicate on the permission field.
+func (f *SystemMenuFilter) WherePermission(p entql.StringP) {
+	f.Where(p.Field(systemmenu.FieldPermission))
+}
+
+// WhereType applies the entql int8 predicate on the type field.
+func (f *SystemMenuFilter) WhereType(p entql.Int8P) {
+	f.Where(p.Field(systemmenu.FieldType))
+}
+
+// WhereSort applies the entql int32 predicate on the sort field.
+func (f *SystemMenuFilter) WhereSort(p entql.Int32P) {
+	f.Where(p.Field(systemmenu.FieldSort))
+}
+
+// WhereParentID applies the entql string predicate on the parent_id field.
+func (f *SystemMenuFilter) WhereParentID(p entql.StringP) {
+	f.Where(p.Field(systemmenu.FieldParentID))
+}
+
+// WherePath applies the entql string predicate on the path field.
+func (f *SystemMenuFilter) WherePath(p entql.StringP) {
+	f.Where(p.Field(systemmenu.FieldPath))
+}
+
+// WhereIcon applies the entql string predicate on the icon field.
+func (f *SystemMenuFilter) WhereIcon(p entql.StringP) {
+	f.Where(p.Field(systemmenu.FieldIcon))
+}
+
+// WhereComponent applies the entql string predicate on the component field.
+func (f *SystemMenuFilter) WhereComponent(p entql.StringP) {
+	f.Where(p.Field(systemmenu.FieldComponent))
+}
+
+// WhereComponentName applies the entql string predicate on the component_name field.
+func (f *SystemMenuFilter) WhereComponentName(p entql.StringP) {
+	f.Where(p.Field(systemmenu.FieldComponentName))
+}
+
+// WhereStatus applies the entql int8 predicate on the status field.
+func (f *SystemMenuFilter) WhereStatus(p entql.Int8P) {
+	f.Where(p.Field(systemmenu.FieldStatus))
+}
+
+// WhereVisible applies the entql bool predicate on the visible field.
+func (f *SystemMenuFilter) WhereVisible(p entql.BoolP) {
+	f.Where(p.Field(systemmenu.FieldVisible))
+}
+
+// WhereKeepAlive applies the entql bool predicate on the keep_alive field.
+func (f *SystemMenuFilter) WhereKeepAlive(p entql.BoolP) {
+	f.Where(p.Field(systemmenu.FieldKeepAlive))
+}
+
+// WhereAlwaysShow applies the entql bool predicate on the always_show field.
+func (f *SystemMenuFilter) WhereAlwaysShow(p entql.BoolP) {
+	f.Where(p.Field(systemmenu.FieldAlwaysShow))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *SystemPostQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the SystemPostQuery builder.
+func (_q *SystemPostQuery) Filter() *SystemPostFilter {
+	return &SystemPostFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *SystemPostMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the SystemPostMutation builder.
+func (m *SystemPostMutation) Filter() *SystemPostFilter {
+	return &SystemPostFilter{config: m.config, predicateAdder: m}
+}
+
+// SystemPostFilter provides a generic filtering capability at runtime for SystemPostQuery.
+type SystemPostFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *SystemPostFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *SystemPostFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(systempost.FieldID))
+}
+
+// WhereCreateBy applies the entql string predicate on the create_by field.
+func (f *SystemPostFilter) WhereCreateBy(p entql.StringP) {
+	f.Where(p.Field(systempost.FieldCreateBy))
+}
+
+// WhereCreatedAt applies the entql times.Time predicate on the created_at field.
+func (f *SystemPostFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(systempost.FieldCreatedAt))
+}
+
+// WhereUpdateBy applies the entql string predicate on the update_by field.
+func (f *SystemPostFilter) WhereUpdateBy(p entql.StringP) {
+	f.Where(p.Field(systempost.FieldUpdateBy))
+}
+
+// WhereUpdatedAt applies the entql times.Time predicate on the updated_at field.
+func (f *SystemPostFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(systempost.FieldUpdatedAt))
+}
+
+// WhereTenantID applies the entql string predicate on the tenant_id field.
+func (f *SystemPostFilter) WhereTenantID(p entql.StringP) {
+	f.Where(p.Field(systempost.FieldTenantID))
+}
+
+// WhereDeletedAt applies the entql times.Time predicate on the deleted_at field.
+func (f *SystemPostFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(systempost.FieldDeletedAt))
+}
+
+// WhereRemark applies the entql string predicate on the remark field.
+func (f *SystemPostFilter) WhereRemark(p entql.StringP) {
+	f.Where(p.Field(systempost.FieldRemark))
+}
+
+// WhereCode applies the entql string predicate on the code field.
+func (f *SystemPostFilter) WhereCode(p entql.StringP) {
+	f.Where(p.Field(systempost.FieldCode))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *SystemPostFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(systempost.FieldName))
+}
+
+// WhereSort applies the entql int32 predicate on the sort field.
+func (f *SystemPostFilter) WhereSort(p entql.Int32P) {
+	f.Where(p.Field(systempost.FieldSort))
+}
+
+// WhereStatus applies the entql int8 predicate on the status field.
+func (f *SystemPostFilter) WhereStatus(p entql.Int8P) {
+	f.Where(p.Field(systempost.FieldStatus))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *SystemRoleQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the SystemRoleQuery builder.
+func (_q *SystemRoleQuery) Filter() *SystemRoleFilter {
+	return &SystemRoleFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *SystemRoleMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the SystemRoleMutation builder.
+func (m *SystemRoleMutation) Filter() *SystemRoleFilter {
+	return &SystemRoleFilter{config: m.config, predicateAdder: m}
+}
+
+// SystemRoleFilter provides a generic filtering capability at runtime for SystemRoleQuery.
+type SystemRoleFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *SystemRoleFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *SystemRoleFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(systemrole.FieldID))
+}
+
+// WhereCreateBy applies the entql string predicate on the create_by field.
+func (f *SystemRoleFilter) WhereCreateBy(p entql.StringP) {
+	f.Where(p.Field(systemrole.FieldCreateBy))
+}
+
+// WhereCreatedAt applies the entql times.Time predicate on the created_at field.
+func (f *SystemRoleFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(systemrole.FieldCreatedAt))
+}
+
+// WhereUpdateBy applies the entql string predicate on the update_by field.
+func (f *SystemRoleFilter) WhereUpdateBy(p entql.StringP) {
+	f.Where(p.Field(systemrole.FieldUpdateBy))
+}
+
+// WhereUpdatedAt applies the entql times.Time predicate on the updated_at field.
+func (f *SystemRoleFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(systemrole.FieldUpdatedAt))
+}
+
+// WhereTenantID applies the entql string predicate on the tenant_id field.
+func (f *SystemRoleFilter) WhereTenantID(p entql.StringP) {
+	f.Where(p.Field(systemrole.FieldTenantID))
+}
+
+// WhereDeletedAt applies the entql times.Time predicate on the deleted_at field.
+func (f *SystemRoleFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(systemrole.FieldDeletedAt))
+}
+
+// WhereRemark applies the entql string predicate on the remark field.
+func (f *SystemRoleFilter) WhereRemark(p entql.StringP) {
+	f.Where(p.Field(systemrole.FieldRemark))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *SystemRoleFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(systemrole.FieldName))
+}
+
+// WhereCode applies the entql string predicate on the code field.
+func (f *SystemRoleFilter) WhereCode(p entql.StringP) {
+	f.Where(p.Field(systemrole.FieldCode))
+}
+
+// WhereSort applies the entql int32 predicate on the sort field.
+func (f *SystemRoleFilter) WhereSort(p entql.Int32P) {
+	f.Where(p.Field(systemrole.FieldSort))
+}
+
+// WhereDataScope applies the entql int8 predicate on the data_scope field.
+func (f *SystemRoleFilter) WhereDataScope(p entql.Int8P) {
+	f.Where(p.Field(systemrole.FieldDataScope))
+}
+
+// WhereDataScopeDeptIds applies the entql json.RawMessage predicate on the data_scope_dept_ids field.
+func (f *SystemRoleFilter) WhereDataScopeDeptIds(p entql.BytesP) {
+	f.Where(p.Field(systemrole.FieldDataScopeDeptIds))
+}
+
+// WhereStatus applies the entql int8 predicate on the status field.
+func (f *SystemRoleFilter) WhereStatus(p entql.Int8P) {
+	f.Where(p.Field(systemrole.FieldStatus))
+}
+
+// WhereType applies the entql int8 predicate on the type field.
+func (f *SystemRoleFilter) WhereType(p entql.Int8P) {
+	f.Where(p.Field(systemrole.FieldType))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *SystemRoleMenuQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the SystemRoleMenuQuery builder.
+func (_q *SystemRoleMenuQuery) Filter() *SystemRoleMenuFilter {
+	return &SystemRoleMenuFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *SystemRoleMenuMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the SystemRoleMenuMutation builder.
+func (m *SystemRoleMenuMutation) Filter() *SystemRoleMenuFilter {
+	return &SystemRoleMenuFilter{config: m.config, predicateAdder: m}
+}
+
+// SystemRoleMenuFilter provides a generic filtering capability at runtime for SystemRoleMenuQuery.
+type SystemRoleMenuFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *SystemRoleMenuFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *SystemRoleMenuFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(systemrolemenu.FieldID))
+}
+
+// WhereCreateBy applies the entql string predicate on the create_by field.
+func (f *SystemRoleMenuFilter) WhereCreateBy(p entql.StringP) {
+	f.Where(p.Field(systemrolemenu.FieldCreateBy))
+}
+
+// WhereCreatedAt applies the entql times.Time predicate on the created_at field.
+func (f *SystemRoleMenuFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(systemrolemenu.FieldCreatedAt))
+}
+
+// WhereUpdateBy applies the entql string predicate on the update_by field.
+func (f *SystemRoleMenuFilter) WhereUpdateBy(p entql.StringP) {
+	f.Where(p.Field(systemrolemenu.FieldUpdateBy))
+}
+
+// WhereUpdatedAt applies the entql times.Time predicate on the updated_at field.
+func (f *SystemRoleMenuFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(systemrolemenu.FieldUpdatedAt))
+}
+
+// WhereTenantID applies the entql string predicate on the tenant_id field.
+func (f *SystemRoleMenuFilter) WhereTenantID(p entql.StringP) {
+	f.Where(p.Field(systemrolemenu.FieldTenantID))
+}
+
+// WhereDeletedAt applies the entql times.Time predicate on the deleted_at field.
+func (f *SystemRoleMenuFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(systemrolemenu.FieldDeletedAt))
+}
+
+// WhereRoleID applies the entql string predicate on the role_id field.
+func (f *SystemRoleMenuFilter) WhereRoleID(p entql.StringP) {
+	f.Where(p.Field(systemrolemenu.FieldRoleID))
+}
+
+// WhereMenuID applies the entql string predicate on the menu_id field.
+func (f *SystemRoleMenuFilter) WhereMenuID(p entql.StringP) {
+	f.Where(p.Field(systemrolemenu.FieldMenuID))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *SystemTenantQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the SystemTenantQuery builder.
+func (_q *SystemTenantQuery) Filter() *SystemTenantFilter {
+	return &SystemTenantFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *SystemTenantMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the SystemTenantMutation builder.
+func (m *SystemTenantMutation) Filter() *SystemTenantFilter {
+	return &SystemTenantFilter{config: m.config, predicateAdder: m}
+}
+
+// SystemTenantFilter provides a generic filtering capability at runtime for SystemTenantQuery.
+type SystemTenantFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *SystemTenantFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *SystemTenantFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(systemtenant.FieldID))
+}
+
+// WhereCreateBy applies the entql string predicate on the create_by field.
+func (f *SystemTenantFilter) WhereCreateBy(p entql.StringP) {
+	f.Where(p.Field(systemtenant.FieldCreateBy))
+}
+
+// WhereCreatedAt applies the entql times.Time predicate on the created_at field.
+func (f *SystemTenantFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(systemtenant.FieldCreatedAt))
+}
+
+// WhereUpdateBy applies the entql string predicate on the update_by field.
+func (f *SystemTenantFilter) WhereUpdateBy(p entql.StringP) {
+	f.Where(p.Field(systemtenant.FieldUpdateBy))
+}
+
+// WhereUpdatedAt applies the entql times.Time predicate on the updated_at field.
+func (f *SystemTenantFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(systemtenant.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql times.Time predicate on the deleted_at field.
+func (f *SystemTenantFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(systemtenant.FieldDeletedAt))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *SystemTenantFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(systemtenant.FieldName))
+}
+
+// WhereContactUserID applies the entql string predicate on the contact_user_id field.
+func (f *SystemTenantFilter) WhereContactUserID(p entql.StringP) {
+	f.Where(p.Field(systemtenant.FieldContactUserID))
+}
+
+// WhereContactName applies the entql string predicate on the contact_name field.
+func (f *SystemTenantFilter) WhereContactName(p entql.StringP) {
+	f.Where(p.Field(systemtenant.FieldContactName))
+}
+
+// WhereContactMobile applies the entql string predicate on the contact_mobile field.
+func (f *SystemTenantFilter) WhereContactMobile(p entql.StringP) {
+	f.Where(p.Field(systemtenant.FieldContactMobile))
+}
+
+// WhereStatus applies the entql int8 predicate on the status field.
+func (f *SystemTenantFilter) WhereStatus(p entql.Int8P) {
+	f.Where(p.Field(systemtenant.FieldStatus))
+}
+
+// WhereWebsite applies the entql string predicate on the website field.
+func (f *SystemTenantFilter) WhereWebsite(p entql.StringP) {
+	f.Where(p.Field(systemtenant.FieldWebsite))
+}
+
+// WherePackageID applies the entql string predicate on the package_id field.
+func (f *SystemTenantFilter) WherePackageID(p entql.StringP) {
+	f.Where(p.Field(systemtenant.FieldPackageID))
+}
+
+// WhereExpireTime applies the entql times.Time predicate on the expire_time field.
+func (f *SystemTenantFilter) WhereExpireTime(p entql.TimeP) {
+	f.Where(p.Field(systemtenant.FieldExpireTime))
+}
+
+// WhereAccountCount applies the entql int32 predicate on the account_count field.
+func (f *SystemTenantFilter) WhereAccountCount(p entql.Int32P) {
+	f.Where(p.Field(systemtenant.FieldAccountCount))
+}
+
+// WhereMfaRequired applies the entql bool predicate on the mfa_required field.
+func (f *SystemTenantFilter) WhereMfaRequired(p entql.BoolP) {
+	f.Where(p.Field(systemtenant.FieldMfaRequired))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *SystemTenantPackageQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the SystemTenantPackageQuery builder.
+func (_q *SystemTenantPackageQuery) Filter() *SystemTenantPackageFilter {
+	return &SystemTenantPackageFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *SystemTenantPackageMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the SystemTenantPackageMutation builder.
+func (m *SystemTenantPackageMutation) Filter() *SystemTenantPackageFilter {
+	return &SystemTenantPackageFilter{config: m.config, predicateAdder: m}
+}
+
+// SystemTenantPackageFilter provides a generic filtering capability at runtime for SystemTenantPackageQuery.
+type SystemTenantPackageFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *SystemTenantPackageFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *SystemTenantPackageFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(systemtenantpackage.FieldID))
+}
+
+// WhereCreateBy applies the entql string predicate on the create_by field.
+func (f *SystemTenantPackageFilter) WhereCreateBy(p entql.StringP) {
+	f.Where(p.Field(systemtenantpackage.FieldCreateBy))
+}
+
+// WhereCreatedAt applies the entql times.Time predicate on the created_at field.
+func (f *SystemTenantPackageFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(systemtenantpackage.FieldCreatedAt))
+}
+
+// WhereUpdateBy applies the entql string predicate on the update_by field.
+func (f *SystemTenantPackageFilter) WhereUpdateBy(p entql.StringP) {
+	f.Where(p.Field(systemtenantpackage.FieldUpdateBy))
+}
+
+// WhereUpdatedAt applies the entql times.Time predicate on the updated_at field.
+func (f *SystemTenantPackageFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(systemtenantpackage.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql times.Time predicate on the deleted_at field.
+func (f *SystemTenantPackageFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(systemtenantpackage.FieldDeletedAt))
+}
+
+// WhereRemark applies the entql string predicate on the remark field.
+func (f *SystemTenantPackageFilter) WhereRemark(p entql.StringP) {
+	f.Where(p.Field(systemtenantpackage.FieldRemark))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *SystemTenantPackageFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(systemtenantpackage.FieldName))
+}
+
+// WhereStatus applies the entql int8 predicate on the status field.
+func (f *SystemTenantPackageFilter) WhereStatus(p entql.Int8P) {
+	f.Where(p.Field(systemtenantpackage.FieldStatus))
+}
+
+// WhereMenuIds applies the entql json.RawMessage predicate on the menu_ids field.
+func (f *SystemTenantPackageFilter) WhereMenuIds(p entql.BytesP) {
+	f.Where(p.Field(systemtenantpackage.FieldMenuIds))
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -84,7 +1278,7 @@ type SystemUserFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *SystemUserFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -115,16 +1309,16 @@ func (f *SystemUserFilter) WhereUpdatedAt(p entql.TimeP) {
 	f.Where(p.Field(systemuser.FieldUpdatedAt))
 }
 
-// WhereDeletedAt applies the entql times.Time predicate on the deleted_at field.
-func (f *SystemUserFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(systemuser.FieldDeletedAt))
-}
-
 // WhereTenantID applies the entql string predicate on the tenant_id field.
 func (f *SystemUserFilter) WhereTenantID(p entql.StringP) {
 	f.Where(p.Field(systemuser.FieldTenantID))
 }
 
+// WhereDeletedAt applies the entql times.Time predicate on the deleted_at field.
+func (f *SystemUserFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(systemuser.FieldDeletedAt))
+}
+
 // WhereAccount applies the entql string predicate on the account field.
 func (f *SystemUserFilter) WhereAccount(p entql.StringP) {
 	f.Where(p.Field(systemuser.FieldAccount))
@@ -150,11 +1344,6 @@ func (f *SystemUserFilter) WhereDeptID(p entql.StringP) {
 	f.Where(p.Field(systemuser.FieldDeptID))
 }
 
-// WherePostIds applies the entql string predicate on the post_ids field.
-func (f *SystemUserFilter) WherePostIds(p entql.StringP) {
-	f.Where(p.Field(systemuser.FieldPostIds))
-}
-
 // WhereEmail applies the entql string predicate on the email field.
 func (f *SystemUserFilter) WhereEmail(p entql.StringP) {
 	f.Where(p.Field(systemuser.FieldEmail))
@@ -189,3 +1378,248 @@ func (f *SystemUserFilter) WhereLoginIP(p entql.StringP) {
 func (f *SystemUserFilter) WhereLoginDate(p entql.TimeP) {
 	f.Where(p.Field(systemuser.FieldLoginDate))
 }
+
+// addPredicate implements the predicateAdder interface.
+func (_q *SystemUserMFAQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the SystemUserMFAQuery builder.
+func (_q *SystemUserMFAQuery) Filter() *SystemUserMFAFilter {
+	return &SystemUserMFAFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *SystemUserMFAMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the SystemUserMFAMutation builder.
+func (m *SystemUserMFAMutation) Filter() *SystemUserMFAFilter {
+	return &SystemUserMFAFilter{config: m.config, predicateAdder: m}
+}
+
+// SystemUserMFAFilter provides a generic filtering capability at runtime for SystemUserMFAQuery.
+type SystemUserMFAFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *SystemUserMFAFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *SystemUserMFAFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(systemusermfa.FieldID))
+}
+
+// WhereCreatedAt applies the entql times.Time predicate on the created_at field.
+func (f *SystemUserMFAFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(systemusermfa.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql times.Time predicate on the updated_at field.
+func (f *SystemUserMFAFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(systemusermfa.FieldUpdatedAt))
+}
+
+// WhereTenantID applies the entql string predicate on the tenant_id field.
+func (f *SystemUserMFAFilter) WhereTenantID(p entql.StringP) {
+	f.Where(p.Field(systemusermfa.FieldTenantID))
+}
+
+// WhereUserID applies the entql string predicate on the user_id field.
+func (f *SystemUserMFAFilter) WhereUserID(p entql.StringP) {
+	f.Where(p.Field(systemusermfa.FieldUserID))
+}
+
+// WhereSecret applies the entql string predicate on the secret field.
+func (f *SystemUserMFAFilter) WhereSecret(p entql.StringP) {
+	f.Where(p.Field(systemusermfa.FieldSecret))
+}
+
+// WhereEnabled applies the entql bool predicate on the enabled field.
+func (f *SystemUserMFAFilter) WhereEnabled(p entql.BoolP) {
+	f.Where(p.Field(systemusermfa.FieldEnabled))
+}
+
+// WhereRecoveryCodes applies the entql json.RawMessage predicate on the recovery_codes field.
+func (f *SystemUserMFAFilter) WhereRecoveryCodes(p entql.BytesP) {
+	f.Where(p.Field(systemusermfa.FieldRecoveryCodes))
+}
+
+// WhereLastUsedStep applies the entql int64 predicate on the last_used_step field.
+func (f *SystemUserMFAFilter) WhereLastUsedStep(p entql.Int64P) {
+	f.Where(p.Field(systemusermfa.FieldLastUsedStep))
+}
+
+// WhereEnabledAt applies the entql times.Time predicate on the enabled_at field.
+func (f *SystemUserMFAFilter) WhereEnabledAt(p entql.TimeP) {
+	f.Where(p.Field(systemusermfa.FieldEnabledAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *SystemUserPostQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the SystemUserPostQuery builder.
+func (_q *SystemUserPostQuery) Filter() *SystemUserPostFilter {
+	return &SystemUserPostFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *SystemUserPostMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the SystemUserPostMutation builder.
+func (m *SystemUserPostMutation) Filter() *SystemUserPostFilter {
+	return &SystemUserPostFilter{config: m.config, predicateAdder: m}
+}
+
+// SystemUserPostFilter provides a generic filtering capability at runtime for SystemUserPostQuery.
+type SystemUserPostFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *SystemUserPostFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *SystemUserPostFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(systemuserpost.FieldID))
+}
+
+// WhereCreateBy applies the entql string predicate on the create_by field.
+func (f *SystemUserPostFilter) WhereCreateBy(p entql.StringP) {
+	f.Where(p.Field(systemuserpost.FieldCreateBy))
+}
+
+// WhereCreatedAt applies the entql times.Time predicate on the created_at field.
+func (f *SystemUserPostFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(systemuserpost.FieldCreatedAt))
+}
+
+// WhereUpdateBy applies the entql string predicate on the update_by field.
+func (f *SystemUserPostFilter) WhereUpdateBy(p entql.StringP) {
+	f.Where(p.Field(systemuserpost.FieldUpdateBy))
+}
+
+// WhereUpdatedAt applies the entql times.Time predicate on the updated_at field.
+func (f *SystemUserPostFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(systemuserpost.FieldUpdatedAt))
+}
+
+// WhereTenantID applies the entql string predicate on the tenant_id field.
+func (f *SystemUserPostFilter) WhereTenantID(p entql.StringP) {
+	f.Where(p.Field(systemuserpost.FieldTenantID))
+}
+
+// WhereDeletedAt applies the entql times.Time predicate on the deleted_at field.
+func (f *SystemUserPostFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(systemuserpost.FieldDeletedAt))
+}
+
+// WhereUserID applies the entql string predicate on the user_id field.
+func (f *SystemUserPostFilter) WhereUserID(p entql.StringP) {
+	f.Where(p.Field(systemuserpost.FieldUserID))
+}
+
+// WherePostID applies the entql string predicate on the post_id field.
+func (f *SystemUserPostFilter) WherePostID(p entql.StringP) {
+	f.Where(p.Field(systemuserpost.FieldPostID))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *SystemUserRoleQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the SystemUserRoleQuery builder.
+func (_q *SystemUserRoleQuery) Filter() *SystemUserRoleFilter {
+	return &SystemUserRoleFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *SystemUserRoleMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the SystemUserRoleMutation builder.
+func (m *SystemUserRoleMutation) Filter() *SystemUserRoleFilter {
+	return &SystemUserRoleFilter{config: m.config, predicateAdder: m}
+}
+
+// SystemUserRoleFilter provides a generic filtering capability at runtime for SystemUserRoleQuery.
+type SystemUserRoleFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *SystemUserRoleFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *SystemUserRoleFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(systemuserrole.FieldID))
+}
+
+// WhereCreateBy applies the entql string predicate on the create_by field.
+func (f *SystemUserRoleFilter) WhereCreateBy(p entql.StringP) {
+	f.Where(p.Field(systemuserrole.FieldCreateBy))
+}
+
+// WhereCreatedAt applies the entql times.Time predicate on the created_at field.
+func (f *SystemUserRoleFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(systemuserrole.FieldCreatedAt))
+}
+
+// WhereUpdateBy applies the entql string predicate on the update_by field.
+func (f *SystemUserRoleFilter) WhereUpdateBy(p entql.StringP) {
+	f.Where(p.Field(systemuserrole.FieldUpdateBy))
+}
+
+// WhereUpdatedAt applies the entql times.Time predicate on the updated_at field.
+func (f *SystemUserRoleFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(systemuserrole.FieldUpdatedAt))
+}
+
+// WhereTenantID applies the entql string predicate on the tenant_id field.
+func (f *SystemUserRoleFilter) WhereTenantID(p entql.StringP) {
+	f.Where(p.Field(systemuserrole.FieldTenantID))
+}
+
+// WhereDeletedAt applies the entql times.Time predicate on the deleted_at field.
+func (f *SystemUserRoleFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(systemuserrole.FieldDeletedAt))
+}
+
+// WhereUserID applies the entql string predicate on the user_id field.
+func (f *SystemUserRoleFilter) WhereUserID(p entql.StringP) {
+	f.Where(p.Field(systemuserrole.FieldUserID))
+}
+
+// WhereRoleID applies the entql string predicate on the role_id field.
+func (f *SystemUserRoleFilter) WhereRoleID(p entql.StringP) {
+	f.Where(p.Field(systemuserrole.FieldRoleID))
+}
@@ -8,6 +8,114 @@ import (
 	"qn-base/app/admin/internal/data/ent"
 )
 
+// The SystemCasbinRuleFunc type is an adapter to allow the use of ordinary
+// function as SystemCasbinRule mutator.
+type SystemCasbinRuleFunc func(context.Context, *ent.SystemCasbinRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SystemCasbinRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SystemCasbinRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SystemCasbinRuleMutation", m)
+}
+
+// The SystemDeptFunc type is an adapter to allow the use of ordinary
+// function as SystemDept mutator.
+type SystemDeptFunc func(context.Context, *ent.SystemDeptMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SystemDeptFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SystemDeptMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SystemDeptMutation", m)
+}
+
+// The SystemLoginLogFunc type is an adapter to allow the use of ordinary
+// function as SystemLoginLog mutator.
+type SystemLoginLogFunc func(context.Context, *ent.SystemLoginLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SystemLoginLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SystemLoginLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SystemLoginLogMutation", m)
+}
+
+// The SystemMenuFunc type is an adapter to allow the use of ordinary
+// function as SystemMenu mutator.
+type SystemMenuFunc func(context.Context, *ent.SystemMenuMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SystemMenuFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SystemMenuMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SystemMenuMutation", m)
+}
+
+// The SystemPostFunc type is an adapter to allow the use of ordinary
+// function as SystemPost mutator.
+type SystemPostFunc func(context.Context, *ent.SystemPostMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SystemPostFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SystemPostMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SystemPostMutation", m)
+}
+
+// The SystemRoleFunc type is an adapter to allow the use of ordinary
+// function as SystemRole mutator.
+type SystemRoleFunc func(context.Context, *ent.SystemRoleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SystemRoleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SystemRoleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SystemRoleMutation", m)
+}
+
+// The SystemRoleMenuFunc type is an adapter to allow the use of ordinary
+// function as SystemRoleMenu mutator.
+type SystemRoleMenuFunc func(context.Context, *ent.SystemRoleMenuMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SystemRoleMenuFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SystemRoleMenuMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SystemRoleMenuMutation", m)
+}
+
+// The SystemTenantFunc type is an adapter to allow the use of ordinary
+// function as SystemTenant mutator.
+type SystemTenantFunc func(context.Context, *ent.SystemTenantMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SystemTenantFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SystemTenantMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SystemTenantMutation", m)
+}
+
+// The SystemTenantPackageFunc type is an adapter to allow the use of ordinary
+// function as SystemTenantPackage mutator.
+type SystemTenantPackageFunc func(context.Context, *ent.SystemTenantPackageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SystemTenantPackageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SystemTenantPackageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SystemTenantPackageMutation", m)
+}
+
 // The SystemUserFunc type is an adapter to allow the use of ordinary
 // function as SystemUser mutator.
 type SystemUserFunc func(context.Context, *ent.SystemUserMutation) (ent.Value, error)
@@ -20,6 +128,42 @@ func (f SystemUserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SystemUserMutation", m)
 }
 
+// The SystemUserMFAFunc type is an adapter to allow the use of ordinary
+// function as SystemUserMFA mutator.
+type SystemUserMFAFunc func(context.Context, *ent.SystemUserMFAMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SystemUserMFAFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SystemUserMFAMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SystemUserMFAMutation", m)
+}
+
+// The SystemUserPostFunc type is an adapter to allow the use of ordinary
+// function as SystemUserPost mutator.
+type SystemUserPostFunc func(context.Context, *ent.SystemUserPostMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SystemUserPostFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SystemUserPostMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SystemUserPostMutation", m)
+}
+
+// The SystemUserRoleFunc type is an adapter to allow the use of ordinary
+// function as SystemUserRole mutator.
+type SystemUserRoleFunc func(context.Context, *ent.SystemUserRoleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SystemUserRoleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SystemUserRoleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SystemUserRoleMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
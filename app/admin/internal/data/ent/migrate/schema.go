@@ -9,6 +9,366 @@ import (
 )
 
 var (
+	// TSystemCasbinRuleColumns holds the columns for the "t_system_casbin_rule" table.
+	TSystemCasbinRuleColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ptype", Type: field.TypeString, Size: 16},
+		{Name: "v0", Type: field.TypeString, Size: 128, Default: ""},
+		{Name: "v1", Type: field.TypeString, Size: 128, Default: ""},
+		{Name: "v2", Type: field.TypeString, Size: 256, Default: ""},
+		{Name: "v3", Type: field.TypeString, Size: 128, Default: ""},
+		{Name: "v4", Type: field.TypeString, Size: 128, Default: ""},
+		{Name: "v5", Type: field.TypeString, Size: 128, Default: ""},
+	}
+	// TSystemCasbinRuleTable holds the schema information for the "t_system_casbin_rule" table.
+	TSystemCasbinRuleTable = &schema.Table{
+		Name:       "t_system_casbin_rule",
+		Columns:    TSystemCasbinRuleColumns,
+		PrimaryKey: []*schema.Column{TSystemCasbinRuleColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "systemcasbinrule_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemCasbinRuleColumns[0]},
+			},
+			{
+				Name:    "systemcasbinrule_ptype_v0_v1_v2_v3_v4_v5",
+				Unique:  true,
+				Columns: []*schema.Column{TSystemCasbinRuleColumns[3], TSystemCasbinRuleColumns[4], TSystemCasbinRuleColumns[5], TSystemCasbinRuleColumns[6], TSystemCasbinRuleColumns[7], TSystemCasbinRuleColumns[8], TSystemCasbinRuleColumns[9]},
+			},
+		},
+	}
+	// TSystemDeptColumns holds the columns for the "t_system_dept" table.
+	TSystemDeptColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "update_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString, Size: 32, Default: ""},
+		{Name: "parent_id", Type: field.TypeString, Size: 32, Default: "0"},
+		{Name: "ancestors", Type: field.TypeString, Size: 1024, Default: "0"},
+		{Name: "sort", Type: field.TypeInt32, Default: 0},
+		{Name: "leader_user_id", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "phone", Type: field.TypeString, Nullable: true, Size: 16},
+		{Name: "email", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "status", Type: field.TypeInt8, Default: 1},
+	}
+	// TSystemDeptTable holds the schema information for the "t_system_dept" table.
+	TSystemDeptTable = &schema.Table{
+		Name:       "t_system_dept",
+		Columns:    TSystemDeptColumns,
+		PrimaryKey: []*schema.Column{TSystemDeptColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "systemdept_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemDeptColumns[0]},
+			},
+			{
+				Name:    "systemdept_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemDeptColumns[5]},
+			},
+			{
+				Name:    "systemdept_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemDeptColumns[8]},
+			},
+			{
+				Name:    "systemdept_ancestors",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemDeptColumns[9]},
+			},
+		},
+	}
+	// TSystemLoginLogColumns holds the columns for the "t_system_login_log" table.
+	TSystemLoginLogColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "event", Type: field.TypeString, Size: 32, Default: "LOGIN"},
+		{Name: "user_id", Type: field.TypeString, Nullable: true},
+		{Name: "account", Type: field.TypeString, Size: 64},
+		{Name: "status", Type: field.TypeInt8},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "ip", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 512},
+	}
+	// TSystemLoginLogTable holds the schema information for the "t_system_login_log" table.
+	TSystemLoginLogTable = &schema.Table{
+		Name:       "t_system_login_log",
+		Columns:    TSystemLoginLogColumns,
+		PrimaryKey: []*schema.Column{TSystemLoginLogColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "systemloginlog_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemLoginLogColumns[0]},
+			},
+			{
+				Name:    "systemloginlog_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemLoginLogColumns[2]},
+			},
+			{
+				Name:    "systemloginlog_tenant_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemLoginLogColumns[2], TSystemLoginLogColumns[1]},
+			},
+			{
+				Name:    "systemloginlog_account",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemLoginLogColumns[5]},
+			},
+			{
+				Name:    "systemloginlog_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemLoginLogColumns[4]},
+			},
+			{
+				Name:    "systemloginlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemLoginLogColumns[1]},
+			},
+		},
+	}
+	// TSystemMenuColumns holds the columns for the "t_system_menu" table.
+	TSystemMenuColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "update_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString, Size: 64},
+		{Name: "permission", Type: field.TypeString, Size: 128, Default: ""},
+		{Name: "type", Type: field.TypeInt8},
+		{Name: "sort", Type: field.TypeInt32, Default: 0},
+		{Name: "parent_id", Type: field.TypeString, Size: 32, Default: "0"},
+		{Name: "path", Type: field.TypeString, Nullable: true, Size: 200},
+		{Name: "icon", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "component", Type: field.TypeString, Nullable: true, Size: 256},
+		{Name: "component_name", Type: field.TypeString, Nullable: true, Size: 256},
+		{Name: "status", Type: field.TypeInt8, Default: 1},
+		{Name: "visible", Type: field.TypeBool, Default: true},
+		{Name: "keep_alive", Type: field.TypeBool, Default: true},
+		{Name: "always_show", Type: field.TypeBool, Default: true},
+	}
+	// TSystemMenuTable holds the schema information for the "t_system_menu" table.
+	TSystemMenuTable = &schema.Table{
+		Name:       "t_system_menu",
+		Columns:    TSystemMenuColumns,
+		PrimaryKey: []*schema.Column{TSystemMenuColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "systemmenu_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemMenuColumns[0]},
+			},
+			{
+				Name:    "systemmenu_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemMenuColumns[10]},
+			},
+		},
+	}
+	// TSystemPostColumns holds the columns for the "t_system_post" table.
+	TSystemPostColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "update_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "remark", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "code", Type: field.TypeString, Size: 64},
+		{Name: "name", Type: field.TypeString, Size: 64},
+		{Name: "sort", Type: field.TypeInt32, Default: 0},
+		{Name: "status", Type: field.TypeInt8, Default: 1},
+	}
+	// TSystemPostTable holds the schema information for the "t_system_post" table.
+	TSystemPostTable = &schema.Table{
+		Name:       "t_system_post",
+		Columns:    TSystemPostColumns,
+		PrimaryKey: []*schema.Column{TSystemPostColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "systempost_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemPostColumns[0]},
+			},
+			{
+				Name:    "systempost_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemPostColumns[5]},
+			},
+			{
+				Name:    "systempost_code",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemPostColumns[8]},
+			},
+		},
+	}
+	// TSystemRoleColumns holds the columns for the "t_system_role" table.
+	TSystemRoleColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "update_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "remark", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "name", Type: field.TypeString, Size: 32},
+		{Name: "code", Type: field.TypeString, Size: 128},
+		{Name: "sort", Type: field.TypeInt32, Default: 0},
+		{Name: "data_scope", Type: field.TypeInt8, Default: 1},
+		{Name: "data_scope_dept_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeInt8, Default: 1},
+		{Name: "type", Type: field.TypeInt8, Default: 2},
+	}
+	// TSystemRoleTable holds the schema information for the "t_system_role" table.
+	TSystemRoleTable = &schema.Table{
+		Name:       "t_system_role",
+		Columns:    TSystemRoleColumns,
+		PrimaryKey: []*schema.Column{TSystemRoleColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "systemrole_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemRoleColumns[0]},
+			},
+			{
+				Name:    "systemrole_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemRoleColumns[5]},
+			},
+			{
+				Name:    "systemrole_code",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemRoleColumns[9]},
+			},
+		},
+	}
+	// TSystemRoleMenuColumns holds the columns for the "t_system_role_menu" table.
+	TSystemRoleMenuColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "update_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "role_id", Type: field.TypeString, Size: 32},
+		{Name: "menu_id", Type: field.TypeString, Size: 32},
+	}
+	// TSystemRoleMenuTable holds the schema information for the "t_system_role_menu" table.
+	TSystemRoleMenuTable = &schema.Table{
+		Name:       "t_system_role_menu",
+		Columns:    TSystemRoleMenuColumns,
+		PrimaryKey: []*schema.Column{TSystemRoleMenuColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "systemrolemenu_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemRoleMenuColumns[0]},
+			},
+			{
+				Name:    "systemrolemenu_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemRoleMenuColumns[5]},
+			},
+			{
+				Name:    "systemrolemenu_role_id_menu_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemRoleMenuColumns[7], TSystemRoleMenuColumns[8]},
+			},
+			{
+				Name:    "systemrolemenu_menu_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemRoleMenuColumns[8]},
+			},
+		},
+	}
+	// TSystemTenantColumns holds the columns for the "t_system_tenant" table.
+	TSystemTenantColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "update_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString, Size: 32},
+		{Name: "contact_user_id", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "contact_name", Type: field.TypeString, Size: 32},
+		{Name: "contact_mobile", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "status", Type: field.TypeInt8, Default: 1},
+		{Name: "website", Type: field.TypeString, Nullable: true, Size: 256, Default: ""},
+		{Name: "package_id", Type: field.TypeString, Size: 32},
+		{Name: "expire_time", Type: field.TypeTime},
+		{Name: "account_count", Type: field.TypeInt32},
+		{Name: "mfa_required", Type: field.TypeBool, Default: false},
+	}
+	// TSystemTenantTable holds the schema information for the "t_system_tenant" table.
+	TSystemTenantTable = &schema.Table{
+		Name:       "t_system_tenant",
+		Columns:    TSystemTenantColumns,
+		PrimaryKey: []*schema.Column{TSystemTenantColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "systemtenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemTenantColumns[0]},
+			},
+			{
+				Name:    "systemtenant_name",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemTenantColumns[6]},
+			},
+			{
+				Name:    "systemtenant_package_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemTenantColumns[12]},
+			},
+		},
+	}
+	// TSystemTenantPackageColumns holds the columns for the "t_system_tenant_package" table.
+	TSystemTenantPackageColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "update_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "remark", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "name", Type: field.TypeString, Size: 32},
+		{Name: "status", Type: field.TypeInt8, Default: 1},
+		{Name: "menu_ids", Type: field.TypeJSON, Nullable: true},
+	}
+	// TSystemTenantPackageTable holds the schema information for the "t_system_tenant_package" table.
+	TSystemTenantPackageTable = &schema.Table{
+		Name:       "t_system_tenant_package",
+		Columns:    TSystemTenantPackageColumns,
+		PrimaryKey: []*schema.Column{TSystemTenantPackageColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "systemtenantpackage_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemTenantPackageColumns[0]},
+			},
+			{
+				Name:    "systemtenantpackage_name",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemTenantPackageColumns[7]},
+			},
+		},
+	}
 	// TSystemUserColumns holds the columns for the "t_system_user" table.
 	TSystemUserColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -16,14 +376,13 @@ var (
 		{Name: "created_at", Type: field.TypeTime, Nullable: true},
 		{Name: "update_by", Type: field.TypeString, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeString},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
-		{Name: "tenant_id", Type: field.TypeString, Unique: true},
-		{Name: "account", Type: field.TypeString, Unique: true},
+		{Name: "account", Type: field.TypeString},
 		{Name: "password", Type: field.TypeString, Nullable: true},
 		{Name: "nickname", Type: field.TypeString, Nullable: true},
 		{Name: "remark", Type: field.TypeString, Nullable: true},
 		{Name: "dept_id", Type: field.TypeString, Nullable: true},
-		{Name: "post_ids", Type: field.TypeString, Nullable: true},
 		{Name: "email", Type: field.TypeString, Nullable: true},
 		{Name: "mobile", Type: field.TypeString, Nullable: true},
 		{Name: "sex", Type: field.TypeInt8, Nullable: true, Default: 0},
@@ -46,18 +405,187 @@ var (
 			{
 				Name:    "systemuser_tenant_id",
 				Unique:  false,
-				Columns: []*schema.Column{TSystemUserColumns[6]},
+				Columns: []*schema.Column{TSystemUserColumns[5]},
+			},
+			{
+				Name:    "systemuser_tenant_id_account",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemUserColumns[5], TSystemUserColumns[7]},
+			},
+		},
+	}
+	// TSystemUserMfaColumns holds the columns for the "t_system_user_mfa" table.
+	TSystemUserMfaColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString, Size: 32},
+		{Name: "secret", Type: field.TypeString, Size: 64},
+		{Name: "enabled", Type: field.TypeBool, Default: false},
+		{Name: "recovery_codes", Type: field.TypeJSON, Nullable: true},
+		{Name: "last_used_step", Type: field.TypeInt64, Default: 0},
+		{Name: "enabled_at", Type: field.TypeTime, Nullable: true},
+	}
+	// TSystemUserMfaTable holds the schema information for the "t_system_user_mfa" table.
+	TSystemUserMfaTable = &schema.Table{
+		Name:       "t_system_user_mfa",
+		Columns:    TSystemUserMfaColumns,
+		PrimaryKey: []*schema.Column{TSystemUserMfaColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "systemusermfa_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemUserMfaColumns[0]},
+			},
+			{
+				Name:    "systemusermfa_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemUserMfaColumns[3]},
+			},
+			{
+				Name:    "systemusermfa_tenant_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{TSystemUserMfaColumns[3], TSystemUserMfaColumns[4]},
+			},
+		},
+	}
+	// TSystemUserPostColumns holds the columns for the "t_system_user_post" table.
+	TSystemUserPostColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "update_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, Size: 32},
+		{Name: "post_id", Type: field.TypeString, Size: 32},
+	}
+	// TSystemUserPostTable holds the schema information for the "t_system_user_post" table.
+	TSystemUserPostTable = &schema.Table{
+		Name:       "t_system_user_post",
+		Columns:    TSystemUserPostColumns,
+		PrimaryKey: []*schema.Column{TSystemUserPostColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "systemuserpost_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemUserPostColumns[0]},
+			},
+			{
+				Name:    "systemuserpost_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemUserPostColumns[5]},
+			},
+			{
+				Name:    "systemuserpost_user_id_post_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemUserPostColumns[7], TSystemUserPostColumns[8]},
+			},
+			{
+				Name:    "systemuserpost_post_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemUserPostColumns[8]},
+			},
+		},
+	}
+	// TSystemUserRoleColumns holds the columns for the "t_system_user_role" table.
+	TSystemUserRoleColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "update_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, Size: 32},
+		{Name: "role_id", Type: field.TypeString, Size: 32},
+	}
+	// TSystemUserRoleTable holds the schema information for the "t_system_user_role" table.
+	TSystemUserRoleTable = &schema.Table{
+		Name:       "t_system_user_role",
+		Columns:    TSystemUserRoleColumns,
+		PrimaryKey: []*schema.Column{TSystemUserRoleColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "systemuserrole_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemUserRoleColumns[0]},
+			},
+			{
+				Name:    "systemuserrole_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemUserRoleColumns[5]},
+			},
+			{
+				Name:    "systemuserrole_user_id_role_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemUserRoleColumns[7], TSystemUserRoleColumns[8]},
+			},
+			{
+				Name:    "systemuserrole_role_id",
+				Unique:  false,
+				Columns: []*schema.Column{TSystemUserRoleColumns[8]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		TSystemCasbinRuleTable,
+		TSystemDeptTable,
+		TSystemLoginLogTable,
+		TSystemMenuTable,
+		TSystemPostTable,
+		TSystemRoleTable,
+		TSystemRoleMenuTable,
+		TSystemTenantTable,
+		TSystemTenantPackageTable,
 		TSystemUserTable,
+		TSystemUserMfaTable,
+		TSystemUserPostTable,
+		TSystemUserRoleTable,
 	}
 )
 
 func init() {
+	TSystemCasbinRuleTable.Annotation = &entsql.Annotation{
+		Table: "t_system_casbin_rule",
+	}
+	TSystemDeptTable.Annotation = &entsql.Annotation{
+		Table: "t_system_dept",
+	}
+	TSystemLoginLogTable.Annotation = &entsql.Annotation{
+		Table: "t_system_login_log",
+	}
+	TSystemMenuTable.Annotation = &entsql.Annotation{
+		Table: "t_system_menu",
+	}
+	TSystemPostTable.Annotation = &entsql.Annotation{
+		Table: "t_system_post",
+	}
+	TSystemRoleTable.Annotation = &entsql.Annotation{
+		Table: "t_system_role",
+	}
+	TSystemRoleMenuTable.Annotation = &entsql.Annotation{
+		Table: "t_system_role_menu",
+	}
+	TSystemTenantTable.Annotation = &entsql.Annotation{
+		Table: "t_system_tenant",
+	}
+	TSystemTenantPackageTable.Annotation = &entsql.Annotation{
+		Table: "t_system_tenant_package",
+	}
 	TSystemUserTable.Annotation = &entsql.Annotation{
 		Table: "t_system_user",
 	}
+	TSystemUserMfaTable.Annotation = &entsql.Annotation{
+		Table: "t_system_user_mfa",
+	}
+	TSystemUserPostTable.Annotation = &entsql.Annotation{
+		Table: "t_system_user_post",
+	}
+	TSystemUserRoleTable.Annotation = &entsql.Annotation{
+		Table: "t_system_user_role",
+	}
 }
@@ -7,7 +7,19 @@ import (
 	"errors"
 	"fmt"
 	"qn-base/app/admin/internal/data/ent/predicate"
+	"qn-base/app/admin/internal/data/ent/systemcasbinrule"
+	"qn-base/app/admin/internal/data/ent/systemdept"
+	"qn-base/app/admin/internal/data/ent/systemloginlog"
+	"qn-base/app/admin/internal/data/ent/systemmenu"
+	"qn-base/app/admin/internal/data/ent/systempost"
+	"qn-base/app/admin/internal/data/ent/systemrole"
+	"qn-base/app/admin/internal/data/ent/systemrolemenu"
+	"qn-base/app/admin/internal/data/ent/systemtenant"
+	"qn-base/app/admin/internal/data/ent/systemtenantpackage"
 	"qn-base/app/admin/internal/data/ent/systemuser"
+	"qn-base/app/admin/internal/data/ent/systemusermfa"
+	"qn-base/app/admin/internal/data/ent/systemuserpost"
+	"qn-base/app/admin/internal/data/ent/systemuserrole"
 	"sync"
 	"time"
 
@@ -24,53 +36,53 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeSystemUser = "SystemUser"
+	TypeSystemCasbinRule    = "SystemCasbinRule"
+	TypeSystemDept          = "SystemDept"
+	TypeSystemLoginLog      = "SystemLoginLog"
+	TypeSystemMenu          = "SystemMenu"
+	TypeSystemPost          = "SystemPost"
+	TypeSystemRole          = "SystemRole"
+	TypeSystemRoleMenu      = "SystemRoleMenu"
+	TypeSystemTenant        = "SystemTenant"
+	TypeSystemTenantPackage = "SystemTenantPackage"
+	TypeSystemUser          = "SystemUser"
+	TypeSystemUserMFA       = "SystemUserMFA"
+	TypeSystemUserPost      = "SystemUserPost"
+	TypeSystemUserRole      = "SystemUserRole"
 )
 
-// SystemUserMutation represents an operation that mutates the SystemUser nodes in the graph.
-type SystemUserMutation struct {
+// SystemCasbinRuleMutation represents an operation that mutates the SystemCasbinRule nodes in the graph.
+type SystemCasbinRuleMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *string
 	create_by     *string
 	created_at    *time.Time
-	update_by     *string
-	updated_at    *time.Time
-	deleted_at    *time.Time
-	tenant_id     *string
-	account       *string
-	password      *string
-	nickname      *string
-	remark        *string
-	dept_id       *string
-	post_ids      *string
-	email         *string
-	mobile        *string
-	sex           *int8
-	addsex        *int8
-	avatar        *string
-	status        *int8
-	addstatus     *int8
-	login_ip      *string
-	login_date    *time.Time
+	ptype         *string
+	v0            *string
+	v1            *string
+	v2            *string
+	v3            *string
+	v4            *string
+	v5            *string
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*SystemUser, error)
-	predicates    []predicate.SystemUser
+	oldValue      func(context.Context) (*SystemCasbinRule, error)
+	predicates    []predicate.SystemCasbinRule
 }
 
-var _ ent.Mutation = (*SystemUserMutation)(nil)
+var _ ent.Mutation = (*SystemCasbinRuleMutation)(nil)
 
-// systemuserOption allows management of the mutation configuration using functional options.
-type systemuserOption func(*SystemUserMutation)
+// systemcasbinruleOption allows management of the mutation configuration using functional options.
+type systemcasbinruleOption func(*SystemCasbinRuleMutation)
 
-// newSystemUserMutation creates new mutation for the SystemUser entity.
-func newSystemUserMutation(c config, op Op, opts ...systemuserOption) *SystemUserMutation {
-	m := &SystemUserMutation{
+// newSystemCasbinRuleMutation creates new mutation for the SystemCasbinRule entity.
+func newSystemCasbinRuleMutation(c config, op Op, opts ...systemcasbinruleOption) *SystemCasbinRuleMutation {
+	m := &SystemCasbinRuleMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSystemUser,
+		typ:           TypeSystemCasbinRule,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -79,20 +91,20 @@ func newSystemUserMutation(c config, op Op, opts ...systemuserOption) *SystemUse
 	return m
 }
 
-// withSystemUserID sets the ID field of the mutation.
-func withSystemUserID(id string) systemuserOption {
-	return func(m *SystemUserMutation) {
+// withSystemCasbinRuleID sets the ID field of the mutation.
+func withSystemCasbinRuleID(id string) systemcasbinruleOption {
+	return func(m *SystemCasbinRuleMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SystemUser
+			value *SystemCasbinRule
 		)
-		m.oldValue = func(ctx context.Context) (*SystemUser, error) {
+		m.oldValue = func(ctx context.Context) (*SystemCasbinRule, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SystemUser.Get(ctx, id)
+					value, err = m.Client().SystemCasbinRule.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -101,10 +113,10 @@ func withSystemUserID(id string) systemuserOption {
 	}
 }
 
-// withSystemUser sets the old SystemUser of the mutation.
-func withSystemUser(node *SystemUser) systemuserOption {
-	return func(m *SystemUserMutation) {
-		m.oldValue = func(context.Context) (*SystemUser, error) {
+// withSystemCasbinRule sets the old SystemCasbinRule of the mutation.
+func withSystemCasbinRule(node *SystemCasbinRule) systemcasbinruleOption {
+	return func(m *SystemCasbinRuleMutation) {
+		m.oldValue = func(context.Context) (*SystemCasbinRule, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -113,7 +125,7 @@ func withSystemUser(node *SystemUser) systemuserOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SystemUserMutation) Client() *Client {
+func (m SystemCasbinRuleMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -121,7 +133,7 @@ func (m SystemUserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SystemUserMutation) Tx() (*Tx, error) {
+func (m SystemCasbinRuleMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -131,14 +143,14 @@ func (m SystemUserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of SystemUser entities.
-func (m *SystemUserMutation) SetID(id string) {
+// operation is only accepted on creation of SystemCasbinRule entities.
+func (m *SystemCasbinRuleMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SystemUserMutation) ID() (id string, exists bool) {
+func (m *SystemCasbinRuleMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -149,7 +161,7 @@ func (m *SystemUserMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SystemUserMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *SystemCasbinRuleMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -158,19 +170,19 @@ func (m *SystemUserMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SystemUser.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SystemCasbinRule.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateBy sets the "create_by" field.
-func (m *SystemUserMutation) SetCreateBy(s string) {
+func (m *SystemCasbinRuleMutation) SetCreateBy(s string) {
 	m.create_by = &s
 }
 
 // CreateBy returns the value of the "create_by" field in the mutation.
-func (m *SystemUserMutation) CreateBy() (r string, exists bool) {
+func (m *SystemCasbinRuleMutation) CreateBy() (r string, exists bool) {
 	v := m.create_by
 	if v == nil {
 		return
@@ -178,10 +190,10 @@ func (m *SystemUserMutation) CreateBy() (r string, exists bool) {
 	return *v, true
 }
 
-// OldCreateBy returns the old "create_by" field's value of the SystemUser entity.
-// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateBy returns the old "create_by" field's value of the SystemCasbinRule entity.
+// If the SystemCasbinRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemUserMutation) OldCreateBy(ctx context.Context) (v *string, err error) {
+func (m *SystemCasbinRuleMutation) OldCreateBy(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
 	}
@@ -196,30 +208,30 @@ func (m *SystemUserMutation) OldCreateBy(ctx context.Context) (v *string, err er
 }
 
 // ClearCreateBy clears the value of the "create_by" field.
-func (m *SystemUserMutation) ClearCreateBy() {
+func (m *SystemCasbinRuleMutation) ClearCreateBy() {
 	m.create_by = nil
-	m.clearedFields[systemuser.FieldCreateBy] = struct{}{}
+	m.clearedFields[systemcasbinrule.FieldCreateBy] = struct{}{}
 }
 
 // CreateByCleared returns if the "create_by" field was cleared in this mutation.
-func (m *SystemUserMutation) CreateByCleared() bool {
-	_, ok := m.clearedFields[systemuser.FieldCreateBy]
+func (m *SystemCasbinRuleMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[systemcasbinrule.FieldCreateBy]
 	return ok
 }
 
 // ResetCreateBy resets all changes to the "create_by" field.
-func (m *SystemUserMutation) ResetCreateBy() {
+func (m *SystemCasbinRuleMutation) ResetCreateBy() {
 	m.create_by = nil
-	delete(m.clearedFields, systemuser.FieldCreateBy)
+	delete(m.clearedFields, systemcasbinrule.FieldCreateBy)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *SystemUserMutation) SetCreatedAt(t time.Time) {
+func (m *SystemCasbinRuleMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SystemUserMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *SystemCasbinRuleMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -227,10 +239,10 @@ func (m *SystemUserMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the SystemUser entity.
-// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the SystemCasbinRule entity.
+// If the SystemCasbinRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemUserMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *SystemCasbinRuleMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -245,881 +257,13558 @@ func (m *SystemUserMutation) OldCreatedAt(ctx context.Context) (v *time.Time, er
 }
 
 // ClearCreatedAt clears the value of the "created_at" field.
-func (m *SystemUserMutation) ClearCreatedAt() {
+func (m *SystemCasbinRuleMutation) ClearCreatedAt() {
 	m.created_at = nil
-	m.clearedFields[systemuser.FieldCreatedAt] = struct{}{}
+	m.clearedFields[systemcasbinrule.FieldCreatedAt] = struct{}{}
 }
 
 // CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
-func (m *SystemUserMutation) CreatedAtCleared() bool {
-	_, ok := m.clearedFields[systemuser.FieldCreatedAt]
+func (m *SystemCasbinRuleMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[systemcasbinrule.FieldCreatedAt]
 	return ok
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SystemUserMutation) ResetCreatedAt() {
+func (m *SystemCasbinRuleMutation) ResetCreatedAt() {
 	m.created_at = nil
-	delete(m.clearedFields, systemuser.FieldCreatedAt)
+	delete(m.clearedFields, systemcasbinrule.FieldCreatedAt)
 }
 
-// SetUpdateBy sets the "update_by" field.
-func (m *SystemUserMutation) SetUpdateBy(s string) {
-	m.update_by = &s
+// SetPtype sets the "ptype" field.
+func (m *SystemCasbinRuleMutation) SetPtype(s string) {
+	m.ptype = &s
 }
 
-// UpdateBy returns the value of the "update_by" field in the mutation.
-func (m *SystemUserMutation) UpdateBy() (r string, exists bool) {
-	v := m.update_by
+// Ptype returns the value of the "ptype" field in the mutation.
+func (m *SystemCasbinRuleMutation) Ptype() (r string, exists bool) {
+	v := m.ptype
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdateBy returns the old "update_by" field's value of the SystemUser entity.
-// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// OldPtype returns the old "ptype" field's value of the SystemCasbinRule entity.
+// If the SystemCasbinRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemUserMutation) OldUpdateBy(ctx context.Context) (v *string, err error) {
+func (m *SystemCasbinRuleMutation) OldPtype(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldPtype is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateBy requires an ID field in the mutation")
+		return v, errors.New("OldPtype requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldPtype: %w", err)
 	}
-	return oldValue.UpdateBy, nil
-}
-
-// ClearUpdateBy clears the value of the "update_by" field.
-func (m *SystemUserMutation) ClearUpdateBy() {
-	m.update_by = nil
-	m.clearedFields[systemuser.FieldUpdateBy] = struct{}{}
-}
-
-// UpdateByCleared returns if the "update_by" field was cleared in this mutation.
-func (m *SystemUserMutation) UpdateByCleared() bool {
-	_, ok := m.clearedFields[systemuser.FieldUpdateBy]
-	return ok
+	return oldValue.Ptype, nil
 }
 
-// ResetUpdateBy resets all changes to the "update_by" field.
-func (m *SystemUserMutation) ResetUpdateBy() {
-	m.update_by = nil
-	delete(m.clearedFields, systemuser.FieldUpdateBy)
+// ResetPtype resets all changes to the "ptype" field.
+func (m *SystemCasbinRuleMutation) ResetPtype() {
+	m.ptype = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *SystemUserMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetV0 sets the "v0" field.
+func (m *SystemCasbinRuleMutation) SetV0(s string) {
+	m.v0 = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *SystemUserMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// V0 returns the value of the "v0" field in the mutation.
+func (m *SystemCasbinRuleMutation) V0() (r string, exists bool) {
+	v := m.v0
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the SystemUser entity.
-// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// OldV0 returns the old "v0" field's value of the SystemCasbinRule entity.
+// If the SystemCasbinRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemUserMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *SystemCasbinRuleMutation) OldV0(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldV0 is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldV0 requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldV0: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (m *SystemUserMutation) ClearUpdatedAt() {
-	m.updated_at = nil
-	m.clearedFields[systemuser.FieldUpdatedAt] = struct{}{}
-}
-
-// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
-func (m *SystemUserMutation) UpdatedAtCleared() bool {
-	_, ok := m.clearedFields[systemuser.FieldUpdatedAt]
-	return ok
+	return oldValue.V0, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *SystemUserMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-	delete(m.clearedFields, systemuser.FieldUpdatedAt)
+// ResetV0 resets all changes to the "v0" field.
+func (m *SystemCasbinRuleMutation) ResetV0() {
+	m.v0 = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *SystemUserMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetV1 sets the "v1" field.
+func (m *SystemCasbinRuleMutation) SetV1(s string) {
+	m.v1 = &s
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *SystemUserMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// V1 returns the value of the "v1" field in the mutation.
+func (m *SystemCasbinRuleMutation) V1() (r string, exists bool) {
+	v := m.v1
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the SystemUser entity.
-// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// OldV1 returns the old "v1" field's value of the SystemCasbinRule entity.
+// If the SystemCasbinRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemUserMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *SystemCasbinRuleMutation) OldV1(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldV1 is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldV1 requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldV1: %w", err)
 	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *SystemUserMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[systemuser.FieldDeletedAt] = struct{}{}
-}
-
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *SystemUserMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[systemuser.FieldDeletedAt]
-	return ok
+	return oldValue.V1, nil
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *SystemUserMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, systemuser.FieldDeletedAt)
+// ResetV1 resets all changes to the "v1" field.
+func (m *SystemCasbinRuleMutation) ResetV1() {
+	m.v1 = nil
 }
 
-// SetTenantID sets the "tenant_id" field.
-func (m *SystemUserMutation) SetTenantID(s string) {
-	m.tenant_id = &s
+// SetV2 sets the "v2" field.
+func (m *SystemCasbinRuleMutation) SetV2(s string) {
+	m.v2 = &s
 }
 
-// TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *SystemUserMutation) TenantID() (r string, exists bool) {
-	v := m.tenant_id
+// V2 returns the value of the "v2" field in the mutation.
+func (m *SystemCasbinRuleMutation) V2() (r string, exists bool) {
+	v := m.v2
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the SystemUser entity.
-// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// OldV2 returns the old "v2" field's value of the SystemCasbinRule entity.
+// If the SystemCasbinRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemUserMutation) OldTenantID(ctx context.Context) (v string, err error) {
+func (m *SystemCasbinRuleMutation) OldV2(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+		return v, errors.New("OldV2 is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTenantID requires an ID field in the mutation")
+		return v, errors.New("OldV2 requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+		return v, fmt.Errorf("querying old value for OldV2: %w", err)
 	}
-	return oldValue.TenantID, nil
+	return oldValue.V2, nil
 }
 
-// ResetTenantID resets all changes to the "tenant_id" field.
-func (m *SystemUserMutation) ResetTenantID() {
-	m.tenant_id = nil
+// ResetV2 resets all changes to the "v2" field.
+func (m *SystemCasbinRuleMutation) ResetV2() {
+	m.v2 = nil
 }
 
-// SetAccount sets the "account" field.
-func (m *SystemUserMutation) SetAccount(s string) {
-	m.account = &s
+// SetV3 sets the "v3" field.
+func (m *SystemCasbinRuleMutation) SetV3(s string) {
+	m.v3 = &s
 }
 
-// Account returns the value of the "account" field in the mutation.
-func (m *SystemUserMutation) Account() (r string, exists bool) {
-	v := m.account
+// V3 returns the value of the "v3" field in the mutation.
+func (m *SystemCasbinRuleMutation) V3() (r string, exists bool) {
+	v := m.v3
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAccount returns the old "account" field's value of the SystemUser entity.
-// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// OldV3 returns the old "v3" field's value of the SystemCasbinRule entity.
+// If the SystemCasbinRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemUserMutation) OldAccount(ctx context.Context) (v string, err error) {
+func (m *SystemCasbinRuleMutation) OldV3(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
+		return v, errors.New("OldV3 is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccount requires an ID field in the mutation")
+		return v, errors.New("OldV3 requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
+		return v, fmt.Errorf("querying old value for OldV3: %w", err)
 	}
-	return oldValue.Account, nil
+	return oldValue.V3, nil
 }
 
-// ResetAccount resets all changes to the "account" field.
-func (m *SystemUserMutation) ResetAccount() {
-	m.account = nil
+// ResetV3 resets all changes to the "v3" field.
+func (m *SystemCasbinRuleMutation) ResetV3() {
+	m.v3 = nil
 }
 
-// SetPassword sets the "password" field.
-func (m *SystemUserMutation) SetPassword(s string) {
-	m.password = &s
+// SetV4 sets the "v4" field.
+func (m *SystemCasbinRuleMutation) SetV4(s string) {
+	m.v4 = &s
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *SystemUserMutation) Password() (r string, exists bool) {
-	v := m.password
+// V4 returns the value of the "v4" field in the mutation.
+func (m *SystemCasbinRuleMutation) V4() (r string, exists bool) {
+	v := m.v4
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the SystemUser entity.
-// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// OldV4 returns the old "v4" field's value of the SystemCasbinRule entity.
+// If the SystemCasbinRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemUserMutation) OldPassword(ctx context.Context) (v *string, err error) {
+func (m *SystemCasbinRuleMutation) OldV4(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldV4 is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+		return v, errors.New("OldV4 requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldV4: %w", err)
 	}
-	return oldValue.Password, nil
-}
-
-// ClearPassword clears the value of the "password" field.
-func (m *SystemUserMutation) ClearPassword() {
-	m.password = nil
-	m.clearedFields[systemuser.FieldPassword] = struct{}{}
-}
-
-// PasswordCleared returns if the "password" field was cleared in this mutation.
-func (m *SystemUserMutation) PasswordCleared() bool {
-	_, ok := m.clearedFields[systemuser.FieldPassword]
-	return ok
+	return oldValue.V4, nil
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *SystemUserMutation) ResetPassword() {
-	m.password = nil
-	delete(m.clearedFields, systemuser.FieldPassword)
+// ResetV4 resets all changes to the "v4" field.
+func (m *SystemCasbinRuleMutation) ResetV4() {
+	m.v4 = nil
 }
 
-// SetNickname sets the "nickname" field.
-func (m *SystemUserMutation) SetNickname(s string) {
-	m.nickname = &s
+// SetV5 sets the "v5" field.
+func (m *SystemCasbinRuleMutation) SetV5(s string) {
+	m.v5 = &s
 }
 
-// Nickname returns the value of the "nickname" field in the mutation.
-func (m *SystemUserMutation) Nickname() (r string, exists bool) {
-	v := m.nickname
+// V5 returns the value of the "v5" field in the mutation.
+func (m *SystemCasbinRuleMutation) V5() (r string, exists bool) {
+	v := m.v5
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNickname returns the old "nickname" field's value of the SystemUser entity.
-// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// OldV5 returns the old "v5" field's value of the SystemCasbinRule entity.
+// If the SystemCasbinRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemUserMutation) OldNickname(ctx context.Context) (v *string, err error) {
+func (m *SystemCasbinRuleMutation) OldV5(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
+		return v, errors.New("OldV5 is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNickname requires an ID field in the mutation")
+		return v, errors.New("OldV5 requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
+		return v, fmt.Errorf("querying old value for OldV5: %w", err)
 	}
-	return oldValue.Nickname, nil
+	return oldValue.V5, nil
 }
 
-// ClearNickname clears the value of the "nickname" field.
-func (m *SystemUserMutation) ClearNickname() {
-	m.nickname = nil
-	m.clearedFields[systemuser.FieldNickname] = struct{}{}
+// ResetV5 resets all changes to the "v5" field.
+func (m *SystemCasbinRuleMutation) ResetV5() {
+	m.v5 = nil
 }
 
-// NicknameCleared returns if the "nickname" field was cleared in this mutation.
-func (m *SystemUserMutation) NicknameCleared() bool {
-	_, ok := m.clearedFields[systemuser.FieldNickname]
-	return ok
+// Where appends a list predicates to the SystemCasbinRuleMutation builder.
+func (m *SystemCasbinRuleMutation) Where(ps ...predicate.SystemCasbinRule) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetNickname resets all changes to the "nickname" field.
-func (m *SystemUserMutation) ResetNickname() {
-	m.nickname = nil
-	delete(m.clearedFields, systemuser.FieldNickname)
+// WhereP appends storage-level predicates to the SystemCasbinRuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SystemCasbinRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SystemCasbinRule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetRemark sets the "remark" field.
-func (m *SystemUserMutation) SetRemark(s string) {
-	m.remark = &s
+// Op returns the operation name.
+func (m *SystemCasbinRuleMutation) Op() Op {
+	return m.op
 }
 
-// Remark returns the value of the "remark" field in the mutation.
-func (m *SystemUserMutation) Remark() (r string, exists bool) {
-	v := m.remark
-	if v == nil {
+// SetOp allows setting the mutation operation.
+func (m *SystemCasbinRuleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SystemCasbinRule).
+func (m *SystemCasbinRuleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SystemCasbinRuleMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.create_by != nil {
+		fields = append(fields, systemcasbinrule.FieldCreateBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, systemcasbinrule.FieldCreatedAt)
+	}
+	if m.ptype != nil {
+		fields = append(fields, systemcasbinrule.FieldPtype)
+	}
+	if m.v0 != nil {
+		fields = append(fields, systemcasbinrule.FieldV0)
+	}
+	if m.v1 != nil {
+		fields = append(fields, systemcasbinrule.FieldV1)
+	}
+	if m.v2 != nil {
+		fields = append(fields, systemcasbinrule.FieldV2)
+	}
+	if m.v3 != nil {
+		fields = append(fields, systemcasbinrule.FieldV3)
+	}
+	if m.v4 != nil {
+		fields = append(fields, systemcasbinrule.FieldV4)
+	}
+	if m.v5 != nil {
+		fields = append(fields, systemcasbinrule.FieldV5)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SystemCasbinRuleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case systemcasbinrule.FieldCreateBy:
+		return m.CreateBy()
+	case systemcasbinrule.FieldCreatedAt:
+		return m.CreatedAt()
+	case systemcasbinrule.FieldPtype:
+		return m.Ptype()
+	case systemcasbinrule.FieldV0:
+		return m.V0()
+	case systemcasbinrule.FieldV1:
+		return m.V1()
+	case systemcasbinrule.FieldV2:
+		return m.V2()
+	case systemcasbinrule.FieldV3:
+		return m.V3()
+	case systemcasbinrule.FieldV4:
+		return m.V4()
+	case systemcasbinrule.FieldV5:
+		return m.V5()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SystemCasbinRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case systemcasbinrule.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case systemcasbinrule.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case systemcasbinrule.FieldPtype:
+		return m.OldPtype(ctx)
+	case systemcasbinrule.FieldV0:
+		return m.OldV0(ctx)
+	case systemcasbinrule.FieldV1:
+		return m.OldV1(ctx)
+	case systemcasbinrule.FieldV2:
+		return m.OldV2(ctx)
+	case systemcasbinrule.FieldV3:
+		return m.OldV3(ctx)
+	case systemcasbinrule.FieldV4:
+		return m.OldV4(ctx)
+	case systemcasbinrule.FieldV5:
+		return m.OldV5(ctx)
+	}
+	return nil, fmt.Errorf("unknown SystemCasbinRule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemCasbinRuleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case systemcasbinrule.FieldCreateBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateBy(v)
+		return nil
+	case systemcasbinrule.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case systemcasbinrule.FieldPtype:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPtype(v)
+		return nil
+	case systemcasbinrule.FieldV0:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetV0(v)
+		return nil
+	case systemcasbinrule.FieldV1:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetV1(v)
+		return nil
+	case systemcasbinrule.FieldV2:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetV2(v)
+		return nil
+	case systemcasbinrule.FieldV3:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetV3(v)
+		return nil
+	case systemcasbinrule.FieldV4:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetV4(v)
+		return nil
+	case systemcasbinrule.FieldV5:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetV5(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SystemCasbinRule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SystemCasbinRuleMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SystemCasbinRuleMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemCasbinRuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SystemCasbinRule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SystemCasbinRuleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(systemcasbinrule.FieldCreateBy) {
+		fields = append(fields, systemcasbinrule.FieldCreateBy)
+	}
+	if m.FieldCleared(systemcasbinrule.FieldCreatedAt) {
+		fields = append(fields, systemcasbinrule.FieldCreatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SystemCasbinRuleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SystemCasbinRuleMutation) ClearField(name string) error {
+	switch name {
+	case systemcasbinrule.FieldCreateBy:
+		m.ClearCreateBy()
+		return nil
+	case systemcasbinrule.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemCasbinRule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SystemCasbinRuleMutation) ResetField(name string) error {
+	switch name {
+	case systemcasbinrule.FieldCreateBy:
+		m.ResetCreateBy()
+		return nil
+	case systemcasbinrule.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case systemcasbinrule.FieldPtype:
+		m.ResetPtype()
+		return nil
+	case systemcasbinrule.FieldV0:
+		m.ResetV0()
+		return nil
+	case systemcasbinrule.FieldV1:
+		m.ResetV1()
+		return nil
+	case systemcasbinrule.FieldV2:
+		m.ResetV2()
+		return nil
+	case systemcasbinrule.FieldV3:
+		m.ResetV3()
+		return nil
+	case systemcasbinrule.FieldV4:
+		m.ResetV4()
+		return nil
+	case systemcasbinrule.FieldV5:
+		m.ResetV5()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemCasbinRule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SystemCasbinRuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SystemCasbinRuleMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SystemCasbinRuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SystemCasbinRuleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SystemCasbinRuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SystemCasbinRuleMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SystemCasbinRuleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SystemCasbinRule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SystemCasbinRuleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SystemCasbinRule edge %s", name)
+}
+
+// SystemDeptMutation represents an operation that mutates the SystemDept nodes in the graph.
+type SystemDeptMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *string
+	create_by      *string
+	created_at     *time.Time
+	update_by      *string
+	updated_at     *time.Time
+	tenant_id      *string
+	deleted_at     *time.Time
+	name           *string
+	parent_id      *string
+	ancestors      *string
+	sort           *int32
+	addsort        *int32
+	leader_user_id *string
+	phone          *string
+	email          *string
+	status         *int8
+	addstatus      *int8
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*SystemDept, error)
+	predicates     []predicate.SystemDept
+}
+
+var _ ent.Mutation = (*SystemDeptMutation)(nil)
+
+// systemdeptOption allows management of the mutation configuration using functional options.
+type systemdeptOption func(*SystemDeptMutation)
+
+// newSystemDeptMutation creates new mutation for the SystemDept entity.
+func newSystemDeptMutation(c config, op Op, opts ...systemdeptOption) *SystemDeptMutation {
+	m := &SystemDeptMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSystemDept,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSystemDeptID sets the ID field of the mutation.
+func withSystemDeptID(id string) systemdeptOption {
+	return func(m *SystemDeptMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SystemDept
+		)
+		m.oldValue = func(ctx context.Context) (*SystemDept, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SystemDept.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSystemDept sets the old SystemDept of the mutation.
+func withSystemDept(node *SystemDept) systemdeptOption {
+	return func(m *SystemDeptMutation) {
+		m.oldValue = func(context.Context) (*SystemDept, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SystemDeptMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SystemDeptMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SystemDept entities.
+func (m *SystemDeptMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SystemDeptMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SystemDeptMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SystemDept.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateBy sets the "create_by" field.
+func (m *SystemDeptMutation) SetCreateBy(s string) {
+	m.create_by = &s
+}
+
+// CreateBy returns the value of the "create_by" field in the mutation.
+func (m *SystemDeptMutation) CreateBy() (r string, exists bool) {
+	v := m.create_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateBy returns the old "create_by" field's value of the SystemDept entity.
+// If the SystemDept object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemDeptMutation) OldCreateBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
+	}
+	return oldValue.CreateBy, nil
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (m *SystemDeptMutation) ClearCreateBy() {
+	m.create_by = nil
+	m.clearedFields[systemdept.FieldCreateBy] = struct{}{}
+}
+
+// CreateByCleared returns if the "create_by" field was cleared in this mutation.
+func (m *SystemDeptMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[systemdept.FieldCreateBy]
+	return ok
+}
+
+// ResetCreateBy resets all changes to the "create_by" field.
+func (m *SystemDeptMutation) ResetCreateBy() {
+	m.create_by = nil
+	delete(m.clearedFields, systemdept.FieldCreateBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SystemDeptMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SystemDeptMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SystemDept entity.
+// If the SystemDept object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemDeptMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *SystemDeptMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[systemdept.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *SystemDeptMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[systemdept.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SystemDeptMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, systemdept.FieldCreatedAt)
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (m *SystemDeptMutation) SetUpdateBy(s string) {
+	m.update_by = &s
+}
+
+// UpdateBy returns the value of the "update_by" field in the mutation.
+func (m *SystemDeptMutation) UpdateBy() (r string, exists bool) {
+	v := m.update_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateBy returns the old "update_by" field's value of the SystemDept entity.
+// If the SystemDept object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemDeptMutation) OldUpdateBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateBy: %w", err)
+	}
+	return oldValue.UpdateBy, nil
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (m *SystemDeptMutation) ClearUpdateBy() {
+	m.update_by = nil
+	m.clearedFields[systemdept.FieldUpdateBy] = struct{}{}
+}
+
+// UpdateByCleared returns if the "update_by" field was cleared in this mutation.
+func (m *SystemDeptMutation) UpdateByCleared() bool {
+	_, ok := m.clearedFields[systemdept.FieldUpdateBy]
+	return ok
+}
+
+// ResetUpdateBy resets all changes to the "update_by" field.
+func (m *SystemDeptMutation) ResetUpdateBy() {
+	m.update_by = nil
+	delete(m.clearedFields, systemdept.FieldUpdateBy)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SystemDeptMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SystemDeptMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SystemDept entity.
+// If the SystemDept object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemDeptMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *SystemDeptMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[systemdept.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *SystemDeptMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[systemdept.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SystemDeptMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, systemdept.FieldUpdatedAt)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *SystemDeptMutation) SetTenantID(s string) {
+	m.tenant_id = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *SystemDeptMutation) TenantID() (r string, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the SystemDept entity.
+// If the SystemDept object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemDeptMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *SystemDeptMutation) ResetTenantID() {
+	m.tenant_id = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *SystemDeptMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *SystemDeptMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the SystemDept entity.
+// If the SystemDept object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemDeptMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *SystemDeptMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[systemdept.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *SystemDeptMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[systemdept.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *SystemDeptMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, systemdept.FieldDeletedAt)
+}
+
+// SetName sets the "name" field.
+func (m *SystemDeptMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SystemDeptMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the SystemDept entity.
+// If the SystemDept object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemDeptMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SystemDeptMutation) ResetName() {
+	m.name = nil
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *SystemDeptMutation) SetParentID(s string) {
+	m.parent_id = &s
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *SystemDeptMutation) ParentID() (r string, exists bool) {
+	v := m.parent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the SystemDept entity.
+// If the SystemDept object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemDeptMutation) OldParentID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *SystemDeptMutation) ResetParentID() {
+	m.parent_id = nil
+}
+
+// SetAncestors sets the "ancestors" field.
+func (m *SystemDeptMutation) SetAncestors(s string) {
+	m.ancestors = &s
+}
+
+// Ancestors returns the value of the "ancestors" field in the mutation.
+func (m *SystemDeptMutation) Ancestors() (r string, exists bool) {
+	v := m.ancestors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAncestors returns the old "ancestors" field's value of the SystemDept entity.
+// If the SystemDept object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemDeptMutation) OldAncestors(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAncestors is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAncestors requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAncestors: %w", err)
+	}
+	return oldValue.Ancestors, nil
+}
+
+// ResetAncestors resets all changes to the "ancestors" field.
+func (m *SystemDeptMutation) ResetAncestors() {
+	m.ancestors = nil
+}
+
+// SetSort sets the "sort" field.
+func (m *SystemDeptMutation) SetSort(i int32) {
+	m.sort = &i
+	m.addsort = nil
+}
+
+// Sort returns the value of the "sort" field in the mutation.
+func (m *SystemDeptMutation) Sort() (r int32, exists bool) {
+	v := m.sort
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSort returns the old "sort" field's value of the SystemDept entity.
+// If the SystemDept object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemDeptMutation) OldSort(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSort is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSort requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSort: %w", err)
+	}
+	return oldValue.Sort, nil
+}
+
+// AddSort adds i to the "sort" field.
+func (m *SystemDeptMutation) AddSort(i int32) {
+	if m.addsort != nil {
+		*m.addsort += i
+	} else {
+		m.addsort = &i
+	}
+}
+
+// AddedSort returns the value that was added to the "sort" field in this mutation.
+func (m *SystemDeptMutation) AddedSort() (r int32, exists bool) {
+	v := m.addsort
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSort resets all changes to the "sort" field.
+func (m *SystemDeptMutation) ResetSort() {
+	m.sort = nil
+	m.addsort = nil
+}
+
+// SetLeaderUserID sets the "leader_user_id" field.
+func (m *SystemDeptMutation) SetLeaderUserID(s string) {
+	m.leader_user_id = &s
+}
+
+// LeaderUserID returns the value of the "leader_user_id" field in the mutation.
+func (m *SystemDeptMutation) LeaderUserID() (r string, exists bool) {
+	v := m.leader_user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeaderUserID returns the old "leader_user_id" field's value of the SystemDept entity.
+// If the SystemDept object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemDeptMutation) OldLeaderUserID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeaderUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeaderUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeaderUserID: %w", err)
+	}
+	return oldValue.LeaderUserID, nil
+}
+
+// ClearLeaderUserID clears the value of the "leader_user_id" field.
+func (m *SystemDeptMutation) ClearLeaderUserID() {
+	m.leader_user_id = nil
+	m.clearedFields[systemdept.FieldLeaderUserID] = struct{}{}
+}
+
+// LeaderUserIDCleared returns if the "leader_user_id" field was cleared in this mutation.
+func (m *SystemDeptMutation) LeaderUserIDCleared() bool {
+	_, ok := m.clearedFields[systemdept.FieldLeaderUserID]
+	return ok
+}
+
+// ResetLeaderUserID resets all changes to the "leader_user_id" field.
+func (m *SystemDeptMutation) ResetLeaderUserID() {
+	m.leader_user_id = nil
+	delete(m.clearedFields, systemdept.FieldLeaderUserID)
+}
+
+// SetPhone sets the "phone" field.
+func (m *SystemDeptMutation) SetPhone(s string) {
+	m.phone = &s
+}
+
+// Phone returns the value of the "phone" field in the mutation.
+func (m *SystemDeptMutation) Phone() (r string, exists bool) {
+	v := m.phone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhone returns the old "phone" field's value of the SystemDept entity.
+// If the SystemDept object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemDeptMutation) OldPhone(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhone: %w", err)
+	}
+	return oldValue.Phone, nil
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (m *SystemDeptMutation) ClearPhone() {
+	m.phone = nil
+	m.clearedFields[systemdept.FieldPhone] = struct{}{}
+}
+
+// PhoneCleared returns if the "phone" field was cleared in this mutation.
+func (m *SystemDeptMutation) PhoneCleared() bool {
+	_, ok := m.clearedFields[systemdept.FieldPhone]
+	return ok
+}
+
+// ResetPhone resets all changes to the "phone" field.
+func (m *SystemDeptMutation) ResetPhone() {
+	m.phone = nil
+	delete(m.clearedFields, systemdept.FieldPhone)
+}
+
+// SetEmail sets the "email" field.
+func (m *SystemDeptMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *SystemDeptMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the SystemDept entity.
+// If the SystemDept object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemDeptMutation) OldEmail(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ClearEmail clears the value of the "email" field.
+func (m *SystemDeptMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[systemdept.FieldEmail] = struct{}{}
+}
+
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *SystemDeptMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[systemdept.FieldEmail]
+	return ok
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *SystemDeptMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, systemdept.FieldEmail)
+}
+
+// SetStatus sets the "status" field.
+func (m *SystemDeptMutation) SetStatus(i int8) {
+	m.status = &i
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SystemDeptMutation) Status() (r int8, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the SystemDept entity.
+// If the SystemDept object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemDeptMutation) OldStatus(ctx context.Context) (v int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds i to the "status" field.
+func (m *SystemDeptMutation) AddStatus(i int8) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *SystemDeptMutation) AddedStatus() (r int8, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SystemDeptMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+}
+
+// Where appends a list predicates to the SystemDeptMutation builder.
+func (m *SystemDeptMutation) Where(ps ...predicate.SystemDept) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SystemDeptMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SystemDeptMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SystemDept, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SystemDeptMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SystemDeptMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SystemDept).
+func (m *SystemDeptMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SystemDeptMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.create_by != nil {
+		fields = append(fields, systemdept.FieldCreateBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, systemdept.FieldCreatedAt)
+	}
+	if m.update_by != nil {
+		fields = append(fields, systemdept.FieldUpdateBy)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, systemdept.FieldUpdatedAt)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, systemdept.FieldTenantID)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, systemdept.FieldDeletedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, systemdept.FieldName)
+	}
+	if m.parent_id != nil {
+		fields = append(fields, systemdept.FieldParentID)
+	}
+	if m.ancestors != nil {
+		fields = append(fields, systemdept.FieldAncestors)
+	}
+	if m.sort != nil {
+		fields = append(fields, systemdept.FieldSort)
+	}
+	if m.leader_user_id != nil {
+		fields = append(fields, systemdept.FieldLeaderUserID)
+	}
+	if m.phone != nil {
+		fields = append(fields, systemdept.FieldPhone)
+	}
+	if m.email != nil {
+		fields = append(fields, systemdept.FieldEmail)
+	}
+	if m.status != nil {
+		fields = append(fields, systemdept.FieldStatus)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SystemDeptMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case systemdept.FieldCreateBy:
+		return m.CreateBy()
+	case systemdept.FieldCreatedAt:
+		return m.CreatedAt()
+	case systemdept.FieldUpdateBy:
+		return m.UpdateBy()
+	case systemdept.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case systemdept.FieldTenantID:
+		return m.TenantID()
+	case systemdept.FieldDeletedAt:
+		return m.DeletedAt()
+	case systemdept.FieldName:
+		return m.Name()
+	case systemdept.FieldParentID:
+		return m.ParentID()
+	case systemdept.FieldAncestors:
+		return m.Ancestors()
+	case systemdept.FieldSort:
+		return m.Sort()
+	case systemdept.FieldLeaderUserID:
+		return m.LeaderUserID()
+	case systemdept.FieldPhone:
+		return m.Phone()
+	case systemdept.FieldEmail:
+		return m.Email()
+	case systemdept.FieldStatus:
+		return m.Status()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SystemDeptMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case systemdept.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case systemdept.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case systemdept.FieldUpdateBy:
+		return m.OldUpdateBy(ctx)
+	case systemdept.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case systemdept.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case systemdept.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case systemdept.FieldName:
+		return m.OldName(ctx)
+	case systemdept.FieldParentID:
+		return m.OldParentID(ctx)
+	case systemdept.FieldAncestors:
+		return m.OldAncestors(ctx)
+	case systemdept.FieldSort:
+		return m.OldSort(ctx)
+	case systemdept.FieldLeaderUserID:
+		return m.OldLeaderUserID(ctx)
+	case systemdept.FieldPhone:
+		return m.OldPhone(ctx)
+	case systemdept.FieldEmail:
+		return m.OldEmail(ctx)
+	case systemdept.FieldStatus:
+		return m.OldStatus(ctx)
+	}
+	return nil, fmt.Errorf("unknown SystemDept field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemDeptMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case systemdept.FieldCreateBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateBy(v)
+		return nil
+	case systemdept.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case systemdept.FieldUpdateBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateBy(v)
+		return nil
+	case systemdept.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case systemdept.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case systemdept.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case systemdept.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case systemdept.FieldParentID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case systemdept.FieldAncestors:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAncestors(v)
+		return nil
+	case systemdept.FieldSort:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSort(v)
+		return nil
+	case systemdept.FieldLeaderUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeaderUserID(v)
+		return nil
+	case systemdept.FieldPhone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhone(v)
+		return nil
+	case systemdept.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case systemdept.FieldStatus:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SystemDept field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SystemDeptMutation) AddedFields() []string {
+	var fields []string
+	if m.addsort != nil {
+		fields = append(fields, systemdept.FieldSort)
+	}
+	if m.addstatus != nil {
+		fields = append(fields, systemdept.FieldStatus)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SystemDeptMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case systemdept.FieldSort:
+		return m.AddedSort()
+	case systemdept.FieldStatus:
+		return m.AddedStatus()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemDeptMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case systemdept.FieldSort:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSort(v)
+		return nil
+	case systemdept.FieldStatus:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SystemDept numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SystemDeptMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(systemdept.FieldCreateBy) {
+		fields = append(fields, systemdept.FieldCreateBy)
+	}
+	if m.FieldCleared(systemdept.FieldCreatedAt) {
+		fields = append(fields, systemdept.FieldCreatedAt)
+	}
+	if m.FieldCleared(systemdept.FieldUpdateBy) {
+		fields = append(fields, systemdept.FieldUpdateBy)
+	}
+	if m.FieldCleared(systemdept.FieldUpdatedAt) {
+		fields = append(fields, systemdept.FieldUpdatedAt)
+	}
+	if m.FieldCleared(systemdept.FieldDeletedAt) {
+		fields = append(fields, systemdept.FieldDeletedAt)
+	}
+	if m.FieldCleared(systemdept.FieldLeaderUserID) {
+		fields = append(fields, systemdept.FieldLeaderUserID)
+	}
+	if m.FieldCleared(systemdept.FieldPhone) {
+		fields = append(fields, systemdept.FieldPhone)
+	}
+	if m.FieldCleared(systemdept.FieldEmail) {
+		fields = append(fields, systemdept.FieldEmail)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SystemDeptMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SystemDeptMutation) ClearField(name string) error {
+	switch name {
+	case systemdept.FieldCreateBy:
+		m.ClearCreateBy()
+		return nil
+	case systemdept.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case systemdept.FieldUpdateBy:
+		m.ClearUpdateBy()
+		return nil
+	case systemdept.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case systemdept.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case systemdept.FieldLeaderUserID:
+		m.ClearLeaderUserID()
+		return nil
+	case systemdept.FieldPhone:
+		m.ClearPhone()
+		return nil
+	case systemdept.FieldEmail:
+		m.ClearEmail()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemDept nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SystemDeptMutation) ResetField(name string) error {
+	switch name {
+	case systemdept.FieldCreateBy:
+		m.ResetCreateBy()
+		return nil
+	case systemdept.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case systemdept.FieldUpdateBy:
+		m.ResetUpdateBy()
+		return nil
+	case systemdept.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case systemdept.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case systemdept.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case systemdept.FieldName:
+		m.ResetName()
+		return nil
+	case systemdept.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case systemdept.FieldAncestors:
+		m.ResetAncestors()
+		return nil
+	case systemdept.FieldSort:
+		m.ResetSort()
+		return nil
+	case systemdept.FieldLeaderUserID:
+		m.ResetLeaderUserID()
+		return nil
+	case systemdept.FieldPhone:
+		m.ResetPhone()
+		return nil
+	case systemdept.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case systemdept.FieldStatus:
+		m.ResetStatus()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemDept field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SystemDeptMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SystemDeptMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SystemDeptMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SystemDeptMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SystemDeptMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SystemDeptMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SystemDeptMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SystemDept unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SystemDeptMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SystemDept edge %s", name)
+}
+
+// SystemLoginLogMutation represents an operation that mutates the SystemLoginLog nodes in the graph.
+type SystemLoginLogMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	created_at    *time.Time
+	tenant_id     *string
+	event         *string
+	user_id       *string
+	account       *string
+	status        *int8
+	addstatus     *int8
+	reason        *string
+	ip            *string
+	user_agent    *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SystemLoginLog, error)
+	predicates    []predicate.SystemLoginLog
+}
+
+var _ ent.Mutation = (*SystemLoginLogMutation)(nil)
+
+// systemloginlogOption allows management of the mutation configuration using functional options.
+type systemloginlogOption func(*SystemLoginLogMutation)
+
+// newSystemLoginLogMutation creates new mutation for the SystemLoginLog entity.
+func newSystemLoginLogMutation(c config, op Op, opts ...systemloginlogOption) *SystemLoginLogMutation {
+	m := &SystemLoginLogMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSystemLoginLog,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSystemLoginLogID sets the ID field of the mutation.
+func withSystemLoginLogID(id string) systemloginlogOption {
+	return func(m *SystemLoginLogMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SystemLoginLog
+		)
+		m.oldValue = func(ctx context.Context) (*SystemLoginLog, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SystemLoginLog.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSystemLoginLog sets the old SystemLoginLog of the mutation.
+func withSystemLoginLog(node *SystemLoginLog) systemloginlogOption {
+	return func(m *SystemLoginLogMutation) {
+		m.oldValue = func(context.Context) (*SystemLoginLog, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SystemLoginLogMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SystemLoginLogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SystemLoginLog entities.
+func (m *SystemLoginLogMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SystemLoginLogMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SystemLoginLogMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SystemLoginLog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SystemLoginLogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SystemLoginLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SystemLoginLog entity.
+// If the SystemLoginLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemLoginLogMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *SystemLoginLogMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[systemloginlog.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *SystemLoginLogMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[systemloginlog.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SystemLoginLogMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, systemloginlog.FieldCreatedAt)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *SystemLoginLogMutation) SetTenantID(s string) {
+	m.tenant_id = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *SystemLoginLogMutation) TenantID() (r string, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the SystemLoginLog entity.
+// If the SystemLoginLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemLoginLogMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *SystemLoginLogMutation) ResetTenantID() {
+	m.tenant_id = nil
+}
+
+// SetEvent sets the "event" field.
+func (m *SystemLoginLogMutation) SetEvent(s string) {
+	m.event = &s
+}
+
+// Event returns the value of the "event" field in the mutation.
+func (m *SystemLoginLogMutation) Event() (r string, exists bool) {
+	v := m.event
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvent returns the old "event" field's value of the SystemLoginLog entity.
+// If the SystemLoginLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemLoginLogMutation) OldEvent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvent: %w", err)
+	}
+	return oldValue.Event, nil
+}
+
+// ResetEvent resets all changes to the "event" field.
+func (m *SystemLoginLogMutation) ResetEvent() {
+	m.event = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SystemLoginLogMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SystemLoginLogMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the SystemLoginLog entity.
+// If the SystemLoginLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemLoginLogMutation) OldUserID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *SystemLoginLogMutation) ClearUserID() {
+	m.user_id = nil
+	m.clearedFields[systemloginlog.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *SystemLoginLogMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[systemloginlog.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SystemLoginLogMutation) ResetUserID() {
+	m.user_id = nil
+	delete(m.clearedFields, systemloginlog.FieldUserID)
+}
+
+// SetAccount sets the "account" field.
+func (m *SystemLoginLogMutation) SetAccount(s string) {
+	m.account = &s
+}
+
+// Account returns the value of the "account" field in the mutation.
+func (m *SystemLoginLogMutation) Account() (r string, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccount returns the old "account" field's value of the SystemLoginLog entity.
+// If the SystemLoginLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemLoginLogMutation) OldAccount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
+	}
+	return oldValue.Account, nil
+}
+
+// ResetAccount resets all changes to the "account" field.
+func (m *SystemLoginLogMutation) ResetAccount() {
+	m.account = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *SystemLoginLogMutation) SetStatus(i int8) {
+	m.status = &i
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SystemLoginLogMutation) Status() (r int8, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the SystemLoginLog entity.
+// If the SystemLoginLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemLoginLogMutation) OldStatus(ctx context.Context) (v int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds i to the "status" field.
+func (m *SystemLoginLogMutation) AddStatus(i int8) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *SystemLoginLogMutation) AddedStatus() (r int8, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SystemLoginLogMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *SystemLoginLogMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *SystemLoginLogMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the SystemLoginLog entity.
+// If the SystemLoginLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemLoginLogMutation) OldReason(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *SystemLoginLogMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[systemloginlog.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *SystemLoginLogMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[systemloginlog.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *SystemLoginLogMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, systemloginlog.FieldReason)
+}
+
+// SetIP sets the "ip" field.
+func (m *SystemLoginLogMutation) SetIP(s string) {
+	m.ip = &s
+}
+
+// IP returns the value of the "ip" field in the mutation.
+func (m *SystemLoginLogMutation) IP() (r string, exists bool) {
+	v := m.ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIP returns the old "ip" field's value of the SystemLoginLog entity.
+// If the SystemLoginLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemLoginLogMutation) OldIP(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
+	}
+	return oldValue.IP, nil
+}
+
+// ClearIP clears the value of the "ip" field.
+func (m *SystemLoginLogMutation) ClearIP() {
+	m.ip = nil
+	m.clearedFields[systemloginlog.FieldIP] = struct{}{}
+}
+
+// IPCleared returns if the "ip" field was cleared in this mutation.
+func (m *SystemLoginLogMutation) IPCleared() bool {
+	_, ok := m.clearedFields[systemloginlog.FieldIP]
+	return ok
+}
+
+// ResetIP resets all changes to the "ip" field.
+func (m *SystemLoginLogMutation) ResetIP() {
+	m.ip = nil
+	delete(m.clearedFields, systemloginlog.FieldIP)
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *SystemLoginLogMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *SystemLoginLogMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "user_agent" field's value of the SystemLoginLog entity.
+// If the SystemLoginLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemLoginLogMutation) OldUserAgent(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *SystemLoginLogMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[systemloginlog.FieldUserAgent] = struct{}{}
+}
+
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *SystemLoginLogMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[systemloginlog.FieldUserAgent]
+	return ok
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *SystemLoginLogMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, systemloginlog.FieldUserAgent)
+}
+
+// Where appends a list predicates to the SystemLoginLogMutation builder.
+func (m *SystemLoginLogMutation) Where(ps ...predicate.SystemLoginLog) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SystemLoginLogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SystemLoginLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SystemLoginLog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SystemLoginLogMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SystemLoginLogMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SystemLoginLog).
+func (m *SystemLoginLogMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SystemLoginLogMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, systemloginlog.FieldCreatedAt)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, systemloginlog.FieldTenantID)
+	}
+	if m.event != nil {
+		fields = append(fields, systemloginlog.FieldEvent)
+	}
+	if m.user_id != nil {
+		fields = append(fields, systemloginlog.FieldUserID)
+	}
+	if m.account != nil {
+		fields = append(fields, systemloginlog.FieldAccount)
+	}
+	if m.status != nil {
+		fields = append(fields, systemloginlog.FieldStatus)
+	}
+	if m.reason != nil {
+		fields = append(fields, systemloginlog.FieldReason)
+	}
+	if m.ip != nil {
+		fields = append(fields, systemloginlog.FieldIP)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, systemloginlog.FieldUserAgent)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SystemLoginLogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case systemloginlog.FieldCreatedAt:
+		return m.CreatedAt()
+	case systemloginlog.FieldTenantID:
+		return m.TenantID()
+	case systemloginlog.FieldEvent:
+		return m.Event()
+	case systemloginlog.FieldUserID:
+		return m.UserID()
+	case systemloginlog.FieldAccount:
+		return m.Account()
+	case systemloginlog.FieldStatus:
+		return m.Status()
+	case systemloginlog.FieldReason:
+		return m.Reason()
+	case systemloginlog.FieldIP:
+		return m.IP()
+	case systemloginlog.FieldUserAgent:
+		return m.UserAgent()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SystemLoginLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case systemloginlog.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case systemloginlog.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case systemloginlog.FieldEvent:
+		return m.OldEvent(ctx)
+	case systemloginlog.FieldUserID:
+		return m.OldUserID(ctx)
+	case systemloginlog.FieldAccount:
+		return m.OldAccount(ctx)
+	case systemloginlog.FieldStatus:
+		return m.OldStatus(ctx)
+	case systemloginlog.FieldReason:
+		return m.OldReason(ctx)
+	case systemloginlog.FieldIP:
+		return m.OldIP(ctx)
+	case systemloginlog.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	}
+	return nil, fmt.Errorf("unknown SystemLoginLog field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemLoginLogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case systemloginlog.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case systemloginlog.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case systemloginlog.FieldEvent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvent(v)
+		return nil
+	case systemloginlog.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case systemloginlog.FieldAccount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccount(v)
+		return nil
+	case systemloginlog.FieldStatus:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case systemloginlog.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case systemloginlog.FieldIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIP(v)
+		return nil
+	case systemloginlog.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SystemLoginLog field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SystemLoginLogMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus != nil {
+		fields = append(fields, systemloginlog.FieldStatus)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SystemLoginLogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case systemloginlog.FieldStatus:
+		return m.AddedStatus()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemLoginLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case systemloginlog.FieldStatus:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SystemLoginLog numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SystemLoginLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(systemloginlog.FieldCreatedAt) {
+		fields = append(fields, systemloginlog.FieldCreatedAt)
+	}
+	if m.FieldCleared(systemloginlog.FieldUserID) {
+		fields = append(fields, systemloginlog.FieldUserID)
+	}
+	if m.FieldCleared(systemloginlog.FieldReason) {
+		fields = append(fields, systemloginlog.FieldReason)
+	}
+	if m.FieldCleared(systemloginlog.FieldIP) {
+		fields = append(fields, systemloginlog.FieldIP)
+	}
+	if m.FieldCleared(systemloginlog.FieldUserAgent) {
+		fields = append(fields, systemloginlog.FieldUserAgent)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SystemLoginLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SystemLoginLogMutation) ClearField(name string) error {
+	switch name {
+	case systemloginlog.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case systemloginlog.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case systemloginlog.FieldReason:
+		m.ClearReason()
+		return nil
+	case systemloginlog.FieldIP:
+		m.ClearIP()
+		return nil
+	case systemloginlog.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemLoginLog nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SystemLoginLogMutation) ResetField(name string) error {
+	switch name {
+	case systemloginlog.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case systemloginlog.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case systemloginlog.FieldEvent:
+		m.ResetEvent()
+		return nil
+	case systemloginlog.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case systemloginlog.FieldAccount:
+		m.ResetAccount()
+		return nil
+	case systemloginlog.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case systemloginlog.FieldReason:
+		m.ResetReason()
+		return nil
+	case systemloginlog.FieldIP:
+		m.ResetIP()
+		return nil
+	case systemloginlog.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemLoginLog field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SystemLoginLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SystemLoginLogMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SystemLoginLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SystemLoginLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SystemLoginLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SystemLoginLogMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SystemLoginLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SystemLoginLog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SystemLoginLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SystemLoginLog edge %s", name)
+}
+
+// SystemMenuMutation represents an operation that mutates the SystemMenu nodes in the graph.
+type SystemMenuMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *string
+	create_by      *string
+	created_at     *time.Time
+	update_by      *string
+	updated_at     *time.Time
+	deleted_at     *time.Time
+	name           *string
+	permission     *string
+	_type          *int8
+	add_type       *int8
+	sort           *int32
+	addsort        *int32
+	parent_id      *string
+	_path          *string
+	icon           *string
+	component      *string
+	component_name *string
+	status         *int8
+	addstatus      *int8
+	visible        *bool
+	keep_alive     *bool
+	always_show    *bool
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*SystemMenu, error)
+	predicates     []predicate.SystemMenu
+}
+
+var _ ent.Mutation = (*SystemMenuMutation)(nil)
+
+// systemmenuOption allows management of the mutation configuration using functional options.
+type systemmenuOption func(*SystemMenuMutation)
+
+// newSystemMenuMutation creates new mutation for the SystemMenu entity.
+func newSystemMenuMutation(c config, op Op, opts ...systemmenuOption) *SystemMenuMutation {
+	m := &SystemMenuMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSystemMenu,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSystemMenuID sets the ID field of the mutation.
+func withSystemMenuID(id string) systemmenuOption {
+	return func(m *SystemMenuMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SystemMenu
+		)
+		m.oldValue = func(ctx context.Context) (*SystemMenu, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SystemMenu.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSystemMenu sets the old SystemMenu of the mutation.
+func withSystemMenu(node *SystemMenu) systemmenuOption {
+	return func(m *SystemMenuMutation) {
+		m.oldValue = func(context.Context) (*SystemMenu, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SystemMenuMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SystemMenuMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SystemMenu entities.
+func (m *SystemMenuMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SystemMenuMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SystemMenuMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SystemMenu.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateBy sets the "create_by" field.
+func (m *SystemMenuMutation) SetCreateBy(s string) {
+	m.create_by = &s
+}
+
+// CreateBy returns the value of the "create_by" field in the mutation.
+func (m *SystemMenuMutation) CreateBy() (r string, exists bool) {
+	v := m.create_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateBy returns the old "create_by" field's value of the SystemMenu entity.
+// If the SystemMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemMenuMutation) OldCreateBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
+	}
+	return oldValue.CreateBy, nil
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (m *SystemMenuMutation) ClearCreateBy() {
+	m.create_by = nil
+	m.clearedFields[systemmenu.FieldCreateBy] = struct{}{}
+}
+
+// CreateByCleared returns if the "create_by" field was cleared in this mutation.
+func (m *SystemMenuMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[systemmenu.FieldCreateBy]
+	return ok
+}
+
+// ResetCreateBy resets all changes to the "create_by" field.
+func (m *SystemMenuMutation) ResetCreateBy() {
+	m.create_by = nil
+	delete(m.clearedFields, systemmenu.FieldCreateBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SystemMenuMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SystemMenuMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SystemMenu entity.
+// If the SystemMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemMenuMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *SystemMenuMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[systemmenu.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *SystemMenuMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[systemmenu.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SystemMenuMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, systemmenu.FieldCreatedAt)
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (m *SystemMenuMutation) SetUpdateBy(s string) {
+	m.update_by = &s
+}
+
+// UpdateBy returns the value of the "update_by" field in the mutation.
+func (m *SystemMenuMutation) UpdateBy() (r string, exists bool) {
+	v := m.update_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateBy returns the old "update_by" field's value of the SystemMenu entity.
+// If the SystemMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemMenuMutation) OldUpdateBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateBy: %w", err)
+	}
+	return oldValue.UpdateBy, nil
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (m *SystemMenuMutation) ClearUpdateBy() {
+	m.update_by = nil
+	m.clearedFields[systemmenu.FieldUpdateBy] = struct{}{}
+}
+
+// UpdateByCleared returns if the "update_by" field was cleared in this mutation.
+func (m *SystemMenuMutation) UpdateByCleared() bool {
+	_, ok := m.clearedFields[systemmenu.FieldUpdateBy]
+	return ok
+}
+
+// ResetUpdateBy resets all changes to the "update_by" field.
+func (m *SystemMenuMutation) ResetUpdateBy() {
+	m.update_by = nil
+	delete(m.clearedFields, systemmenu.FieldUpdateBy)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SystemMenuMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SystemMenuMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SystemMenu entity.
+// If the SystemMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemMenuMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *SystemMenuMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[systemmenu.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *SystemMenuMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[systemmenu.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SystemMenuMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, systemmenu.FieldUpdatedAt)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *SystemMenuMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *SystemMenuMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the SystemMenu entity.
+// If the SystemMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemMenuMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *SystemMenuMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[systemmenu.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *SystemMenuMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[systemmenu.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *SystemMenuMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, systemmenu.FieldDeletedAt)
+}
+
+// SetName sets the "name" field.
+func (m *SystemMenuMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SystemMenuMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the SystemMenu entity.
+// If the SystemMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemMenuMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SystemMenuMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPermission sets the "permission" field.
+func (m *SystemMenuMutation) SetPermission(s string) {
+	m.permission = &s
+}
+
+// Permission returns the value of the "permission" field in the mutation.
+func (m *SystemMenuMutation) Permission() (r string, exists bool) {
+	v := m.permission
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPermission returns the old "permission" field's value of the SystemMenu entity.
+// If the SystemMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemMenuMutation) OldPermission(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPermission is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPermission requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPermission: %w", err)
+	}
+	return oldValue.Permission, nil
+}
+
+// ResetPermission resets all changes to the "permission" field.
+func (m *SystemMenuMutation) ResetPermission() {
+	m.permission = nil
+}
+
+// SetType sets the "type" field.
+func (m *SystemMenuMutation) SetType(i int8) {
+	m._type = &i
+	m.add_type = nil
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *SystemMenuMutation) GetType() (r int8, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the SystemMenu entity.
+// If the SystemMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemMenuMutation) OldType(ctx context.Context) (v int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// AddType adds i to the "type" field.
+func (m *SystemMenuMutation) AddType(i int8) {
+	if m.add_type != nil {
+		*m.add_type += i
+	} else {
+		m.add_type = &i
+	}
+}
+
+// AddedType returns the value that was added to the "type" field in this mutation.
+func (m *SystemMenuMutation) AddedType() (r int8, exists bool) {
+	v := m.add_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *SystemMenuMutation) ResetType() {
+	m._type = nil
+	m.add_type = nil
+}
+
+// SetSort sets the "sort" field.
+func (m *SystemMenuMutation) SetSort(i int32) {
+	m.sort = &i
+	m.addsort = nil
+}
+
+// Sort returns the value of the "sort" field in the mutation.
+func (m *SystemMenuMutation) Sort() (r int32, exists bool) {
+	v := m.sort
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSort returns the old "sort" field's value of the SystemMenu entity.
+// If the SystemMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemMenuMutation) OldSort(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSort is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSort requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSort: %w", err)
+	}
+	return oldValue.Sort, nil
+}
+
+// AddSort adds i to the "sort" field.
+func (m *SystemMenuMutation) AddSort(i int32) {
+	if m.addsort != nil {
+		*m.addsort += i
+	} else {
+		m.addsort = &i
+	}
+}
+
+// AddedSort returns the value that was added to the "sort" field in this mutation.
+func (m *SystemMenuMutation) AddedSort() (r int32, exists bool) {
+	v := m.addsort
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSort resets all changes to the "sort" field.
+func (m *SystemMenuMutation) ResetSort() {
+	m.sort = nil
+	m.addsort = nil
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *SystemMenuMutation) SetParentID(s string) {
+	m.parent_id = &s
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *SystemMenuMutation) ParentID() (r string, exists bool) {
+	v := m.parent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the SystemMenu entity.
+// If the SystemMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemMenuMutation) OldParentID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *SystemMenuMutation) ResetParentID() {
+	m.parent_id = nil
+}
+
+// SetPath sets the "path" field.
+func (m *SystemMenuMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *SystemMenuMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the SystemMenu entity.
+// If the SystemMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemMenuMutation) OldPath(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ClearPath clears the value of the "path" field.
+func (m *SystemMenuMutation) ClearPath() {
+	m._path = nil
+	m.clearedFields[systemmenu.FieldPath] = struct{}{}
+}
+
+// PathCleared returns if the "path" field was cleared in this mutation.
+func (m *SystemMenuMutation) PathCleared() bool {
+	_, ok := m.clearedFields[systemmenu.FieldPath]
+	return ok
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *SystemMenuMutation) ResetPath() {
+	m._path = nil
+	delete(m.clearedFields, systemmenu.FieldPath)
+}
+
+// SetIcon sets the "icon" field.
+func (m *SystemMenuMutation) SetIcon(s string) {
+	m.icon = &s
+}
+
+// Icon returns the value of the "icon" field in the mutation.
+func (m *SystemMenuMutation) Icon() (r string, exists bool) {
+	v := m.icon
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIcon returns the old "icon" field's value of the SystemMenu entity.
+// If the SystemMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemMenuMutation) OldIcon(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIcon requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
+	}
+	return oldValue.Icon, nil
+}
+
+// ClearIcon clears the value of the "icon" field.
+func (m *SystemMenuMutation) ClearIcon() {
+	m.icon = nil
+	m.clearedFields[systemmenu.FieldIcon] = struct{}{}
+}
+
+// IconCleared returns if the "icon" field was cleared in this mutation.
+func (m *SystemMenuMutation) IconCleared() bool {
+	_, ok := m.clearedFields[systemmenu.FieldIcon]
+	return ok
+}
+
+// ResetIcon resets all changes to the "icon" field.
+func (m *SystemMenuMutation) ResetIcon() {
+	m.icon = nil
+	delete(m.clearedFields, systemmenu.FieldIcon)
+}
+
+// SetComponent sets the "component" field.
+func (m *SystemMenuMutation) SetComponent(s string) {
+	m.component = &s
+}
+
+// Component returns the value of the "component" field in the mutation.
+func (m *SystemMenuMutation) Component() (r string, exists bool) {
+	v := m.component
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldComponent returns the old "component" field's value of the SystemMenu entity.
+// If the SystemMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemMenuMutation) OldComponent(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComponent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComponent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComponent: %w", err)
+	}
+	return oldValue.Component, nil
+}
+
+// ClearComponent clears the value of the "component" field.
+func (m *SystemMenuMutation) ClearComponent() {
+	m.component = nil
+	m.clearedFields[systemmenu.FieldComponent] = struct{}{}
+}
+
+// ComponentCleared returns if the "component" field was cleared in this mutation.
+func (m *SystemMenuMutation) ComponentCleared() bool {
+	_, ok := m.clearedFields[systemmenu.FieldComponent]
+	return ok
+}
+
+// ResetComponent resets all changes to the "component" field.
+func (m *SystemMenuMutation) ResetComponent() {
+	m.component = nil
+	delete(m.clearedFields, systemmenu.FieldComponent)
+}
+
+// SetComponentName sets the "component_name" field.
+func (m *SystemMenuMutation) SetComponentName(s string) {
+	m.component_name = &s
+}
+
+// ComponentName returns the value of the "component_name" field in the mutation.
+func (m *SystemMenuMutation) ComponentName() (r string, exists bool) {
+	v := m.component_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldComponentName returns the old "component_name" field's value of the SystemMenu entity.
+// If the SystemMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemMenuMutation) OldComponentName(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComponentName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComponentName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComponentName: %w", err)
+	}
+	return oldValue.ComponentName, nil
+}
+
+// ClearComponentName clears the value of the "component_name" field.
+func (m *SystemMenuMutation) ClearComponentName() {
+	m.component_name = nil
+	m.clearedFields[systemmenu.FieldComponentName] = struct{}{}
+}
+
+// ComponentNameCleared returns if the "component_name" field was cleared in this mutation.
+func (m *SystemMenuMutation) ComponentNameCleared() bool {
+	_, ok := m.clearedFields[systemmenu.FieldComponentName]
+	return ok
+}
+
+// ResetComponentName resets all changes to the "component_name" field.
+func (m *SystemMenuMutation) ResetComponentName() {
+	m.component_name = nil
+	delete(m.clearedFields, systemmenu.FieldComponentName)
+}
+
+// SetStatus sets the "status" field.
+func (m *SystemMenuMutation) SetStatus(i int8) {
+	m.status = &i
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SystemMenuMutation) Status() (r int8, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the SystemMenu entity.
+// If the SystemMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemMenuMutation) OldStatus(ctx context.Context) (v int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds i to the "status" field.
+func (m *SystemMenuMutation) AddStatus(i int8) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *SystemMenuMutation) AddedStatus() (r int8, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SystemMenuMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+}
+
+// SetVisible sets the "visible" field.
+func (m *SystemMenuMutation) SetVisible(b bool) {
+	m.visible = &b
+}
+
+// Visible returns the value of the "visible" field in the mutation.
+func (m *SystemMenuMutation) Visible() (r bool, exists bool) {
+	v := m.visible
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVisible returns the old "visible" field's value of the SystemMenu entity.
+// If the SystemMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemMenuMutation) OldVisible(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVisible is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVisible requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVisible: %w", err)
+	}
+	return oldValue.Visible, nil
+}
+
+// ResetVisible resets all changes to the "visible" field.
+func (m *SystemMenuMutation) ResetVisible() {
+	m.visible = nil
+}
+
+// SetKeepAlive sets the "keep_alive" field.
+func (m *SystemMenuMutation) SetKeepAlive(b bool) {
+	m.keep_alive = &b
+}
+
+// KeepAlive returns the value of the "keep_alive" field in the mutation.
+func (m *SystemMenuMutation) KeepAlive() (r bool, exists bool) {
+	v := m.keep_alive
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeepAlive returns the old "keep_alive" field's value of the SystemMenu entity.
+// If the SystemMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemMenuMutation) OldKeepAlive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeepAlive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeepAlive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeepAlive: %w", err)
+	}
+	return oldValue.KeepAlive, nil
+}
+
+// ResetKeepAlive resets all changes to the "keep_alive" field.
+func (m *SystemMenuMutation) ResetKeepAlive() {
+	m.keep_alive = nil
+}
+
+// SetAlwaysShow sets the "always_show" field.
+func (m *SystemMenuMutation) SetAlwaysShow(b bool) {
+	m.always_show = &b
+}
+
+// AlwaysShow returns the value of the "always_show" field in the mutation.
+func (m *SystemMenuMutation) AlwaysShow() (r bool, exists bool) {
+	v := m.always_show
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlwaysShow returns the old "always_show" field's value of the SystemMenu entity.
+// If the SystemMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemMenuMutation) OldAlwaysShow(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlwaysShow is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlwaysShow requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlwaysShow: %w", err)
+	}
+	return oldValue.AlwaysShow, nil
+}
+
+// ResetAlwaysShow resets all changes to the "always_show" field.
+func (m *SystemMenuMutation) ResetAlwaysShow() {
+	m.always_show = nil
+}
+
+// Where appends a list predicates to the SystemMenuMutation builder.
+func (m *SystemMenuMutation) Where(ps ...predicate.SystemMenu) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SystemMenuMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SystemMenuMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SystemMenu, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SystemMenuMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SystemMenuMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SystemMenu).
+func (m *SystemMenuMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SystemMenuMutation) Fields() []string {
+	fields := make([]string, 0, 18)
+	if m.create_by != nil {
+		fields = append(fields, systemmenu.FieldCreateBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, systemmenu.FieldCreatedAt)
+	}
+	if m.update_by != nil {
+		fields = append(fields, systemmenu.FieldUpdateBy)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, systemmenu.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, systemmenu.FieldDeletedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, systemmenu.FieldName)
+	}
+	if m.permission != nil {
+		fields = append(fields, systemmenu.FieldPermission)
+	}
+	if m._type != nil {
+		fields = append(fields, systemmenu.FieldType)
+	}
+	if m.sort != nil {
+		fields = append(fields, systemmenu.FieldSort)
+	}
+	if m.parent_id != nil {
+		fields = append(fields, systemmenu.FieldParentID)
+	}
+	if m._path != nil {
+		fields = append(fields, systemmenu.FieldPath)
+	}
+	if m.icon != nil {
+		fields = append(fields, systemmenu.FieldIcon)
+	}
+	if m.component != nil {
+		fields = append(fields, systemmenu.FieldComponent)
+	}
+	if m.component_name != nil {
+		fields = append(fields, systemmenu.FieldComponentName)
+	}
+	if m.status != nil {
+		fields = append(fields, systemmenu.FieldStatus)
+	}
+	if m.visible != nil {
+		fields = append(fields, systemmenu.FieldVisible)
+	}
+	if m.keep_alive != nil {
+		fields = append(fields, systemmenu.FieldKeepAlive)
+	}
+	if m.always_show != nil {
+		fields = append(fields, systemmenu.FieldAlwaysShow)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SystemMenuMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case systemmenu.FieldCreateBy:
+		return m.CreateBy()
+	case systemmenu.FieldCreatedAt:
+		return m.CreatedAt()
+	case systemmenu.FieldUpdateBy:
+		return m.UpdateBy()
+	case systemmenu.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case systemmenu.FieldDeletedAt:
+		return m.DeletedAt()
+	case systemmenu.FieldName:
+		return m.Name()
+	case systemmenu.FieldPermission:
+		return m.Permission()
+	case systemmenu.FieldType:
+		return m.GetType()
+	case systemmenu.FieldSort:
+		return m.Sort()
+	case systemmenu.FieldParentID:
+		return m.ParentID()
+	case systemmenu.FieldPath:
+		return m.Path()
+	case systemmenu.FieldIcon:
+		return m.Icon()
+	case systemmenu.FieldComponent:
+		return m.Component()
+	case systemmenu.FieldComponentName:
+		return m.ComponentName()
+	case systemmenu.FieldStatus:
+		return m.Status()
+	case systemmenu.FieldVisible:
+		return m.Visible()
+	case systemmenu.FieldKeepAlive:
+		return m.KeepAlive()
+	case systemmenu.FieldAlwaysShow:
+		return m.AlwaysShow()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SystemMenuMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case systemmenu.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case systemmenu.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case systemmenu.FieldUpdateBy:
+		return m.OldUpdateBy(ctx)
+	case systemmenu.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case systemmenu.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case systemmenu.FieldName:
+		return m.OldName(ctx)
+	case systemmenu.FieldPermission:
+		return m.OldPermission(ctx)
+	case systemmenu.FieldType:
+		return m.OldType(ctx)
+	case systemmenu.FieldSort:
+		return m.OldSort(ctx)
+	case systemmenu.FieldParentID:
+		return m.OldParentID(ctx)
+	case systemmenu.FieldPath:
+		return m.OldPath(ctx)
+	case systemmenu.FieldIcon:
+		return m.OldIcon(ctx)
+	case systemmenu.FieldComponent:
+		return m.OldComponent(ctx)
+	case systemmenu.FieldComponentName:
+		return m.OldComponentName(ctx)
+	case systemmenu.FieldStatus:
+		return m.OldStatus(ctx)
+	case systemmenu.FieldVisible:
+		return m.OldVisible(ctx)
+	case systemmenu.FieldKeepAlive:
+		return m.OldKeepAlive(ctx)
+	case systemmenu.FieldAlwaysShow:
+		return m.OldAlwaysShow(ctx)
+	}
+	return nil, fmt.Errorf("unknown SystemMenu field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemMenuMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case systemmenu.FieldCreateBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateBy(v)
+		return nil
+	case systemmenu.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case systemmenu.FieldUpdateBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateBy(v)
+		return nil
+	case systemmenu.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case systemmenu.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case systemmenu.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case systemmenu.FieldPermission:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermission(v)
+		return nil
+	case systemmenu.FieldType:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case systemmenu.FieldSort:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSort(v)
+		return nil
+	case systemmenu.FieldParentID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case systemmenu.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case systemmenu.FieldIcon:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIcon(v)
+		return nil
+	case systemmenu.FieldComponent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComponent(v)
+		return nil
+	case systemmenu.FieldComponentName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComponentName(v)
+		return nil
+	case systemmenu.FieldStatus:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case systemmenu.FieldVisible:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisible(v)
+		return nil
+	case systemmenu.FieldKeepAlive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeepAlive(v)
+		return nil
+	case systemmenu.FieldAlwaysShow:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlwaysShow(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SystemMenu field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SystemMenuMutation) AddedFields() []string {
+	var fields []string
+	if m.add_type != nil {
+		fields = append(fields, systemmenu.FieldType)
+	}
+	if m.addsort != nil {
+		fields = append(fields, systemmenu.FieldSort)
+	}
+	if m.addstatus != nil {
+		fields = append(fields, systemmenu.FieldStatus)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SystemMenuMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case systemmenu.FieldType:
+		return m.AddedType()
+	case systemmenu.FieldSort:
+		return m.AddedSort()
+	case systemmenu.FieldStatus:
+		return m.AddedStatus()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemMenuMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case systemmenu.FieldType:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddType(v)
+		return nil
+	case systemmenu.FieldSort:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSort(v)
+		return nil
+	case systemmenu.FieldStatus:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SystemMenu numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SystemMenuMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(systemmenu.FieldCreateBy) {
+		fields = append(fields, systemmenu.FieldCreateBy)
+	}
+	if m.FieldCleared(systemmenu.FieldCreatedAt) {
+		fields = append(fields, systemmenu.FieldCreatedAt)
+	}
+	if m.FieldCleared(systemmenu.FieldUpdateBy) {
+		fields = append(fields, systemmenu.FieldUpdateBy)
+	}
+	if m.FieldCleared(systemmenu.FieldUpdatedAt) {
+		fields = append(fields, systemmenu.FieldUpdatedAt)
+	}
+	if m.FieldCleared(systemmenu.FieldDeletedAt) {
+		fields = append(fields, systemmenu.FieldDeletedAt)
+	}
+	if m.FieldCleared(systemmenu.FieldPath) {
+		fields = append(fields, systemmenu.FieldPath)
+	}
+	if m.FieldCleared(systemmenu.FieldIcon) {
+		fields = append(fields, systemmenu.FieldIcon)
+	}
+	if m.FieldCleared(systemmenu.FieldComponent) {
+		fields = append(fields, systemmenu.FieldComponent)
+	}
+	if m.FieldCleared(systemmenu.FieldComponentName) {
+		fields = append(fields, systemmenu.FieldComponentName)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SystemMenuMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SystemMenuMutation) ClearField(name string) error {
+	switch name {
+	case systemmenu.FieldCreateBy:
+		m.ClearCreateBy()
+		return nil
+	case systemmenu.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case systemmenu.FieldUpdateBy:
+		m.ClearUpdateBy()
+		return nil
+	case systemmenu.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case systemmenu.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case systemmenu.FieldPath:
+		m.ClearPath()
+		return nil
+	case systemmenu.FieldIcon:
+		m.ClearIcon()
+		return nil
+	case systemmenu.FieldComponent:
+		m.ClearComponent()
+		return nil
+	case systemmenu.FieldComponentName:
+		m.ClearComponentName()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemMenu nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SystemMenuMutation) ResetField(name string) error {
+	switch name {
+	case systemmenu.FieldCreateBy:
+		m.ResetCreateBy()
+		return nil
+	case systemmenu.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case systemmenu.FieldUpdateBy:
+		m.ResetUpdateBy()
+		return nil
+	case systemmenu.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case systemmenu.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case systemmenu.FieldName:
+		m.ResetName()
+		return nil
+	case systemmenu.FieldPermission:
+		m.ResetPermission()
+		return nil
+	case systemmenu.FieldType:
+		m.ResetType()
+		return nil
+	case systemmenu.FieldSort:
+		m.ResetSort()
+		return nil
+	case systemmenu.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case systemmenu.FieldPath:
+		m.ResetPath()
+		return nil
+	case systemmenu.FieldIcon:
+		m.ResetIcon()
+		return nil
+	case systemmenu.FieldComponent:
+		m.ResetComponent()
+		return nil
+	case systemmenu.FieldComponentName:
+		m.ResetComponentName()
+		return nil
+	case systemmenu.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case systemmenu.FieldVisible:
+		m.ResetVisible()
+		return nil
+	case systemmenu.FieldKeepAlive:
+		m.ResetKeepAlive()
+		return nil
+	case systemmenu.FieldAlwaysShow:
+		m.ResetAlwaysShow()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemMenu field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SystemMenuMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SystemMenuMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SystemMenuMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SystemMenuMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SystemMenuMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SystemMenuMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SystemMenuMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SystemMenu unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SystemMenuMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SystemMenu edge %s", name)
+}
+
+// SystemPostMutation represents an operation that mutates the SystemPost nodes in the graph.
+type SystemPostMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	create_by     *string
+	created_at    *time.Time
+	update_by     *string
+	updated_at    *time.Time
+	tenant_id     *string
+	deleted_at    *time.Time
+	remark        *string
+	code          *string
+	name          *string
+	sort          *int32
+	addsort       *int32
+	status        *int8
+	addstatus     *int8
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SystemPost, error)
+	predicates    []predicate.SystemPost
+}
+
+var _ ent.Mutation = (*SystemPostMutation)(nil)
+
+// systempostOption allows management of the mutation configuration using functional options.
+type systempostOption func(*SystemPostMutation)
+
+// newSystemPostMutation creates new mutation for the SystemPost entity.
+func newSystemPostMutation(c config, op Op, opts ...systempostOption) *SystemPostMutation {
+	m := &SystemPostMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSystemPost,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSystemPostID sets the ID field of the mutation.
+func withSystemPostID(id string) systempostOption {
+	return func(m *SystemPostMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SystemPost
+		)
+		m.oldValue = func(ctx context.Context) (*SystemPost, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SystemPost.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSystemPost sets the old SystemPost of the mutation.
+func withSystemPost(node *SystemPost) systempostOption {
+	return func(m *SystemPostMutation) {
+		m.oldValue = func(context.Context) (*SystemPost, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SystemPostMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SystemPostMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SystemPost entities.
+func (m *SystemPostMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SystemPostMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SystemPostMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SystemPost.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateBy sets the "create_by" field.
+func (m *SystemPostMutation) SetCreateBy(s string) {
+	m.create_by = &s
+}
+
+// CreateBy returns the value of the "create_by" field in the mutation.
+func (m *SystemPostMutation) CreateBy() (r string, exists bool) {
+	v := m.create_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateBy returns the old "create_by" field's value of the SystemPost entity.
+// If the SystemPost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemPostMutation) OldCreateBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
+	}
+	return oldValue.CreateBy, nil
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (m *SystemPostMutation) ClearCreateBy() {
+	m.create_by = nil
+	m.clearedFields[systempost.FieldCreateBy] = struct{}{}
+}
+
+// CreateByCleared returns if the "create_by" field was cleared in this mutation.
+func (m *SystemPostMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[systempost.FieldCreateBy]
+	return ok
+}
+
+// ResetCreateBy resets all changes to the "create_by" field.
+func (m *SystemPostMutation) ResetCreateBy() {
+	m.create_by = nil
+	delete(m.clearedFields, systempost.FieldCreateBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SystemPostMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SystemPostMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SystemPost entity.
+// If the SystemPost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemPostMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *SystemPostMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[systempost.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *SystemPostMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[systempost.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SystemPostMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, systempost.FieldCreatedAt)
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (m *SystemPostMutation) SetUpdateBy(s string) {
+	m.update_by = &s
+}
+
+// UpdateBy returns the value of the "update_by" field in the mutation.
+func (m *SystemPostMutation) UpdateBy() (r string, exists bool) {
+	v := m.update_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateBy returns the old "update_by" field's value of the SystemPost entity.
+// If the SystemPost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemPostMutation) OldUpdateBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateBy: %w", err)
+	}
+	return oldValue.UpdateBy, nil
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (m *SystemPostMutation) ClearUpdateBy() {
+	m.update_by = nil
+	m.clearedFields[systempost.FieldUpdateBy] = struct{}{}
+}
+
+// UpdateByCleared returns if the "update_by" field was cleared in this mutation.
+func (m *SystemPostMutation) UpdateByCleared() bool {
+	_, ok := m.clearedFields[systempost.FieldUpdateBy]
+	return ok
+}
+
+// ResetUpdateBy resets all changes to the "update_by" field.
+func (m *SystemPostMutation) ResetUpdateBy() {
+	m.update_by = nil
+	delete(m.clearedFields, systempost.FieldUpdateBy)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SystemPostMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SystemPostMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SystemPost entity.
+// If the SystemPost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemPostMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *SystemPostMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[systempost.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *SystemPostMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[systempost.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SystemPostMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, systempost.FieldUpdatedAt)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *SystemPostMutation) SetTenantID(s string) {
+	m.tenant_id = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *SystemPostMutation) TenantID() (r string, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the SystemPost entity.
+// If the SystemPost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemPostMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *SystemPostMutation) ResetTenantID() {
+	m.tenant_id = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *SystemPostMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *SystemPostMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the SystemPost entity.
+// If the SystemPost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemPostMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *SystemPostMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[systempost.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *SystemPostMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[systempost.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *SystemPostMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, systempost.FieldDeletedAt)
+}
+
+// SetRemark sets the "remark" field.
+func (m *SystemPostMutation) SetRemark(s string) {
+	m.remark = &s
+}
+
+// Remark returns the value of the "remark" field in the mutation.
+func (m *SystemPostMutation) Remark() (r string, exists bool) {
+	v := m.remark
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemark returns the old "remark" field's value of the SystemPost entity.
+// If the SystemPost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemPostMutation) OldRemark(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemark is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemark requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemark: %w", err)
+	}
+	return oldValue.Remark, nil
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (m *SystemPostMutation) ClearRemark() {
+	m.remark = nil
+	m.clearedFields[systempost.FieldRemark] = struct{}{}
+}
+
+// RemarkCleared returns if the "remark" field was cleared in this mutation.
+func (m *SystemPostMutation) RemarkCleared() bool {
+	_, ok := m.clearedFields[systempost.FieldRemark]
+	return ok
+}
+
+// ResetRemark resets all changes to the "remark" field.
+func (m *SystemPostMutation) ResetRemark() {
+	m.remark = nil
+	delete(m.clearedFields, systempost.FieldRemark)
+}
+
+// SetCode sets the "code" field.
+func (m *SystemPostMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *SystemPostMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the SystemPost entity.
+// If the SystemPost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemPostMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *SystemPostMutation) ResetCode() {
+	m.code = nil
+}
+
+// SetName sets the "name" field.
+func (m *SystemPostMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SystemPostMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the SystemPost entity.
+// If the SystemPost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemPostMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SystemPostMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSort sets the "sort" field.
+func (m *SystemPostMutation) SetSort(i int32) {
+	m.sort = &i
+	m.addsort = nil
+}
+
+// Sort returns the value of the "sort" field in the mutation.
+func (m *SystemPostMutation) Sort() (r int32, exists bool) {
+	v := m.sort
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSort returns the old "sort" field's value of the SystemPost entity.
+// If the SystemPost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemPostMutation) OldSort(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSort is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSort requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSort: %w", err)
+	}
+	return oldValue.Sort, nil
+}
+
+// AddSort adds i to the "sort" field.
+func (m *SystemPostMutation) AddSort(i int32) {
+	if m.addsort != nil {
+		*m.addsort += i
+	} else {
+		m.addsort = &i
+	}
+}
+
+// AddedSort returns the value that was added to the "sort" field in this mutation.
+func (m *SystemPostMutation) AddedSort() (r int32, exists bool) {
+	v := m.addsort
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSort resets all changes to the "sort" field.
+func (m *SystemPostMutation) ResetSort() {
+	m.sort = nil
+	m.addsort = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *SystemPostMutation) SetStatus(i int8) {
+	m.status = &i
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SystemPostMutation) Status() (r int8, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the SystemPost entity.
+// If the SystemPost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemPostMutation) OldStatus(ctx context.Context) (v int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds i to the "status" field.
+func (m *SystemPostMutation) AddStatus(i int8) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *SystemPostMutation) AddedStatus() (r int8, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SystemPostMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+}
+
+// Where appends a list predicates to the SystemPostMutation builder.
+func (m *SystemPostMutation) Where(ps ...predicate.SystemPost) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SystemPostMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SystemPostMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SystemPost, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SystemPostMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SystemPostMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SystemPost).
+func (m *SystemPostMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SystemPostMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.create_by != nil {
+		fields = append(fields, systempost.FieldCreateBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, systempost.FieldCreatedAt)
+	}
+	if m.update_by != nil {
+		fields = append(fields, systempost.FieldUpdateBy)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, systempost.FieldUpdatedAt)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, systempost.FieldTenantID)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, systempost.FieldDeletedAt)
+	}
+	if m.remark != nil {
+		fields = append(fields, systempost.FieldRemark)
+	}
+	if m.code != nil {
+		fields = append(fields, systempost.FieldCode)
+	}
+	if m.name != nil {
+		fields = append(fields, systempost.FieldName)
+	}
+	if m.sort != nil {
+		fields = append(fields, systempost.FieldSort)
+	}
+	if m.status != nil {
+		fields = append(fields, systempost.FieldStatus)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SystemPostMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case systempost.FieldCreateBy:
+		return m.CreateBy()
+	case systempost.FieldCreatedAt:
+		return m.CreatedAt()
+	case systempost.FieldUpdateBy:
+		return m.UpdateBy()
+	case systempost.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case systempost.FieldTenantID:
+		return m.TenantID()
+	case systempost.FieldDeletedAt:
+		return m.DeletedAt()
+	case systempost.FieldRemark:
+		return m.Remark()
+	case systempost.FieldCode:
+		return m.Code()
+	case systempost.FieldName:
+		return m.Name()
+	case systempost.FieldSort:
+		return m.Sort()
+	case systempost.FieldStatus:
+		return m.Status()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SystemPostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case systempost.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case systempost.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case systempost.FieldUpdateBy:
+		return m.OldUpdateBy(ctx)
+	case systempost.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case systempost.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case systempost.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case systempost.FieldRemark:
+		return m.OldRemark(ctx)
+	case systempost.FieldCode:
+		return m.OldCode(ctx)
+	case systempost.FieldName:
+		return m.OldName(ctx)
+	case systempost.FieldSort:
+		return m.OldSort(ctx)
+	case systempost.FieldStatus:
+		return m.OldStatus(ctx)
+	}
+	return nil, fmt.Errorf("unknown SystemPost field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemPostMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case systempost.FieldCreateBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateBy(v)
+		return nil
+	case systempost.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case systempost.FieldUpdateBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateBy(v)
+		return nil
+	case systempost.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case systempost.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case systempost.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case systempost.FieldRemark:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemark(v)
+		return nil
+	case systempost.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case systempost.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case systempost.FieldSort:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSort(v)
+		return nil
+	case systempost.FieldStatus:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SystemPost field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SystemPostMutation) AddedFields() []string {
+	var fields []string
+	if m.addsort != nil {
+		fields = append(fields, systempost.FieldSort)
+	}
+	if m.addstatus != nil {
+		fields = append(fields, systempost.FieldStatus)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SystemPostMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case systempost.FieldSort:
+		return m.AddedSort()
+	case systempost.FieldStatus:
+		return m.AddedStatus()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemPostMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case systempost.FieldSort:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSort(v)
+		return nil
+	case systempost.FieldStatus:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SystemPost numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SystemPostMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(systempost.FieldCreateBy) {
+		fields = append(fields, systempost.FieldCreateBy)
+	}
+	if m.FieldCleared(systempost.FieldCreatedAt) {
+		fields = append(fields, systempost.FieldCreatedAt)
+	}
+	if m.FieldCleared(systempost.FieldUpdateBy) {
+		fields = append(fields, systempost.FieldUpdateBy)
+	}
+	if m.FieldCleared(systempost.FieldUpdatedAt) {
+		fields = append(fields, systempost.FieldUpdatedAt)
+	}
+	if m.FieldCleared(systempost.FieldDeletedAt) {
+		fields = append(fields, systempost.FieldDeletedAt)
+	}
+	if m.FieldCleared(systempost.FieldRemark) {
+		fields = append(fields, systempost.FieldRemark)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SystemPostMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SystemPostMutation) ClearField(name string) error {
+	switch name {
+	case systempost.FieldCreateBy:
+		m.ClearCreateBy()
+		return nil
+	case systempost.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case systempost.FieldUpdateBy:
+		m.ClearUpdateBy()
+		return nil
+	case systempost.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case systempost.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case systempost.FieldRemark:
+		m.ClearRemark()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemPost nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SystemPostMutation) ResetField(name string) error {
+	switch name {
+	case systempost.FieldCreateBy:
+		m.ResetCreateBy()
+		return nil
+	case systempost.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case systempost.FieldUpdateBy:
+		m.ResetUpdateBy()
+		return nil
+	case systempost.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case systempost.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case systempost.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case systempost.FieldRemark:
+		m.ResetRemark()
+		return nil
+	case systempost.FieldCode:
+		m.ResetCode()
+		return nil
+	case systempost.FieldName:
+		m.ResetName()
+		return nil
+	case systempost.FieldSort:
+		m.ResetSort()
+		return nil
+	case systempost.FieldStatus:
+		m.ResetStatus()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemPost field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SystemPostMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SystemPostMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SystemPostMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SystemPostMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SystemPostMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SystemPostMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SystemPostMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SystemPost unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SystemPostMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SystemPost edge %s", name)
+}
+
+// SystemRoleMutation represents an operation that mutates the SystemRole nodes in the graph.
+type SystemRoleMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *string
+	create_by                 *string
+	created_at                *time.Time
+	update_by                 *string
+	updated_at                *time.Time
+	tenant_id                 *string
+	deleted_at                *time.Time
+	remark                    *string
+	name                      *string
+	code                      *string
+	sort                      *int32
+	addsort                   *int32
+	data_scope                *int8
+	adddata_scope             *int8
+	data_scope_dept_ids       *[]string
+	appenddata_scope_dept_ids []string
+	status                    *int8
+	addstatus                 *int8
+	_type                     *int8
+	add_type                  *int8
+	clearedFields             map[string]struct{}
+	done                      bool
+	oldValue                  func(context.Context) (*SystemRole, error)
+	predicates                []predicate.SystemRole
+}
+
+var _ ent.Mutation = (*SystemRoleMutation)(nil)
+
+// systemroleOption allows management of the mutation configuration using functional options.
+type systemroleOption func(*SystemRoleMutation)
+
+// newSystemRoleMutation creates new mutation for the SystemRole entity.
+func newSystemRoleMutation(c config, op Op, opts ...systemroleOption) *SystemRoleMutation {
+	m := &SystemRoleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSystemRole,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSystemRoleID sets the ID field of the mutation.
+func withSystemRoleID(id string) systemroleOption {
+	return func(m *SystemRoleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SystemRole
+		)
+		m.oldValue = func(ctx context.Context) (*SystemRole, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SystemRole.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSystemRole sets the old SystemRole of the mutation.
+func withSystemRole(node *SystemRole) systemroleOption {
+	return func(m *SystemRoleMutation) {
+		m.oldValue = func(context.Context) (*SystemRole, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SystemRoleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SystemRoleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SystemRole entities.
+func (m *SystemRoleMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SystemRoleMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SystemRoleMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SystemRole.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateBy sets the "create_by" field.
+func (m *SystemRoleMutation) SetCreateBy(s string) {
+	m.create_by = &s
+}
+
+// CreateBy returns the value of the "create_by" field in the mutation.
+func (m *SystemRoleMutation) CreateBy() (r string, exists bool) {
+	v := m.create_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateBy returns the old "create_by" field's value of the SystemRole entity.
+// If the SystemRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemRoleMutation) OldCreateBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
+	}
+	return oldValue.CreateBy, nil
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (m *SystemRoleMutation) ClearCreateBy() {
+	m.create_by = nil
+	m.clearedFields[systemrole.FieldCreateBy] = struct{}{}
+}
+
+// CreateByCleared returns if the "create_by" field was cleared in this mutation.
+func (m *SystemRoleMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[systemrole.FieldCreateBy]
+	return ok
+}
+
+// ResetCreateBy resets all changes to the "create_by" field.
+func (m *SystemRoleMutation) ResetCreateBy() {
+	m.create_by = nil
+	delete(m.clearedFields, systemrole.FieldCreateBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SystemRoleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SystemRoleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SystemRole entity.
+// If the SystemRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemRoleMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *SystemRoleMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[systemrole.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *SystemRoleMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[systemrole.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SystemRoleMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, systemrole.FieldCreatedAt)
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (m *SystemRoleMutation) SetUpdateBy(s string) {
+	m.update_by = &s
+}
+
+// UpdateBy returns the value of the "update_by" field in the mutation.
+func (m *SystemRoleMutation) UpdateBy() (r string, exists bool) {
+	v := m.update_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateBy returns the old "update_by" field's value of the SystemRole entity.
+// If the SystemRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemRoleMutation) OldUpdateBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateBy: %w", err)
+	}
+	return oldValue.UpdateBy, nil
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (m *SystemRoleMutation) ClearUpdateBy() {
+	m.update_by = nil
+	m.clearedFields[systemrole.FieldUpdateBy] = struct{}{}
+}
+
+// UpdateByCleared returns if the "update_by" field was cleared in this mutation.
+func (m *SystemRoleMutation) UpdateByCleared() bool {
+	_, ok := m.clearedFields[systemrole.FieldUpdateBy]
+	return ok
+}
+
+// ResetUpdateBy resets all changes to the "update_by" field.
+func (m *SystemRoleMutation) ResetUpdateBy() {
+	m.update_by = nil
+	delete(m.clearedFields, systemrole.FieldUpdateBy)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SystemRoleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SystemRoleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SystemRole entity.
+// If the SystemRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemRoleMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *SystemRoleMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[systemrole.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *SystemRoleMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[systemrole.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SystemRoleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, systemrole.FieldUpdatedAt)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *SystemRoleMutation) SetTenantID(s string) {
+	m.tenant_id = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *SystemRoleMutation) TenantID() (r string, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the SystemRole entity.
+// If the SystemRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemRoleMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *SystemRoleMutation) ResetTenantID() {
+	m.tenant_id = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *SystemRoleMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *SystemRoleMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the SystemRole entity.
+// If the SystemRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemRoleMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *SystemRoleMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[systemrole.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *SystemRoleMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[systemrole.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *SystemRoleMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, systemrole.FieldDeletedAt)
+}
+
+// SetRemark sets the "remark" field.
+func (m *SystemRoleMutation) SetRemark(s string) {
+	m.remark = &s
+}
+
+// Remark returns the value of the "remark" field in the mutation.
+func (m *SystemRoleMutation) Remark() (r string, exists bool) {
+	v := m.remark
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemark returns the old "remark" field's value of the SystemRole entity.
+// If the SystemRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemRoleMutation) OldRemark(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemark is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemark requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemark: %w", err)
+	}
+	return oldValue.Remark, nil
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (m *SystemRoleMutation) ClearRemark() {
+	m.remark = nil
+	m.clearedFields[systemrole.FieldRemark] = struct{}{}
+}
+
+// RemarkCleared returns if the "remark" field was cleared in this mutation.
+func (m *SystemRoleMutation) RemarkCleared() bool {
+	_, ok := m.clearedFields[systemrole.FieldRemark]
+	return ok
+}
+
+// ResetRemark resets all changes to the "remark" field.
+func (m *SystemRoleMutation) ResetRemark() {
+	m.remark = nil
+	delete(m.clearedFields, systemrole.FieldRemark)
+}
+
+// SetName sets the "name" field.
+func (m *SystemRoleMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SystemRoleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the SystemRole entity.
+// If the SystemRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemRoleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SystemRoleMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCode sets the "code" field.
+func (m *SystemRoleMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *SystemRoleMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the SystemRole entity.
+// If the SystemRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemRoleMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *SystemRoleMutation) ResetCode() {
+	m.code = nil
+}
+
+// SetSort sets the "sort" field.
+func (m *SystemRoleMutation) SetSort(i int32) {
+	m.sort = &i
+	m.addsort = nil
+}
+
+// Sort returns the value of the "sort" field in the mutation.
+func (m *SystemRoleMutation) Sort() (r int32, exists bool) {
+	v := m.sort
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSort returns the old "sort" field's value of the SystemRole entity.
+// If the SystemRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemRoleMutation) OldSort(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSort is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSort requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSort: %w", err)
+	}
+	return oldValue.Sort, nil
+}
+
+// AddSort adds i to the "sort" field.
+func (m *SystemRoleMutation) AddSort(i int32) {
+	if m.addsort != nil {
+		*m.addsort += i
+	} else {
+		m.addsort = &i
+	}
+}
+
+// AddedSort returns the value that was added to the "sort" field in this mutation.
+func (m *SystemRoleMutation) AddedSort() (r int32, exists bool) {
+	v := m.addsort
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSort resets all changes to the "sort" field.
+func (m *SystemRoleMutation) ResetSort() {
+	m.sort = nil
+	m.addsort = nil
+}
+
+// SetDataScope sets the "data_scope" field.
+func (m *SystemRoleMutation) SetDataScope(i int8) {
+	m.data_scope = &i
+	m.adddata_scope = nil
+}
+
+// DataScope returns the value of the "data_scope" field in the mutation.
+func (m *SystemRoleMutation) DataScope() (r int8, exists bool) {
+	v := m.data_scope
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDataScope returns the old "data_scope" field's value of the SystemRole entity.
+// If the SystemRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemRoleMutation) OldDataScope(ctx context.Context) (v int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDataScope is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDataScope requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDataScope: %w", err)
+	}
+	return oldValue.DataScope, nil
+}
+
+// AddDataScope adds i to the "data_scope" field.
+func (m *SystemRoleMutation) AddDataScope(i int8) {
+	if m.adddata_scope != nil {
+		*m.adddata_scope += i
+	} else {
+		m.adddata_scope = &i
+	}
+}
+
+// AddedDataScope returns the value that was added to the "data_scope" field in this mutation.
+func (m *SystemRoleMutation) AddedDataScope() (r int8, exists bool) {
+	v := m.adddata_scope
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDataScope resets all changes to the "data_scope" field.
+func (m *SystemRoleMutation) ResetDataScope() {
+	m.data_scope = nil
+	m.adddata_scope = nil
+}
+
+// SetDataScopeDeptIds sets the "data_scope_dept_ids" field.
+func (m *SystemRoleMutation) SetDataScopeDeptIds(s []string) {
+	m.data_scope_dept_ids = &s
+	m.appenddata_scope_dept_ids = nil
+}
+
+// DataScopeDeptIds returns the value of the "data_scope_dept_ids" field in the mutation.
+func (m *SystemRoleMutation) DataScopeDeptIds() (r []string, exists bool) {
+	v := m.data_scope_dept_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDataScopeDeptIds returns the old "data_scope_dept_ids" field's value of the SystemRole entity.
+// If the SystemRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemRoleMutation) OldDataScopeDeptIds(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDataScopeDeptIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDataScopeDeptIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDataScopeDeptIds: %w", err)
+	}
+	return oldValue.DataScopeDeptIds, nil
+}
+
+// AppendDataScopeDeptIds adds s to the "data_scope_dept_ids" field.
+func (m *SystemRoleMutation) AppendDataScopeDeptIds(s []string) {
+	m.appenddata_scope_dept_ids = append(m.appenddata_scope_dept_ids, s...)
+}
+
+// AppendedDataScopeDeptIds returns the list of values that were appended to the "data_scope_dept_ids" field in this mutation.
+func (m *SystemRoleMutation) AppendedDataScopeDeptIds() ([]string, bool) {
+	if len(m.appenddata_scope_dept_ids) == 0 {
+		return nil, false
+	}
+	return m.appenddata_scope_dept_ids, true
+}
+
+// ClearDataScopeDeptIds clears the value of the "data_scope_dept_ids" field.
+func (m *SystemRoleMutation) ClearDataScopeDeptIds() {
+	m.data_scope_dept_ids = nil
+	m.appenddata_scope_dept_ids = nil
+	m.clearedFields[systemrole.FieldDataScopeDeptIds] = struct{}{}
+}
+
+// DataScopeDeptIdsCleared returns if the "data_scope_dept_ids" field was cleared in this mutation.
+func (m *SystemRoleMutation) DataScopeDeptIdsCleared() bool {
+	_, ok := m.clearedFields[systemrole.FieldDataScopeDeptIds]
+	return ok
+}
+
+// ResetDataScopeDeptIds resets all changes to the "data_scope_dept_ids" field.
+func (m *SystemRoleMutation) ResetDataScopeDeptIds() {
+	m.data_scope_dept_ids = nil
+	m.appenddata_scope_dept_ids = nil
+	delete(m.clearedFields, systemrole.FieldDataScopeDeptIds)
+}
+
+// SetStatus sets the "status" field.
+func (m *SystemRoleMutation) SetStatus(i int8) {
+	m.status = &i
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SystemRoleMutation) Status() (r int8, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the SystemRole entity.
+// If the SystemRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemRoleMutation) OldStatus(ctx context.Context) (v int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds i to the "status" field.
+func (m *SystemRoleMutation) AddStatus(i int8) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *SystemRoleMutation) AddedStatus() (r int8, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SystemRoleMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+}
+
+// SetType sets the "type" field.
+func (m *SystemRoleMutation) SetType(i int8) {
+	m._type = &i
+	m.add_type = nil
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *SystemRoleMutation) GetType() (r int8, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the SystemRole entity.
+// If the SystemRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemRoleMutation) OldType(ctx context.Context) (v int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// AddType adds i to the "type" field.
+func (m *SystemRoleMutation) AddType(i int8) {
+	if m.add_type != nil {
+		*m.add_type += i
+	} else {
+		m.add_type = &i
+	}
+}
+
+// AddedType returns the value that was added to the "type" field in this mutation.
+func (m *SystemRoleMutation) AddedType() (r int8, exists bool) {
+	v := m.add_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *SystemRoleMutation) ResetType() {
+	m._type = nil
+	m.add_type = nil
+}
+
+// Where appends a list predicates to the SystemRoleMutation builder.
+func (m *SystemRoleMutation) Where(ps ...predicate.SystemRole) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SystemRoleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SystemRoleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SystemRole, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SystemRoleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SystemRoleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SystemRole).
+func (m *SystemRoleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SystemRoleMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.create_by != nil {
+		fields = append(fields, systemrole.FieldCreateBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, systemrole.FieldCreatedAt)
+	}
+	if m.update_by != nil {
+		fields = append(fields, systemrole.FieldUpdateBy)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, systemrole.FieldUpdatedAt)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, systemrole.FieldTenantID)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, systemrole.FieldDeletedAt)
+	}
+	if m.remark != nil {
+		fields = append(fields, systemrole.FieldRemark)
+	}
+	if m.name != nil {
+		fields = append(fields, systemrole.FieldName)
+	}
+	if m.code != nil {
+		fields = append(fields, systemrole.FieldCode)
+	}
+	if m.sort != nil {
+		fields = append(fields, systemrole.FieldSort)
+	}
+	if m.data_scope != nil {
+		fields = append(fields, systemrole.FieldDataScope)
+	}
+	if m.data_scope_dept_ids != nil {
+		fields = append(fields, systemrole.FieldDataScopeDeptIds)
+	}
+	if m.status != nil {
+		fields = append(fields, systemrole.FieldStatus)
+	}
+	if m._type != nil {
+		fields = append(fields, systemrole.FieldType)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SystemRoleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case systemrole.FieldCreateBy:
+		return m.CreateBy()
+	case systemrole.FieldCreatedAt:
+		return m.CreatedAt()
+	case systemrole.FieldUpdateBy:
+		return m.UpdateBy()
+	case systemrole.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case systemrole.FieldTenantID:
+		return m.TenantID()
+	case systemrole.FieldDeletedAt:
+		return m.DeletedAt()
+	case systemrole.FieldRemark:
+		return m.Remark()
+	case systemrole.FieldName:
+		return m.Name()
+	case systemrole.FieldCode:
+		return m.Code()
+	case systemrole.FieldSort:
+		return m.Sort()
+	case systemrole.FieldDataScope:
+		return m.DataScope()
+	case systemrole.FieldDataScopeDeptIds:
+		return m.DataScopeDeptIds()
+	case systemrole.FieldStatus:
+		return m.Status()
+	case systemrole.FieldType:
+		return m.GetType()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SystemRoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case systemrole.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case systemrole.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case systemrole.FieldUpdateBy:
+		return m.OldUpdateBy(ctx)
+	case systemrole.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case systemrole.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case systemrole.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case systemrole.FieldRemark:
+		return m.OldRemark(ctx)
+	case systemrole.FieldName:
+		return m.OldName(ctx)
+	case systemrole.FieldCode:
+		return m.OldCode(ctx)
+	case systemrole.FieldSort:
+		return m.OldSort(ctx)
+	case systemrole.FieldDataScope:
+		return m.OldDataScope(ctx)
+	case systemrole.FieldDataScopeDeptIds:
+		return m.OldDataScopeDeptIds(ctx)
+	case systemrole.FieldStatus:
+		return m.OldStatus(ctx)
+	case systemrole.FieldType:
+		return m.OldType(ctx)
+	}
+	return nil, fmt.Errorf("unknown SystemRole field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemRoleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case systemrole.FieldCreateBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateBy(v)
+		return nil
+	case systemrole.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case systemrole.FieldUpdateBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateBy(v)
+		return nil
+	case systemrole.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case systemrole.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case systemrole.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case systemrole.FieldRemark:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemark(v)
+		return nil
+	case systemrole.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case systemrole.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case systemrole.FieldSort:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSort(v)
+		return nil
+	case systemrole.FieldDataScope:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDataScope(v)
+		return nil
+	case systemrole.FieldDataScopeDeptIds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDataScopeDeptIds(v)
+		return nil
+	case systemrole.FieldStatus:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case systemrole.FieldType:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SystemRole field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SystemRoleMutation) AddedFields() []string {
+	var fields []string
+	if m.addsort != nil {
+		fields = append(fields, systemrole.FieldSort)
+	}
+	if m.adddata_scope != nil {
+		fields = append(fields, systemrole.FieldDataScope)
+	}
+	if m.addstatus != nil {
+		fields = append(fields, systemrole.FieldStatus)
+	}
+	if m.add_type != nil {
+		fields = append(fields, systemrole.FieldType)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SystemRoleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case systemrole.FieldSort:
+		return m.AddedSort()
+	case systemrole.FieldDataScope:
+		return m.AddedDataScope()
+	case systemrole.FieldStatus:
+		return m.AddedStatus()
+	case systemrole.FieldType:
+		return m.AddedType()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemRoleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case systemrole.FieldSort:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSort(v)
+		return nil
+	case systemrole.FieldDataScope:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDataScope(v)
+		return nil
+	case systemrole.FieldStatus:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	case systemrole.FieldType:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddType(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SystemRole numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SystemRoleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(systemrole.FieldCreateBy) {
+		fields = append(fields, systemrole.FieldCreateBy)
+	}
+	if m.FieldCleared(systemrole.FieldCreatedAt) {
+		fields = append(fields, systemrole.FieldCreatedAt)
+	}
+	if m.FieldCleared(systemrole.FieldUpdateBy) {
+		fields = append(fields, systemrole.FieldUpdateBy)
+	}
+	if m.FieldCleared(systemrole.FieldUpdatedAt) {
+		fields = append(fields, systemrole.FieldUpdatedAt)
+	}
+	if m.FieldCleared(systemrole.FieldDeletedAt) {
+		fields = append(fields, systemrole.FieldDeletedAt)
+	}
+	if m.FieldCleared(systemrole.FieldRemark) {
+		fields = append(fields, systemrole.FieldRemark)
+	}
+	if m.FieldCleared(systemrole.FieldDataScopeDeptIds) {
+		fields = append(fields, systemrole.FieldDataScopeDeptIds)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SystemRoleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SystemRoleMutation) ClearField(name string) error {
+	switch name {
+	case systemrole.FieldCreateBy:
+		m.ClearCreateBy()
+		return nil
+	case systemrole.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case systemrole.FieldUpdateBy:
+		m.ClearUpdateBy()
+		return nil
+	case systemrole.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case systemrole.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case systemrole.FieldRemark:
+		m.ClearRemark()
+		return nil
+	case systemrole.FieldDataScopeDeptIds:
+		m.ClearDataScopeDeptIds()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemRole nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SystemRoleMutation) ResetField(name string) error {
+	switch name {
+	case systemrole.FieldCreateBy:
+		m.ResetCreateBy()
+		return nil
+	case systemrole.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case systemrole.FieldUpdateBy:
+		m.ResetUpdateBy()
+		return nil
+	case systemrole.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case systemrole.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case systemrole.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case systemrole.FieldRemark:
+		m.ResetRemark()
+		return nil
+	case systemrole.FieldName:
+		m.ResetName()
+		return nil
+	case systemrole.FieldCode:
+		m.ResetCode()
+		return nil
+	case systemrole.FieldSort:
+		m.ResetSort()
+		return nil
+	case systemrole.FieldDataScope:
+		m.ResetDataScope()
+		return nil
+	case systemrole.FieldDataScopeDeptIds:
+		m.ResetDataScopeDeptIds()
+		return nil
+	case systemrole.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case systemrole.FieldType:
+		m.ResetType()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemRole field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SystemRoleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SystemRoleMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SystemRoleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SystemRoleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SystemRoleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SystemRoleMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SystemRoleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SystemRole unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SystemRoleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SystemRole edge %s", name)
+}
+
+// SystemRoleMenuMutation represents an operation that mutates the SystemRoleMenu nodes in the graph.
+type SystemRoleMenuMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	create_by     *string
+	created_at    *time.Time
+	update_by     *string
+	updated_at    *time.Time
+	tenant_id     *string
+	deleted_at    *time.Time
+	role_id       *string
+	menu_id       *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SystemRoleMenu, error)
+	predicates    []predicate.SystemRoleMenu
+}
+
+var _ ent.Mutation = (*SystemRoleMenuMutation)(nil)
+
+// systemrolemenuOption allows management of the mutation configuration using functional options.
+type systemrolemenuOption func(*SystemRoleMenuMutation)
+
+// newSystemRoleMenuMutation creates new mutation for the SystemRoleMenu entity.
+func newSystemRoleMenuMutation(c config, op Op, opts ...systemrolemenuOption) *SystemRoleMenuMutation {
+	m := &SystemRoleMenuMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSystemRoleMenu,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSystemRoleMenuID sets the ID field of the mutation.
+func withSystemRoleMenuID(id string) systemrolemenuOption {
+	return func(m *SystemRoleMenuMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SystemRoleMenu
+		)
+		m.oldValue = func(ctx context.Context) (*SystemRoleMenu, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SystemRoleMenu.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSystemRoleMenu sets the old SystemRoleMenu of the mutation.
+func withSystemRoleMenu(node *SystemRoleMenu) systemrolemenuOption {
+	return func(m *SystemRoleMenuMutation) {
+		m.oldValue = func(context.Context) (*SystemRoleMenu, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SystemRoleMenuMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SystemRoleMenuMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SystemRoleMenu entities.
+func (m *SystemRoleMenuMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SystemRoleMenuMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SystemRoleMenuMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SystemRoleMenu.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateBy sets the "create_by" field.
+func (m *SystemRoleMenuMutation) SetCreateBy(s string) {
+	m.create_by = &s
+}
+
+// CreateBy returns the value of the "create_by" field in the mutation.
+func (m *SystemRoleMenuMutation) CreateBy() (r string, exists bool) {
+	v := m.create_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateBy returns the old "create_by" field's value of the SystemRoleMenu entity.
+// If the SystemRoleMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemRoleMenuMutation) OldCreateBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
+	}
+	return oldValue.CreateBy, nil
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (m *SystemRoleMenuMutation) ClearCreateBy() {
+	m.create_by = nil
+	m.clearedFields[systemrolemenu.FieldCreateBy] = struct{}{}
+}
+
+// CreateByCleared returns if the "create_by" field was cleared in this mutation.
+func (m *SystemRoleMenuMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[systemrolemenu.FieldCreateBy]
+	return ok
+}
+
+// ResetCreateBy resets all changes to the "create_by" field.
+func (m *SystemRoleMenuMutation) ResetCreateBy() {
+	m.create_by = nil
+	delete(m.clearedFields, systemrolemenu.FieldCreateBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SystemRoleMenuMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SystemRoleMenuMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SystemRoleMenu entity.
+// If the SystemRoleMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemRoleMenuMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *SystemRoleMenuMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[systemrolemenu.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *SystemRoleMenuMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[systemrolemenu.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SystemRoleMenuMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, systemrolemenu.FieldCreatedAt)
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (m *SystemRoleMenuMutation) SetUpdateBy(s string) {
+	m.update_by = &s
+}
+
+// UpdateBy returns the value of the "update_by" field in the mutation.
+func (m *SystemRoleMenuMutation) UpdateBy() (r string, exists bool) {
+	v := m.update_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateBy returns the old "update_by" field's value of the SystemRoleMenu entity.
+// If the SystemRoleMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemRoleMenuMutation) OldUpdateBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateBy: %w", err)
+	}
+	return oldValue.UpdateBy, nil
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (m *SystemRoleMenuMutation) ClearUpdateBy() {
+	m.update_by = nil
+	m.clearedFields[systemrolemenu.FieldUpdateBy] = struct{}{}
+}
+
+// UpdateByCleared returns if the "update_by" field was cleared in this mutation.
+func (m *SystemRoleMenuMutation) UpdateByCleared() bool {
+	_, ok := m.clearedFields[systemrolemenu.FieldUpdateBy]
+	return ok
+}
+
+// ResetUpdateBy resets all changes to the "update_by" field.
+func (m *SystemRoleMenuMutation) ResetUpdateBy() {
+	m.update_by = nil
+	delete(m.clearedFields, systemrolemenu.FieldUpdateBy)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SystemRoleMenuMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SystemRoleMenuMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SystemRoleMenu entity.
+// If the SystemRoleMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemRoleMenuMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *SystemRoleMenuMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[systemrolemenu.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *SystemRoleMenuMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[systemrolemenu.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SystemRoleMenuMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, systemrolemenu.FieldUpdatedAt)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *SystemRoleMenuMutation) SetTenantID(s string) {
+	m.tenant_id = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *SystemRoleMenuMutation) TenantID() (r string, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the SystemRoleMenu entity.
+// If the SystemRoleMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemRoleMenuMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *SystemRoleMenuMutation) ResetTenantID() {
+	m.tenant_id = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *SystemRoleMenuMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *SystemRoleMenuMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the SystemRoleMenu entity.
+// If the SystemRoleMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemRoleMenuMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *SystemRoleMenuMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[systemrolemenu.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *SystemRoleMenuMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[systemrolemenu.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *SystemRoleMenuMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, systemrolemenu.FieldDeletedAt)
+}
+
+// SetRoleID sets the "role_id" field.
+func (m *SystemRoleMenuMutation) SetRoleID(s string) {
+	m.role_id = &s
+}
+
+// RoleID returns the value of the "role_id" field in the mutation.
+func (m *SystemRoleMenuMutation) RoleID() (r string, exists bool) {
+	v := m.role_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleID returns the old "role_id" field's value of the SystemRoleMenu entity.
+// If the SystemRoleMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemRoleMenuMutation) OldRoleID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleID: %w", err)
+	}
+	return oldValue.RoleID, nil
+}
+
+// ResetRoleID resets all changes to the "role_id" field.
+func (m *SystemRoleMenuMutation) ResetRoleID() {
+	m.role_id = nil
+}
+
+// SetMenuID sets the "menu_id" field.
+func (m *SystemRoleMenuMutation) SetMenuID(s string) {
+	m.menu_id = &s
+}
+
+// MenuID returns the value of the "menu_id" field in the mutation.
+func (m *SystemRoleMenuMutation) MenuID() (r string, exists bool) {
+	v := m.menu_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMenuID returns the old "menu_id" field's value of the SystemRoleMenu entity.
+// If the SystemRoleMenu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemRoleMenuMutation) OldMenuID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMenuID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMenuID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMenuID: %w", err)
+	}
+	return oldValue.MenuID, nil
+}
+
+// ResetMenuID resets all changes to the "menu_id" field.
+func (m *SystemRoleMenuMutation) ResetMenuID() {
+	m.menu_id = nil
+}
+
+// Where appends a list predicates to the SystemRoleMenuMutation builder.
+func (m *SystemRoleMenuMutation) Where(ps ...predicate.SystemRoleMenu) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SystemRoleMenuMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SystemRoleMenuMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SystemRoleMenu, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SystemRoleMenuMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SystemRoleMenuMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SystemRoleMenu).
+func (m *SystemRoleMenuMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SystemRoleMenuMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_by != nil {
+		fields = append(fields, systemrolemenu.FieldCreateBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, systemrolemenu.FieldCreatedAt)
+	}
+	if m.update_by != nil {
+		fields = append(fields, systemrolemenu.FieldUpdateBy)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, systemrolemenu.FieldUpdatedAt)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, systemrolemenu.FieldTenantID)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, systemrolemenu.FieldDeletedAt)
+	}
+	if m.role_id != nil {
+		fields = append(fields, systemrolemenu.FieldRoleID)
+	}
+	if m.menu_id != nil {
+		fields = append(fields, systemrolemenu.FieldMenuID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SystemRoleMenuMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case systemrolemenu.FieldCreateBy:
+		return m.CreateBy()
+	case systemrolemenu.FieldCreatedAt:
+		return m.CreatedAt()
+	case systemrolemenu.FieldUpdateBy:
+		return m.UpdateBy()
+	case systemrolemenu.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case systemrolemenu.FieldTenantID:
+		return m.TenantID()
+	case systemrolemenu.FieldDeletedAt:
+		return m.DeletedAt()
+	case systemrolemenu.FieldRoleID:
+		return m.RoleID()
+	case systemrolemenu.FieldMenuID:
+		return m.MenuID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SystemRoleMenuMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case systemrolemenu.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case systemrolemenu.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case systemrolemenu.FieldUpdateBy:
+		return m.OldUpdateBy(ctx)
+	case systemrolemenu.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case systemrolemenu.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case systemrolemenu.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case systemrolemenu.FieldRoleID:
+		return m.OldRoleID(ctx)
+	case systemrolemenu.FieldMenuID:
+		return m.OldMenuID(ctx)
+	}
+	return nil, fmt.Errorf("unknown SystemRoleMenu field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemRoleMenuMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case systemrolemenu.FieldCreateBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateBy(v)
+		return nil
+	case systemrolemenu.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case systemrolemenu.FieldUpdateBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateBy(v)
+		return nil
+	case systemrolemenu.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case systemrolemenu.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case systemrolemenu.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case systemrolemenu.FieldRoleID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleID(v)
+		return nil
+	case systemrolemenu.FieldMenuID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMenuID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SystemRoleMenu field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SystemRoleMenuMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SystemRoleMenuMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemRoleMenuMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SystemRoleMenu numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SystemRoleMenuMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(systemrolemenu.FieldCreateBy) {
+		fields = append(fields, systemrolemenu.FieldCreateBy)
+	}
+	if m.FieldCleared(systemrolemenu.FieldCreatedAt) {
+		fields = append(fields, systemrolemenu.FieldCreatedAt)
+	}
+	if m.FieldCleared(systemrolemenu.FieldUpdateBy) {
+		fields = append(fields, systemrolemenu.FieldUpdateBy)
+	}
+	if m.FieldCleared(systemrolemenu.FieldUpdatedAt) {
+		fields = append(fields, systemrolemenu.FieldUpdatedAt)
+	}
+	if m.FieldCleared(systemrolemenu.FieldDeletedAt) {
+		fields = append(fields, systemrolemenu.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SystemRoleMenuMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SystemRoleMenuMutation) ClearField(name string) error {
+	switch name {
+	case systemrolemenu.FieldCreateBy:
+		m.ClearCreateBy()
+		return nil
+	case systemrolemenu.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case systemrolemenu.FieldUpdateBy:
+		m.ClearUpdateBy()
+		return nil
+	case systemrolemenu.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case systemrolemenu.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemRoleMenu nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SystemRoleMenuMutation) ResetField(name string) error {
+	switch name {
+	case systemrolemenu.FieldCreateBy:
+		m.ResetCreateBy()
+		return nil
+	case systemrolemenu.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case systemrolemenu.FieldUpdateBy:
+		m.ResetUpdateBy()
+		return nil
+	case systemrolemenu.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case systemrolemenu.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case systemrolemenu.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case systemrolemenu.FieldRoleID:
+		m.ResetRoleID()
+		return nil
+	case systemrolemenu.FieldMenuID:
+		m.ResetMenuID()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemRoleMenu field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SystemRoleMenuMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SystemRoleMenuMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SystemRoleMenuMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SystemRoleMenuMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SystemRoleMenuMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SystemRoleMenuMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SystemRoleMenuMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SystemRoleMenu unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SystemRoleMenuMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SystemRoleMenu edge %s", name)
+}
+
+// SystemTenantMutation represents an operation that mutates the SystemTenant nodes in the graph.
+type SystemTenantMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *string
+	create_by        *string
+	created_at       *time.Time
+	update_by        *string
+	updated_at       *time.Time
+	deleted_at       *time.Time
+	name             *string
+	contact_user_id  *string
+	contact_name     *string
+	contact_mobile   *string
+	status           *int8
+	addstatus        *int8
+	website          *string
+	package_id       *string
+	expire_time      *time.Time
+	account_count    *int32
+	addaccount_count *int32
+	mfa_required     *bool
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*SystemTenant, error)
+	predicates       []predicate.SystemTenant
+}
+
+var _ ent.Mutation = (*SystemTenantMutation)(nil)
+
+// systemtenantOption allows management of the mutation configuration using functional options.
+type systemtenantOption func(*SystemTenantMutation)
+
+// newSystemTenantMutation creates new mutation for the SystemTenant entity.
+func newSystemTenantMutation(c config, op Op, opts ...systemtenantOption) *SystemTenantMutation {
+	m := &SystemTenantMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSystemTenant,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSystemTenantID sets the ID field of the mutation.
+func withSystemTenantID(id string) systemtenantOption {
+	return func(m *SystemTenantMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SystemTenant
+		)
+		m.oldValue = func(ctx context.Context) (*SystemTenant, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SystemTenant.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSystemTenant sets the old SystemTenant of the mutation.
+func withSystemTenant(node *SystemTenant) systemtenantOption {
+	return func(m *SystemTenantMutation) {
+		m.oldValue = func(context.Context) (*SystemTenant, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SystemTenantMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SystemTenantMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SystemTenant entities.
+func (m *SystemTenantMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SystemTenantMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SystemTenantMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SystemTenant.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateBy sets the "create_by" field.
+func (m *SystemTenantMutation) SetCreateBy(s string) {
+	m.create_by = &s
+}
+
+// CreateBy returns the value of the "create_by" field in the mutation.
+func (m *SystemTenantMutation) CreateBy() (r string, exists bool) {
+	v := m.create_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateBy returns the old "create_by" field's value of the SystemTenant entity.
+// If the SystemTenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantMutation) OldCreateBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
+	}
+	return oldValue.CreateBy, nil
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (m *SystemTenantMutation) ClearCreateBy() {
+	m.create_by = nil
+	m.clearedFields[systemtenant.FieldCreateBy] = struct{}{}
+}
+
+// CreateByCleared returns if the "create_by" field was cleared in this mutation.
+func (m *SystemTenantMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[systemtenant.FieldCreateBy]
+	return ok
+}
+
+// ResetCreateBy resets all changes to the "create_by" field.
+func (m *SystemTenantMutation) ResetCreateBy() {
+	m.create_by = nil
+	delete(m.clearedFields, systemtenant.FieldCreateBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SystemTenantMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SystemTenantMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SystemTenant entity.
+// If the SystemTenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *SystemTenantMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[systemtenant.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *SystemTenantMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[systemtenant.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SystemTenantMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, systemtenant.FieldCreatedAt)
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (m *SystemTenantMutation) SetUpdateBy(s string) {
+	m.update_by = &s
+}
+
+// UpdateBy returns the value of the "update_by" field in the mutation.
+func (m *SystemTenantMutation) UpdateBy() (r string, exists bool) {
+	v := m.update_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateBy returns the old "update_by" field's value of the SystemTenant entity.
+// If the SystemTenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantMutation) OldUpdateBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateBy: %w", err)
+	}
+	return oldValue.UpdateBy, nil
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (m *SystemTenantMutation) ClearUpdateBy() {
+	m.update_by = nil
+	m.clearedFields[systemtenant.FieldUpdateBy] = struct{}{}
+}
+
+// UpdateByCleared returns if the "update_by" field was cleared in this mutation.
+func (m *SystemTenantMutation) UpdateByCleared() bool {
+	_, ok := m.clearedFields[systemtenant.FieldUpdateBy]
+	return ok
+}
+
+// ResetUpdateBy resets all changes to the "update_by" field.
+func (m *SystemTenantMutation) ResetUpdateBy() {
+	m.update_by = nil
+	delete(m.clearedFields, systemtenant.FieldUpdateBy)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SystemTenantMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SystemTenantMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SystemTenant entity.
+// If the SystemTenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *SystemTenantMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[systemtenant.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *SystemTenantMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[systemtenant.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SystemTenantMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, systemtenant.FieldUpdatedAt)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *SystemTenantMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *SystemTenantMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the SystemTenant entity.
+// If the SystemTenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *SystemTenantMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[systemtenant.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *SystemTenantMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[systemtenant.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *SystemTenantMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, systemtenant.FieldDeletedAt)
+}
+
+// SetName sets the "name" field.
+func (m *SystemTenantMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SystemTenantMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the SystemTenant entity.
+// If the SystemTenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SystemTenantMutation) ResetName() {
+	m.name = nil
+}
+
+// SetContactUserID sets the "contact_user_id" field.
+func (m *SystemTenantMutation) SetContactUserID(s string) {
+	m.contact_user_id = &s
+}
+
+// ContactUserID returns the value of the "contact_user_id" field in the mutation.
+func (m *SystemTenantMutation) ContactUserID() (r string, exists bool) {
+	v := m.contact_user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContactUserID returns the old "contact_user_id" field's value of the SystemTenant entity.
+// If the SystemTenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantMutation) OldContactUserID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContactUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContactUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContactUserID: %w", err)
+	}
+	return oldValue.ContactUserID, nil
+}
+
+// ClearContactUserID clears the value of the "contact_user_id" field.
+func (m *SystemTenantMutation) ClearContactUserID() {
+	m.contact_user_id = nil
+	m.clearedFields[systemtenant.FieldContactUserID] = struct{}{}
+}
+
+// ContactUserIDCleared returns if the "contact_user_id" field was cleared in this mutation.
+func (m *SystemTenantMutation) ContactUserIDCleared() bool {
+	_, ok := m.clearedFields[systemtenant.FieldContactUserID]
+	return ok
+}
+
+// ResetContactUserID resets all changes to the "contact_user_id" field.
+func (m *SystemTenantMutation) ResetContactUserID() {
+	m.contact_user_id = nil
+	delete(m.clearedFields, systemtenant.FieldContactUserID)
+}
+
+// SetContactName sets the "contact_name" field.
+func (m *SystemTenantMutation) SetContactName(s string) {
+	m.contact_name = &s
+}
+
+// ContactName returns the value of the "contact_name" field in the mutation.
+func (m *SystemTenantMutation) ContactName() (r string, exists bool) {
+	v := m.contact_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContactName returns the old "contact_name" field's value of the SystemTenant entity.
+// If the SystemTenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantMutation) OldContactName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContactName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContactName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContactName: %w", err)
+	}
+	return oldValue.ContactName, nil
+}
+
+// ResetContactName resets all changes to the "contact_name" field.
+func (m *SystemTenantMutation) ResetContactName() {
+	m.contact_name = nil
+}
+
+// SetContactMobile sets the "contact_mobile" field.
+func (m *SystemTenantMutation) SetContactMobile(s string) {
+	m.contact_mobile = &s
+}
+
+// ContactMobile returns the value of the "contact_mobile" field in the mutation.
+func (m *SystemTenantMutation) ContactMobile() (r string, exists bool) {
+	v := m.contact_mobile
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContactMobile returns the old "contact_mobile" field's value of the SystemTenant entity.
+// If the SystemTenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantMutation) OldContactMobile(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContactMobile is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContactMobile requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContactMobile: %w", err)
+	}
+	return oldValue.ContactMobile, nil
+}
+
+// ClearContactMobile clears the value of the "contact_mobile" field.
+func (m *SystemTenantMutation) ClearContactMobile() {
+	m.contact_mobile = nil
+	m.clearedFields[systemtenant.FieldContactMobile] = struct{}{}
+}
+
+// ContactMobileCleared returns if the "contact_mobile" field was cleared in this mutation.
+func (m *SystemTenantMutation) ContactMobileCleared() bool {
+	_, ok := m.clearedFields[systemtenant.FieldContactMobile]
+	return ok
+}
+
+// ResetContactMobile resets all changes to the "contact_mobile" field.
+func (m *SystemTenantMutation) ResetContactMobile() {
+	m.contact_mobile = nil
+	delete(m.clearedFields, systemtenant.FieldContactMobile)
+}
+
+// SetStatus sets the "status" field.
+func (m *SystemTenantMutation) SetStatus(i int8) {
+	m.status = &i
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SystemTenantMutation) Status() (r int8, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the SystemTenant entity.
+// If the SystemTenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantMutation) OldStatus(ctx context.Context) (v int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds i to the "status" field.
+func (m *SystemTenantMutation) AddStatus(i int8) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *SystemTenantMutation) AddedStatus() (r int8, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SystemTenantMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+}
+
+// SetWebsite sets the "website" field.
+func (m *SystemTenantMutation) SetWebsite(s string) {
+	m.website = &s
+}
+
+// Website returns the value of the "website" field in the mutation.
+func (m *SystemTenantMutation) Website() (r string, exists bool) {
+	v := m.website
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWebsite returns the old "website" field's value of the SystemTenant entity.
+// If the SystemTenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantMutation) OldWebsite(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWebsite is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWebsite requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWebsite: %w", err)
+	}
+	return oldValue.Website, nil
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (m *SystemTenantMutation) ClearWebsite() {
+	m.website = nil
+	m.clearedFields[systemtenant.FieldWebsite] = struct{}{}
+}
+
+// WebsiteCleared returns if the "website" field was cleared in this mutation.
+func (m *SystemTenantMutation) WebsiteCleared() bool {
+	_, ok := m.clearedFields[systemtenant.FieldWebsite]
+	return ok
+}
+
+// ResetWebsite resets all changes to the "website" field.
+func (m *SystemTenantMutation) ResetWebsite() {
+	m.website = nil
+	delete(m.clearedFields, systemtenant.FieldWebsite)
+}
+
+// SetPackageID sets the "package_id" field.
+func (m *SystemTenantMutation) SetPackageID(s string) {
+	m.package_id = &s
+}
+
+// PackageID returns the value of the "package_id" field in the mutation.
+func (m *SystemTenantMutation) PackageID() (r string, exists bool) {
+	v := m.package_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageID returns the old "package_id" field's value of the SystemTenant entity.
+// If the SystemTenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantMutation) OldPackageID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageID: %w", err)
+	}
+	return oldValue.PackageID, nil
+}
+
+// ResetPackageID resets all changes to the "package_id" field.
+func (m *SystemTenantMutation) ResetPackageID() {
+	m.package_id = nil
+}
+
+// SetExpireTime sets the "expire_time" field.
+func (m *SystemTenantMutation) SetExpireTime(t time.Time) {
+	m.expire_time = &t
+}
+
+// ExpireTime returns the value of the "expire_time" field in the mutation.
+func (m *SystemTenantMutation) ExpireTime() (r time.Time, exists bool) {
+	v := m.expire_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpireTime returns the old "expire_time" field's value of the SystemTenant entity.
+// If the SystemTenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantMutation) OldExpireTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpireTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpireTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpireTime: %w", err)
+	}
+	return oldValue.ExpireTime, nil
+}
+
+// ResetExpireTime resets all changes to the "expire_time" field.
+func (m *SystemTenantMutation) ResetExpireTime() {
+	m.expire_time = nil
+}
+
+// SetAccountCount sets the "account_count" field.
+func (m *SystemTenantMutation) SetAccountCount(i int32) {
+	m.account_count = &i
+	m.addaccount_count = nil
+}
+
+// AccountCount returns the value of the "account_count" field in the mutation.
+func (m *SystemTenantMutation) AccountCount() (r int32, exists bool) {
+	v := m.account_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountCount returns the old "account_count" field's value of the SystemTenant entity.
+// If the SystemTenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantMutation) OldAccountCount(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountCount: %w", err)
+	}
+	return oldValue.AccountCount, nil
+}
+
+// AddAccountCount adds i to the "account_count" field.
+func (m *SystemTenantMutation) AddAccountCount(i int32) {
+	if m.addaccount_count != nil {
+		*m.addaccount_count += i
+	} else {
+		m.addaccount_count = &i
+	}
+}
+
+// AddedAccountCount returns the value that was added to the "account_count" field in this mutation.
+func (m *SystemTenantMutation) AddedAccountCount() (r int32, exists bool) {
+	v := m.addaccount_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAccountCount resets all changes to the "account_count" field.
+func (m *SystemTenantMutation) ResetAccountCount() {
+	m.account_count = nil
+	m.addaccount_count = nil
+}
+
+// SetMfaRequired sets the "mfa_required" field.
+func (m *SystemTenantMutation) SetMfaRequired(b bool) {
+	m.mfa_required = &b
+}
+
+// MfaRequired returns the value of the "mfa_required" field in the mutation.
+func (m *SystemTenantMutation) MfaRequired() (r bool, exists bool) {
+	v := m.mfa_required
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMfaRequired returns the old "mfa_required" field's value of the SystemTenant entity.
+// If the SystemTenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantMutation) OldMfaRequired(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMfaRequired is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMfaRequired requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMfaRequired: %w", err)
+	}
+	return oldValue.MfaRequired, nil
+}
+
+// ResetMfaRequired resets all changes to the "mfa_required" field.
+func (m *SystemTenantMutation) ResetMfaRequired() {
+	m.mfa_required = nil
+}
+
+// Where appends a list predicates to the SystemTenantMutation builder.
+func (m *SystemTenantMutation) Where(ps ...predicate.SystemTenant) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SystemTenantMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SystemTenantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SystemTenant, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SystemTenantMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SystemTenantMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SystemTenant).
+func (m *SystemTenantMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SystemTenantMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.create_by != nil {
+		fields = append(fields, systemtenant.FieldCreateBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, systemtenant.FieldCreatedAt)
+	}
+	if m.update_by != nil {
+		fields = append(fields, systemtenant.FieldUpdateBy)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, systemtenant.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, systemtenant.FieldDeletedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, systemtenant.FieldName)
+	}
+	if m.contact_user_id != nil {
+		fields = append(fields, systemtenant.FieldContactUserID)
+	}
+	if m.contact_name != nil {
+		fields = append(fields, systemtenant.FieldContactName)
+	}
+	if m.contact_mobile != nil {
+		fields = append(fields, systemtenant.FieldContactMobile)
+	}
+	if m.status != nil {
+		fields = append(fields, systemtenant.FieldStatus)
+	}
+	if m.website != nil {
+		fields = append(fields, systemtenant.FieldWebsite)
+	}
+	if m.package_id != nil {
+		fields = append(fields, systemtenant.FieldPackageID)
+	}
+	if m.expire_time != nil {
+		fields = append(fields, systemtenant.FieldExpireTime)
+	}
+	if m.account_count != nil {
+		fields = append(fields, systemtenant.FieldAccountCount)
+	}
+	if m.mfa_required != nil {
+		fields = append(fields, systemtenant.FieldMfaRequired)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SystemTenantMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case systemtenant.FieldCreateBy:
+		return m.CreateBy()
+	case systemtenant.FieldCreatedAt:
+		return m.CreatedAt()
+	case systemtenant.FieldUpdateBy:
+		return m.UpdateBy()
+	case systemtenant.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case systemtenant.FieldDeletedAt:
+		return m.DeletedAt()
+	case systemtenant.FieldName:
+		return m.Name()
+	case systemtenant.FieldContactUserID:
+		return m.ContactUserID()
+	case systemtenant.FieldContactName:
+		return m.ContactName()
+	case systemtenant.FieldContactMobile:
+		return m.ContactMobile()
+	case systemtenant.FieldStatus:
+		return m.Status()
+	case systemtenant.FieldWebsite:
+		return m.Website()
+	case systemtenant.FieldPackageID:
+		return m.PackageID()
+	case systemtenant.FieldExpireTime:
+		return m.ExpireTime()
+	case systemtenant.FieldAccountCount:
+		return m.AccountCount()
+	case systemtenant.FieldMfaRequired:
+		return m.MfaRequired()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SystemTenantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case systemtenant.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case systemtenant.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case systemtenant.FieldUpdateBy:
+		return m.OldUpdateBy(ctx)
+	case systemtenant.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case systemtenant.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case systemtenant.FieldName:
+		return m.OldName(ctx)
+	case systemtenant.FieldContactUserID:
+		return m.OldContactUserID(ctx)
+	case systemtenant.FieldContactName:
+		return m.OldContactName(ctx)
+	case systemtenant.FieldContactMobile:
+		return m.OldContactMobile(ctx)
+	case systemtenant.FieldStatus:
+		return m.OldStatus(ctx)
+	case systemtenant.FieldWebsite:
+		return m.OldWebsite(ctx)
+	case systemtenant.FieldPackageID:
+		return m.OldPackageID(ctx)
+	case systemtenant.FieldExpireTime:
+		return m.OldExpireTime(ctx)
+	case systemtenant.FieldAccountCount:
+		return m.OldAccountCount(ctx)
+	case systemtenant.FieldMfaRequired:
+		return m.OldMfaRequired(ctx)
+	}
+	return nil, fmt.Errorf("unknown SystemTenant field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemTenantMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case systemtenant.FieldCreateBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateBy(v)
+		return nil
+	case systemtenant.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case systemtenant.FieldUpdateBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateBy(v)
+		return nil
+	case systemtenant.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case systemtenant.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case systemtenant.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case systemtenant.FieldContactUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContactUserID(v)
+		return nil
+	case systemtenant.FieldContactName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContactName(v)
+		return nil
+	case systemtenant.FieldContactMobile:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContactMobile(v)
+		return nil
+	case systemtenant.FieldStatus:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case systemtenant.FieldWebsite:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebsite(v)
+		return nil
+	case systemtenant.FieldPackageID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageID(v)
+		return nil
+	case systemtenant.FieldExpireTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpireTime(v)
+		return nil
+	case systemtenant.FieldAccountCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountCount(v)
+		return nil
+	case systemtenant.FieldMfaRequired:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMfaRequired(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SystemTenant field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SystemTenantMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus != nil {
+		fields = append(fields, systemtenant.FieldStatus)
+	}
+	if m.addaccount_count != nil {
+		fields = append(fields, systemtenant.FieldAccountCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SystemTenantMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case systemtenant.FieldStatus:
+		return m.AddedStatus()
+	case systemtenant.FieldAccountCount:
+		return m.AddedAccountCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemTenantMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case systemtenant.FieldStatus:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	case systemtenant.FieldAccountCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAccountCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SystemTenant numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SystemTenantMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(systemtenant.FieldCreateBy) {
+		fields = append(fields, systemtenant.FieldCreateBy)
+	}
+	if m.FieldCleared(systemtenant.FieldCreatedAt) {
+		fields = append(fields, systemtenant.FieldCreatedAt)
+	}
+	if m.FieldCleared(systemtenant.FieldUpdateBy) {
+		fields = append(fields, systemtenant.FieldUpdateBy)
+	}
+	if m.FieldCleared(systemtenant.FieldUpdatedAt) {
+		fields = append(fields, systemtenant.FieldUpdatedAt)
+	}
+	if m.FieldCleared(systemtenant.FieldDeletedAt) {
+		fields = append(fields, systemtenant.FieldDeletedAt)
+	}
+	if m.FieldCleared(systemtenant.FieldContactUserID) {
+		fields = append(fields, systemtenant.FieldContactUserID)
+	}
+	if m.FieldCleared(systemtenant.FieldContactMobile) {
+		fields = append(fields, systemtenant.FieldContactMobile)
+	}
+	if m.FieldCleared(systemtenant.FieldWebsite) {
+		fields = append(fields, systemtenant.FieldWebsite)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SystemTenantMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SystemTenantMutation) ClearField(name string) error {
+	switch name {
+	case systemtenant.FieldCreateBy:
+		m.ClearCreateBy()
+		return nil
+	case systemtenant.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case systemtenant.FieldUpdateBy:
+		m.ClearUpdateBy()
+		return nil
+	case systemtenant.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case systemtenant.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case systemtenant.FieldContactUserID:
+		m.ClearContactUserID()
+		return nil
+	case systemtenant.FieldContactMobile:
+		m.ClearContactMobile()
+		return nil
+	case systemtenant.FieldWebsite:
+		m.ClearWebsite()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemTenant nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SystemTenantMutation) ResetField(name string) error {
+	switch name {
+	case systemtenant.FieldCreateBy:
+		m.ResetCreateBy()
+		return nil
+	case systemtenant.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case systemtenant.FieldUpdateBy:
+		m.ResetUpdateBy()
+		return nil
+	case systemtenant.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case systemtenant.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case systemtenant.FieldName:
+		m.ResetName()
+		return nil
+	case systemtenant.FieldContactUserID:
+		m.ResetContactUserID()
+		return nil
+	case systemtenant.FieldContactName:
+		m.ResetContactName()
+		return nil
+	case systemtenant.FieldContactMobile:
+		m.ResetContactMobile()
+		return nil
+	case systemtenant.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case systemtenant.FieldWebsite:
+		m.ResetWebsite()
+		return nil
+	case systemtenant.FieldPackageID:
+		m.ResetPackageID()
+		return nil
+	case systemtenant.FieldExpireTime:
+		m.ResetExpireTime()
+		return nil
+	case systemtenant.FieldAccountCount:
+		m.ResetAccountCount()
+		return nil
+	case systemtenant.FieldMfaRequired:
+		m.ResetMfaRequired()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemTenant field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SystemTenantMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SystemTenantMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SystemTenantMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SystemTenantMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SystemTenantMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SystemTenantMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SystemTenantMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SystemTenant unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SystemTenantMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SystemTenant edge %s", name)
+}
+
+// SystemTenantPackageMutation represents an operation that mutates the SystemTenantPackage nodes in the graph.
+type SystemTenantPackageMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *string
+	create_by      *string
+	created_at     *time.Time
+	update_by      *string
+	updated_at     *time.Time
+	deleted_at     *time.Time
+	remark         *string
+	name           *string
+	status         *int8
+	addstatus      *int8
+	menu_ids       *[]string
+	appendmenu_ids []string
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*SystemTenantPackage, error)
+	predicates     []predicate.SystemTenantPackage
+}
+
+var _ ent.Mutation = (*SystemTenantPackageMutation)(nil)
+
+// systemtenantpackageOption allows management of the mutation configuration using functional options.
+type systemtenantpackageOption func(*SystemTenantPackageMutation)
+
+// newSystemTenantPackageMutation creates new mutation for the SystemTenantPackage entity.
+func newSystemTenantPackageMutation(c config, op Op, opts ...systemtenantpackageOption) *SystemTenantPackageMutation {
+	m := &SystemTenantPackageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSystemTenantPackage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSystemTenantPackageID sets the ID field of the mutation.
+func withSystemTenantPackageID(id string) systemtenantpackageOption {
+	return func(m *SystemTenantPackageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SystemTenantPackage
+		)
+		m.oldValue = func(ctx context.Context) (*SystemTenantPackage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SystemTenantPackage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSystemTenantPackage sets the old SystemTenantPackage of the mutation.
+func withSystemTenantPackage(node *SystemTenantPackage) systemtenantpackageOption {
+	return func(m *SystemTenantPackageMutation) {
+		m.oldValue = func(context.Context) (*SystemTenantPackage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SystemTenantPackageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SystemTenantPackageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SystemTenantPackage entities.
+func (m *SystemTenantPackageMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SystemTenantPackageMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SystemTenantPackageMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SystemTenantPackage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateBy sets the "create_by" field.
+func (m *SystemTenantPackageMutation) SetCreateBy(s string) {
+	m.create_by = &s
+}
+
+// CreateBy returns the value of the "create_by" field in the mutation.
+func (m *SystemTenantPackageMutation) CreateBy() (r string, exists bool) {
+	v := m.create_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateBy returns the old "create_by" field's value of the SystemTenantPackage entity.
+// If the SystemTenantPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantPackageMutation) OldCreateBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
+	}
+	return oldValue.CreateBy, nil
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (m *SystemTenantPackageMutation) ClearCreateBy() {
+	m.create_by = nil
+	m.clearedFields[systemtenantpackage.FieldCreateBy] = struct{}{}
+}
+
+// CreateByCleared returns if the "create_by" field was cleared in this mutation.
+func (m *SystemTenantPackageMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[systemtenantpackage.FieldCreateBy]
+	return ok
+}
+
+// ResetCreateBy resets all changes to the "create_by" field.
+func (m *SystemTenantPackageMutation) ResetCreateBy() {
+	m.create_by = nil
+	delete(m.clearedFields, systemtenantpackage.FieldCreateBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SystemTenantPackageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SystemTenantPackageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SystemTenantPackage entity.
+// If the SystemTenantPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantPackageMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *SystemTenantPackageMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[systemtenantpackage.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *SystemTenantPackageMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[systemtenantpackage.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SystemTenantPackageMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, systemtenantpackage.FieldCreatedAt)
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (m *SystemTenantPackageMutation) SetUpdateBy(s string) {
+	m.update_by = &s
+}
+
+// UpdateBy returns the value of the "update_by" field in the mutation.
+func (m *SystemTenantPackageMutation) UpdateBy() (r string, exists bool) {
+	v := m.update_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateBy returns the old "update_by" field's value of the SystemTenantPackage entity.
+// If the SystemTenantPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantPackageMutation) OldUpdateBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateBy: %w", err)
+	}
+	return oldValue.UpdateBy, nil
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (m *SystemTenantPackageMutation) ClearUpdateBy() {
+	m.update_by = nil
+	m.clearedFields[systemtenantpackage.FieldUpdateBy] = struct{}{}
+}
+
+// UpdateByCleared returns if the "update_by" field was cleared in this mutation.
+func (m *SystemTenantPackageMutation) UpdateByCleared() bool {
+	_, ok := m.clearedFields[systemtenantpackage.FieldUpdateBy]
+	return ok
+}
+
+// ResetUpdateBy resets all changes to the "update_by" field.
+func (m *SystemTenantPackageMutation) ResetUpdateBy() {
+	m.update_by = nil
+	delete(m.clearedFields, systemtenantpackage.FieldUpdateBy)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SystemTenantPackageMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SystemTenantPackageMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SystemTenantPackage entity.
+// If the SystemTenantPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantPackageMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *SystemTenantPackageMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[systemtenantpackage.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *SystemTenantPackageMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[systemtenantpackage.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SystemTenantPackageMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, systemtenantpackage.FieldUpdatedAt)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *SystemTenantPackageMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *SystemTenantPackageMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the SystemTenantPackage entity.
+// If the SystemTenantPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantPackageMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *SystemTenantPackageMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[systemtenantpackage.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *SystemTenantPackageMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[systemtenantpackage.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *SystemTenantPackageMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, systemtenantpackage.FieldDeletedAt)
+}
+
+// SetRemark sets the "remark" field.
+func (m *SystemTenantPackageMutation) SetRemark(s string) {
+	m.remark = &s
+}
+
+// Remark returns the value of the "remark" field in the mutation.
+func (m *SystemTenantPackageMutation) Remark() (r string, exists bool) {
+	v := m.remark
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemark returns the old "remark" field's value of the SystemTenantPackage entity.
+// If the SystemTenantPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantPackageMutation) OldRemark(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemark is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemark requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemark: %w", err)
+	}
+	return oldValue.Remark, nil
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (m *SystemTenantPackageMutation) ClearRemark() {
+	m.remark = nil
+	m.clearedFields[systemtenantpackage.FieldRemark] = struct{}{}
+}
+
+// RemarkCleared returns if the "remark" field was cleared in this mutation.
+func (m *SystemTenantPackageMutation) RemarkCleared() bool {
+	_, ok := m.clearedFields[systemtenantpackage.FieldRemark]
+	return ok
+}
+
+// ResetRemark resets all changes to the "remark" field.
+func (m *SystemTenantPackageMutation) ResetRemark() {
+	m.remark = nil
+	delete(m.clearedFields, systemtenantpackage.FieldRemark)
+}
+
+// SetName sets the "name" field.
+func (m *SystemTenantPackageMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SystemTenantPackageMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the SystemTenantPackage entity.
+// If the SystemTenantPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantPackageMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SystemTenantPackageMutation) ResetName() {
+	m.name = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *SystemTenantPackageMutation) SetStatus(i int8) {
+	m.status = &i
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SystemTenantPackageMutation) Status() (r int8, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the SystemTenantPackage entity.
+// If the SystemTenantPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantPackageMutation) OldStatus(ctx context.Context) (v int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds i to the "status" field.
+func (m *SystemTenantPackageMutation) AddStatus(i int8) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *SystemTenantPackageMutation) AddedStatus() (r int8, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SystemTenantPackageMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+}
+
+// SetMenuIds sets the "menu_ids" field.
+func (m *SystemTenantPackageMutation) SetMenuIds(s []string) {
+	m.menu_ids = &s
+	m.appendmenu_ids = nil
+}
+
+// MenuIds returns the value of the "menu_ids" field in the mutation.
+func (m *SystemTenantPackageMutation) MenuIds() (r []string, exists bool) {
+	v := m.menu_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMenuIds returns the old "menu_ids" field's value of the SystemTenantPackage entity.
+// If the SystemTenantPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemTenantPackageMutation) OldMenuIds(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMenuIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMenuIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMenuIds: %w", err)
+	}
+	return oldValue.MenuIds, nil
+}
+
+// AppendMenuIds adds s to the "menu_ids" field.
+func (m *SystemTenantPackageMutation) AppendMenuIds(s []string) {
+	m.appendmenu_ids = append(m.appendmenu_ids, s...)
+}
+
+// AppendedMenuIds returns the list of values that were appended to the "menu_ids" field in this mutation.
+func (m *SystemTenantPackageMutation) AppendedMenuIds() ([]string, bool) {
+	if len(m.appendmenu_ids) == 0 {
+		return nil, false
+	}
+	return m.appendmenu_ids, true
+}
+
+// ClearMenuIds clears the value of the "menu_ids" field.
+func (m *SystemTenantPackageMutation) ClearMenuIds() {
+	m.menu_ids = nil
+	m.appendmenu_ids = nil
+	m.clearedFields[systemtenantpackage.FieldMenuIds] = struct{}{}
+}
+
+// MenuIdsCleared returns if the "menu_ids" field was cleared in this mutation.
+func (m *SystemTenantPackageMutation) MenuIdsCleared() bool {
+	_, ok := m.clearedFields[systemtenantpackage.FieldMenuIds]
+	return ok
+}
+
+// ResetMenuIds resets all changes to the "menu_ids" field.
+func (m *SystemTenantPackageMutation) ResetMenuIds() {
+	m.menu_ids = nil
+	m.appendmenu_ids = nil
+	delete(m.clearedFields, systemtenantpackage.FieldMenuIds)
+}
+
+// Where appends a list predicates to the SystemTenantPackageMutation builder.
+func (m *SystemTenantPackageMutation) Where(ps ...predicate.SystemTenantPackage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SystemTenantPackageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SystemTenantPackageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SystemTenantPackage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SystemTenantPackageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SystemTenantPackageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SystemTenantPackage).
+func (m *SystemTenantPackageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SystemTenantPackageMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.create_by != nil {
+		fields = append(fields, systemtenantpackage.FieldCreateBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, systemtenantpackage.FieldCreatedAt)
+	}
+	if m.update_by != nil {
+		fields = append(fields, systemtenantpackage.FieldUpdateBy)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, systemtenantpackage.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, systemtenantpackage.FieldDeletedAt)
+	}
+	if m.remark != nil {
+		fields = append(fields, systemtenantpackage.FieldRemark)
+	}
+	if m.name != nil {
+		fields = append(fields, systemtenantpackage.FieldName)
+	}
+	if m.status != nil {
+		fields = append(fields, systemtenantpackage.FieldStatus)
+	}
+	if m.menu_ids != nil {
+		fields = append(fields, systemtenantpackage.FieldMenuIds)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SystemTenantPackageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case systemtenantpackage.FieldCreateBy:
+		return m.CreateBy()
+	case systemtenantpackage.FieldCreatedAt:
+		return m.CreatedAt()
+	case systemtenantpackage.FieldUpdateBy:
+		return m.UpdateBy()
+	case systemtenantpackage.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case systemtenantpackage.FieldDeletedAt:
+		return m.DeletedAt()
+	case systemtenantpackage.FieldRemark:
+		return m.Remark()
+	case systemtenantpackage.FieldName:
+		return m.Name()
+	case systemtenantpackage.FieldStatus:
+		return m.Status()
+	case systemtenantpackage.FieldMenuIds:
+		return m.MenuIds()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SystemTenantPackageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case systemtenantpackage.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case systemtenantpackage.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case systemtenantpackage.FieldUpdateBy:
+		return m.OldUpdateBy(ctx)
+	case systemtenantpackage.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case systemtenantpackage.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case systemtenantpackage.FieldRemark:
+		return m.OldRemark(ctx)
+	case systemtenantpackage.FieldName:
+		return m.OldName(ctx)
+	case systemtenantpackage.FieldStatus:
+		return m.OldStatus(ctx)
+	case systemtenantpackage.FieldMenuIds:
+		return m.OldMenuIds(ctx)
+	}
+	return nil, fmt.Errorf("unknown SystemTenantPackage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemTenantPackageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case systemtenantpackage.FieldCreateBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateBy(v)
+		return nil
+	case systemtenantpackage.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case systemtenantpackage.FieldUpdateBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateBy(v)
+		return nil
+	case systemtenantpackage.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case systemtenantpackage.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case systemtenantpackage.FieldRemark:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemark(v)
+		return nil
+	case systemtenantpackage.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case systemtenantpackage.FieldStatus:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case systemtenantpackage.FieldMenuIds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMenuIds(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SystemTenantPackage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SystemTenantPackageMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus != nil {
+		fields = append(fields, systemtenantpackage.FieldStatus)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SystemTenantPackageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case systemtenantpackage.FieldStatus:
+		return m.AddedStatus()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemTenantPackageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case systemtenantpackage.FieldStatus:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SystemTenantPackage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SystemTenantPackageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(systemtenantpackage.FieldCreateBy) {
+		fields = append(fields, systemtenantpackage.FieldCreateBy)
+	}
+	if m.FieldCleared(systemtenantpackage.FieldCreatedAt) {
+		fields = append(fields, systemtenantpackage.FieldCreatedAt)
+	}
+	if m.FieldCleared(systemtenantpackage.FieldUpdateBy) {
+		fields = append(fields, systemtenantpackage.FieldUpdateBy)
+	}
+	if m.FieldCleared(systemtenantpackage.FieldUpdatedAt) {
+		fields = append(fields, systemtenantpackage.FieldUpdatedAt)
+	}
+	if m.FieldCleared(systemtenantpackage.FieldDeletedAt) {
+		fields = append(fields, systemtenantpackage.FieldDeletedAt)
+	}
+	if m.FieldCleared(systemtenantpackage.FieldRemark) {
+		fields = append(fields, systemtenantpackage.FieldRemark)
+	}
+	if m.FieldCleared(systemtenantpackage.FieldMenuIds) {
+		fields = append(fields, systemtenantpackage.FieldMenuIds)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SystemTenantPackageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SystemTenantPackageMutation) ClearField(name string) error {
+	switch name {
+	case systemtenantpackage.FieldCreateBy:
+		m.ClearCreateBy()
+		return nil
+	case systemtenantpackage.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case systemtenantpackage.FieldUpdateBy:
+		m.ClearUpdateBy()
+		return nil
+	case systemtenantpackage.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case systemtenantpackage.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case systemtenantpackage.FieldRemark:
+		m.ClearRemark()
+		return nil
+	case systemtenantpackage.FieldMenuIds:
+		m.ClearMenuIds()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemTenantPackage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SystemTenantPackageMutation) ResetField(name string) error {
+	switch name {
+	case systemtenantpackage.FieldCreateBy:
+		m.ResetCreateBy()
+		return nil
+	case systemtenantpackage.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case systemtenantpackage.FieldUpdateBy:
+		m.ResetUpdateBy()
+		return nil
+	case systemtenantpackage.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case systemtenantpackage.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case systemtenantpackage.FieldRemark:
+		m.ResetRemark()
+		return nil
+	case systemtenantpackage.FieldName:
+		m.ResetName()
+		return nil
+	case systemtenantpackage.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case systemtenantpackage.FieldMenuIds:
+		m.ResetMenuIds()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemTenantPackage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SystemTenantPackageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SystemTenantPackageMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SystemTenantPackageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SystemTenantPackageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SystemTenantPackageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SystemTenantPackageMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SystemTenantPackageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SystemTenantPackage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SystemTenantPackageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SystemTenantPackage edge %s", name)
+}
+
+// SystemUserMutation represents an operation that mutates the SystemUser nodes in the graph.
+type SystemUserMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	create_by     *string
+	created_at    *time.Time
+	update_by     *string
+	updated_at    *time.Time
+	tenant_id     *string
+	deleted_at    *time.Time
+	account       *string
+	password      *string
+	nickname      *string
+	remark        *string
+	dept_id       *string
+	email         *string
+	mobile        *string
+	sex           *int8
+	addsex        *int8
+	avatar        *string
+	status        *int8
+	addstatus     *int8
+	login_ip      *string
+	login_date    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SystemUser, error)
+	predicates    []predicate.SystemUser
+}
+
+var _ ent.Mutation = (*SystemUserMutation)(nil)
+
+// systemuserOption allows management of the mutation configuration using functional options.
+type systemuserOption func(*SystemUserMutation)
+
+// newSystemUserMutation creates new mutation for the SystemUser entity.
+func newSystemUserMutation(c config, op Op, opts ...systemuserOption) *SystemUserMutation {
+	m := &SystemUserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSystemUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSystemUserID sets the ID field of the mutation.
+func withSystemUserID(id string) systemuserOption {
+	return func(m *SystemUserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SystemUser
+		)
+		m.oldValue = func(ctx context.Context) (*SystemUser, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SystemUser.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSystemUser sets the old SystemUser of the mutation.
+func withSystemUser(node *SystemUser) systemuserOption {
+	return func(m *SystemUserMutation) {
+		m.oldValue = func(context.Context) (*SystemUser, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SystemUserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SystemUserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SystemUser entities.
+func (m *SystemUserMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SystemUserMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SystemUserMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SystemUser.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateBy sets the "create_by" field.
+func (m *SystemUserMutation) SetCreateBy(s string) {
+	m.create_by = &s
+}
+
+// CreateBy returns the value of the "create_by" field in the mutation.
+func (m *SystemUserMutation) CreateBy() (r string, exists bool) {
+	v := m.create_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateBy returns the old "create_by" field's value of the SystemUser entity.
+// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMutation) OldCreateBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
+	}
+	return oldValue.CreateBy, nil
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (m *SystemUserMutation) ClearCreateBy() {
+	m.create_by = nil
+	m.clearedFields[systemuser.FieldCreateBy] = struct{}{}
+}
+
+// CreateByCleared returns if the "create_by" field was cleared in this mutation.
+func (m *SystemUserMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[systemuser.FieldCreateBy]
+	return ok
+}
+
+// ResetCreateBy resets all changes to the "create_by" field.
+func (m *SystemUserMutation) ResetCreateBy() {
+	m.create_by = nil
+	delete(m.clearedFields, systemuser.FieldCreateBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SystemUserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SystemUserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SystemUser entity.
+// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *SystemUserMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[systemuser.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *SystemUserMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[systemuser.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SystemUserMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, systemuser.FieldCreatedAt)
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (m *SystemUserMutation) SetUpdateBy(s string) {
+	m.update_by = &s
+}
+
+// UpdateBy returns the value of the "update_by" field in the mutation.
+func (m *SystemUserMutation) UpdateBy() (r string, exists bool) {
+	v := m.update_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateBy returns the old "update_by" field's value of the SystemUser entity.
+// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMutation) OldUpdateBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateBy: %w", err)
+	}
+	return oldValue.UpdateBy, nil
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (m *SystemUserMutation) ClearUpdateBy() {
+	m.update_by = nil
+	m.clearedFields[systemuser.FieldUpdateBy] = struct{}{}
+}
+
+// UpdateByCleared returns if the "update_by" field was cleared in this mutation.
+func (m *SystemUserMutation) UpdateByCleared() bool {
+	_, ok := m.clearedFields[systemuser.FieldUpdateBy]
+	return ok
+}
+
+// ResetUpdateBy resets all changes to the "update_by" field.
+func (m *SystemUserMutation) ResetUpdateBy() {
+	m.update_by = nil
+	delete(m.clearedFields, systemuser.FieldUpdateBy)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SystemUserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SystemUserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SystemUser entity.
+// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *SystemUserMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[systemuser.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *SystemUserMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[systemuser.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SystemUserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, systemuser.FieldUpdatedAt)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *SystemUserMutation) SetTenantID(s string) {
+	m.tenant_id = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *SystemUserMutation) TenantID() (r string, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the SystemUser entity.
+// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *SystemUserMutation) ResetTenantID() {
+	m.tenant_id = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *SystemUserMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *SystemUserMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the SystemUser entity.
+// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *SystemUserMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[systemuser.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *SystemUserMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[systemuser.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *SystemUserMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, systemuser.FieldDeletedAt)
+}
+
+// SetAccount sets the "account" field.
+func (m *SystemUserMutation) SetAccount(s string) {
+	m.account = &s
+}
+
+// Account returns the value of the "account" field in the mutation.
+func (m *SystemUserMutation) Account() (r string, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccount returns the old "account" field's value of the SystemUser entity.
+// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMutation) OldAccount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
+	}
+	return oldValue.Account, nil
+}
+
+// ResetAccount resets all changes to the "account" field.
+func (m *SystemUserMutation) ResetAccount() {
+	m.account = nil
+}
+
+// SetPassword sets the "password" field.
+func (m *SystemUserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *SystemUserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the SystemUser entity.
+// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMutation) OldPassword(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ClearPassword clears the value of the "password" field.
+func (m *SystemUserMutation) ClearPassword() {
+	m.password = nil
+	m.clearedFields[systemuser.FieldPassword] = struct{}{}
+}
+
+// PasswordCleared returns if the "password" field was cleared in this mutation.
+func (m *SystemUserMutation) PasswordCleared() bool {
+	_, ok := m.clearedFields[systemuser.FieldPassword]
+	return ok
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *SystemUserMutation) ResetPassword() {
+	m.password = nil
+	delete(m.clearedFields, systemuser.FieldPassword)
+}
+
+// SetNickname sets the "nickname" field.
+func (m *SystemUserMutation) SetNickname(s string) {
+	m.nickname = &s
+}
+
+// Nickname returns the value of the "nickname" field in the mutation.
+func (m *SystemUserMutation) Nickname() (r string, exists bool) {
+	v := m.nickname
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNickname returns the old "nickname" field's value of the SystemUser entity.
+// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMutation) OldNickname(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNickname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
+	}
+	return oldValue.Nickname, nil
+}
+
+// ClearNickname clears the value of the "nickname" field.
+func (m *SystemUserMutation) ClearNickname() {
+	m.nickname = nil
+	m.clearedFields[systemuser.FieldNickname] = struct{}{}
+}
+
+// NicknameCleared returns if the "nickname" field was cleared in this mutation.
+func (m *SystemUserMutation) NicknameCleared() bool {
+	_, ok := m.clearedFields[systemuser.FieldNickname]
+	return ok
+}
+
+// ResetNickname resets all changes to the "nickname" field.
+func (m *SystemUserMutation) ResetNickname() {
+	m.nickname = nil
+	delete(m.clearedFields, systemuser.FieldNickname)
+}
+
+// SetRemark sets the "remark" field.
+func (m *SystemUserMutation) SetRemark(s string) {
+	m.remark = &s
+}
+
+// Remark returns the value of the "remark" field in the mutation.
+func (m *SystemUserMutation) Remark() (r string, exists bool) {
+	v := m.remark
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemark returns the old "remark" field's value of the SystemUser entity.
+// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMutation) OldRemark(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemark is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemark requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemark: %w", err)
+	}
+	return oldValue.Remark, nil
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (m *SystemUserMutation) ClearRemark() {
+	m.remark = nil
+	m.clearedFields[systemuser.FieldRemark] = struct{}{}
+}
+
+// RemarkCleared returns if the "remark" field was cleared in this mutation.
+func (m *SystemUserMutation) RemarkCleared() bool {
+	_, ok := m.clearedFields[systemuser.FieldRemark]
+	return ok
+}
+
+// ResetRemark resets all changes to the "remark" field.
+func (m *SystemUserMutation) ResetRemark() {
+	m.remark = nil
+	delete(m.clearedFields, systemuser.FieldRemark)
+}
+
+// SetDeptID sets the "dept_id" field.
+func (m *SystemUserMutation) SetDeptID(s string) {
+	m.dept_id = &s
+}
+
+// DeptID returns the value of the "dept_id" field in the mutation.
+func (m *SystemUserMutation) DeptID() (r string, exists bool) {
+	v := m.dept_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeptID returns the old "dept_id" field's value of the SystemUser entity.
+// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMutation) OldDeptID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeptID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeptID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeptID: %w", err)
+	}
+	return oldValue.DeptID, nil
+}
+
+// ClearDeptID clears the value of the "dept_id" field.
+func (m *SystemUserMutation) ClearDeptID() {
+	m.dept_id = nil
+	m.clearedFields[systemuser.FieldDeptID] = struct{}{}
+}
+
+// DeptIDCleared returns if the "dept_id" field was cleared in this mutation.
+func (m *SystemUserMutation) DeptIDCleared() bool {
+	_, ok := m.clearedFields[systemuser.FieldDeptID]
+	return ok
+}
+
+// ResetDeptID resets all changes to the "dept_id" field.
+func (m *SystemUserMutation) ResetDeptID() {
+	m.dept_id = nil
+	delete(m.clearedFields, systemuser.FieldDeptID)
+}
+
+// SetEmail sets the "email" field.
+func (m *SystemUserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *SystemUserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the SystemUser entity.
+// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMutation) OldEmail(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ClearEmail clears the value of the "email" field.
+func (m *SystemUserMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[systemuser.FieldEmail] = struct{}{}
+}
+
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *SystemUserMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[systemuser.FieldEmail]
+	return ok
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *SystemUserMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, systemuser.FieldEmail)
+}
+
+// SetMobile sets the "mobile" field.
+func (m *SystemUserMutation) SetMobile(s string) {
+	m.mobile = &s
+}
+
+// Mobile returns the value of the "mobile" field in the mutation.
+func (m *SystemUserMutation) Mobile() (r string, exists bool) {
+	v := m.mobile
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMobile returns the old "mobile" field's value of the SystemUser entity.
+// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMutation) OldMobile(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMobile is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMobile requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMobile: %w", err)
+	}
+	return oldValue.Mobile, nil
+}
+
+// ClearMobile clears the value of the "mobile" field.
+func (m *SystemUserMutation) ClearMobile() {
+	m.mobile = nil
+	m.clearedFields[systemuser.FieldMobile] = struct{}{}
+}
+
+// MobileCleared returns if the "mobile" field was cleared in this mutation.
+func (m *SystemUserMutation) MobileCleared() bool {
+	_, ok := m.clearedFields[systemuser.FieldMobile]
+	return ok
+}
+
+// ResetMobile resets all changes to the "mobile" field.
+func (m *SystemUserMutation) ResetMobile() {
+	m.mobile = nil
+	delete(m.clearedFields, systemuser.FieldMobile)
+}
+
+// SetSex sets the "sex" field.
+func (m *SystemUserMutation) SetSex(i int8) {
+	m.sex = &i
+	m.addsex = nil
+}
+
+// Sex returns the value of the "sex" field in the mutation.
+func (m *SystemUserMutation) Sex() (r int8, exists bool) {
+	v := m.sex
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSex returns the old "sex" field's value of the SystemUser entity.
+// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMutation) OldSex(ctx context.Context) (v *int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSex: %w", err)
+	}
+	return oldValue.Sex, nil
+}
+
+// AddSex adds i to the "sex" field.
+func (m *SystemUserMutation) AddSex(i int8) {
+	if m.addsex != nil {
+		*m.addsex += i
+	} else {
+		m.addsex = &i
+	}
+}
+
+// AddedSex returns the value that was added to the "sex" field in this mutation.
+func (m *SystemUserMutation) AddedSex() (r int8, exists bool) {
+	v := m.addsex
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSex clears the value of the "sex" field.
+func (m *SystemUserMutation) ClearSex() {
+	m.sex = nil
+	m.addsex = nil
+	m.clearedFields[systemuser.FieldSex] = struct{}{}
+}
+
+// SexCleared returns if the "sex" field was cleared in this mutation.
+func (m *SystemUserMutation) SexCleared() bool {
+	_, ok := m.clearedFields[systemuser.FieldSex]
+	return ok
+}
+
+// ResetSex resets all changes to the "sex" field.
+func (m *SystemUserMutation) ResetSex() {
+	m.sex = nil
+	m.addsex = nil
+	delete(m.clearedFields, systemuser.FieldSex)
+}
+
+// SetAvatar sets the "avatar" field.
+func (m *SystemUserMutation) SetAvatar(s string) {
+	m.avatar = &s
+}
+
+// Avatar returns the value of the "avatar" field in the mutation.
+func (m *SystemUserMutation) Avatar() (r string, exists bool) {
+	v := m.avatar
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvatar returns the old "avatar" field's value of the SystemUser entity.
+// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMutation) OldAvatar(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatar requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+	}
+	return oldValue.Avatar, nil
+}
+
+// ClearAvatar clears the value of the "avatar" field.
+func (m *SystemUserMutation) ClearAvatar() {
+	m.avatar = nil
+	m.clearedFields[systemuser.FieldAvatar] = struct{}{}
+}
+
+// AvatarCleared returns if the "avatar" field was cleared in this mutation.
+func (m *SystemUserMutation) AvatarCleared() bool {
+	_, ok := m.clearedFields[systemuser.FieldAvatar]
+	return ok
+}
+
+// ResetAvatar resets all changes to the "avatar" field.
+func (m *SystemUserMutation) ResetAvatar() {
+	m.avatar = nil
+	delete(m.clearedFields, systemuser.FieldAvatar)
+}
+
+// SetStatus sets the "status" field.
+func (m *SystemUserMutation) SetStatus(i int8) {
+	m.status = &i
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SystemUserMutation) Status() (r int8, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the SystemUser entity.
+// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMutation) OldStatus(ctx context.Context) (v int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds i to the "status" field.
+func (m *SystemUserMutation) AddStatus(i int8) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *SystemUserMutation) AddedStatus() (r int8, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStatus clears the value of the "status" field.
+func (m *SystemUserMutation) ClearStatus() {
+	m.status = nil
+	m.addstatus = nil
+	m.clearedFields[systemuser.FieldStatus] = struct{}{}
+}
+
+// StatusCleared returns if the "status" field was cleared in this mutation.
+func (m *SystemUserMutation) StatusCleared() bool {
+	_, ok := m.clearedFields[systemuser.FieldStatus]
+	return ok
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SystemUserMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+	delete(m.clearedFields, systemuser.FieldStatus)
+}
+
+// SetLoginIP sets the "login_ip" field.
+func (m *SystemUserMutation) SetLoginIP(s string) {
+	m.login_ip = &s
+}
+
+// LoginIP returns the value of the "login_ip" field in the mutation.
+func (m *SystemUserMutation) LoginIP() (r string, exists bool) {
+	v := m.login_ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLoginIP returns the old "login_ip" field's value of the SystemUser entity.
+// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMutation) OldLoginIP(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoginIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoginIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoginIP: %w", err)
+	}
+	return oldValue.LoginIP, nil
+}
+
+// ClearLoginIP clears the value of the "login_ip" field.
+func (m *SystemUserMutation) ClearLoginIP() {
+	m.login_ip = nil
+	m.clearedFields[systemuser.FieldLoginIP] = struct{}{}
+}
+
+// LoginIPCleared returns if the "login_ip" field was cleared in this mutation.
+func (m *SystemUserMutation) LoginIPCleared() bool {
+	_, ok := m.clearedFields[systemuser.FieldLoginIP]
+	return ok
+}
+
+// ResetLoginIP resets all changes to the "login_ip" field.
+func (m *SystemUserMutation) ResetLoginIP() {
+	m.login_ip = nil
+	delete(m.clearedFields, systemuser.FieldLoginIP)
+}
+
+// SetLoginDate sets the "login_date" field.
+func (m *SystemUserMutation) SetLoginDate(t time.Time) {
+	m.login_date = &t
+}
+
+// LoginDate returns the value of the "login_date" field in the mutation.
+func (m *SystemUserMutation) LoginDate() (r time.Time, exists bool) {
+	v := m.login_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLoginDate returns the old "login_date" field's value of the SystemUser entity.
+// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMutation) OldLoginDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoginDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoginDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoginDate: %w", err)
+	}
+	return oldValue.LoginDate, nil
+}
+
+// ClearLoginDate clears the value of the "login_date" field.
+func (m *SystemUserMutation) ClearLoginDate() {
+	m.login_date = nil
+	m.clearedFields[systemuser.FieldLoginDate] = struct{}{}
+}
+
+// LoginDateCleared returns if the "login_date" field was cleared in this mutation.
+func (m *SystemUserMutation) LoginDateCleared() bool {
+	_, ok := m.clearedFields[systemuser.FieldLoginDate]
+	return ok
+}
+
+// ResetLoginDate resets all changes to the "login_date" field.
+func (m *SystemUserMutation) ResetLoginDate() {
+	m.login_date = nil
+	delete(m.clearedFields, systemuser.FieldLoginDate)
+}
+
+// Where appends a list predicates to the SystemUserMutation builder.
+func (m *SystemUserMutation) Where(ps ...predicate.SystemUser) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SystemUserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SystemUserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SystemUser, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SystemUserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SystemUserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SystemUser).
+func (m *SystemUserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SystemUserMutation) Fields() []string {
+	fields := make([]string, 0, 18)
+	if m.create_by != nil {
+		fields = append(fields, systemuser.FieldCreateBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, systemuser.FieldCreatedAt)
+	}
+	if m.update_by != nil {
+		fields = append(fields, systemuser.FieldUpdateBy)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, systemuser.FieldUpdatedAt)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, systemuser.FieldTenantID)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, systemuser.FieldDeletedAt)
+	}
+	if m.account != nil {
+		fields = append(fields, systemuser.FieldAccount)
+	}
+	if m.password != nil {
+		fields = append(fields, systemuser.FieldPassword)
+	}
+	if m.nickname != nil {
+		fields = append(fields, systemuser.FieldNickname)
+	}
+	if m.remark != nil {
+		fields = append(fields, systemuser.FieldRemark)
+	}
+	if m.dept_id != nil {
+		fields = append(fields, systemuser.FieldDeptID)
+	}
+	if m.email != nil {
+		fields = append(fields, systemuser.FieldEmail)
+	}
+	if m.mobile != nil {
+		fields = append(fields, systemuser.FieldMobile)
+	}
+	if m.sex != nil {
+		fields = append(fields, systemuser.FieldSex)
+	}
+	if m.avatar != nil {
+		fields = append(fields, systemuser.FieldAvatar)
+	}
+	if m.status != nil {
+		fields = append(fields, systemuser.FieldStatus)
+	}
+	if m.login_ip != nil {
+		fields = append(fields, systemuser.FieldLoginIP)
+	}
+	if m.login_date != nil {
+		fields = append(fields, systemuser.FieldLoginDate)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SystemUserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case systemuser.FieldCreateBy:
+		return m.CreateBy()
+	case systemuser.FieldCreatedAt:
+		return m.CreatedAt()
+	case systemuser.FieldUpdateBy:
+		return m.UpdateBy()
+	case systemuser.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case systemuser.FieldTenantID:
+		return m.TenantID()
+	case systemuser.FieldDeletedAt:
+		return m.DeletedAt()
+	case systemuser.FieldAccount:
+		return m.Account()
+	case systemuser.FieldPassword:
+		return m.Password()
+	case systemuser.FieldNickname:
+		return m.Nickname()
+	case systemuser.FieldRemark:
+		return m.Remark()
+	case systemuser.FieldDeptID:
+		return m.DeptID()
+	case systemuser.FieldEmail:
+		return m.Email()
+	case systemuser.FieldMobile:
+		return m.Mobile()
+	case systemuser.FieldSex:
+		return m.Sex()
+	case systemuser.FieldAvatar:
+		return m.Avatar()
+	case systemuser.FieldStatus:
+		return m.Status()
+	case systemuser.FieldLoginIP:
+		return m.LoginIP()
+	case systemuser.FieldLoginDate:
+		return m.LoginDate()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SystemUserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case systemuser.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case systemuser.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case systemuser.FieldUpdateBy:
+		return m.OldUpdateBy(ctx)
+	case systemuser.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case systemuser.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case systemuser.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case systemuser.FieldAccount:
+		return m.OldAccount(ctx)
+	case systemuser.FieldPassword:
+		return m.OldPassword(ctx)
+	case systemuser.FieldNickname:
+		return m.OldNickname(ctx)
+	case systemuser.FieldRemark:
+		return m.OldRemark(ctx)
+	case systemuser.FieldDeptID:
+		return m.OldDeptID(ctx)
+	case systemuser.FieldEmail:
+		return m.OldEmail(ctx)
+	case systemuser.FieldMobile:
+		return m.OldMobile(ctx)
+	case systemuser.FieldSex:
+		return m.OldSex(ctx)
+	case systemuser.FieldAvatar:
+		return m.OldAvatar(ctx)
+	case systemuser.FieldStatus:
+		return m.OldStatus(ctx)
+	case systemuser.FieldLoginIP:
+		return m.OldLoginIP(ctx)
+	case systemuser.FieldLoginDate:
+		return m.OldLoginDate(ctx)
+	}
+	return nil, fmt.Errorf("unknown SystemUser field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemUserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case systemuser.FieldCreateBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateBy(v)
+		return nil
+	case systemuser.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case systemuser.FieldUpdateBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateBy(v)
+		return nil
+	case systemuser.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case systemuser.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case systemuser.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case systemuser.FieldAccount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccount(v)
+		return nil
+	case systemuser.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case systemuser.FieldNickname:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNickname(v)
+		return nil
+	case systemuser.FieldRemark:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemark(v)
+		return nil
+	case systemuser.FieldDeptID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeptID(v)
+		return nil
+	case systemuser.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case systemuser.FieldMobile:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMobile(v)
+		return nil
+	case systemuser.FieldSex:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSex(v)
+		return nil
+	case systemuser.FieldAvatar:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatar(v)
+		return nil
+	case systemuser.FieldStatus:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case systemuser.FieldLoginIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoginIP(v)
+		return nil
+	case systemuser.FieldLoginDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoginDate(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SystemUser field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SystemUserMutation) AddedFields() []string {
+	var fields []string
+	if m.addsex != nil {
+		fields = append(fields, systemuser.FieldSex)
+	}
+	if m.addstatus != nil {
+		fields = append(fields, systemuser.FieldStatus)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SystemUserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case systemuser.FieldSex:
+		return m.AddedSex()
+	case systemuser.FieldStatus:
+		return m.AddedStatus()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemUserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case systemuser.FieldSex:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSex(v)
+		return nil
+	case systemuser.FieldStatus:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SystemUser numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SystemUserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(systemuser.FieldCreateBy) {
+		fields = append(fields, systemuser.FieldCreateBy)
+	}
+	if m.FieldCleared(systemuser.FieldCreatedAt) {
+		fields = append(fields, systemuser.FieldCreatedAt)
+	}
+	if m.FieldCleared(systemuser.FieldUpdateBy) {
+		fields = append(fields, systemuser.FieldUpdateBy)
+	}
+	if m.FieldCleared(systemuser.FieldUpdatedAt) {
+		fields = append(fields, systemuser.FieldUpdatedAt)
+	}
+	if m.FieldCleared(systemuser.FieldDeletedAt) {
+		fields = append(fields, systemuser.FieldDeletedAt)
+	}
+	if m.FieldCleared(systemuser.FieldPassword) {
+		fields = append(fields, systemuser.FieldPassword)
+	}
+	if m.FieldCleared(systemuser.FieldNickname) {
+		fields = append(fields, systemuser.FieldNickname)
+	}
+	if m.FieldCleared(systemuser.FieldRemark) {
+		fields = append(fields, systemuser.FieldRemark)
+	}
+	if m.FieldCleared(systemuser.FieldDeptID) {
+		fields = append(fields, systemuser.FieldDeptID)
+	}
+	if m.FieldCleared(systemuser.FieldEmail) {
+		fields = append(fields, systemuser.FieldEmail)
+	}
+	if m.FieldCleared(systemuser.FieldMobile) {
+		fields = append(fields, systemuser.FieldMobile)
+	}
+	if m.FieldCleared(systemuser.FieldSex) {
+		fields = append(fields, systemuser.FieldSex)
+	}
+	if m.FieldCleared(systemuser.FieldAvatar) {
+		fields = append(fields, systemuser.FieldAvatar)
+	}
+	if m.FieldCleared(systemuser.FieldStatus) {
+		fields = append(fields, systemuser.FieldStatus)
+	}
+	if m.FieldCleared(systemuser.FieldLoginIP) {
+		fields = append(fields, systemuser.FieldLoginIP)
+	}
+	if m.FieldCleared(systemuser.FieldLoginDate) {
+		fields = append(fields, systemuser.FieldLoginDate)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SystemUserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SystemUserMutation) ClearField(name string) error {
+	switch name {
+	case systemuser.FieldCreateBy:
+		m.ClearCreateBy()
+		return nil
+	case systemuser.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case systemuser.FieldUpdateBy:
+		m.ClearUpdateBy()
+		return nil
+	case systemuser.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case systemuser.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case systemuser.FieldPassword:
+		m.ClearPassword()
+		return nil
+	case systemuser.FieldNickname:
+		m.ClearNickname()
+		return nil
+	case systemuser.FieldRemark:
+		m.ClearRemark()
+		return nil
+	case systemuser.FieldDeptID:
+		m.ClearDeptID()
+		return nil
+	case systemuser.FieldEmail:
+		m.ClearEmail()
+		return nil
+	case systemuser.FieldMobile:
+		m.ClearMobile()
+		return nil
+	case systemuser.FieldSex:
+		m.ClearSex()
+		return nil
+	case systemuser.FieldAvatar:
+		m.ClearAvatar()
+		return nil
+	case systemuser.FieldStatus:
+		m.ClearStatus()
+		return nil
+	case systemuser.FieldLoginIP:
+		m.ClearLoginIP()
+		return nil
+	case systemuser.FieldLoginDate:
+		m.ClearLoginDate()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemUser nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SystemUserMutation) ResetField(name string) error {
+	switch name {
+	case systemuser.FieldCreateBy:
+		m.ResetCreateBy()
+		return nil
+	case systemuser.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case systemuser.FieldUpdateBy:
+		m.ResetUpdateBy()
+		return nil
+	case systemuser.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case systemuser.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case systemuser.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case systemuser.FieldAccount:
+		m.ResetAccount()
+		return nil
+	case systemuser.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case systemuser.FieldNickname:
+		m.ResetNickname()
+		return nil
+	case systemuser.FieldRemark:
+		m.ResetRemark()
+		return nil
+	case systemuser.FieldDeptID:
+		m.ResetDeptID()
+		return nil
+	case systemuser.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case systemuser.FieldMobile:
+		m.ResetMobile()
+		return nil
+	case systemuser.FieldSex:
+		m.ResetSex()
+		return nil
+	case systemuser.FieldAvatar:
+		m.ResetAvatar()
+		return nil
+	case systemuser.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case systemuser.FieldLoginIP:
+		m.ResetLoginIP()
+		return nil
+	case systemuser.FieldLoginDate:
+		m.ResetLoginDate()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemUser field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SystemUserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SystemUserMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SystemUserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SystemUserMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SystemUserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SystemUserMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SystemUserMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SystemUser unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SystemUserMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SystemUser edge %s", name)
+}
+
+// SystemUserMFAMutation represents an operation that mutates the SystemUserMFA nodes in the graph.
+type SystemUserMFAMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *string
+	created_at           *time.Time
+	updated_at           *time.Time
+	tenant_id            *string
+	user_id              *string
+	secret               *string
+	enabled              *bool
+	recovery_codes       *[]string
+	appendrecovery_codes []string
+	last_used_step       *int64
+	addlast_used_step    *int64
+	enabled_at           *time.Time
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*SystemUserMFA, error)
+	predicates           []predicate.SystemUserMFA
+}
+
+var _ ent.Mutation = (*SystemUserMFAMutation)(nil)
+
+// systemusermfaOption allows management of the mutation configuration using functional options.
+type systemusermfaOption func(*SystemUserMFAMutation)
+
+// newSystemUserMFAMutation creates new mutation for the SystemUserMFA entity.
+func newSystemUserMFAMutation(c config, op Op, opts ...systemusermfaOption) *SystemUserMFAMutation {
+	m := &SystemUserMFAMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSystemUserMFA,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSystemUserMFAID sets the ID field of the mutation.
+func withSystemUserMFAID(id string) systemusermfaOption {
+	return func(m *SystemUserMFAMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SystemUserMFA
+		)
+		m.oldValue = func(ctx context.Context) (*SystemUserMFA, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SystemUserMFA.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSystemUserMFA sets the old SystemUserMFA of the mutation.
+func withSystemUserMFA(node *SystemUserMFA) systemusermfaOption {
+	return func(m *SystemUserMFAMutation) {
+		m.oldValue = func(context.Context) (*SystemUserMFA, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SystemUserMFAMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SystemUserMFAMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SystemUserMFA entities.
+func (m *SystemUserMFAMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SystemUserMFAMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SystemUserMFAMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SystemUserMFA.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SystemUserMFAMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SystemUserMFAMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SystemUserMFA entity.
+// If the SystemUserMFA object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMFAMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *SystemUserMFAMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[systemusermfa.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *SystemUserMFAMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[systemusermfa.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SystemUserMFAMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, systemusermfa.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SystemUserMFAMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SystemUserMFAMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SystemUserMFA entity.
+// If the SystemUserMFA object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMFAMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *SystemUserMFAMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[systemusermfa.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *SystemUserMFAMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[systemusermfa.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SystemUserMFAMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, systemusermfa.FieldUpdatedAt)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *SystemUserMFAMutation) SetTenantID(s string) {
+	m.tenant_id = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *SystemUserMFAMutation) TenantID() (r string, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the SystemUserMFA entity.
+// If the SystemUserMFA object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMFAMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *SystemUserMFAMutation) ResetTenantID() {
+	m.tenant_id = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SystemUserMFAMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SystemUserMFAMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the SystemUserMFA entity.
+// If the SystemUserMFA object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMFAMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SystemUserMFAMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetSecret sets the "secret" field.
+func (m *SystemUserMFAMutation) SetSecret(s string) {
+	m.secret = &s
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *SystemUserMFAMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the SystemUserMFA entity.
+// If the SystemUserMFA object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMFAMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *SystemUserMFAMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *SystemUserMFAMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *SystemUserMFAMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the SystemUserMFA entity.
+// If the SystemUserMFA object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMFAMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *SystemUserMFAMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (m *SystemUserMFAMutation) SetRecoveryCodes(s []string) {
+	m.recovery_codes = &s
+	m.appendrecovery_codes = nil
+}
+
+// RecoveryCodes returns the value of the "recovery_codes" field in the mutation.
+func (m *SystemUserMFAMutation) RecoveryCodes() (r []string, exists bool) {
+	v := m.recovery_codes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecoveryCodes returns the old "recovery_codes" field's value of the SystemUserMFA entity.
+// If the SystemUserMFA object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMFAMutation) OldRecoveryCodes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecoveryCodes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecoveryCodes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecoveryCodes: %w", err)
+	}
+	return oldValue.RecoveryCodes, nil
+}
+
+// AppendRecoveryCodes adds s to the "recovery_codes" field.
+func (m *SystemUserMFAMutation) AppendRecoveryCodes(s []string) {
+	m.appendrecovery_codes = append(m.appendrecovery_codes, s...)
+}
+
+// AppendedRecoveryCodes returns the list of values that were appended to the "recovery_codes" field in this mutation.
+func (m *SystemUserMFAMutation) AppendedRecoveryCodes() ([]string, bool) {
+	if len(m.appendrecovery_codes) == 0 {
+		return nil, false
+	}
+	return m.appendrecovery_codes, true
+}
+
+// ClearRecoveryCodes clears the value of the "recovery_codes" field.
+func (m *SystemUserMFAMutation) ClearRecoveryCodes() {
+	m.recovery_codes = nil
+	m.appendrecovery_codes = nil
+	m.clearedFields[systemusermfa.FieldRecoveryCodes] = struct{}{}
+}
+
+// RecoveryCodesCleared returns if the "recovery_codes" field was cleared in this mutation.
+func (m *SystemUserMFAMutation) RecoveryCodesCleared() bool {
+	_, ok := m.clearedFields[systemusermfa.FieldRecoveryCodes]
+	return ok
+}
+
+// ResetRecoveryCodes resets all changes to the "recovery_codes" field.
+func (m *SystemUserMFAMutation) ResetRecoveryCodes() {
+	m.recovery_codes = nil
+	m.appendrecovery_codes = nil
+	delete(m.clearedFields, systemusermfa.FieldRecoveryCodes)
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (m *SystemUserMFAMutation) SetLastUsedStep(i int64) {
+	m.last_used_step = &i
+	m.addlast_used_step = nil
+}
+
+// LastUsedStep returns the value of the "last_used_step" field in the mutation.
+func (m *SystemUserMFAMutation) LastUsedStep() (r int64, exists bool) {
+	v := m.last_used_step
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedStep returns the old "last_used_step" field's value of the SystemUserMFA entity.
+// If the SystemUserMFA object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMFAMutation) OldLastUsedStep(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedStep is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedStep requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedStep: %w", err)
+	}
+	return oldValue.LastUsedStep, nil
+}
+
+// AddLastUsedStep adds i to the "last_used_step" field.
+func (m *SystemUserMFAMutation) AddLastUsedStep(i int64) {
+	if m.addlast_used_step != nil {
+		*m.addlast_used_step += i
+	} else {
+		m.addlast_used_step = &i
+	}
+}
+
+// AddedLastUsedStep returns the value that was added to the "last_used_step" field in this mutation.
+func (m *SystemUserMFAMutation) AddedLastUsedStep() (r int64, exists bool) {
+	v := m.addlast_used_step
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLastUsedStep resets all changes to the "last_used_step" field.
+func (m *SystemUserMFAMutation) ResetLastUsedStep() {
+	m.last_used_step = nil
+	m.addlast_used_step = nil
+}
+
+// SetEnabledAt sets the "enabled_at" field.
+func (m *SystemUserMFAMutation) SetEnabledAt(t time.Time) {
+	m.enabled_at = &t
+}
+
+// EnabledAt returns the value of the "enabled_at" field in the mutation.
+func (m *SystemUserMFAMutation) EnabledAt() (r time.Time, exists bool) {
+	v := m.enabled_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabledAt returns the old "enabled_at" field's value of the SystemUserMFA entity.
+// If the SystemUserMFA object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserMFAMutation) OldEnabledAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabledAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabledAt: %w", err)
+	}
+	return oldValue.EnabledAt, nil
+}
+
+// ClearEnabledAt clears the value of the "enabled_at" field.
+func (m *SystemUserMFAMutation) ClearEnabledAt() {
+	m.enabled_at = nil
+	m.clearedFields[systemusermfa.FieldEnabledAt] = struct{}{}
+}
+
+// EnabledAtCleared returns if the "enabled_at" field was cleared in this mutation.
+func (m *SystemUserMFAMutation) EnabledAtCleared() bool {
+	_, ok := m.clearedFields[systemusermfa.FieldEnabledAt]
+	return ok
+}
+
+// ResetEnabledAt resets all changes to the "enabled_at" field.
+func (m *SystemUserMFAMutation) ResetEnabledAt() {
+	m.enabled_at = nil
+	delete(m.clearedFields, systemusermfa.FieldEnabledAt)
+}
+
+// Where appends a list predicates to the SystemUserMFAMutation builder.
+func (m *SystemUserMFAMutation) Where(ps ...predicate.SystemUserMFA) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SystemUserMFAMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SystemUserMFAMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SystemUserMFA, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SystemUserMFAMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SystemUserMFAMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SystemUserMFA).
+func (m *SystemUserMFAMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SystemUserMFAMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, systemusermfa.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, systemusermfa.FieldUpdatedAt)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, systemusermfa.FieldTenantID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, systemusermfa.FieldUserID)
+	}
+	if m.secret != nil {
+		fields = append(fields, systemusermfa.FieldSecret)
+	}
+	if m.enabled != nil {
+		fields = append(fields, systemusermfa.FieldEnabled)
+	}
+	if m.recovery_codes != nil {
+		fields = append(fields, systemusermfa.FieldRecoveryCodes)
+	}
+	if m.last_used_step != nil {
+		fields = append(fields, systemusermfa.FieldLastUsedStep)
+	}
+	if m.enabled_at != nil {
+		fields = append(fields, systemusermfa.FieldEnabledAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SystemUserMFAMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case systemusermfa.FieldCreatedAt:
+		return m.CreatedAt()
+	case systemusermfa.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case systemusermfa.FieldTenantID:
+		return m.TenantID()
+	case systemusermfa.FieldUserID:
+		return m.UserID()
+	case systemusermfa.FieldSecret:
+		return m.Secret()
+	case systemusermfa.FieldEnabled:
+		return m.Enabled()
+	case systemusermfa.FieldRecoveryCodes:
+		return m.RecoveryCodes()
+	case systemusermfa.FieldLastUsedStep:
+		return m.LastUsedStep()
+	case systemusermfa.FieldEnabledAt:
+		return m.EnabledAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SystemUserMFAMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case systemusermfa.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case systemusermfa.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case systemusermfa.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case systemusermfa.FieldUserID:
+		return m.OldUserID(ctx)
+	case systemusermfa.FieldSecret:
+		return m.OldSecret(ctx)
+	case systemusermfa.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case systemusermfa.FieldRecoveryCodes:
+		return m.OldRecoveryCodes(ctx)
+	case systemusermfa.FieldLastUsedStep:
+		return m.OldLastUsedStep(ctx)
+	case systemusermfa.FieldEnabledAt:
+		return m.OldEnabledAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SystemUserMFA field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemUserMFAMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case systemusermfa.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case systemusermfa.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case systemusermfa.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case systemusermfa.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case systemusermfa.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case systemusermfa.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case systemusermfa.FieldRecoveryCodes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecoveryCodes(v)
+		return nil
+	case systemusermfa.FieldLastUsedStep:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedStep(v)
+		return nil
+	case systemusermfa.FieldEnabledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabledAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SystemUserMFA field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SystemUserMFAMutation) AddedFields() []string {
+	var fields []string
+	if m.addlast_used_step != nil {
+		fields = append(fields, systemusermfa.FieldLastUsedStep)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SystemUserMFAMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case systemusermfa.FieldLastUsedStep:
+		return m.AddedLastUsedStep()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemUserMFAMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case systemusermfa.FieldLastUsedStep:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastUsedStep(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SystemUserMFA numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SystemUserMFAMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(systemusermfa.FieldCreatedAt) {
+		fields = append(fields, systemusermfa.FieldCreatedAt)
+	}
+	if m.FieldCleared(systemusermfa.FieldUpdatedAt) {
+		fields = append(fields, systemusermfa.FieldUpdatedAt)
+	}
+	if m.FieldCleared(systemusermfa.FieldRecoveryCodes) {
+		fields = append(fields, systemusermfa.FieldRecoveryCodes)
+	}
+	if m.FieldCleared(systemusermfa.FieldEnabledAt) {
+		fields = append(fields, systemusermfa.FieldEnabledAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SystemUserMFAMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SystemUserMFAMutation) ClearField(name string) error {
+	switch name {
+	case systemusermfa.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case systemusermfa.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case systemusermfa.FieldRecoveryCodes:
+		m.ClearRecoveryCodes()
+		return nil
+	case systemusermfa.FieldEnabledAt:
+		m.ClearEnabledAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemUserMFA nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SystemUserMFAMutation) ResetField(name string) error {
+	switch name {
+	case systemusermfa.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case systemusermfa.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case systemusermfa.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case systemusermfa.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case systemusermfa.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case systemusermfa.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case systemusermfa.FieldRecoveryCodes:
+		m.ResetRecoveryCodes()
+		return nil
+	case systemusermfa.FieldLastUsedStep:
+		m.ResetLastUsedStep()
+		return nil
+	case systemusermfa.FieldEnabledAt:
+		m.ResetEnabledAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemUserMFA field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SystemUserMFAMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SystemUserMFAMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SystemUserMFAMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SystemUserMFAMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SystemUserMFAMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SystemUserMFAMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SystemUserMFAMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SystemUserMFA unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SystemUserMFAMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SystemUserMFA edge %s", name)
+}
+
+// SystemUserPostMutation represents an operation that mutates the SystemUserPost nodes in the graph.
+type SystemUserPostMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	create_by     *string
+	created_at    *time.Time
+	update_by     *string
+	updated_at    *time.Time
+	tenant_id     *string
+	deleted_at    *time.Time
+	user_id       *string
+	post_id       *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SystemUserPost, error)
+	predicates    []predicate.SystemUserPost
+}
+
+var _ ent.Mutation = (*SystemUserPostMutation)(nil)
+
+// systemuserpostOption allows management of the mutation configuration using functional options.
+type systemuserpostOption func(*SystemUserPostMutation)
+
+// newSystemUserPostMutation creates new mutation for the SystemUserPost entity.
+func newSystemUserPostMutation(c config, op Op, opts ...systemuserpostOption) *SystemUserPostMutation {
+	m := &SystemUserPostMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSystemUserPost,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSystemUserPostID sets the ID field of the mutation.
+func withSystemUserPostID(id string) systemuserpostOption {
+	return func(m *SystemUserPostMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SystemUserPost
+		)
+		m.oldValue = func(ctx context.Context) (*SystemUserPost, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SystemUserPost.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSystemUserPost sets the old SystemUserPost of the mutation.
+func withSystemUserPost(node *SystemUserPost) systemuserpostOption {
+	return func(m *SystemUserPostMutation) {
+		m.oldValue = func(context.Context) (*SystemUserPost, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SystemUserPostMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SystemUserPostMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SystemUserPost entities.
+func (m *SystemUserPostMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SystemUserPostMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SystemUserPostMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SystemUserPost.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateBy sets the "create_by" field.
+func (m *SystemUserPostMutation) SetCreateBy(s string) {
+	m.create_by = &s
+}
+
+// CreateBy returns the value of the "create_by" field in the mutation.
+func (m *SystemUserPostMutation) CreateBy() (r string, exists bool) {
+	v := m.create_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateBy returns the old "create_by" field's value of the SystemUserPost entity.
+// If the SystemUserPost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserPostMutation) OldCreateBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
+	}
+	return oldValue.CreateBy, nil
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (m *SystemUserPostMutation) ClearCreateBy() {
+	m.create_by = nil
+	m.clearedFields[systemuserpost.FieldCreateBy] = struct{}{}
+}
+
+// CreateByCleared returns if the "create_by" field was cleared in this mutation.
+func (m *SystemUserPostMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[systemuserpost.FieldCreateBy]
+	return ok
+}
+
+// ResetCreateBy resets all changes to the "create_by" field.
+func (m *SystemUserPostMutation) ResetCreateBy() {
+	m.create_by = nil
+	delete(m.clearedFields, systemuserpost.FieldCreateBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SystemUserPostMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SystemUserPostMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SystemUserPost entity.
+// If the SystemUserPost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserPostMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *SystemUserPostMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[systemuserpost.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *SystemUserPostMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[systemuserpost.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SystemUserPostMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, systemuserpost.FieldCreatedAt)
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (m *SystemUserPostMutation) SetUpdateBy(s string) {
+	m.update_by = &s
+}
+
+// UpdateBy returns the value of the "update_by" field in the mutation.
+func (m *SystemUserPostMutation) UpdateBy() (r string, exists bool) {
+	v := m.update_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateBy returns the old "update_by" field's value of the SystemUserPost entity.
+// If the SystemUserPost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserPostMutation) OldUpdateBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateBy: %w", err)
+	}
+	return oldValue.UpdateBy, nil
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (m *SystemUserPostMutation) ClearUpdateBy() {
+	m.update_by = nil
+	m.clearedFields[systemuserpost.FieldUpdateBy] = struct{}{}
+}
+
+// UpdateByCleared returns if the "update_by" field was cleared in this mutation.
+func (m *SystemUserPostMutation) UpdateByCleared() bool {
+	_, ok := m.clearedFields[systemuserpost.FieldUpdateBy]
+	return ok
+}
+
+// ResetUpdateBy resets all changes to the "update_by" field.
+func (m *SystemUserPostMutation) ResetUpdateBy() {
+	m.update_by = nil
+	delete(m.clearedFields, systemuserpost.FieldUpdateBy)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SystemUserPostMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SystemUserPostMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SystemUserPost entity.
+// If the SystemUserPost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserPostMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *SystemUserPostMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[systemuserpost.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *SystemUserPostMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[systemuserpost.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SystemUserPostMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, systemuserpost.FieldUpdatedAt)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *SystemUserPostMutation) SetTenantID(s string) {
+	m.tenant_id = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *SystemUserPostMutation) TenantID() (r string, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the SystemUserPost entity.
+// If the SystemUserPost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserPostMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *SystemUserPostMutation) ResetTenantID() {
+	m.tenant_id = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *SystemUserPostMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *SystemUserPostMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the SystemUserPost entity.
+// If the SystemUserPost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserPostMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *SystemUserPostMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[systemuserpost.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *SystemUserPostMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[systemuserpost.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *SystemUserPostMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, systemuserpost.FieldDeletedAt)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SystemUserPostMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SystemUserPostMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the SystemUserPost entity.
+// If the SystemUserPost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserPostMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SystemUserPostMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetPostID sets the "post_id" field.
+func (m *SystemUserPostMutation) SetPostID(s string) {
+	m.post_id = &s
+}
+
+// PostID returns the value of the "post_id" field in the mutation.
+func (m *SystemUserPostMutation) PostID() (r string, exists bool) {
+	v := m.post_id
+	if v == nil {
 		return
 	}
-	return *v, true
+	return *v, true
+}
+
+// OldPostID returns the old "post_id" field's value of the SystemUserPost entity.
+// If the SystemUserPost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserPostMutation) OldPostID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPostID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPostID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPostID: %w", err)
+	}
+	return oldValue.PostID, nil
+}
+
+// ResetPostID resets all changes to the "post_id" field.
+func (m *SystemUserPostMutation) ResetPostID() {
+	m.post_id = nil
+}
+
+// Where appends a list predicates to the SystemUserPostMutation builder.
+func (m *SystemUserPostMutation) Where(ps ...predicate.SystemUserPost) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SystemUserPostMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SystemUserPostMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SystemUserPost, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SystemUserPostMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SystemUserPostMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SystemUserPost).
+func (m *SystemUserPostMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SystemUserPostMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_by != nil {
+		fields = append(fields, systemuserpost.FieldCreateBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, systemuserpost.FieldCreatedAt)
+	}
+	if m.update_by != nil {
+		fields = append(fields, systemuserpost.FieldUpdateBy)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, systemuserpost.FieldUpdatedAt)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, systemuserpost.FieldTenantID)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, systemuserpost.FieldDeletedAt)
+	}
+	if m.user_id != nil {
+		fields = append(fields, systemuserpost.FieldUserID)
+	}
+	if m.post_id != nil {
+		fields = append(fields, systemuserpost.FieldPostID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SystemUserPostMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case systemuserpost.FieldCreateBy:
+		return m.CreateBy()
+	case systemuserpost.FieldCreatedAt:
+		return m.CreatedAt()
+	case systemuserpost.FieldUpdateBy:
+		return m.UpdateBy()
+	case systemuserpost.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case systemuserpost.FieldTenantID:
+		return m.TenantID()
+	case systemuserpost.FieldDeletedAt:
+		return m.DeletedAt()
+	case systemuserpost.FieldUserID:
+		return m.UserID()
+	case systemuserpost.FieldPostID:
+		return m.PostID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SystemUserPostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case systemuserpost.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case systemuserpost.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case systemuserpost.FieldUpdateBy:
+		return m.OldUpdateBy(ctx)
+	case systemuserpost.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case systemuserpost.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case systemuserpost.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case systemuserpost.FieldUserID:
+		return m.OldUserID(ctx)
+	case systemuserpost.FieldPostID:
+		return m.OldPostID(ctx)
+	}
+	return nil, fmt.Errorf("unknown SystemUserPost field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemUserPostMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case systemuserpost.FieldCreateBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateBy(v)
+		return nil
+	case systemuserpost.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case systemuserpost.FieldUpdateBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateBy(v)
+		return nil
+	case systemuserpost.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case systemuserpost.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case systemuserpost.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case systemuserpost.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case systemuserpost.FieldPostID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SystemUserPost field %s", name)
 }
 
-// OldRemark returns the old "remark" field's value of the SystemUser entity.
-// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemUserMutation) OldRemark(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRemark is only allowed on UpdateOne operations")
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SystemUserPostMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SystemUserPostMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemUserPostMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRemark requires an ID field in the mutation")
+	return fmt.Errorf("unknown SystemUserPost numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SystemUserPostMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(systemuserpost.FieldCreateBy) {
+		fields = append(fields, systemuserpost.FieldCreateBy)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRemark: %w", err)
+	if m.FieldCleared(systemuserpost.FieldCreatedAt) {
+		fields = append(fields, systemuserpost.FieldCreatedAt)
 	}
-	return oldValue.Remark, nil
+	if m.FieldCleared(systemuserpost.FieldUpdateBy) {
+		fields = append(fields, systemuserpost.FieldUpdateBy)
+	}
+	if m.FieldCleared(systemuserpost.FieldUpdatedAt) {
+		fields = append(fields, systemuserpost.FieldUpdatedAt)
+	}
+	if m.FieldCleared(systemuserpost.FieldDeletedAt) {
+		fields = append(fields, systemuserpost.FieldDeletedAt)
+	}
+	return fields
 }
 
-// ClearRemark clears the value of the "remark" field.
-func (m *SystemUserMutation) ClearRemark() {
-	m.remark = nil
-	m.clearedFields[systemuser.FieldRemark] = struct{}{}
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SystemUserPostMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// RemarkCleared returns if the "remark" field was cleared in this mutation.
-func (m *SystemUserMutation) RemarkCleared() bool {
-	_, ok := m.clearedFields[systemuser.FieldRemark]
-	return ok
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SystemUserPostMutation) ClearField(name string) error {
+	switch name {
+	case systemuserpost.FieldCreateBy:
+		m.ClearCreateBy()
+		return nil
+	case systemuserpost.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case systemuserpost.FieldUpdateBy:
+		m.ClearUpdateBy()
+		return nil
+	case systemuserpost.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case systemuserpost.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemUserPost nullable field %s", name)
 }
 
-// ResetRemark resets all changes to the "remark" field.
-func (m *SystemUserMutation) ResetRemark() {
-	m.remark = nil
-	delete(m.clearedFields, systemuser.FieldRemark)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SystemUserPostMutation) ResetField(name string) error {
+	switch name {
+	case systemuserpost.FieldCreateBy:
+		m.ResetCreateBy()
+		return nil
+	case systemuserpost.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case systemuserpost.FieldUpdateBy:
+		m.ResetUpdateBy()
+		return nil
+	case systemuserpost.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case systemuserpost.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case systemuserpost.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case systemuserpost.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case systemuserpost.FieldPostID:
+		m.ResetPostID()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemUserPost field %s", name)
 }
 
-// SetDeptID sets the "dept_id" field.
-func (m *SystemUserMutation) SetDeptID(s string) {
-	m.dept_id = &s
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SystemUserPostMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// DeptID returns the value of the "dept_id" field in the mutation.
-func (m *SystemUserMutation) DeptID() (r string, exists bool) {
-	v := m.dept_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SystemUserPostMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldDeptID returns the old "dept_id" field's value of the SystemUser entity.
-// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemUserMutation) OldDeptID(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeptID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeptID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeptID: %w", err)
-	}
-	return oldValue.DeptID, nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SystemUserPostMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearDeptID clears the value of the "dept_id" field.
-func (m *SystemUserMutation) ClearDeptID() {
-	m.dept_id = nil
-	m.clearedFields[systemuser.FieldDeptID] = struct{}{}
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SystemUserPostMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// DeptIDCleared returns if the "dept_id" field was cleared in this mutation.
-func (m *SystemUserMutation) DeptIDCleared() bool {
-	_, ok := m.clearedFields[systemuser.FieldDeptID]
-	return ok
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SystemUserPostMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetDeptID resets all changes to the "dept_id" field.
-func (m *SystemUserMutation) ResetDeptID() {
-	m.dept_id = nil
-	delete(m.clearedFields, systemuser.FieldDeptID)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SystemUserPostMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// SetPostIds sets the "post_ids" field.
-func (m *SystemUserMutation) SetPostIds(s string) {
-	m.post_ids = &s
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SystemUserPostMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SystemUserPost unique edge %s", name)
 }
 
-// PostIds returns the value of the "post_ids" field in the mutation.
-func (m *SystemUserMutation) PostIds() (r string, exists bool) {
-	v := m.post_ids
-	if v == nil {
-		return
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SystemUserPostMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SystemUserPost edge %s", name)
+}
+
+// SystemUserRoleMutation represents an operation that mutates the SystemUserRole nodes in the graph.
+type SystemUserRoleMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	create_by     *string
+	created_at    *time.Time
+	update_by     *string
+	updated_at    *time.Time
+	tenant_id     *string
+	deleted_at    *time.Time
+	user_id       *string
+	role_id       *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SystemUserRole, error)
+	predicates    []predicate.SystemUserRole
+}
+
+var _ ent.Mutation = (*SystemUserRoleMutation)(nil)
+
+// systemuserroleOption allows management of the mutation configuration using functional options.
+type systemuserroleOption func(*SystemUserRoleMutation)
+
+// newSystemUserRoleMutation creates new mutation for the SystemUserRole entity.
+func newSystemUserRoleMutation(c config, op Op, opts ...systemuserroleOption) *SystemUserRoleMutation {
+	m := &SystemUserRoleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSystemUserRole,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldPostIds returns the old "post_ids" field's value of the SystemUser entity.
-// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemUserMutation) OldPostIds(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPostIds is only allowed on UpdateOne operations")
+// withSystemUserRoleID sets the ID field of the mutation.
+func withSystemUserRoleID(id string) systemuserroleOption {
+	return func(m *SystemUserRoleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SystemUserRole
+		)
+		m.oldValue = func(ctx context.Context) (*SystemUserRole, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SystemUserRole.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPostIds requires an ID field in the mutation")
+}
+
+// withSystemUserRole sets the old SystemUserRole of the mutation.
+func withSystemUserRole(node *SystemUserRole) systemuserroleOption {
+	return func(m *SystemUserRoleMutation) {
+		m.oldValue = func(context.Context) (*SystemUserRole, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPostIds: %w", err)
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SystemUserRoleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SystemUserRoleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return oldValue.PostIds, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearPostIds clears the value of the "post_ids" field.
-func (m *SystemUserMutation) ClearPostIds() {
-	m.post_ids = nil
-	m.clearedFields[systemuser.FieldPostIds] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SystemUserRole entities.
+func (m *SystemUserRoleMutation) SetID(id string) {
+	m.id = &id
 }
 
-// PostIdsCleared returns if the "post_ids" field was cleared in this mutation.
-func (m *SystemUserMutation) PostIdsCleared() bool {
-	_, ok := m.clearedFields[systemuser.FieldPostIds]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SystemUserRoleMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetPostIds resets all changes to the "post_ids" field.
-func (m *SystemUserMutation) ResetPostIds() {
-	m.post_ids = nil
-	delete(m.clearedFields, systemuser.FieldPostIds)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SystemUserRoleMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SystemUserRole.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetEmail sets the "email" field.
-func (m *SystemUserMutation) SetEmail(s string) {
-	m.email = &s
+// SetCreateBy sets the "create_by" field.
+func (m *SystemUserRoleMutation) SetCreateBy(s string) {
+	m.create_by = &s
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *SystemUserMutation) Email() (r string, exists bool) {
-	v := m.email
+// CreateBy returns the value of the "create_by" field in the mutation.
+func (m *SystemUserRoleMutation) CreateBy() (r string, exists bool) {
+	v := m.create_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the SystemUser entity.
-// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateBy returns the old "create_by" field's value of the SystemUserRole entity.
+// If the SystemUserRole object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemUserMutation) OldEmail(ctx context.Context) (v *string, err error) {
+func (m *SystemUserRoleMutation) OldCreateBy(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldCreateBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateBy: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.CreateBy, nil
 }
 
-// ClearEmail clears the value of the "email" field.
-func (m *SystemUserMutation) ClearEmail() {
-	m.email = nil
-	m.clearedFields[systemuser.FieldEmail] = struct{}{}
+// ClearCreateBy clears the value of the "create_by" field.
+func (m *SystemUserRoleMutation) ClearCreateBy() {
+	m.create_by = nil
+	m.clearedFields[systemuserrole.FieldCreateBy] = struct{}{}
 }
 
-// EmailCleared returns if the "email" field was cleared in this mutation.
-func (m *SystemUserMutation) EmailCleared() bool {
-	_, ok := m.clearedFields[systemuser.FieldEmail]
+// CreateByCleared returns if the "create_by" field was cleared in this mutation.
+func (m *SystemUserRoleMutation) CreateByCleared() bool {
+	_, ok := m.clearedFields[systemuserrole.FieldCreateBy]
 	return ok
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *SystemUserMutation) ResetEmail() {
-	m.email = nil
-	delete(m.clearedFields, systemuser.FieldEmail)
+// ResetCreateBy resets all changes to the "create_by" field.
+func (m *SystemUserRoleMutation) ResetCreateBy() {
+	m.create_by = nil
+	delete(m.clearedFields, systemuserrole.FieldCreateBy)
 }
 
-// SetMobile sets the "mobile" field.
-func (m *SystemUserMutation) SetMobile(s string) {
-	m.mobile = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *SystemUserRoleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Mobile returns the value of the "mobile" field in the mutation.
-func (m *SystemUserMutation) Mobile() (r string, exists bool) {
-	v := m.mobile
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SystemUserRoleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMobile returns the old "mobile" field's value of the SystemUser entity.
-// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the SystemUserRole entity.
+// If the SystemUserRole object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemUserMutation) OldMobile(ctx context.Context) (v *string, err error) {
+func (m *SystemUserRoleMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMobile is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMobile requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMobile: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Mobile, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ClearMobile clears the value of the "mobile" field.
-func (m *SystemUserMutation) ClearMobile() {
-	m.mobile = nil
-	m.clearedFields[systemuser.FieldMobile] = struct{}{}
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *SystemUserRoleMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[systemuserrole.FieldCreatedAt] = struct{}{}
 }
 
-// MobileCleared returns if the "mobile" field was cleared in this mutation.
-func (m *SystemUserMutation) MobileCleared() bool {
-	_, ok := m.clearedFields[systemuser.FieldMobile]
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *SystemUserRoleMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[systemuserrole.FieldCreatedAt]
 	return ok
 }
 
-// ResetMobile resets all changes to the "mobile" field.
-func (m *SystemUserMutation) ResetMobile() {
-	m.mobile = nil
-	delete(m.clearedFields, systemuser.FieldMobile)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SystemUserRoleMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, systemuserrole.FieldCreatedAt)
 }
 
-// SetSex sets the "sex" field.
-func (m *SystemUserMutation) SetSex(i int8) {
-	m.sex = &i
-	m.addsex = nil
+// SetUpdateBy sets the "update_by" field.
+func (m *SystemUserRoleMutation) SetUpdateBy(s string) {
+	m.update_by = &s
 }
 
-// Sex returns the value of the "sex" field in the mutation.
-func (m *SystemUserMutation) Sex() (r int8, exists bool) {
-	v := m.sex
+// UpdateBy returns the value of the "update_by" field in the mutation.
+func (m *SystemUserRoleMutation) UpdateBy() (r string, exists bool) {
+	v := m.update_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSex returns the old "sex" field's value of the SystemUser entity.
-// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateBy returns the old "update_by" field's value of the SystemUserRole entity.
+// If the SystemUserRole object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemUserMutation) OldSex(ctx context.Context) (v *int8, err error) {
+func (m *SystemUserRoleMutation) OldUpdateBy(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSex is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSex requires an ID field in the mutation")
+		return v, errors.New("OldUpdateBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSex: %w", err)
-	}
-	return oldValue.Sex, nil
-}
-
-// AddSex adds i to the "sex" field.
-func (m *SystemUserMutation) AddSex(i int8) {
-	if m.addsex != nil {
-		*m.addsex += i
-	} else {
-		m.addsex = &i
-	}
-}
-
-// AddedSex returns the value that was added to the "sex" field in this mutation.
-func (m *SystemUserMutation) AddedSex() (r int8, exists bool) {
-	v := m.addsex
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldUpdateBy: %w", err)
 	}
-	return *v, true
+	return oldValue.UpdateBy, nil
 }
 
-// ClearSex clears the value of the "sex" field.
-func (m *SystemUserMutation) ClearSex() {
-	m.sex = nil
-	m.addsex = nil
-	m.clearedFields[systemuser.FieldSex] = struct{}{}
+// ClearUpdateBy clears the value of the "update_by" field.
+func (m *SystemUserRoleMutation) ClearUpdateBy() {
+	m.update_by = nil
+	m.clearedFields[systemuserrole.FieldUpdateBy] = struct{}{}
 }
 
-// SexCleared returns if the "sex" field was cleared in this mutation.
-func (m *SystemUserMutation) SexCleared() bool {
-	_, ok := m.clearedFields[systemuser.FieldSex]
+// UpdateByCleared returns if the "update_by" field was cleared in this mutation.
+func (m *SystemUserRoleMutation) UpdateByCleared() bool {
+	_, ok := m.clearedFields[systemuserrole.FieldUpdateBy]
 	return ok
 }
 
-// ResetSex resets all changes to the "sex" field.
-func (m *SystemUserMutation) ResetSex() {
-	m.sex = nil
-	m.addsex = nil
-	delete(m.clearedFields, systemuser.FieldSex)
+// ResetUpdateBy resets all changes to the "update_by" field.
+func (m *SystemUserRoleMutation) ResetUpdateBy() {
+	m.update_by = nil
+	delete(m.clearedFields, systemuserrole.FieldUpdateBy)
 }
 
-// SetAvatar sets the "avatar" field.
-func (m *SystemUserMutation) SetAvatar(s string) {
-	m.avatar = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SystemUserRoleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Avatar returns the value of the "avatar" field in the mutation.
-func (m *SystemUserMutation) Avatar() (r string, exists bool) {
-	v := m.avatar
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SystemUserRoleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAvatar returns the old "avatar" field's value of the SystemUser entity.
-// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the SystemUserRole entity.
+// If the SystemUserRole object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemUserMutation) OldAvatar(ctx context.Context) (v *string, err error) {
+func (m *SystemUserRoleMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvatar requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Avatar, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearAvatar clears the value of the "avatar" field.
-func (m *SystemUserMutation) ClearAvatar() {
-	m.avatar = nil
-	m.clearedFields[systemuser.FieldAvatar] = struct{}{}
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *SystemUserRoleMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[systemuserrole.FieldUpdatedAt] = struct{}{}
 }
 
-// AvatarCleared returns if the "avatar" field was cleared in this mutation.
-func (m *SystemUserMutation) AvatarCleared() bool {
-	_, ok := m.clearedFields[systemuser.FieldAvatar]
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *SystemUserRoleMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[systemuserrole.FieldUpdatedAt]
 	return ok
 }
 
-// ResetAvatar resets all changes to the "avatar" field.
-func (m *SystemUserMutation) ResetAvatar() {
-	m.avatar = nil
-	delete(m.clearedFields, systemuser.FieldAvatar)
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SystemUserRoleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, systemuserrole.FieldUpdatedAt)
 }
 
-// SetStatus sets the "status" field.
-func (m *SystemUserMutation) SetStatus(i int8) {
-	m.status = &i
-	m.addstatus = nil
+// SetTenantID sets the "tenant_id" field.
+func (m *SystemUserRoleMutation) SetTenantID(s string) {
+	m.tenant_id = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *SystemUserMutation) Status() (r int8, exists bool) {
-	v := m.status
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *SystemUserRoleMutation) TenantID() (r string, exists bool) {
+	v := m.tenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the SystemUser entity.
-// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the SystemUserRole entity.
+// If the SystemUserRole object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemUserMutation) OldStatus(ctx context.Context) (v int8, err error) {
+func (m *SystemUserRoleMutation) OldTenantID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.TenantID, nil
 }
 
-// AddStatus adds i to the "status" field.
-func (m *SystemUserMutation) AddStatus(i int8) {
-	if m.addstatus != nil {
-		*m.addstatus += i
-	} else {
-		m.addstatus = &i
-	}
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *SystemUserRoleMutation) ResetTenantID() {
+	m.tenant_id = nil
 }
 
-// AddedStatus returns the value that was added to the "status" field in this mutation.
-func (m *SystemUserMutation) AddedStatus() (r int8, exists bool) {
-	v := m.addstatus
+// SetDeletedAt sets the "deleted_at" field.
+func (m *SystemUserRoleMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *SystemUserRoleMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearStatus clears the value of the "status" field.
-func (m *SystemUserMutation) ClearStatus() {
-	m.status = nil
-	m.addstatus = nil
-	m.clearedFields[systemuser.FieldStatus] = struct{}{}
+// OldDeletedAt returns the old "deleted_at" field's value of the SystemUserRole entity.
+// If the SystemUserRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemUserRoleMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
 }
 
-// StatusCleared returns if the "status" field was cleared in this mutation.
-func (m *SystemUserMutation) StatusCleared() bool {
-	_, ok := m.clearedFields[systemuser.FieldStatus]
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *SystemUserRoleMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[systemuserrole.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *SystemUserRoleMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[systemuserrole.FieldDeletedAt]
 	return ok
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *SystemUserMutation) ResetStatus() {
-	m.status = nil
-	m.addstatus = nil
-	delete(m.clearedFields, systemuser.FieldStatus)
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *SystemUserRoleMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, systemuserrole.FieldDeletedAt)
 }
 
-// SetLoginIP sets the "login_ip" field.
-func (m *SystemUserMutation) SetLoginIP(s string) {
-	m.login_ip = &s
+// SetUserID sets the "user_id" field.
+func (m *SystemUserRoleMutation) SetUserID(s string) {
+	m.user_id = &s
 }
 
-// LoginIP returns the value of the "login_ip" field in the mutation.
-func (m *SystemUserMutation) LoginIP() (r string, exists bool) {
-	v := m.login_ip
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SystemUserRoleMutation) UserID() (r string, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLoginIP returns the old "login_ip" field's value of the SystemUser entity.
-// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the SystemUserRole entity.
+// If the SystemUserRole object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemUserMutation) OldLoginIP(ctx context.Context) (v *string, err error) {
+func (m *SystemUserRoleMutation) OldUserID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLoginIP is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLoginIP requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLoginIP: %w", err)
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
-	return oldValue.LoginIP, nil
-}
-
-// ClearLoginIP clears the value of the "login_ip" field.
-func (m *SystemUserMutation) ClearLoginIP() {
-	m.login_ip = nil
-	m.clearedFields[systemuser.FieldLoginIP] = struct{}{}
-}
-
-// LoginIPCleared returns if the "login_ip" field was cleared in this mutation.
-func (m *SystemUserMutation) LoginIPCleared() bool {
-	_, ok := m.clearedFields[systemuser.FieldLoginIP]
-	return ok
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
 }
 
-// ResetLoginIP resets all changes to the "login_ip" field.
-func (m *SystemUserMutation) ResetLoginIP() {
-	m.login_ip = nil
-	delete(m.clearedFields, systemuser.FieldLoginIP)
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SystemUserRoleMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetLoginDate sets the "login_date" field.
-func (m *SystemUserMutation) SetLoginDate(t time.Time) {
-	m.login_date = &t
+// SetRoleID sets the "role_id" field.
+func (m *SystemUserRoleMutation) SetRoleID(s string) {
+	m.role_id = &s
 }
 
-// LoginDate returns the value of the "login_date" field in the mutation.
-func (m *SystemUserMutation) LoginDate() (r time.Time, exists bool) {
-	v := m.login_date
+// RoleID returns the value of the "role_id" field in the mutation.
+func (m *SystemUserRoleMutation) RoleID() (r string, exists bool) {
+	v := m.role_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLoginDate returns the old "login_date" field's value of the SystemUser entity.
-// If the SystemUser object wasn't provided to the builder, the object is fetched from the database.
+// OldRoleID returns the old "role_id" field's value of the SystemUserRole entity.
+// If the SystemUserRole object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemUserMutation) OldLoginDate(ctx context.Context) (v *time.Time, err error) {
+func (m *SystemUserRoleMutation) OldRoleID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLoginDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLoginDate requires an ID field in the mutation")
+		return v, errors.New("OldRoleID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLoginDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldRoleID: %w", err)
 	}
-	return oldValue.LoginDate, nil
-}
-
-// ClearLoginDate clears the value of the "login_date" field.
-func (m *SystemUserMutation) ClearLoginDate() {
-	m.login_date = nil
-	m.clearedFields[systemuser.FieldLoginDate] = struct{}{}
-}
-
-// LoginDateCleared returns if the "login_date" field was cleared in this mutation.
-func (m *SystemUserMutation) LoginDateCleared() bool {
-	_, ok := m.clearedFields[systemuser.FieldLoginDate]
-	return ok
+	return oldValue.RoleID, nil
 }
 
-// ResetLoginDate resets all changes to the "login_date" field.
-func (m *SystemUserMutation) ResetLoginDate() {
-	m.login_date = nil
-	delete(m.clearedFields, systemuser.FieldLoginDate)
+// ResetRoleID resets all changes to the "role_id" field.
+func (m *SystemUserRoleMutation) ResetRoleID() {
+	m.role_id = nil
 }
 
-// Where appends a list predicates to the SystemUserMutation builder.
-func (m *SystemUserMutation) Where(ps ...predicate.SystemUser) {
+// Where appends a list predicates to the SystemUserRoleMutation builder.
+func (m *SystemUserRoleMutation) Where(ps ...predicate.SystemUserRole) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SystemUserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SystemUserRoleMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SystemUserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SystemUser, len(ps))
+func (m *SystemUserRoleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SystemUserRole, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1127,603 +13816,317 @@ func (m *SystemUserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SystemUserMutation) Op() Op {
+func (m *SystemUserRoleMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SystemUserMutation) SetOp(op Op) {
+func (m *SystemUserRoleMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SystemUser).
-func (m *SystemUserMutation) Type() string {
+// Type returns the node type of this mutation (SystemUserRole).
+func (m *SystemUserRoleMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SystemUserMutation) Fields() []string {
-	fields := make([]string, 0, 19)
+func (m *SystemUserRoleMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.create_by != nil {
-		fields = append(fields, systemuser.FieldCreateBy)
+		fields = append(fields, systemuserrole.FieldCreateBy)
 	}
 	if m.created_at != nil {
-		fields = append(fields, systemuser.FieldCreatedAt)
+		fields = append(fields, systemuserrole.FieldCreatedAt)
 	}
 	if m.update_by != nil {
-		fields = append(fields, systemuser.FieldUpdateBy)
+		fields = append(fields, systemuserrole.FieldUpdateBy)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, systemuser.FieldUpdatedAt)
-	}
-	if m.deleted_at != nil {
-		fields = append(fields, systemuser.FieldDeletedAt)
-	}
-	if m.tenant_id != nil {
-		fields = append(fields, systemuser.FieldTenantID)
-	}
-	if m.account != nil {
-		fields = append(fields, systemuser.FieldAccount)
-	}
-	if m.password != nil {
-		fields = append(fields, systemuser.FieldPassword)
-	}
-	if m.nickname != nil {
-		fields = append(fields, systemuser.FieldNickname)
-	}
-	if m.remark != nil {
-		fields = append(fields, systemuser.FieldRemark)
-	}
-	if m.dept_id != nil {
-		fields = append(fields, systemuser.FieldDeptID)
-	}
-	if m.post_ids != nil {
-		fields = append(fields, systemuser.FieldPostIds)
-	}
-	if m.email != nil {
-		fields = append(fields, systemuser.FieldEmail)
-	}
-	if m.mobile != nil {
-		fields = append(fields, systemuser.FieldMobile)
-	}
-	if m.sex != nil {
-		fields = append(fields, systemuser.FieldSex)
-	}
-	if m.avatar != nil {
-		fields = append(fields, systemuser.FieldAvatar)
-	}
-	if m.status != nil {
-		fields = append(fields, systemuser.FieldStatus)
-	}
-	if m.login_ip != nil {
-		fields = append(fields, systemuser.FieldLoginIP)
-	}
-	if m.login_date != nil {
-		fields = append(fields, systemuser.FieldLoginDate)
-	}
-	return fields
-}
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *SystemUserMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case systemuser.FieldCreateBy:
-		return m.CreateBy()
-	case systemuser.FieldCreatedAt:
-		return m.CreatedAt()
-	case systemuser.FieldUpdateBy:
-		return m.UpdateBy()
-	case systemuser.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case systemuser.FieldDeletedAt:
-		return m.DeletedAt()
-	case systemuser.FieldTenantID:
-		return m.TenantID()
-	case systemuser.FieldAccount:
-		return m.Account()
-	case systemuser.FieldPassword:
-		return m.Password()
-	case systemuser.FieldNickname:
-		return m.Nickname()
-	case systemuser.FieldRemark:
-		return m.Remark()
-	case systemuser.FieldDeptID:
-		return m.DeptID()
-	case systemuser.FieldPostIds:
-		return m.PostIds()
-	case systemuser.FieldEmail:
-		return m.Email()
-	case systemuser.FieldMobile:
-		return m.Mobile()
-	case systemuser.FieldSex:
-		return m.Sex()
-	case systemuser.FieldAvatar:
-		return m.Avatar()
-	case systemuser.FieldStatus:
-		return m.Status()
-	case systemuser.FieldLoginIP:
-		return m.LoginIP()
-	case systemuser.FieldLoginDate:
-		return m.LoginDate()
-	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *SystemUserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case systemuser.FieldCreateBy:
-		return m.OldCreateBy(ctx)
-	case systemuser.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case systemuser.FieldUpdateBy:
-		return m.OldUpdateBy(ctx)
-	case systemuser.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case systemuser.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case systemuser.FieldTenantID:
-		return m.OldTenantID(ctx)
-	case systemuser.FieldAccount:
-		return m.OldAccount(ctx)
-	case systemuser.FieldPassword:
-		return m.OldPassword(ctx)
-	case systemuser.FieldNickname:
-		return m.OldNickname(ctx)
-	case systemuser.FieldRemark:
-		return m.OldRemark(ctx)
-	case systemuser.FieldDeptID:
-		return m.OldDeptID(ctx)
-	case systemuser.FieldPostIds:
-		return m.OldPostIds(ctx)
-	case systemuser.FieldEmail:
-		return m.OldEmail(ctx)
-	case systemuser.FieldMobile:
-		return m.OldMobile(ctx)
-	case systemuser.FieldSex:
-		return m.OldSex(ctx)
-	case systemuser.FieldAvatar:
-		return m.OldAvatar(ctx)
-	case systemuser.FieldStatus:
-		return m.OldStatus(ctx)
-	case systemuser.FieldLoginIP:
-		return m.OldLoginIP(ctx)
-	case systemuser.FieldLoginDate:
-		return m.OldLoginDate(ctx)
-	}
-	return nil, fmt.Errorf("unknown SystemUser field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *SystemUserMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case systemuser.FieldCreateBy:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateBy(v)
-		return nil
-	case systemuser.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case systemuser.FieldUpdateBy:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdateBy(v)
-		return nil
-	case systemuser.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case systemuser.FieldDeletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
-		return nil
-	case systemuser.FieldTenantID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTenantID(v)
-		return nil
-	case systemuser.FieldAccount:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAccount(v)
-		return nil
-	case systemuser.FieldPassword:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPassword(v)
-		return nil
-	case systemuser.FieldNickname:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNickname(v)
-		return nil
-	case systemuser.FieldRemark:
+		fields = append(fields, systemuserrole.FieldUpdatedAt)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, systemuserrole.FieldTenantID)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, systemuserrole.FieldDeletedAt)
+	}
+	if m.user_id != nil {
+		fields = append(fields, systemuserrole.FieldUserID)
+	}
+	if m.role_id != nil {
+		fields = append(fields, systemuserrole.FieldRoleID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SystemUserRoleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case systemuserrole.FieldCreateBy:
+		return m.CreateBy()
+	case systemuserrole.FieldCreatedAt:
+		return m.CreatedAt()
+	case systemuserrole.FieldUpdateBy:
+		return m.UpdateBy()
+	case systemuserrole.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case systemuserrole.FieldTenantID:
+		return m.TenantID()
+	case systemuserrole.FieldDeletedAt:
+		return m.DeletedAt()
+	case systemuserrole.FieldUserID:
+		return m.UserID()
+	case systemuserrole.FieldRoleID:
+		return m.RoleID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SystemUserRoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case systemuserrole.FieldCreateBy:
+		return m.OldCreateBy(ctx)
+	case systemuserrole.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case systemuserrole.FieldUpdateBy:
+		return m.OldUpdateBy(ctx)
+	case systemuserrole.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case systemuserrole.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case systemuserrole.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case systemuserrole.FieldUserID:
+		return m.OldUserID(ctx)
+	case systemuserrole.FieldRoleID:
+		return m.OldRoleID(ctx)
+	}
+	return nil, fmt.Errorf("unknown SystemUserRole field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemUserRoleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case systemuserrole.FieldCreateBy:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRemark(v)
+		m.SetCreateBy(v)
 		return nil
-	case systemuser.FieldDeptID:
-		v, ok := value.(string)
+	case systemuserrole.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeptID(v)
+		m.SetCreatedAt(v)
 		return nil
-	case systemuser.FieldPostIds:
+	case systemuserrole.FieldUpdateBy:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPostIds(v)
+		m.SetUpdateBy(v)
 		return nil
-	case systemuser.FieldEmail:
-		v, ok := value.(string)
+	case systemuserrole.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case systemuser.FieldMobile:
+	case systemuserrole.FieldTenantID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMobile(v)
+		m.SetTenantID(v)
 		return nil
-	case systemuser.FieldSex:
-		v, ok := value.(int8)
+	case systemuserrole.FieldDeletedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSex(v)
+		m.SetDeletedAt(v)
 		return nil
-	case systemuser.FieldAvatar:
+	case systemuserrole.FieldUserID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAvatar(v)
-		return nil
-	case systemuser.FieldStatus:
-		v, ok := value.(int8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
+		m.SetUserID(v)
 		return nil
-	case systemuser.FieldLoginIP:
+	case systemuserrole.FieldRoleID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLoginIP(v)
-		return nil
-	case systemuser.FieldLoginDate:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLoginDate(v)
+		m.SetRoleID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SystemUser field %s", name)
+	return fmt.Errorf("unknown SystemUserRole field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SystemUserMutation) AddedFields() []string {
-	var fields []string
-	if m.addsex != nil {
-		fields = append(fields, systemuser.FieldSex)
-	}
-	if m.addstatus != nil {
-		fields = append(fields, systemuser.FieldStatus)
-	}
-	return fields
+func (m *SystemUserRoleMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SystemUserMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case systemuser.FieldSex:
-		return m.AddedSex()
-	case systemuser.FieldStatus:
-		return m.AddedStatus()
-	}
+func (m *SystemUserRoleMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SystemUserMutation) AddField(name string, value ent.Value) error {
+func (m *SystemUserRoleMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case systemuser.FieldSex:
-		v, ok := value.(int8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSex(v)
-		return nil
-	case systemuser.FieldStatus:
-		v, ok := value.(int8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddStatus(v)
-		return nil
 	}
-	return fmt.Errorf("unknown SystemUser numeric field %s", name)
+	return fmt.Errorf("unknown SystemUserRole numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SystemUserMutation) ClearedFields() []string {
+func (m *SystemUserRoleMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(systemuser.FieldCreateBy) {
-		fields = append(fields, systemuser.FieldCreateBy)
-	}
-	if m.FieldCleared(systemuser.FieldCreatedAt) {
-		fields = append(fields, systemuser.FieldCreatedAt)
-	}
-	if m.FieldCleared(systemuser.FieldUpdateBy) {
-		fields = append(fields, systemuser.FieldUpdateBy)
-	}
-	if m.FieldCleared(systemuser.FieldUpdatedAt) {
-		fields = append(fields, systemuser.FieldUpdatedAt)
-	}
-	if m.FieldCleared(systemuser.FieldDeletedAt) {
-		fields = append(fields, systemuser.FieldDeletedAt)
-	}
-	if m.FieldCleared(systemuser.FieldPassword) {
-		fields = append(fields, systemuser.FieldPassword)
-	}
-	if m.FieldCleared(systemuser.FieldNickname) {
-		fields = append(fields, systemuser.FieldNickname)
-	}
-	if m.FieldCleared(systemuser.FieldRemark) {
-		fields = append(fields, systemuser.FieldRemark)
-	}
-	if m.FieldCleared(systemuser.FieldDeptID) {
-		fields = append(fields, systemuser.FieldDeptID)
-	}
-	if m.FieldCleared(systemuser.FieldPostIds) {
-		fields = append(fields, systemuser.FieldPostIds)
-	}
-	if m.FieldCleared(systemuser.FieldEmail) {
-		fields = append(fields, systemuser.FieldEmail)
-	}
-	if m.FieldCleared(systemuser.FieldMobile) {
-		fields = append(fields, systemuser.FieldMobile)
+	if m.FieldCleared(systemuserrole.FieldCreateBy) {
+		fields = append(fields, systemuserrole.FieldCreateBy)
 	}
-	if m.FieldCleared(systemuser.FieldSex) {
-		fields = append(fields, systemuser.FieldSex)
-	}
-	if m.FieldCleared(systemuser.FieldAvatar) {
-		fields = append(fields, systemuser.FieldAvatar)
+	if m.FieldCleared(systemuserrole.FieldCreatedAt) {
+		fields = append(fields, systemuserrole.FieldCreatedAt)
 	}
-	if m.FieldCleared(systemuser.FieldStatus) {
-		fields = append(fields, systemuser.FieldStatus)
+	if m.FieldCleared(systemuserrole.FieldUpdateBy) {
+		fields = append(fields, systemuserrole.FieldUpdateBy)
 	}
-	if m.FieldCleared(systemuser.FieldLoginIP) {
-		fields = append(fields, systemuser.FieldLoginIP)
+	if m.FieldCleared(systemuserrole.FieldUpdatedAt) {
+		fields = append(fields, systemuserrole.FieldUpdatedAt)
 	}
-	if m.FieldCleared(systemuser.FieldLoginDate) {
-		fields = append(fields, systemuser.FieldLoginDate)
+	if m.FieldCleared(systemuserrole.FieldDeletedAt) {
+		fields = append(fields, systemuserrole.FieldDeletedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SystemUserMutation) FieldCleared(name string) bool {
+func (m *SystemUserRoleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SystemUserMutation) ClearField(name string) error {
+func (m *SystemUserRoleMutation) ClearField(name string) error {
 	switch name {
-	case systemuser.FieldCreateBy:
+	case systemuserrole.FieldCreateBy:
 		m.ClearCreateBy()
 		return nil
-	case systemuser.FieldCreatedAt:
+	case systemuserrole.FieldCreatedAt:
 		m.ClearCreatedAt()
 		return nil
-	case systemuser.FieldUpdateBy:
+	case systemuserrole.FieldUpdateBy:
 		m.ClearUpdateBy()
 		return nil
-	case systemuser.FieldUpdatedAt:
+	case systemuserrole.FieldUpdatedAt:
 		m.ClearUpdatedAt()
 		return nil
-	case systemuser.FieldDeletedAt:
+	case systemuserrole.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
-	case systemuser.FieldPassword:
-		m.ClearPassword()
-		return nil
-	case systemuser.FieldNickname:
-		m.ClearNickname()
-		return nil
-	case systemuser.FieldRemark:
-		m.ClearRemark()
-		return nil
-	case systemuser.FieldDeptID:
-		m.ClearDeptID()
-		return nil
-	case systemuser.FieldPostIds:
-		m.ClearPostIds()
-		return nil
-	case systemuser.FieldEmail:
-		m.ClearEmail()
-		return nil
-	case systemuser.FieldMobile:
-		m.ClearMobile()
-		return nil
-	case systemuser.FieldSex:
-		m.ClearSex()
-		return nil
-	case systemuser.FieldAvatar:
-		m.ClearAvatar()
-		return nil
-	case systemuser.FieldStatus:
-		m.ClearStatus()
-		return nil
-	case systemuser.FieldLoginIP:
-		m.ClearLoginIP()
-		return nil
-	case systemuser.FieldLoginDate:
-		m.ClearLoginDate()
-		return nil
 	}
-	return fmt.Errorf("unknown SystemUser nullable field %s", name)
+	return fmt.Errorf("unknown SystemUserRole nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SystemUserMutation) ResetField(name string) error {
+func (m *SystemUserRoleMutation) ResetField(name string) error {
 	switch name {
-	case systemuser.FieldCreateBy:
+	case systemuserrole.FieldCreateBy:
 		m.ResetCreateBy()
 		return nil
-	case systemuser.FieldCreatedAt:
+	case systemuserrole.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case systemuser.FieldUpdateBy:
+	case systemuserrole.FieldUpdateBy:
 		m.ResetUpdateBy()
 		return nil
-	case systemuser.FieldUpdatedAt:
+	case systemuserrole.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case systemuser.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
-	case systemuser.FieldTenantID:
+	case systemuserrole.FieldTenantID:
 		m.ResetTenantID()
 		return nil
-	case systemuser.FieldAccount:
-		m.ResetAccount()
-		return nil
-	case systemuser.FieldPassword:
-		m.ResetPassword()
-		return nil
-	case systemuser.FieldNickname:
-		m.ResetNickname()
-		return nil
-	case systemuser.FieldRemark:
-		m.ResetRemark()
-		return nil
-	case systemuser.FieldDeptID:
-		m.ResetDeptID()
-		return nil
-	case systemuser.FieldPostIds:
-		m.ResetPostIds()
-		return nil
-	case systemuser.FieldEmail:
-		m.ResetEmail()
-		return nil
-	case systemuser.FieldMobile:
-		m.ResetMobile()
-		return nil
-	case systemuser.FieldSex:
-		m.ResetSex()
-		return nil
-	case systemuser.FieldAvatar:
-		m.ResetAvatar()
-		return nil
-	case systemuser.FieldStatus:
-		m.ResetStatus()
+	case systemuserrole.FieldDeletedAt:
+		m.ResetDeletedAt()
 		return nil
-	case systemuser.FieldLoginIP:
-		m.ResetLoginIP()
+	case systemuserrole.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case systemuser.FieldLoginDate:
-		m.ResetLoginDate()
+	case systemuserrole.FieldRoleID:
+		m.ResetRoleID()
 		return nil
 	}
-	return fmt.Errorf("unknown SystemUser field %s", name)
+	return fmt.Errorf("unknown SystemUserRole field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SystemUserMutation) AddedEdges() []string {
+func (m *SystemUserRoleMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SystemUserMutation) AddedIDs(name string) []ent.Value {
+func (m *SystemUserRoleMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SystemUserMutation) RemovedEdges() []string {
+func (m *SystemUserRoleMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SystemUserMutation) RemovedIDs(name string) []ent.Value {
+func (m *SystemUserRoleMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SystemUserMutation) ClearedEdges() []string {
+func (m *SystemUserRoleMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SystemUserMutation) EdgeCleared(name string) bool {
+func (m *SystemUserRoleMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SystemUserMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown SystemUser unique edge %s", name)
+func (m *SystemUserRoleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SystemUserRole unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SystemUserMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown SystemUser edge %s", name)
+func (m *SystemUserRoleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SystemUserRole edge %s", name)
 }
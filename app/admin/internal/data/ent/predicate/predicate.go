@@ -6,5 +6,41 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// SystemCasbinRule is the predicate function for systemcasbinrule builders.
+type SystemCasbinRule func(*sql.Selector)
+
+// SystemDept is the predicate function for systemdept builders.
+type SystemDept func(*sql.Selector)
+
+// SystemLoginLog is the predicate function for systemloginlog builders.
+type SystemLoginLog func(*sql.Selector)
+
+// SystemMenu is the predicate function for systemmenu builders.
+type SystemMenu func(*sql.Selector)
+
+// SystemPost is the predicate function for systempost builders.
+type SystemPost func(*sql.Selector)
+
+// SystemRole is the predicate function for systemrole builders.
+type SystemRole func(*sql.Selector)
+
+// SystemRoleMenu is the predicate function for systemrolemenu builders.
+type SystemRoleMenu func(*sql.Selector)
+
+// SystemTenant is the predicate function for systemtenant builders.
+type SystemTenant func(*sql.Selector)
+
+// SystemTenantPackage is the predicate function for systemtenantpackage builders.
+type SystemTenantPackage func(*sql.Selector)
+
 // SystemUser is the predicate function for systemuser builders.
 type SystemUser func(*sql.Selector)
+
+// SystemUserMFA is the predicate function for systemusermfa builders.
+type SystemUserMFA func(*sql.Selector)
+
+// SystemUserPost is the predicate function for systemuserpost builders.
+type SystemUserPost func(*sql.Selector)
+
+// SystemUserRole is the predicate function for systemuserrole builders.
+type SystemUserRole func(*sql.Selector)
@@ -111,6 +111,222 @@ func DenyMutationOperationRule(op ent.Op) MutationRule {
 	return OnMutationOperation(rule, op)
 }
 
+// The SystemCasbinRuleQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SystemCasbinRuleQueryRuleFunc func(context.Context, *ent.SystemCasbinRuleQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SystemCasbinRuleQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SystemCasbinRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SystemCasbinRuleQuery", q)
+}
+
+// The SystemCasbinRuleMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SystemCasbinRuleMutationRuleFunc func(context.Context, *ent.SystemCasbinRuleMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SystemCasbinRuleMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SystemCasbinRuleMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SystemCasbinRuleMutation", m)
+}
+
+// The SystemDeptQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SystemDeptQueryRuleFunc func(context.Context, *ent.SystemDeptQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SystemDeptQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SystemDeptQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SystemDeptQuery", q)
+}
+
+// The SystemDeptMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SystemDeptMutationRuleFunc func(context.Context, *ent.SystemDeptMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SystemDeptMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SystemDeptMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SystemDeptMutation", m)
+}
+
+// The SystemLoginLogQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SystemLoginLogQueryRuleFunc func(context.Context, *ent.SystemLoginLogQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SystemLoginLogQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SystemLoginLogQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SystemLoginLogQuery", q)
+}
+
+// The SystemLoginLogMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SystemLoginLogMutationRuleFunc func(context.Context, *ent.SystemLoginLogMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SystemLoginLogMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SystemLoginLogMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SystemLoginLogMutation", m)
+}
+
+// The SystemMenuQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SystemMenuQueryRuleFunc func(context.Context, *ent.SystemMenuQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SystemMenuQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SystemMenuQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SystemMenuQuery", q)
+}
+
+// The SystemMenuMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SystemMenuMutationRuleFunc func(context.Context, *ent.SystemMenuMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SystemMenuMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SystemMenuMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SystemMenuMutation", m)
+}
+
+// The SystemPostQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SystemPostQueryRuleFunc func(context.Context, *ent.SystemPostQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SystemPostQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SystemPostQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SystemPostQuery", q)
+}
+
+// The SystemPostMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SystemPostMutationRuleFunc func(context.Context, *ent.SystemPostMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SystemPostMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SystemPostMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SystemPostMutation", m)
+}
+
+// The SystemRoleQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SystemRoleQueryRuleFunc func(context.Context, *ent.SystemRoleQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SystemRoleQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SystemRoleQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SystemRoleQuery", q)
+}
+
+// The SystemRoleMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SystemRoleMutationRuleFunc func(context.Context, *ent.SystemRoleMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SystemRoleMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SystemRoleMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SystemRoleMutation", m)
+}
+
+// The SystemRoleMenuQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SystemRoleMenuQueryRuleFunc func(context.Context, *ent.SystemRoleMenuQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SystemRoleMenuQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SystemRoleMenuQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SystemRoleMenuQuery", q)
+}
+
+// The SystemRoleMenuMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SystemRoleMenuMutationRuleFunc func(context.Context, *ent.SystemRoleMenuMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SystemRoleMenuMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SystemRoleMenuMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SystemRoleMenuMutation", m)
+}
+
+// The SystemTenantQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SystemTenantQueryRuleFunc func(context.Context, *ent.SystemTenantQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SystemTenantQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SystemTenantQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SystemTenantQuery", q)
+}
+
+// The SystemTenantMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SystemTenantMutationRuleFunc func(context.Context, *ent.SystemTenantMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SystemTenantMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SystemTenantMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SystemTenantMutation", m)
+}
+
+// The SystemTenantPackageQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SystemTenantPackageQueryRuleFunc func(context.Context, *ent.SystemTenantPackageQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SystemTenantPackageQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SystemTenantPackageQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SystemTenantPackageQuery", q)
+}
+
+// The SystemTenantPackageMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SystemTenantPackageMutationRuleFunc func(context.Context, *ent.SystemTenantPackageMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SystemTenantPackageMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SystemTenantPackageMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SystemTenantPackageMutation", m)
+}
+
 // The SystemUserQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type SystemUserQueryRuleFunc func(context.Context, *ent.SystemUserQuery) error
@@ -135,6 +351,78 @@ func (f SystemUserMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Muta
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SystemUserMutation", m)
 }
 
+// The SystemUserMFAQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SystemUserMFAQueryRuleFunc func(context.Context, *ent.SystemUserMFAQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SystemUserMFAQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SystemUserMFAQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SystemUserMFAQuery", q)
+}
+
+// The SystemUserMFAMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SystemUserMFAMutationRuleFunc func(context.Context, *ent.SystemUserMFAMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SystemUserMFAMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SystemUserMFAMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SystemUserMFAMutation", m)
+}
+
+// The SystemUserPostQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SystemUserPostQueryRuleFunc func(context.Context, *ent.SystemUserPostQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SystemUserPostQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SystemUserPostQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SystemUserPostQuery", q)
+}
+
+// The SystemUserPostMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SystemUserPostMutationRuleFunc func(context.Context, *ent.SystemUserPostMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SystemUserPostMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SystemUserPostMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SystemUserPostMutation", m)
+}
+
+// The SystemUserRoleQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SystemUserRoleQueryRuleFunc func(context.Context, *ent.SystemUserRoleQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SystemUserRoleQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SystemUserRoleQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SystemUserRoleQuery", q)
+}
+
+// The SystemUserRoleMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SystemUserRoleMutationRuleFunc func(context.Context, *ent.SystemUserRoleMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SystemUserRoleMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SystemUserRoleMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SystemUserRoleMutation", m)
+}
+
 type (
 	// Filter is the interface that wraps the Where function
 	// for filtering nodes in queries and mutations.
@@ -170,8 +458,32 @@ var _ QueryMutationRule = FilterFunc(nil)
 
 func queryFilter(q ent.Query) (Filter, error) {
 	switch q := q.(type) {
+	case *ent.SystemCasbinRuleQuery:
+		return q.Filter(), nil
+	case *ent.SystemDeptQuery:
+		return q.Filter(), nil
+	case *ent.SystemLoginLogQuery:
+		return q.Filter(), nil
+	case *ent.SystemMenuQuery:
+		return q.Filter(), nil
+	case *ent.SystemPostQuery:
+		return q.Filter(), nil
+	case *ent.SystemRoleQuery:
+		return q.Filter(), nil
+	case *ent.SystemRoleMenuQuery:
+		return q.Filter(), nil
+	case *ent.SystemTenantQuery:
+		return q.Filter(), nil
+	case *ent.SystemTenantPackageQuery:
+		return q.Filter(), nil
 	case *ent.SystemUserQuery:
 		return q.Filter(), nil
+	case *ent.SystemUserMFAQuery:
+		return q.Filter(), nil
+	case *ent.SystemUserPostQuery:
+		return q.Filter(), nil
+	case *ent.SystemUserRoleQuery:
+		return q.Filter(), nil
 	default:
 		return nil, Denyf("ent/privacy: unexpected query type %T for query filter", q)
 	}
@@ -179,8 +491,32 @@ func queryFilter(q ent.Query) (Filter, error) {
 
 func mutationFilter(m ent.Mutation) (Filter, error) {
 	switch m := m.(type) {
+	case *ent.SystemCasbinRuleMutation:
+		return m.Filter(), nil
+	case *ent.SystemDeptMutation:
+		return m.Filter(), nil
+	case *ent.SystemLoginLogMutation:
+		return m.Filter(), nil
+	case *ent.SystemMenuMutation:
+		return m.Filter(), nil
+	case *ent.SystemPostMutation:
+		return m.Filter(), nil
+	case *ent.SystemRoleMutation:
+		return m.Filter(), nil
+	case *ent.SystemRoleMenuMutation:
+		return m.Filter(), nil
+	case *ent.SystemTenantMutation:
+		return m.Filter(), nil
+	case *ent.SystemTenantPackageMutation:
+		return m.Filter(), nil
 	case *ent.SystemUserMutation:
 		return m.Filter(), nil
+	case *ent.SystemUserMFAMutation:
+		return m.Filter(), nil
+	case *ent.SystemUserPostMutation:
+		return m.Filter(), nil
+	case *ent.SystemUserRoleMutation:
+		return m.Filter(), nil
 	default:
 		return nil, Denyf("ent/privacy: unexpected mutation type %T for mutation filter", m)
 	}
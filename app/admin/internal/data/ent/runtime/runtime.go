@@ -4,18 +4,547 @@ package runtime
 
 import (
 	"qn-base/app/admin/internal/data/ent/schema"
+	"qn-base/app/admin/internal/data/ent/systemcasbinrule"
+	"qn-base/app/admin/internal/data/ent/systemdept"
+	"qn-base/app/admin/internal/data/ent/systemloginlog"
+	"qn-base/app/admin/internal/data/ent/systemmenu"
+	"qn-base/app/admin/internal/data/ent/systempost"
+	"qn-base/app/admin/internal/data/ent/systemrole"
+	"qn-base/app/admin/internal/data/ent/systemrolemenu"
+	"qn-base/app/admin/internal/data/ent/systemtenant"
+	"qn-base/app/admin/internal/data/ent/systemtenantpackage"
 	"qn-base/app/admin/internal/data/ent/systemuser"
+	"qn-base/app/admin/internal/data/ent/systemusermfa"
+	"qn-base/app/admin/internal/data/ent/systemuserpost"
+	"qn-base/app/admin/internal/data/ent/systemuserrole"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	systemcasbinruleMixin := schema.SystemCasbinRule{}.Mixin()
+	systemcasbinruleMixinHooks0 := systemcasbinruleMixin[0].Hooks()
+	systemcasbinruleMixinHooks1 := systemcasbinruleMixin[1].Hooks()
+	systemcasbinruleMixinHooks2 := systemcasbinruleMixin[2].Hooks()
+	systemcasbinrule.Hooks[0] = systemcasbinruleMixinHooks0[0]
+	systemcasbinrule.Hooks[1] = systemcasbinruleMixinHooks1[0]
+	systemcasbinrule.Hooks[2] = systemcasbinruleMixinHooks2[0]
+	systemcasbinruleMixinFields0 := systemcasbinruleMixin[0].Fields()
+	_ = systemcasbinruleMixinFields0
+	systemcasbinruleFields := schema.SystemCasbinRule{}.Fields()
+	_ = systemcasbinruleFields
+	// systemcasbinruleDescPtype is the schema descriptor for ptype field.
+	systemcasbinruleDescPtype := systemcasbinruleFields[0].Descriptor()
+	// systemcasbinrule.PtypeValidator is a validator for the "ptype" field. It is called by the builders before save.
+	systemcasbinrule.PtypeValidator = systemcasbinruleDescPtype.Validators[0].(func(string) error)
+	// systemcasbinruleDescV0 is the schema descriptor for v0 field.
+	systemcasbinruleDescV0 := systemcasbinruleFields[1].Descriptor()
+	// systemcasbinrule.DefaultV0 holds the default value on creation for the v0 field.
+	systemcasbinrule.DefaultV0 = systemcasbinruleDescV0.Default.(string)
+	// systemcasbinrule.V0Validator is a validator for the "v0" field. It is called by the builders before save.
+	systemcasbinrule.V0Validator = systemcasbinruleDescV0.Validators[0].(func(string) error)
+	// systemcasbinruleDescV1 is the schema descriptor for v1 field.
+	systemcasbinruleDescV1 := systemcasbinruleFields[2].Descriptor()
+	// systemcasbinrule.DefaultV1 holds the default value on creation for the v1 field.
+	systemcasbinrule.DefaultV1 = systemcasbinruleDescV1.Default.(string)
+	// systemcasbinrule.V1Validator is a validator for the "v1" field. It is called by the builders before save.
+	systemcasbinrule.V1Validator = systemcasbinruleDescV1.Validators[0].(func(string) error)
+	// systemcasbinruleDescV2 is the schema descriptor for v2 field.
+	systemcasbinruleDescV2 := systemcasbinruleFields[3].Descriptor()
+	// systemcasbinrule.DefaultV2 holds the default value on creation for the v2 field.
+	systemcasbinrule.DefaultV2 = systemcasbinruleDescV2.Default.(string)
+	// systemcasbinrule.V2Validator is a validator for the "v2" field. It is called by the builders before save.
+	systemcasbinrule.V2Validator = systemcasbinruleDescV2.Validators[0].(func(string) error)
+	// systemcasbinruleDescV3 is the schema descriptor for v3 field.
+	systemcasbinruleDescV3 := systemcasbinruleFields[4].Descriptor()
+	// systemcasbinrule.DefaultV3 holds the default value on creation for the v3 field.
+	systemcasbinrule.DefaultV3 = systemcasbinruleDescV3.Default.(string)
+	// systemcasbinrule.V3Validator is a validator for the "v3" field. It is called by the builders before save.
+	systemcasbinrule.V3Validator = systemcasbinruleDescV3.Validators[0].(func(string) error)
+	// systemcasbinruleDescV4 is the schema descriptor for v4 field.
+	systemcasbinruleDescV4 := systemcasbinruleFields[5].Descriptor()
+	// systemcasbinrule.DefaultV4 holds the default value on creation for the v4 field.
+	systemcasbinrule.DefaultV4 = systemcasbinruleDescV4.Default.(string)
+	// systemcasbinrule.V4Validator is a validator for the "v4" field. It is called by the builders before save.
+	systemcasbinrule.V4Validator = systemcasbinruleDescV4.Validators[0].(func(string) error)
+	// systemcasbinruleDescV5 is the schema descriptor for v5 field.
+	systemcasbinruleDescV5 := systemcasbinruleFields[6].Descriptor()
+	// systemcasbinrule.DefaultV5 holds the default value on creation for the v5 field.
+	systemcasbinrule.DefaultV5 = systemcasbinruleDescV5.Default.(string)
+	// systemcasbinrule.V5Validator is a validator for the "v5" field. It is called by the builders before save.
+	systemcasbinrule.V5Validator = systemcasbinruleDescV5.Validators[0].(func(string) error)
+	// systemcasbinruleDescID is the schema descriptor for id field.
+	systemcasbinruleDescID := systemcasbinruleMixinFields0[0].Descriptor()
+	// systemcasbinrule.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	systemcasbinrule.IDValidator = systemcasbinruleDescID.Validators[0].(func(string) error)
+	systemdeptMixin := schema.SystemDept{}.Mixin()
+	systemdeptMixinHooks0 := systemdeptMixin[0].Hooks()
+	systemdeptMixinHooks1 := systemdeptMixin[1].Hooks()
+	systemdeptMixinHooks2 := systemdeptMixin[2].Hooks()
+	systemdeptMixinHooks3 := systemdeptMixin[3].Hooks()
+	systemdeptMixinHooks4 := systemdeptMixin[4].Hooks()
+	systemdeptMixinHooks5 := systemdeptMixin[5].Hooks()
+	systemdeptMixinHooks6 := systemdeptMixin[6].Hooks()
+	systemdept.Hooks[0] = systemdeptMixinHooks0[0]
+	systemdept.Hooks[1] = systemdeptMixinHooks1[0]
+	systemdept.Hooks[2] = systemdeptMixinHooks2[0]
+	systemdept.Hooks[3] = systemdeptMixinHooks3[0]
+	systemdept.Hooks[4] = systemdeptMixinHooks4[0]
+	systemdept.Hooks[5] = systemdeptMixinHooks5[0]
+	systemdept.Hooks[6] = systemdeptMixinHooks6[0]
+	systemdeptMixinInters5 := systemdeptMixin[5].Interceptors()
+	systemdeptMixinInters6 := systemdeptMixin[6].Interceptors()
+	systemdept.Interceptors[0] = systemdeptMixinInters5[0]
+	systemdept.Interceptors[1] = systemdeptMixinInters6[0]
+	systemdeptMixinFields0 := systemdeptMixin[0].Fields()
+	_ = systemdeptMixinFields0
+	systemdeptMixinFields5 := systemdeptMixin[5].Fields()
+	_ = systemdeptMixinFields5
+	systemdeptFields := schema.SystemDept{}.Fields()
+	_ = systemdeptFields
+	// systemdeptDescTenantID is the schema descriptor for tenant_id field.
+	systemdeptDescTenantID := systemdeptMixinFields5[0].Descriptor()
+	// systemdept.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	systemdept.TenantIDValidator = systemdeptDescTenantID.Validators[0].(func(string) error)
+	// systemdeptDescName is the schema descriptor for name field.
+	systemdeptDescName := systemdeptFields[0].Descriptor()
+	// systemdept.DefaultName holds the default value on creation for the name field.
+	systemdept.DefaultName = systemdeptDescName.Default.(string)
+	// systemdept.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	systemdept.NameValidator = systemdeptDescName.Validators[0].(func(string) error)
+	// systemdeptDescParentID is the schema descriptor for parent_id field.
+	systemdeptDescParentID := systemdeptFields[1].Descriptor()
+	// systemdept.DefaultParentID holds the default value on creation for the parent_id field.
+	systemdept.DefaultParentID = systemdeptDescParentID.Default.(string)
+	// systemdept.ParentIDValidator is a validator for the "parent_id" field. It is called by the builders before save.
+	systemdept.ParentIDValidator = systemdeptDescParentID.Validators[0].(func(string) error)
+	// systemdeptDescAncestors is the schema descriptor for ancestors field.
+	systemdeptDescAncestors := systemdeptFields[2].Descriptor()
+	// systemdept.DefaultAncestors holds the default value on creation for the ancestors field.
+	systemdept.DefaultAncestors = systemdeptDescAncestors.Default.(string)
+	// systemdept.AncestorsValidator is a validator for the "ancestors" field. It is called by the builders before save.
+	systemdept.AncestorsValidator = systemdeptDescAncestors.Validators[0].(func(string) error)
+	// systemdeptDescSort is the schema descriptor for sort field.
+	systemdeptDescSort := systemdeptFields[3].Descriptor()
+	// systemdept.DefaultSort holds the default value on creation for the sort field.
+	systemdept.DefaultSort = systemdeptDescSort.Default.(int32)
+	// systemdeptDescLeaderUserID is the schema descriptor for leader_user_id field.
+	systemdeptDescLeaderUserID := systemdeptFields[4].Descriptor()
+	// systemdept.LeaderUserIDValidator is a validator for the "leader_user_id" field. It is called by the builders before save.
+	systemdept.LeaderUserIDValidator = systemdeptDescLeaderUserID.Validators[0].(func(string) error)
+	// systemdeptDescPhone is the schema descriptor for phone field.
+	systemdeptDescPhone := systemdeptFields[5].Descriptor()
+	// systemdept.PhoneValidator is a validator for the "phone" field. It is called by the builders before save.
+	systemdept.PhoneValidator = systemdeptDescPhone.Validators[0].(func(string) error)
+	// systemdeptDescEmail is the schema descriptor for email field.
+	systemdeptDescEmail := systemdeptFields[6].Descriptor()
+	// systemdept.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	systemdept.EmailValidator = systemdeptDescEmail.Validators[0].(func(string) error)
+	// systemdeptDescStatus is the schema descriptor for status field.
+	systemdeptDescStatus := systemdeptFields[7].Descriptor()
+	// systemdept.DefaultStatus holds the default value on creation for the status field.
+	systemdept.DefaultStatus = systemdeptDescStatus.Default.(int8)
+	// systemdeptDescID is the schema descriptor for id field.
+	systemdeptDescID := systemdeptMixinFields0[0].Descriptor()
+	// systemdept.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	systemdept.IDValidator = systemdeptDescID.Validators[0].(func(string) error)
+	systemloginlogMixin := schema.SystemLoginLog{}.Mixin()
+	systemloginlogMixinHooks0 := systemloginlogMixin[0].Hooks()
+	systemloginlogMixinHooks1 := systemloginlogMixin[1].Hooks()
+	systemloginlogMixinHooks2 := systemloginlogMixin[2].Hooks()
+	systemloginlog.Hooks[0] = systemloginlogMixinHooks0[0]
+	systemloginlog.Hooks[1] = systemloginlogMixinHooks1[0]
+	systemloginlog.Hooks[2] = systemloginlogMixinHooks2[0]
+	systemloginlogMixinInters2 := systemloginlogMixin[2].Interceptors()
+	systemloginlog.Interceptors[0] = systemloginlogMixinInters2[0]
+	systemloginlogMixinFields0 := systemloginlogMixin[0].Fields()
+	_ = systemloginlogMixinFields0
+	systemloginlogMixinFields2 := systemloginlogMixin[2].Fields()
+	_ = systemloginlogMixinFields2
+	systemloginlogFields := schema.SystemLoginLog{}.Fields()
+	_ = systemloginlogFields
+	// systemloginlogDescTenantID is the schema descriptor for tenant_id field.
+	systemloginlogDescTenantID := systemloginlogMixinFields2[0].Descriptor()
+	// systemloginlog.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	systemloginlog.TenantIDValidator = systemloginlogDescTenantID.Validators[0].(func(string) error)
+	// systemloginlogDescEvent is the schema descriptor for event field.
+	systemloginlogDescEvent := systemloginlogFields[0].Descriptor()
+	// systemloginlog.DefaultEvent holds the default value on creation for the event field.
+	systemloginlog.DefaultEvent = systemloginlogDescEvent.Default.(string)
+	// systemloginlog.EventValidator is a validator for the "event" field. It is called by the builders before save.
+	systemloginlog.EventValidator = systemloginlogDescEvent.Validators[0].(func(string) error)
+	// systemloginlogDescAccount is the schema descriptor for account field.
+	systemloginlogDescAccount := systemloginlogFields[2].Descriptor()
+	// systemloginlog.AccountValidator is a validator for the "account" field. It is called by the builders before save.
+	systemloginlog.AccountValidator = systemloginlogDescAccount.Validators[0].(func(string) error)
+	// systemloginlogDescReason is the schema descriptor for reason field.
+	systemloginlogDescReason := systemloginlogFields[4].Descriptor()
+	// systemloginlog.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	systemloginlog.ReasonValidator = systemloginlogDescReason.Validators[0].(func(string) error)
+	// systemloginlogDescIP is the schema descriptor for ip field.
+	systemloginlogDescIP := systemloginlogFields[5].Descriptor()
+	// systemloginlog.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	systemloginlog.IPValidator = systemloginlogDescIP.Validators[0].(func(string) error)
+	// systemloginlogDescUserAgent is the schema descriptor for user_agent field.
+	systemloginlogDescUserAgent := systemloginlogFields[6].Descriptor()
+	// systemloginlog.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	systemloginlog.UserAgentValidator = systemloginlogDescUserAgent.Validators[0].(func(string) error)
+	// systemloginlogDescID is the schema descriptor for id field.
+	systemloginlogDescID := systemloginlogMixinFields0[0].Descriptor()
+	// systemloginlog.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	systemloginlog.IDValidator = systemloginlogDescID.Validators[0].(func(string) error)
+	systemmenuMixin := schema.SystemMenu{}.Mixin()
+	systemmenuMixinHooks0 := systemmenuMixin[0].Hooks()
+	systemmenuMixinHooks1 := systemmenuMixin[1].Hooks()
+	systemmenuMixinHooks2 := systemmenuMixin[2].Hooks()
+	systemmenuMixinHooks3 := systemmenuMixin[3].Hooks()
+	systemmenuMixinHooks4 := systemmenuMixin[4].Hooks()
+	systemmenuMixinHooks5 := systemmenuMixin[5].Hooks()
+	systemmenu.Hooks[0] = systemmenuMixinHooks0[0]
+	systemmenu.Hooks[1] = systemmenuMixinHooks1[0]
+	systemmenu.Hooks[2] = systemmenuMixinHooks2[0]
+	systemmenu.Hooks[3] = systemmenuMixinHooks3[0]
+	systemmenu.Hooks[4] = systemmenuMixinHooks4[0]
+	systemmenu.Hooks[5] = systemmenuMixinHooks5[0]
+	systemmenuMixinInters5 := systemmenuMixin[5].Interceptors()
+	systemmenu.Interceptors[0] = systemmenuMixinInters5[0]
+	systemmenuMixinFields0 := systemmenuMixin[0].Fields()
+	_ = systemmenuMixinFields0
+	systemmenuFields := schema.SystemMenu{}.Fields()
+	_ = systemmenuFields
+	// systemmenuDescName is the schema descriptor for name field.
+	systemmenuDescName := systemmenuFields[0].Descriptor()
+	// systemmenu.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	systemmenu.NameValidator = systemmenuDescName.Validators[0].(func(string) error)
+	// systemmenuDescPermission is the schema descriptor for permission field.
+	systemmenuDescPermission := systemmenuFields[1].Descriptor()
+	// systemmenu.DefaultPermission holds the default value on creation for the permission field.
+	systemmenu.DefaultPermission = systemmenuDescPermission.Default.(string)
+	// systemmenu.PermissionValidator is a validator for the "permission" field. It is called by the builders before save.
+	systemmenu.PermissionValidator = systemmenuDescPermission.Validators[0].(func(string) error)
+	// systemmenuDescSort is the schema descriptor for sort field.
+	systemmenuDescSort := systemmenuFields[3].Descriptor()
+	// systemmenu.DefaultSort holds the default value on creation for the sort field.
+	systemmenu.DefaultSort = systemmenuDescSort.Default.(int32)
+	// systemmenuDescParentID is the schema descriptor for parent_id field.
+	systemmenuDescParentID := systemmenuFields[4].Descriptor()
+	// systemmenu.DefaultParentID holds the default value on creation for the parent_id field.
+	systemmenu.DefaultParentID = systemmenuDescParentID.Default.(string)
+	// systemmenu.ParentIDValidator is a validator for the "parent_id" field. It is called by the builders before save.
+	systemmenu.ParentIDValidator = systemmenuDescParentID.Validators[0].(func(string) error)
+	// systemmenuDescPath is the schema descriptor for path field.
+	systemmenuDescPath := systemmenuFields[5].Descriptor()
+	// systemmenu.PathValidator is a validator for the "path" field. It is called by the builders before save.
+	systemmenu.PathValidator = systemmenuDescPath.Validators[0].(func(string) error)
+	// systemmenuDescIcon is the schema descriptor for icon field.
+	systemmenuDescIcon := systemmenuFields[6].Descriptor()
+	// systemmenu.IconValidator is a validator for the "icon" field. It is called by the builders before save.
+	systemmenu.IconValidator = systemmenuDescIcon.Validators[0].(func(string) error)
+	// systemmenuDescComponent is the schema descriptor for component field.
+	systemmenuDescComponent := systemmenuFields[7].Descriptor()
+	// systemmenu.ComponentValidator is a validator for the "component" field. It is called by the builders before save.
+	systemmenu.ComponentValidator = systemmenuDescComponent.Validators[0].(func(string) error)
+	// systemmenuDescComponentName is the schema descriptor for component_name field.
+	systemmenuDescComponentName := systemmenuFields[8].Descriptor()
+	// systemmenu.ComponentNameValidator is a validator for the "component_name" field. It is called by the builders before save.
+	systemmenu.ComponentNameValidator = systemmenuDescComponentName.Validators[0].(func(string) error)
+	// systemmenuDescStatus is the schema descriptor for status field.
+	systemmenuDescStatus := systemmenuFields[9].Descriptor()
+	// systemmenu.DefaultStatus holds the default value on creation for the status field.
+	systemmenu.DefaultStatus = systemmenuDescStatus.Default.(int8)
+	// systemmenuDescVisible is the schema descriptor for visible field.
+	systemmenuDescVisible := systemmenuFields[10].Descriptor()
+	// systemmenu.DefaultVisible holds the default value on creation for the visible field.
+	systemmenu.DefaultVisible = systemmenuDescVisible.Default.(bool)
+	// systemmenuDescKeepAlive is the schema descriptor for keep_alive field.
+	systemmenuDescKeepAlive := systemmenuFields[11].Descriptor()
+	// systemmenu.DefaultKeepAlive holds the default value on creation for the keep_alive field.
+	systemmenu.DefaultKeepAlive = systemmenuDescKeepAlive.Default.(bool)
+	// systemmenuDescAlwaysShow is the schema descriptor for always_show field.
+	systemmenuDescAlwaysShow := systemmenuFields[12].Descriptor()
+	// systemmenu.DefaultAlwaysShow holds the default value on creation for the always_show field.
+	systemmenu.DefaultAlwaysShow = systemmenuDescAlwaysShow.Default.(bool)
+	// systemmenuDescID is the schema descriptor for id field.
+	systemmenuDescID := systemmenuMixinFields0[0].Descriptor()
+	// systemmenu.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	systemmenu.IDValidator = systemmenuDescID.Validators[0].(func(string) error)
+	systempostMixin := schema.SystemPost{}.Mixin()
+	systempostMixinHooks0 := systempostMixin[0].Hooks()
+	systempostMixinHooks1 := systempostMixin[1].Hooks()
+	systempostMixinHooks2 := systempostMixin[2].Hooks()
+	systempostMixinHooks3 := systempostMixin[3].Hooks()
+	systempostMixinHooks4 := systempostMixin[4].Hooks()
+	systempostMixinHooks5 := systempostMixin[5].Hooks()
+	systempostMixinHooks6 := systempostMixin[6].Hooks()
+	systempost.Hooks[0] = systempostMixinHooks0[0]
+	systempost.Hooks[1] = systempostMixinHooks1[0]
+	systempost.Hooks[2] = systempostMixinHooks2[0]
+	systempost.Hooks[3] = systempostMixinHooks3[0]
+	systempost.Hooks[4] = systempostMixinHooks4[0]
+	systempost.Hooks[5] = systempostMixinHooks5[0]
+	systempost.Hooks[6] = systempostMixinHooks6[0]
+	systempostMixinInters5 := systempostMixin[5].Interceptors()
+	systempostMixinInters6 := systempostMixin[6].Interceptors()
+	systempost.Interceptors[0] = systempostMixinInters5[0]
+	systempost.Interceptors[1] = systempostMixinInters6[0]
+	systempostMixinFields0 := systempostMixin[0].Fields()
+	_ = systempostMixinFields0
+	systempostMixinFields5 := systempostMixin[5].Fields()
+	_ = systempostMixinFields5
+	systempostMixinFields7 := systempostMixin[7].Fields()
+	_ = systempostMixinFields7
+	systempostFields := schema.SystemPost{}.Fields()
+	_ = systempostFields
+	// systempostDescTenantID is the schema descriptor for tenant_id field.
+	systempostDescTenantID := systempostMixinFields5[0].Descriptor()
+	// systempost.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	systempost.TenantIDValidator = systempostDescTenantID.Validators[0].(func(string) error)
+	// systempostDescRemark is the schema descriptor for remark field.
+	systempostDescRemark := systempostMixinFields7[0].Descriptor()
+	// systempost.DefaultRemark holds the default value on creation for the remark field.
+	systempost.DefaultRemark = systempostDescRemark.Default.(string)
+	// systempostDescCode is the schema descriptor for code field.
+	systempostDescCode := systempostFields[0].Descriptor()
+	// systempost.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	systempost.CodeValidator = systempostDescCode.Validators[0].(func(string) error)
+	// systempostDescName is the schema descriptor for name field.
+	systempostDescName := systempostFields[1].Descriptor()
+	// systempost.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	systempost.NameValidator = systempostDescName.Validators[0].(func(string) error)
+	// systempostDescSort is the schema descriptor for sort field.
+	systempostDescSort := systempostFields[2].Descriptor()
+	// systempost.DefaultSort holds the default value on creation for the sort field.
+	systempost.DefaultSort = systempostDescSort.Default.(int32)
+	// systempostDescStatus is the schema descriptor for status field.
+	systempostDescStatus := systempostFields[3].Descriptor()
+	// systempost.DefaultStatus holds the default value on creation for the status field.
+	systempost.DefaultStatus = systempostDescStatus.Default.(int8)
+	// systempostDescID is the schema descriptor for id field.
+	systempostDescID := systempostMixinFields0[0].Descriptor()
+	// systempost.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	systempost.IDValidator = systempostDescID.Validators[0].(func(string) error)
+	systemroleMixin := schema.SystemRole{}.Mixin()
+	systemroleMixinHooks0 := systemroleMixin[0].Hooks()
+	systemroleMixinHooks1 := systemroleMixin[1].Hooks()
+	systemroleMixinHooks2 := systemroleMixin[2].Hooks()
+	systemroleMixinHooks3 := systemroleMixin[3].Hooks()
+	systemroleMixinHooks4 := systemroleMixin[4].Hooks()
+	systemroleMixinHooks5 := systemroleMixin[5].Hooks()
+	systemroleMixinHooks6 := systemroleMixin[6].Hooks()
+	systemrole.Hooks[0] = systemroleMixinHooks0[0]
+	systemrole.Hooks[1] = systemroleMixinHooks1[0]
+	systemrole.Hooks[2] = systemroleMixinHooks2[0]
+	systemrole.Hooks[3] = systemroleMixinHooks3[0]
+	systemrole.Hooks[4] = systemroleMixinHooks4[0]
+	systemrole.Hooks[5] = systemroleMixinHooks5[0]
+	systemrole.Hooks[6] = systemroleMixinHooks6[0]
+	systemroleMixinInters5 := systemroleMixin[5].Interceptors()
+	systemroleMixinInters6 := systemroleMixin[6].Interceptors()
+	systemrole.Interceptors[0] = systemroleMixinInters5[0]
+	systemrole.Interceptors[1] = systemroleMixinInters6[0]
+	systemroleMixinFields0 := systemroleMixin[0].Fields()
+	_ = systemroleMixinFields0
+	systemroleMixinFields5 := systemroleMixin[5].Fields()
+	_ = systemroleMixinFields5
+	systemroleMixinFields7 := systemroleMixin[7].Fields()
+	_ = systemroleMixinFields7
+	systemroleFields := schema.SystemRole{}.Fields()
+	_ = systemroleFields
+	// systemroleDescTenantID is the schema descriptor for tenant_id field.
+	systemroleDescTenantID := systemroleMixinFields5[0].Descriptor()
+	// systemrole.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	systemrole.TenantIDValidator = systemroleDescTenantID.Validators[0].(func(string) error)
+	// systemroleDescRemark is the schema descriptor for remark field.
+	systemroleDescRemark := systemroleMixinFields7[0].Descriptor()
+	// systemrole.DefaultRemark holds the default value on creation for the remark field.
+	systemrole.DefaultRemark = systemroleDescRemark.Default.(string)
+	// systemroleDescName is the schema descriptor for name field.
+	systemroleDescName := systemroleFields[0].Descriptor()
+	// systemrole.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	systemrole.NameValidator = systemroleDescName.Validators[0].(func(string) error)
+	// systemroleDescCode is the schema descriptor for code field.
+	systemroleDescCode := systemroleFields[1].Descriptor()
+	// systemrole.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	systemrole.CodeValidator = systemroleDescCode.Validators[0].(func(string) error)
+	// systemroleDescSort is the schema descriptor for sort field.
+	systemroleDescSort := systemroleFields[2].Descriptor()
+	// systemrole.DefaultSort holds the default value on creation for the sort field.
+	systemrole.DefaultSort = systemroleDescSort.Default.(int32)
+	// systemroleDescDataScope is the schema descriptor for data_scope field.
+	systemroleDescDataScope := systemroleFields[3].Descriptor()
+	// systemrole.DefaultDataScope holds the default value on creation for the data_scope field.
+	systemrole.DefaultDataScope = systemroleDescDataScope.Default.(int8)
+	// systemroleDescStatus is the schema descriptor for status field.
+	systemroleDescStatus := systemroleFields[5].Descriptor()
+	// systemrole.DefaultStatus holds the default value on creation for the status field.
+	systemrole.DefaultStatus = systemroleDescStatus.Default.(int8)
+	// systemroleDescType is the schema descriptor for type field.
+	systemroleDescType := systemroleFields[6].Descriptor()
+	// systemrole.DefaultType holds the default value on creation for the type field.
+	systemrole.DefaultType = systemroleDescType.Default.(int8)
+	// systemroleDescID is the schema descriptor for id field.
+	systemroleDescID := systemroleMixinFields0[0].Descriptor()
+	// systemrole.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	systemrole.IDValidator = systemroleDescID.Validators[0].(func(string) error)
+	systemrolemenuMixin := schema.SystemRoleMenu{}.Mixin()
+	systemrolemenuMixinHooks0 := systemrolemenuMixin[0].Hooks()
+	systemrolemenuMixinHooks1 := systemrolemenuMixin[1].Hooks()
+	systemrolemenuMixinHooks2 := systemrolemenuMixin[2].Hooks()
+	systemrolemenuMixinHooks3 := systemrolemenuMixin[3].Hooks()
+	systemrolemenuMixinHooks4 := systemrolemenuMixin[4].Hooks()
+	systemrolemenuMixinHooks5 := systemrolemenuMixin[5].Hooks()
+	systemrolemenuMixinHooks6 := systemrolemenuMixin[6].Hooks()
+	systemrolemenu.Hooks[0] = systemrolemenuMixinHooks0[0]
+	systemrolemenu.Hooks[1] = systemrolemenuMixinHooks1[0]
+	systemrolemenu.Hooks[2] = systemrolemenuMixinHooks2[0]
+	systemrolemenu.Hooks[3] = systemrolemenuMixinHooks3[0]
+	systemrolemenu.Hooks[4] = systemrolemenuMixinHooks4[0]
+	systemrolemenu.Hooks[5] = systemrolemenuMixinHooks5[0]
+	systemrolemenu.Hooks[6] = systemrolemenuMixinHooks6[0]
+	systemrolemenuMixinInters5 := systemrolemenuMixin[5].Interceptors()
+	systemrolemenuMixinInters6 := systemrolemenuMixin[6].Interceptors()
+	systemrolemenu.Interceptors[0] = systemrolemenuMixinInters5[0]
+	systemrolemenu.Interceptors[1] = systemrolemenuMixinInters6[0]
+	systemrolemenuMixinFields0 := systemrolemenuMixin[0].Fields()
+	_ = systemrolemenuMixinFields0
+	systemrolemenuMixinFields5 := systemrolemenuMixin[5].Fields()
+	_ = systemrolemenuMixinFields5
+	systemrolemenuFields := schema.SystemRoleMenu{}.Fields()
+	_ = systemrolemenuFields
+	// systemrolemenuDescTenantID is the schema descriptor for tenant_id field.
+	systemrolemenuDescTenantID := systemrolemenuMixinFields5[0].Descriptor()
+	// systemrolemenu.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	systemrolemenu.TenantIDValidator = systemrolemenuDescTenantID.Validators[0].(func(string) error)
+	// systemrolemenuDescRoleID is the schema descriptor for role_id field.
+	systemrolemenuDescRoleID := systemrolemenuFields[0].Descriptor()
+	// systemrolemenu.RoleIDValidator is a validator for the "role_id" field. It is called by the builders before save.
+	systemrolemenu.RoleIDValidator = systemrolemenuDescRoleID.Validators[0].(func(string) error)
+	// systemrolemenuDescMenuID is the schema descriptor for menu_id field.
+	systemrolemenuDescMenuID := systemrolemenuFields[1].Descriptor()
+	// systemrolemenu.MenuIDValidator is a validator for the "menu_id" field. It is called by the builders before save.
+	systemrolemenu.MenuIDValidator = systemrolemenuDescMenuID.Validators[0].(func(string) error)
+	// systemrolemenuDescID is the schema descriptor for id field.
+	systemrolemenuDescID := systemrolemenuMixinFields0[0].Descriptor()
+	// systemrolemenu.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	systemrolemenu.IDValidator = systemrolemenuDescID.Validators[0].(func(string) error)
+	systemtenantMixin := schema.SystemTenant{}.Mixin()
+	systemtenantMixinHooks0 := systemtenantMixin[0].Hooks()
+	systemtenantMixinHooks1 := systemtenantMixin[1].Hooks()
+	systemtenantMixinHooks2 := systemtenantMixin[2].Hooks()
+	systemtenantMixinHooks3 := systemtenantMixin[3].Hooks()
+	systemtenantMixinHooks4 := systemtenantMixin[4].Hooks()
+	systemtenantMixinHooks5 := systemtenantMixin[5].Hooks()
+	systemtenant.Hooks[0] = systemtenantMixinHooks0[0]
+	systemtenant.Hooks[1] = systemtenantMixinHooks1[0]
+	systemtenant.Hooks[2] = systemtenantMixinHooks2[0]
+	systemtenant.Hooks[3] = systemtenantMixinHooks3[0]
+	systemtenant.Hooks[4] = systemtenantMixinHooks4[0]
+	systemtenant.Hooks[5] = systemtenantMixinHooks5[0]
+	systemtenantMixinInters5 := systemtenantMixin[5].Interceptors()
+	systemtenant.Interceptors[0] = systemtenantMixinInters5[0]
+	systemtenantMixinFields0 := systemtenantMixin[0].Fields()
+	_ = systemtenantMixinFields0
+	systemtenantFields := schema.SystemTenant{}.Fields()
+	_ = systemtenantFields
+	// systemtenantDescName is the schema descriptor for name field.
+	systemtenantDescName := systemtenantFields[0].Descriptor()
+	// systemtenant.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	systemtenant.NameValidator = systemtenantDescName.Validators[0].(func(string) error)
+	// systemtenantDescContactUserID is the schema descriptor for contact_user_id field.
+	systemtenantDescContactUserID := systemtenantFields[1].Descriptor()
+	// systemtenant.ContactUserIDValidator is a validator for the "contact_user_id" field. It is called by the builders before save.
+	systemtenant.ContactUserIDValidator = systemtenantDescContactUserID.Validators[0].(func(string) error)
+	// systemtenantDescContactName is the schema descriptor for contact_name field.
+	systemtenantDescContactName := systemtenantFields[2].Descriptor()
+	// systemtenant.ContactNameValidator is a validator for the "contact_name" field. It is called by the builders before save.
+	systemtenant.ContactNameValidator = systemtenantDescContactName.Validators[0].(func(string) error)
+	// systemtenantDescContactMobile is the schema descriptor for contact_mobile field.
+	systemtenantDescContactMobile := systemtenantFields[3].Descriptor()
+	// systemtenant.ContactMobileValidator is a validator for the "contact_mobile" field. It is called by the builders before save.
+	systemtenant.ContactMobileValidator = systemtenantDescContactMobile.Validators[0].(func(string) error)
+	// systemtenantDescStatus is the schema descriptor for status field.
+	systemtenantDescStatus := systemtenantFields[4].Descriptor()
+	// systemtenant.DefaultStatus holds the default value on creation for the status field.
+	systemtenant.DefaultStatus = systemtenantDescStatus.Default.(int8)
+	// systemtenantDescWebsite is the schema descriptor for website field.
+	systemtenantDescWebsite := systemtenantFields[5].Descriptor()
+	// systemtenant.DefaultWebsite holds the default value on creation for the website field.
+	systemtenant.DefaultWebsite = systemtenantDescWebsite.Default.(string)
+	// systemtenant.WebsiteValidator is a validator for the "website" field. It is called by the builders before save.
+	systemtenant.WebsiteValidator = systemtenantDescWebsite.Validators[0].(func(string) error)
+	// systemtenantDescPackageID is the schema descriptor for package_id field.
+	systemtenantDescPackageID := systemtenantFields[6].Descriptor()
+	// systemtenant.PackageIDValidator is a validator for the "package_id" field. It is called by the builders before save.
+	systemtenant.PackageIDValidator = systemtenantDescPackageID.Validators[0].(func(string) error)
+	// systemtenantDescMfaRequired is the schema descriptor for mfa_required field.
+	systemtenantDescMfaRequired := systemtenantFields[9].Descriptor()
+	// systemtenant.DefaultMfaRequired holds the default value on creation for the mfa_required field.
+	systemtenant.DefaultMfaRequired = systemtenantDescMfaRequired.Default.(bool)
+	// systemtenantDescID is the schema descriptor for id field.
+	systemtenantDescID := systemtenantMixinFields0[0].Descriptor()
+	// systemtenant.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	systemtenant.IDValidator = systemtenantDescID.Validators[0].(func(string) error)
+	systemtenantpackageMixin := schema.SystemTenantPackage{}.Mixin()
+	systemtenantpackageMixinHooks0 := systemtenantpackageMixin[0].Hooks()
+	systemtenantpackageMixinHooks1 := systemtenantpackageMixin[1].Hooks()
+	systemtenantpackageMixinHooks2 := systemtenantpackageMixin[2].Hooks()
+	systemtenantpackageMixinHooks3 := systemtenantpackageMixin[3].Hooks()
+	systemtenantpackageMixinHooks4 := systemtenantpackageMixin[4].Hooks()
+	systemtenantpackageMixinHooks5 := systemtenantpackageMixin[5].Hooks()
+	systemtenantpackage.Hooks[0] = systemtenantpackageMixinHooks0[0]
+	systemtenantpackage.Hooks[1] = systemtenantpackageMixinHooks1[0]
+	systemtenantpackage.Hooks[2] = systemtenantpackageMixinHooks2[0]
+	systemtenantpackage.Hooks[3] = systemtenantpackageMixinHooks3[0]
+	systemtenantpackage.Hooks[4] = systemtenantpackageMixinHooks4[0]
+	systemtenantpackage.Hooks[5] = systemtenantpackageMixinHooks5[0]
+	systemtenantpackageMixinInters5 := systemtenantpackageMixin[5].Interceptors()
+	systemtenantpackage.Interceptors[0] = systemtenantpackageMixinInters5[0]
+	systemtenantpackageMixinFields0 := systemtenantpackageMixin[0].Fields()
+	_ = systemtenantpackageMixinFields0
+	systemtenantpackageMixinFields6 := systemtenantpackageMixin[6].Fields()
+	_ = systemtenantpackageMixinFields6
+	systemtenantpackageFields := schema.SystemTenantPackage{}.Fields()
+	_ = systemtenantpackageFields
+	// systemtenantpackageDescRemark is the schema descriptor for remark field.
+	systemtenantpackageDescRemark := systemtenantpackageMixinFields6[0].Descriptor()
+	// systemtenantpackage.DefaultRemark holds the default value on creation for the remark field.
+	systemtenantpackage.DefaultRemark = systemtenantpackageDescRemark.Default.(string)
+	// systemtenantpackageDescName is the schema descriptor for name field.
+	systemtenantpackageDescName := systemtenantpackageFields[0].Descriptor()
+	// systemtenantpackage.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	systemtenantpackage.NameValidator = systemtenantpackageDescName.Validators[0].(func(string) error)
+	// systemtenantpackageDescStatus is the schema descriptor for status field.
+	systemtenantpackageDescStatus := systemtenantpackageFields[1].Descriptor()
+	// systemtenantpackage.DefaultStatus holds the default value on creation for the status field.
+	systemtenantpackage.DefaultStatus = systemtenantpackageDescStatus.Default.(int8)
+	// systemtenantpackageDescID is the schema descriptor for id field.
+	systemtenantpackageDescID := systemtenantpackageMixinFields0[0].Descriptor()
+	// systemtenantpackage.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	systemtenantpackage.IDValidator = systemtenantpackageDescID.Validators[0].(func(string) error)
 	systemuserMixin := schema.SystemUser{}.Mixin()
+	systemuserMixinHooks0 := systemuserMixin[0].Hooks()
+	systemuserMixinHooks1 := systemuserMixin[1].Hooks()
+	systemuserMixinHooks2 := systemuserMixin[2].Hooks()
+	systemuserMixinHooks3 := systemuserMixin[3].Hooks()
+	systemuserMixinHooks4 := systemuserMixin[4].Hooks()
 	systemuserMixinHooks5 := systemuserMixin[5].Hooks()
-	systemuser.Hooks[0] = systemuserMixinHooks5[0]
+	systemuserMixinHooks6 := systemuserMixin[6].Hooks()
+	systemuserMixinHooks7 := systemuserMixin[7].Hooks()
+	systemuser.Hooks[0] = systemuserMixinHooks0[0]
+	systemuser.Hooks[1] = systemuserMixinHooks1[0]
+	systemuser.Hooks[2] = systemuserMixinHooks2[0]
+	systemuser.Hooks[3] = systemuserMixinHooks3[0]
+	systemuser.Hooks[4] = systemuserMixinHooks4[0]
+	systemuser.Hooks[5] = systemuserMixinHooks5[0]
+	systemuser.Hooks[6] = systemuserMixinHooks6[0]
+	systemuser.Hooks[7] = systemuserMixinHooks7[0]
 	systemuserMixinInters5 := systemuserMixin[5].Interceptors()
+	systemuserMixinInters6 := systemuserMixin[6].Interceptors()
+	systemuserMixinInters7 := systemuserMixin[7].Interceptors()
 	systemuser.Interceptors[0] = systemuserMixinInters5[0]
+	systemuser.Interceptors[1] = systemuserMixinInters6[0]
+	systemuser.Interceptors[2] = systemuserMixinInters7[0]
 	systemuserMixinFields0 := systemuserMixin[0].Fields()
 	_ = systemuserMixinFields0
 	systemuserMixinFields6 := systemuserMixin[6].Fields()
@@ -27,17 +556,140 @@ func init() {
 	// systemuser.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
 	systemuser.TenantIDValidator = systemuserDescTenantID.Validators[0].(func(string) error)
 	// systemuserDescSex is the schema descriptor for sex field.
-	systemuserDescSex := systemuserFields[8].Descriptor()
+	systemuserDescSex := systemuserFields[7].Descriptor()
 	// systemuser.DefaultSex holds the default value on creation for the sex field.
 	systemuser.DefaultSex = systemuserDescSex.Default.(int8)
 	// systemuserDescStatus is the schema descriptor for status field.
-	systemuserDescStatus := systemuserFields[10].Descriptor()
+	systemuserDescStatus := systemuserFields[9].Descriptor()
 	// systemuser.DefaultStatus holds the default value on creation for the status field.
 	systemuser.DefaultStatus = systemuserDescStatus.Default.(int8)
 	// systemuserDescID is the schema descriptor for id field.
 	systemuserDescID := systemuserMixinFields0[0].Descriptor()
 	// systemuser.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	systemuser.IDValidator = systemuserDescID.Validators[0].(func(string) error)
+	systemusermfaMixin := schema.SystemUserMFA{}.Mixin()
+	systemusermfaMixinHooks0 := systemusermfaMixin[0].Hooks()
+	systemusermfaMixinHooks1 := systemusermfaMixin[1].Hooks()
+	systemusermfaMixinHooks2 := systemusermfaMixin[2].Hooks()
+	systemusermfaMixinHooks3 := systemusermfaMixin[3].Hooks()
+	systemusermfa.Hooks[0] = systemusermfaMixinHooks0[0]
+	systemusermfa.Hooks[1] = systemusermfaMixinHooks1[0]
+	systemusermfa.Hooks[2] = systemusermfaMixinHooks2[0]
+	systemusermfa.Hooks[3] = systemusermfaMixinHooks3[0]
+	systemusermfaMixinInters3 := systemusermfaMixin[3].Interceptors()
+	systemusermfa.Interceptors[0] = systemusermfaMixinInters3[0]
+	systemusermfaMixinFields0 := systemusermfaMixin[0].Fields()
+	_ = systemusermfaMixinFields0
+	systemusermfaMixinFields3 := systemusermfaMixin[3].Fields()
+	_ = systemusermfaMixinFields3
+	systemusermfaFields := schema.SystemUserMFA{}.Fields()
+	_ = systemusermfaFields
+	// systemusermfaDescTenantID is the schema descriptor for tenant_id field.
+	systemusermfaDescTenantID := systemusermfaMixinFields3[0].Descriptor()
+	// systemusermfa.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	systemusermfa.TenantIDValidator = systemusermfaDescTenantID.Validators[0].(func(string) error)
+	// systemusermfaDescUserID is the schema descriptor for user_id field.
+	systemusermfaDescUserID := systemusermfaFields[0].Descriptor()
+	// systemusermfa.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	systemusermfa.UserIDValidator = systemusermfaDescUserID.Validators[0].(func(string) error)
+	// systemusermfaDescSecret is the schema descriptor for secret field.
+	systemusermfaDescSecret := systemusermfaFields[1].Descriptor()
+	// systemusermfa.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	systemusermfa.SecretValidator = systemusermfaDescSecret.Validators[0].(func(string) error)
+	// systemusermfaDescEnabled is the schema descriptor for enabled field.
+	systemusermfaDescEnabled := systemusermfaFields[2].Descriptor()
+	// systemusermfa.DefaultEnabled holds the default value on creation for the enabled field.
+	systemusermfa.DefaultEnabled = systemusermfaDescEnabled.Default.(bool)
+	// systemusermfaDescLastUsedStep is the schema descriptor for last_used_step field.
+	systemusermfaDescLastUsedStep := systemusermfaFields[4].Descriptor()
+	// systemusermfa.DefaultLastUsedStep holds the default value on creation for the last_used_step field.
+	systemusermfa.DefaultLastUsedStep = systemusermfaDescLastUsedStep.Default.(int64)
+	// systemusermfaDescID is the schema descriptor for id field.
+	systemusermfaDescID := systemusermfaMixinFields0[0].Descriptor()
+	// systemusermfa.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	systemusermfa.IDValidator = systemusermfaDescID.Validators[0].(func(string) error)
+	systemuserpostMixin := schema.SystemUserPost{}.Mixin()
+	systemuserpostMixinHooks0 := systemuserpostMixin[0].Hooks()
+	systemuserpostMixinHooks1 := systemuserpostMixin[1].Hooks()
+	systemuserpostMixinHooks2 := systemuserpostMixin[2].Hooks()
+	systemuserpostMixinHooks3 := systemuserpostMixin[3].Hooks()
+	systemuserpostMixinHooks4 := systemuserpostMixin[4].Hooks()
+	systemuserpostMixinHooks5 := systemuserpostMixin[5].Hooks()
+	systemuserpostMixinHooks6 := systemuserpostMixin[6].Hooks()
+	systemuserpost.Hooks[0] = systemuserpostMixinHooks0[0]
+	systemuserpost.Hooks[1] = systemuserpostMixinHooks1[0]
+	systemuserpost.Hooks[2] = systemuserpostMixinHooks2[0]
+	systemuserpost.Hooks[3] = systemuserpostMixinHooks3[0]
+	systemuserpost.Hooks[4] = systemuserpostMixinHooks4[0]
+	systemuserpost.Hooks[5] = systemuserpostMixinHooks5[0]
+	systemuserpost.Hooks[6] = systemuserpostMixinHooks6[0]
+	systemuserpostMixinInters5 := systemuserpostMixin[5].Interceptors()
+	systemuserpostMixinInters6 := systemuserpostMixin[6].Interceptors()
+	systemuserpost.Interceptors[0] = systemuserpostMixinInters5[0]
+	systemuserpost.Interceptors[1] = systemuserpostMixinInters6[0]
+	systemuserpostMixinFields0 := systemuserpostMixin[0].Fields()
+	_ = systemuserpostMixinFields0
+	systemuserpostMixinFields5 := systemuserpostMixin[5].Fields()
+	_ = systemuserpostMixinFields5
+	systemuserpostFields := schema.SystemUserPost{}.Fields()
+	_ = systemuserpostFields
+	// systemuserpostDescTenantID is the schema descriptor for tenant_id field.
+	systemuserpostDescTenantID := systemuserpostMixinFields5[0].Descriptor()
+	// systemuserpost.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	systemuserpost.TenantIDValidator = systemuserpostDescTenantID.Validators[0].(func(string) error)
+	// systemuserpostDescUserID is the schema descriptor for user_id field.
+	systemuserpostDescUserID := systemuserpostFields[0].Descriptor()
+	// systemuserpost.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	systemuserpost.UserIDValidator = systemuserpostDescUserID.Validators[0].(func(string) error)
+	// systemuserpostDescPostID is the schema descriptor for post_id field.
+	systemuserpostDescPostID := systemuserpostFields[1].Descriptor()
+	// systemuserpost.PostIDValidator is a validator for the "post_id" field. It is called by the builders before save.
+	systemuserpost.PostIDValidator = systemuserpostDescPostID.Validators[0].(func(string) error)
+	// systemuserpostDescID is the schema descriptor for id field.
+	systemuserpostDescID := systemuserpostMixinFields0[0].Descriptor()
+	// systemuserpost.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	systemuserpost.IDValidator = systemuserpostDescID.Validators[0].(func(string) error)
+	systemuserroleMixin := schema.SystemUserRole{}.Mixin()
+	systemuserroleMixinHooks0 := systemuserroleMixin[0].Hooks()
+	systemuserroleMixinHooks1 := systemuserroleMixin[1].Hooks()
+	systemuserroleMixinHooks2 := systemuserroleMixin[2].Hooks()
+	systemuserroleMixinHooks3 := systemuserroleMixin[3].Hooks()
+	systemuserroleMixinHooks4 := systemuserroleMixin[4].Hooks()
+	systemuserroleMixinHooks5 := systemuserroleMixin[5].Hooks()
+	systemuserroleMixinHooks6 := systemuserroleMixin[6].Hooks()
+	systemuserrole.Hooks[0] = systemuserroleMixinHooks0[0]
+	systemuserrole.Hooks[1] = systemuserroleMixinHooks1[0]
+	systemuserrole.Hooks[2] = systemuserroleMixinHooks2[0]
+	systemuserrole.Hooks[3] = systemuserroleMixinHooks3[0]
+	systemuserrole.Hooks[4] = systemuserroleMixinHooks4[0]
+	systemuserrole.Hooks[5] = systemuserroleMixinHooks5[0]
+	systemuserrole.Hooks[6] = systemuserroleMixinHooks6[0]
+	systemuserroleMixinInters5 := systemuserroleMixin[5].Interceptors()
+	systemuserroleMixinInters6 := systemuserroleMixin[6].Interceptors()
+	systemuserrole.Interceptors[0] = systemuserroleMixinInters5[0]
+	systemuserrole.Interceptors[1] = systemuserroleMixinInters6[0]
+	systemuserroleMixinFields0 := systemuserroleMixin[0].Fields()
+	_ = systemuserroleMixinFields0
+	systemuserroleMixinFields5 := systemuserroleMixin[5].Fields()
+	_ = systemuserroleMixinFields5
+	systemuserroleFields := schema.SystemUserRole{}.Fields()
+	_ = systemuserroleFields
+	// systemuserroleDescTenantID is the schema descriptor for tenant_id field.
+	systemuserroleDescTenantID := systemuserroleMixinFields5[0].Descriptor()
+	// systemuserrole.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	systemuserrole.TenantIDValidator = systemuserroleDescTenantID.Validators[0].(func(string) error)
+	// systemuserroleDescUserID is the schema descriptor for user_id field.
+	systemuserroleDescUserID := systemuserroleFields[0].Descriptor()
+	// systemuserrole.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	systemuserrole.UserIDValidator = systemuserroleDescUserID.Validators[0].(func(string) error)
+	// systemuserroleDescRoleID is the schema descriptor for role_id field.
+	systemuserroleDescRoleID := systemuserroleFields[1].Descriptor()
+	// systemuserrole.RoleIDValidator is a validator for the "role_id" field. It is called by the builders before save.
+	systemuserrole.RoleIDValidator = systemuserroleDescRoleID.Validators[0].(func(string) error)
+	// systemuserroleDescID is the schema descriptor for id field.
+	systemuserroleDescID := systemuserroleMixinFields0[0].Descriptor()
+	// systemuserrole.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	systemuserrole.IDValidator = systemuserroleDescID.Validators[0].(func(string) error)
 }
 
 const (
@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"qn-base/pkg/ent/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SystemCasbinRule holds the schema definition for the SystemCasbinRule entity.
+//
+// 一行对应一条 casbin 规则，v0-v5 依次为规则的各个字段
+type SystemCasbinRule struct {
+	ent.Schema
+}
+
+// Annotations of the SystemCasbinRule.
+func (SystemCasbinRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "t_system_casbin_rule"},
+	}
+}
+
+// Fields of the SystemCasbinRule.
+func (SystemCasbinRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("ptype").
+			MaxLen(16).
+			Comment("规则类型，p 或 g"),
+		field.String("v0").
+			MaxLen(128).
+			Default("").
+			Comment("规则字段0"),
+		field.String("v1").
+			MaxLen(128).
+			Default("").
+			Comment("规则字段1"),
+		field.String("v2").
+			MaxLen(256).
+			Default("").
+			Comment("规则字段2"),
+		field.String("v3").
+			MaxLen(128).
+			Default("").
+			Comment("规则字段3"),
+		field.String("v4").
+			MaxLen(128).
+			Default("").
+			Comment("规则字段4"),
+		field.String("v5").
+			MaxLen(128).
+			Default("").
+			Comment("规则字段5"),
+	}
+}
+
+// Edges of the SystemCasbinRule.
+func (SystemCasbinRule) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SystemCasbinRule.
+func (SystemCasbinRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("ptype", "v0", "v1", "v2", "v3", "v4", "v5").
+			Unique(),
+	}
+}
+
+// Mixin of the SystemCasbinRule.
+func (SystemCasbinRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.StringId{},
+		mixin.CreateBy{},
+		mixin.CreateAt{},
+	}
+}
@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"qn-base/pkg/ent/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SystemDept holds the schema definition for the SystemDept entity.
+type SystemDept struct {
+	ent.Schema
+}
+
+// Annotations of the SystemDept.
+func (SystemDept) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "t_system_dept"},
+	}
+}
+
+// Fields of the SystemDept.
+func (SystemDept) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(32).
+			Default("").
+			Comment("部门名称"),
+		field.String("parent_id").
+			MaxLen(32).
+			Default("0").
+			Comment("父部门id"),
+		field.String("ancestors").
+			MaxLen(1024).
+			Default("0").
+			Comment("祖级列表，从顶级到父部门的id以逗号分隔，如 0,1,5"),
+		field.Int32("sort").
+			Default(0).
+			Comment("显示顺序"),
+		field.String("leader_user_id").
+			MaxLen(32).
+			Optional().
+			Nillable().
+			Comment("负责人"),
+		field.String("phone").
+			MaxLen(16).
+			Optional().
+			Nillable().
+			Comment("联系电话"),
+		field.String("email").
+			MaxLen(64).
+			Optional().
+			Nillable().
+			Comment("邮箱"),
+		field.Int8("status").
+			Default(1).
+			Comment("部门状态（0停用 1正常）"),
+	}
+}
+
+// Edges of the SystemDept.
+func (SystemDept) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SystemDept.
+func (SystemDept) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("parent_id"),
+		// 按祖级列表前缀查询子孙部门
+		index.Fields("ancestors"),
+	}
+}
+
+// Mixin of the SystemDept.
+func (SystemDept) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.StringId{},
+		mixin.CreateBy{},
+		mixin.CreateAt{},
+		mixin.UpdateBy{},
+		mixin.UpdateAt{},
+		mixin.TenantID{},
+		mixin.DeletedAt{},
+	}
+}
@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"qn-base/pkg/ent/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SystemLoginLog holds the schema definition for the SystemLoginLog entity.
+// 登录日志只追加，不修改也不软删除，过期的日志由定时任务清理
+type SystemLoginLog struct {
+	ent.Schema
+}
+
+// Annotations of the SystemLoginLog.
+func (SystemLoginLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "t_system_login_log"},
+	}
+}
+
+// Fields of the SystemLoginLog.
+func (SystemLoginLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("event").
+			MaxLen(32).
+			Default("LOGIN").
+			Comment("事件类型(LOGIN:登录 LOGOUT:登出)"),
+		field.String("user_id").
+			Optional().
+			Nillable().
+			Comment("用户ID，账号不存在时为空"),
+		field.String("account").
+			MaxLen(64).
+			Comment("登录账号"),
+		field.Int8("status").
+			Comment("结果(0:失败 1:成功)"),
+		field.String("reason").
+			MaxLen(64).
+			Optional().
+			Nillable().
+			Comment("失败原因，如 INCORRECT_PASSWORD、USER_FREEZE"),
+		field.String("ip").
+			MaxLen(64).
+			Optional().
+			Nillable().
+			Comment("登录IP"),
+		field.String("user_agent").
+			MaxLen(512).
+			Optional().
+			Nillable().
+			Comment("浏览器 User-Agent"),
+	}
+}
+
+// Edges of the SystemLoginLog.
+func (SystemLoginLog) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SystemLoginLog.
+func (SystemLoginLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "created_at"),
+		index.Fields("account"),
+		index.Fields("user_id"),
+		// 按保留期清理
+		index.Fields("created_at"),
+	}
+}
+
+// Mixin of the SystemLoginLog.
+func (SystemLoginLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.StringId{},
+		mixin.CreateAt{},
+		mixin.TenantID{},
+	}
+}
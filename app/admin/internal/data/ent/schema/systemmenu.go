@@ -0,0 +1,102 @@
+package schema
+
+import (
+	"qn-base/pkg/ent/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SystemMenu holds the schema definition for the SystemMenu entity.
+type SystemMenu struct {
+	ent.Schema
+}
+
+// Annotations of the SystemMenu.
+func (SystemMenu) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "t_system_menu"},
+	}
+}
+
+// Fields of the SystemMenu.
+func (SystemMenu) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(64).
+			Comment("菜单名称"),
+		field.String("permission").
+			MaxLen(128).
+			Default("").
+			Comment("权限标识"),
+		field.Int8("type").
+			Comment("菜单类型(1:目录 2:菜单 3:按钮)"),
+		field.Int32("sort").
+			Default(0).
+			Comment("显示顺序"),
+		field.String("parent_id").
+			MaxLen(32).
+			Default("0").
+			Comment("父菜单ID"),
+		field.String("path").
+			MaxLen(200).
+			Optional().
+			Nillable().
+			Comment("路由地址"),
+		field.String("icon").
+			MaxLen(128).
+			Optional().
+			Nillable().
+			Comment("菜单图标"),
+		field.String("component").
+			MaxLen(256).
+			Optional().
+			Nillable().
+			Comment("组件路径"),
+		field.String("component_name").
+			MaxLen(256).
+			Optional().
+			Nillable().
+			Comment("组件名"),
+		field.Int8("status").
+			Default(1).
+			Comment("菜单状态(0:停用 1:正常)"),
+		field.Bool("visible").
+			Default(true).
+			Comment("是否可见"),
+		field.Bool("keep_alive").
+			Default(true).
+			Comment("是否缓存"),
+		field.Bool("always_show").
+			Default(true).
+			Comment("是否总是显示"),
+	}
+}
+
+// Edges of the SystemMenu.
+func (SystemMenu) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SystemMenu.
+func (SystemMenu) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("parent_id"),
+	}
+}
+
+// Mixin of the SystemMenu.
+// 菜单为平台级数据，不区分租户
+func (SystemMenu) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.StringId{},
+		mixin.CreateBy{},
+		mixin.CreateAt{},
+		mixin.UpdateBy{},
+		mixin.UpdateAt{},
+		mixin.DeletedAt{},
+	}
+}
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"qn-base/pkg/ent/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SystemPost holds the schema definition for the SystemPost entity.
+type SystemPost struct {
+	ent.Schema
+}
+
+// Annotations of the SystemPost.
+func (SystemPost) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "t_system_post"},
+	}
+}
+
+// Fields of the SystemPost.
+func (SystemPost) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code").
+			MaxLen(64).
+			Comment("岗位编码"),
+		field.String("name").
+			MaxLen(64).
+			Comment("岗位名称"),
+		field.Int32("sort").
+			Default(0).
+			Comment("显示顺序"),
+		field.Int8("status").
+			Default(1).
+			Comment("状态(0:停用 1:正常)"),
+	}
+}
+
+// Edges of the SystemPost.
+func (SystemPost) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SystemPost.
+func (SystemPost) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("code"),
+	}
+}
+
+// Mixin of the SystemPost.
+func (SystemPost) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.StringId{},
+		mixin.CreateBy{},
+		mixin.CreateAt{},
+		mixin.UpdateBy{},
+		mixin.UpdateAt{},
+		mixin.TenantID{},
+		mixin.DeletedAt{},
+		mixin.Remark{},
+	}
+}
@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"qn-base/pkg/ent/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SystemRole holds the schema definition for the SystemRole entity.
+type SystemRole struct {
+	ent.Schema
+}
+
+// Annotations of the SystemRole.
+func (SystemRole) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "t_system_role"},
+	}
+}
+
+// Fields of the SystemRole.
+func (SystemRole) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(32).
+			Comment("角色名称"),
+		field.String("code").
+			MaxLen(128).
+			Comment("角色权限字符串"),
+		field.Int32("sort").
+			Default(0).
+			Comment("显示顺序"),
+		field.Int8("data_scope").
+			Default(1).
+			Comment("数据范围(1:全部数据权限 2:自定数据权限 3:本部门数据权限 4:本部门及以下数据权限)"),
+		field.Strings("data_scope_dept_ids").
+			Optional().
+			Comment("数据范围(指定部门数组)"),
+		field.Int8("status").
+			Default(1).
+			Comment("角色状态(0:停用 1:正常)"),
+		field.Int8("type").
+			Default(2).
+			Comment("角色类型(1:系统内置 2:自定义)"),
+	}
+}
+
+// Edges of the SystemRole.
+func (SystemRole) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SystemRole.
+func (SystemRole) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("code"),
+	}
+}
+
+// Mixin of the SystemRole.
+func (SystemRole) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.StringId{},
+		mixin.CreateBy{},
+		mixin.CreateAt{},
+		mixin.UpdateBy{},
+		mixin.UpdateAt{},
+		mixin.TenantID{},
+		mixin.DeletedAt{},
+		mixin.Remark{},
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"qn-base/pkg/ent/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SystemRoleMenu holds the schema definition for the SystemRoleMenu entity.
+type SystemRoleMenu struct {
+	ent.Schema
+}
+
+// Annotations of the SystemRoleMenu.
+func (SystemRoleMenu) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "t_system_role_menu"},
+	}
+}
+
+// Fields of the SystemRoleMenu.
+func (SystemRoleMenu) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("role_id").
+			MaxLen(32).
+			Comment("角色ID"),
+		field.String("menu_id").
+			MaxLen(32).
+			Comment("菜单ID"),
+	}
+}
+
+// Edges of the SystemRoleMenu.
+func (SystemRoleMenu) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SystemRoleMenu.
+func (SystemRoleMenu) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("role_id", "menu_id"),
+		index.Fields("menu_id"),
+	}
+}
+
+// Mixin of the SystemRoleMenu.
+func (SystemRoleMenu) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.StringId{},
+		mixin.CreateBy{},
+		mixin.CreateAt{},
+		mixin.UpdateBy{},
+		mixin.UpdateAt{},
+		mixin.TenantID{},
+		mixin.DeletedAt{},
+	}
+}
@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"qn-base/pkg/ent/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SystemTenant holds the schema definition for the SystemTenant entity.
+type SystemTenant struct {
+	ent.Schema
+}
+
+// Annotations of the SystemTenant.
+func (SystemTenant) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "t_system_tenant"},
+	}
+}
+
+// Fields of the SystemTenant.
+func (SystemTenant) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(32).
+			Comment("租户名"),
+		field.String("contact_user_id").
+			MaxLen(32).
+			Optional().
+			Nillable().
+			Comment("联系人的用户编号"),
+		field.String("contact_name").
+			MaxLen(32).
+			Comment("联系人"),
+		field.String("contact_mobile").
+			MaxLen(512).
+			Optional().
+			Nillable().
+			Comment("联系手机"),
+		field.Int8("status").
+			Default(1).
+			Comment("租户状态(0:停用 1:正常)"),
+		field.String("website").
+			MaxLen(256).
+			Default("").
+			Optional().
+			Nillable().
+			Comment("绑定域名"),
+		field.String("package_id").
+			MaxLen(32).
+			Comment("租户套餐编号"),
+		field.Time("expire_time").
+			Comment("过期时间"),
+		field.Int32("account_count").
+			Comment("账号数量"),
+		field.Bool("mfa_required").
+			Default(false).
+			Comment("是否要求两步验证，要求时未启用的用户登录后需先绑定"),
+	}
+}
+
+// Edges of the SystemTenant.
+func (SystemTenant) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SystemTenant.
+func (SystemTenant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name"),
+		index.Fields("package_id"),
+	}
+}
+
+// Mixin of the SystemTenant.
+func (SystemTenant) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.StringId{},
+		mixin.CreateBy{},
+		mixin.CreateAt{},
+		mixin.UpdateBy{},
+		mixin.UpdateAt{},
+		mixin.DeletedAt{},
+	}
+}
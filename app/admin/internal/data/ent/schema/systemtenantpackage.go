@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"qn-base/pkg/ent/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SystemTenantPackage holds the schema definition for the SystemTenantPackage entity.
+type SystemTenantPackage struct {
+	ent.Schema
+}
+
+// Annotations of the SystemTenantPackage.
+func (SystemTenantPackage) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "t_system_tenant_package"},
+	}
+}
+
+// Fields of the SystemTenantPackage.
+func (SystemTenantPackage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(32).
+			Comment("套餐名"),
+		field.Int8("status").
+			Default(1).
+			Comment("套餐状态(0:停用 1:正常)"),
+		field.Strings("menu_ids").
+			Optional().
+			Comment("关联的菜单编号"),
+	}
+}
+
+// Edges of the SystemTenantPackage.
+func (SystemTenantPackage) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SystemTenantPackage.
+func (SystemTenantPackage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name"),
+	}
+}
+
+// Mixin of the SystemTenantPackage.
+func (SystemTenantPackage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.StringId{},
+		mixin.CreateBy{},
+		mixin.CreateAt{},
+		mixin.UpdateBy{},
+		mixin.UpdateAt{},
+		mixin.DeletedAt{},
+		mixin.Remark{},
+	}
+}
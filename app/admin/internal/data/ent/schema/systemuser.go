@@ -7,6 +7,14 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// 用户唯一索引名，由 sql/migrations 创建，数据层据此将唯一约束冲突转换为业务错误
+const (
+	UserAccountUniqueIndex = "uk_system_user_tenant_account"
+	UserEmailUniqueIndex   = "uk_system_user_tenant_email"
+	UserMobileUniqueIndex  = "uk_system_user_tenant_mobile"
 )
 
 // SystemUser holds the schema definition for the SystemUser entity.
@@ -25,7 +33,6 @@ func (SystemUser) Annotations() []schema.Annotation {
 func (SystemUser) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("account").
-			Unique().
 			Comment("用户账号"),
 		field.String("password").
 			Optional().
@@ -43,10 +50,6 @@ func (SystemUser) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("部门ID"),
-		field.String("post_ids").
-			Optional().
-			Nillable().
-			Comment("岗位编号数组"),
 		field.String("email").
 			Optional().
 			Nillable().
@@ -84,6 +87,17 @@ func (SystemUser) Edges() []ent.Edge {
 	return nil
 }
 
+// Indexes of the SystemUser.
+//
+// 账号、邮箱和手机号在租户内唯一，只计算未删除的数据。MySQL 不支持部分索引，
+// ent 只能生成同时计算已删除数据的普通唯一索引，因此唯一索引仅由 sql/migrations 中的函数索引维护，
+// 索引名见 UserAccountUniqueIndex 等；这里只声明按账号查找用的普通索引
+func (SystemUser) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "account"),
+	}
+}
+
 // Mixin of the SystemUser.
 func (SystemUser) Mixin() []ent.Mixin {
 	return []ent.Mixin{
@@ -92,7 +106,8 @@ func (SystemUser) Mixin() []ent.Mixin {
 		mixin.CreateAt{},
 		mixin.UpdateBy{},
 		mixin.UpdateAt{},
-		mixin.DeletedAt{},
+		mixin.DataScope{},
 		mixin.TenantID{},
+		mixin.DeletedAt{},
 	}
 }
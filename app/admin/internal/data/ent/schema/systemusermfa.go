@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"qn-base/pkg/ent/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SystemUserMFA holds the schema definition for the SystemUserMFA entity.
+// 每个用户最多一条，绑定时创建、验证后启用，关闭两步验证时删除
+type SystemUserMFA struct {
+	ent.Schema
+}
+
+// Annotations of the SystemUserMFA.
+func (SystemUserMFA) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "t_system_user_mfa"},
+	}
+}
+
+// Fields of the SystemUserMFA.
+func (SystemUserMFA) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id").
+			MaxLen(32).
+			Comment("用户ID"),
+		field.String("secret").
+			MaxLen(64).
+			Sensitive().
+			Comment("TOTP 密钥(base32)"),
+		field.Bool("enabled").
+			Default(false).
+			Comment("是否已启用，绑定后验证通过才启用"),
+		field.Strings("recovery_codes").
+			Optional().
+			Sensitive().
+			Comment("恢复码哈希，使用后移除"),
+		field.Int64("last_used_step").
+			Default(0).
+			Comment("最近使用的验证码时间步，防止验证码重放"),
+		field.Time("enabled_at").
+			Optional().
+			Nillable().
+			Comment("启用时间"),
+	}
+}
+
+// Edges of the SystemUserMFA.
+func (SystemUserMFA) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SystemUserMFA.
+func (SystemUserMFA) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "user_id").
+			Unique(),
+	}
+}
+
+// Mixin of the SystemUserMFA.
+func (SystemUserMFA) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.StringId{},
+		mixin.CreateAt{},
+		mixin.UpdateAt{},
+		mixin.TenantID{},
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"qn-base/pkg/ent/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SystemUserPost holds the schema definition for the SystemUserPost entity.
+type SystemUserPost struct {
+	ent.Schema
+}
+
+// Annotations of the SystemUserPost.
+func (SystemUserPost) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "t_system_user_post"},
+	}
+}
+
+// Fields of the SystemUserPost.
+func (SystemUserPost) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id").
+			MaxLen(32).
+			Comment("用户ID"),
+		field.String("post_id").
+			MaxLen(32).
+			Comment("岗位ID"),
+	}
+}
+
+// Edges of the SystemUserPost.
+func (SystemUserPost) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SystemUserPost.
+func (SystemUserPost) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "post_id"),
+		index.Fields("post_id"),
+	}
+}
+
+// Mixin of the SystemUserPost.
+func (SystemUserPost) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.StringId{},
+		mixin.CreateBy{},
+		mixin.CreateAt{},
+		mixin.UpdateBy{},
+		mixin.UpdateAt{},
+		mixin.TenantID{},
+		mixin.DeletedAt{},
+	}
+}
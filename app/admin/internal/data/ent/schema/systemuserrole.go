@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"qn-base/pkg/ent/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SystemUserRole holds the schema definition for the SystemUserRole entity.
+type SystemUserRole struct {
+	ent.Schema
+}
+
+// Annotations of the SystemUserRole.
+func (SystemUserRole) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "t_system_user_role"},
+	}
+}
+
+// Fields of the SystemUserRole.
+func (SystemUserRole) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id").
+			MaxLen(32).
+			Comment("用户ID"),
+		field.String("role_id").
+			MaxLen(32).
+			Comment("角色ID"),
+	}
+}
+
+// Edges of the SystemUserRole.
+func (SystemUserRole) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SystemUserRole.
+func (SystemUserRole) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "role_id"),
+		index.Fields("role_id"),
+	}
+}
+
+// Mixin of the SystemUserRole.
+func (SystemUserRole) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.StringId{},
+		mixin.CreateBy{},
+		mixin.CreateAt{},
+		mixin.UpdateBy{},
+		mixin.UpdateAt{},
+		mixin.TenantID{},
+		mixin.DeletedAt{},
+	}
+}
@@ -26,10 +26,10 @@ type SystemUser struct {
 	UpdateBy *string `json:"update_by,omitempty"`
 	// 更新时间
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
-	// 删除时间
-	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// 租户id
 	TenantID string `json:"tenant_id,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// 用户账号
 	Account string `json:"account,omitempty"`
 	// 密码
@@ -40,8 +40,6 @@ type SystemUser struct {
 	Remark *string `json:"remark,omitempty"`
 	// 部门ID
 	DeptID *string `json:"dept_id,omitempty"`
-	// 岗位编号数组
-	PostIds *string `json:"post_ids,omitempty"`
 	// 用户邮箱
 	Email *string `json:"email,omitempty"`
 	// 手机号码
@@ -66,7 +64,7 @@ func (*SystemUser) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case systemuser.FieldSex, systemuser.FieldStatus:
 			values[i] = new(sql.NullInt64)
-		case systemuser.FieldID, systemuser.FieldCreateBy, systemuser.FieldUpdateBy, systemuser.FieldTenantID, systemuser.FieldAccount, systemuser.FieldPassword, systemuser.FieldNickname, systemuser.FieldRemark, systemuser.FieldDeptID, systemuser.FieldPostIds, systemuser.FieldEmail, systemuser.FieldMobile, systemuser.FieldAvatar, systemuser.FieldLoginIP:
+		case systemuser.FieldID, systemuser.FieldCreateBy, systemuser.FieldUpdateBy, systemuser.FieldTenantID, systemuser.FieldAccount, systemuser.FieldPassword, systemuser.FieldNickname, systemuser.FieldRemark, systemuser.FieldDeptID, systemuser.FieldEmail, systemuser.FieldMobile, systemuser.FieldAvatar, systemuser.FieldLoginIP:
 			values[i] = new(sql.NullString)
 		case systemuser.FieldCreatedAt, systemuser.FieldUpdatedAt, systemuser.FieldDeletedAt, systemuser.FieldLoginDate:
 			values[i] = new(sql.NullTime)
@@ -119,6 +117,12 @@ func (_m *SystemUser) assignValues(columns []string, values []any) error {
 				_m.UpdatedAt = new(time.Time)
 				*_m.UpdatedAt = value.Time
 			}
+		case systemuser.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = value.String
+			}
 		case systemuser.FieldDeletedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
@@ -126,12 +130,6 @@ func (_m *SystemUser) assignValues(columns []string, values []any) error {
 				_m.DeletedAt = new(time.Time)
 				*_m.DeletedAt = value.Time
 			}
-		case systemuser.FieldTenantID:
-			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
-			} else if value.Valid {
-				_m.TenantID = value.String
-			}
 		case systemuser.FieldAccount:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field account", values[i])
@@ -166,13 +164,6 @@ func (_m *SystemUser) assignValues(columns []string, values []any) error {
 				_m.DeptID = new(string)
 				*_m.DeptID = value.String
 			}
-		case systemuser.FieldPostIds:
-			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field post_ids", values[i])
-			} else if value.Valid {
-				_m.PostIds = new(string)
-				*_m.PostIds = value.String
-			}
 		case systemuser.FieldEmail:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field email", values[i])
@@ -277,14 +268,14 @@ func (_m *SystemUser) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(_m.TenantID)
+	builder.WriteString(", ")
 	if v := _m.DeletedAt; v != nil {
 		builder.WriteString("deleted_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
-	builder.WriteString("tenant_id=")
-	builder.WriteString(_m.TenantID)
-	builder.WriteString(", ")
 	builder.WriteString("account=")
 	builder.WriteString(_m.Account)
 	builder.WriteString(", ")
@@ -308,11 +299,6 @@ func (_m *SystemUser) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
-	if v := _m.PostIds; v != nil {
-		builder.WriteString("post_ids=")
-		builder.WriteString(*v)
-	}
-	builder.WriteString(", ")
 	if v := _m.Email; v != nil {
 		builder.WriteString("email=")
 		builder.WriteString(*v)
@@ -20,10 +20,10 @@ const (
 	FieldUpdateBy = "update_by"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
-	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
-	FieldDeletedAt = "deleted_at"
 	// FieldTenantID holds the string denoting the tenant_id field in the database.
 	FieldTenantID = "tenant_id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldAccount holds the string denoting the account field in the database.
 	FieldAccount = "account"
 	// FieldPassword holds the string denoting the password field in the database.
@@ -34,8 +34,6 @@ const (
 	FieldRemark = "remark"
 	// FieldDeptID holds the string denoting the dept_id field in the database.
 	FieldDeptID = "dept_id"
-	// FieldPostIds holds the string denoting the post_ids field in the database.
-	FieldPostIds = "post_ids"
 	// FieldEmail holds the string denoting the email field in the database.
 	FieldEmail = "email"
 	// FieldMobile holds the string denoting the mobile field in the database.
@@ -61,14 +59,13 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldUpdateBy,
 	FieldUpdatedAt,
-	FieldDeletedAt,
 	FieldTenantID,
+	FieldDeletedAt,
 	FieldAccount,
 	FieldPassword,
 	FieldNickname,
 	FieldRemark,
 	FieldDeptID,
-	FieldPostIds,
 	FieldEmail,
 	FieldMobile,
 	FieldSex,
@@ -94,8 +91,8 @@ func ValidColumn(column string) bool {
 //
 //	import _ "qn-base/app/admin/internal/data/ent/runtime"
 var (
-	Hooks        [1]ent.Hook
-	Interceptors [1]ent.Interceptor
+	Hooks        [8]ent.Hook
+	Interceptors [3]ent.Interceptor
 	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
 	TenantIDValidator func(string) error
 	// DefaultSex holds the default value on creation for the "sex" field.
@@ -134,16 +131,16 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
-// ByDeletedAt orders the results by the deleted_at field.
-func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
-}
-
 // ByTenantID orders the results by the tenant_id field.
 func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByAccount orders the results by the account field.
 func ByAccount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAccount, opts...).ToFunc()
@@ -169,11 +166,6 @@ func ByDeptID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeptID, opts...).ToFunc()
 }
 
-// ByPostIds orders the results by the post_ids field.
-func ByPostIds(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldPostIds, opts...).ToFunc()
-}
-
 // ByEmail orders the results by the email field.
 func ByEmail(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmail, opts...).ToFunc()
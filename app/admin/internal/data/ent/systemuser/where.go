@@ -84,16 +84,16 @@ func UpdatedAt(v time.Time) predicate.SystemUser {
 	return predicate.SystemUser(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
-// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
-func DeletedAt(v time.Time) predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldEQ(FieldDeletedAt, v))
-}
-
 // TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
 func TenantID(v string) predicate.SystemUser {
 	return predicate.SystemUser(sql.FieldEQ(FieldTenantID, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.SystemUser {
+	return predicate.SystemUser(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // Account applies equality check predicate on the "account" field. It's identical to AccountEQ.
 func Account(v string) predicate.SystemUser {
 	return predicate.SystemUser(sql.FieldEQ(FieldAccount, v))
@@ -119,11 +119,6 @@ func DeptID(v string) predicate.SystemUser {
 	return predicate.SystemUser(sql.FieldEQ(FieldDeptID, v))
 }
 
-// PostIds applies equality check predicate on the "post_ids" field. It's identical to PostIdsEQ.
-func PostIds(v string) predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldEQ(FieldPostIds, v))
-}
-
 // Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
 func Email(v string) predicate.SystemUser {
 	return predicate.SystemUser(sql.FieldEQ(FieldEmail, v))
@@ -409,56 +404,6 @@ func UpdatedAtNotNil() predicate.SystemUser {
 	return predicate.SystemUser(sql.FieldNotNull(FieldUpdatedAt))
 }
 
-// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
-func DeletedAtEQ(v time.Time) predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldEQ(FieldDeletedAt, v))
-}
-
-// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
-func DeletedAtNEQ(v time.Time) predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldNEQ(FieldDeletedAt, v))
-}
-
-// DeletedAtIn applies the In predicate on the "deleted_at" field.
-func DeletedAtIn(vs ...time.Time) predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldIn(FieldDeletedAt, vs...))
-}
-
-// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
-func DeletedAtNotIn(vs ...time.Time) predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldNotIn(FieldDeletedAt, vs...))
-}
-
-// DeletedAtGT applies the GT predicate on the "deleted_at" field.
-func DeletedAtGT(v time.Time) predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldGT(FieldDeletedAt, v))
-}
-
-// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
-func DeletedAtGTE(v time.Time) predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldGTE(FieldDeletedAt, v))
-}
-
-// DeletedAtLT applies the LT predicate on the "deleted_at" field.
-func DeletedAtLT(v time.Time) predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldLT(FieldDeletedAt, v))
-}
-
-// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
-func DeletedAtLTE(v time.Time) predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldLTE(FieldDeletedAt, v))
-}
-
-// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
-func DeletedAtIsNil() predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldIsNull(FieldDeletedAt))
-}
-
-// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
-func DeletedAtNotNil() predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldNotNull(FieldDeletedAt))
-}
-
 // TenantIDEQ applies the EQ predicate on the "tenant_id" field.
 func TenantIDEQ(v string) predicate.SystemUser {
 	return predicate.SystemUser(sql.FieldEQ(FieldTenantID, v))
@@ -524,6 +469,56 @@ func TenantIDContainsFold(v string) predicate.SystemUser {
 	return predicate.SystemUser(sql.FieldContainsFold(FieldTenantID, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.SystemUser {
+	return predicate.SystemUser(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.SystemUser {
+	return predicate.SystemUser(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.SystemUser {
+	return predicate.SystemUser(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.SystemUser {
+	return predicate.SystemUser(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.SystemUser {
+	return predicate.SystemUser(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.SystemUser {
+	return predicate.SystemUser(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.SystemUser {
+	return predicate.SystemUser(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.SystemUser {
+	return predicate.SystemUser(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.SystemUser {
+	return predicate.SystemUser(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.SystemUser {
+	return predicate.SystemUser(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // AccountEQ applies the EQ predicate on the "account" field.
 func AccountEQ(v string) predicate.SystemUser {
 	return predicate.SystemUser(sql.FieldEQ(FieldAccount, v))
@@ -889,81 +884,6 @@ func DeptIDContainsFold(v string) predicate.SystemUser {
 	return predicate.SystemUser(sql.FieldContainsFold(FieldDeptID, v))
 }
 
-// PostIdsEQ applies the EQ predicate on the "post_ids" field.
-func PostIdsEQ(v string) predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldEQ(FieldPostIds, v))
-}
-
-// PostIdsNEQ applies the NEQ predicate on the "post_ids" field.
-func PostIdsNEQ(v string) predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldNEQ(FieldPostIds, v))
-}
-
-// PostIdsIn applies the In predicate on the "post_ids" field.
-func PostIdsIn(vs ...string) predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldIn(FieldPostIds, vs...))
-}
-
-// PostIdsNotIn applies the NotIn predicate on the "post_ids" field.
-func PostIdsNotIn(vs ...string) predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldNotIn(FieldPostIds, vs...))
-}
-
-// PostIdsGT applies the GT predicate on the "post_ids" field.
-func PostIdsGT(v string) predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldGT(FieldPostIds, v))
-}
-
-// PostIdsGTE applies the GTE predicate on the "post_ids" field.
-func PostIdsGTE(v string) predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldGTE(FieldPostIds, v))
-}
-
-// PostIdsLT applies the LT predicate on the "post_ids" field.
-func PostIdsLT(v string) predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldLT(FieldPostIds, v))
-}
-
-// PostIdsLTE applies the LTE predicate on the "post_ids" field.
-func PostIdsLTE(v string) predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldLTE(FieldPostIds, v))
-}
-
-// PostIdsContains applies the Contains predicate on the "post_ids" field.
-func PostIdsContains(v string) predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldContains(FieldPostIds, v))
-}
-
-// PostIdsHasPrefix applies the HasPrefix predicate on the "post_ids" field.
-func PostIdsHasPrefix(v string) predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldHasPrefix(FieldPostIds, v))
-}
-
-// PostIdsHasSuffix applies the HasSuffix predicate on the "post_ids" field.
-func PostIdsHasSuffix(v string) predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldHasSuffix(FieldPostIds, v))
-}
-
-// PostIdsIsNil applies the IsNil predicate on the "post_ids" field.
-func PostIdsIsNil() predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldIsNull(FieldPostIds))
-}
-
-// PostIdsNotNil applies the NotNil predicate on the "post_ids" field.
-func PostIdsNotNil() predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldNotNull(FieldPostIds))
-}
-
-// PostIdsEqualFold applies the EqualFold predicate on the "post_ids" field.
-func PostIdsEqualFold(v string) predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldEqualFold(FieldPostIds, v))
-}
-
-// PostIdsContainsFold applies the ContainsFold predicate on the "post_ids" field.
-func PostIdsContainsFold(v string) predicate.SystemUser {
-	return predicate.SystemUser(sql.FieldContainsFold(FieldPostIds, v))
-}
-
 // EmailEQ applies the EQ predicate on the "email" field.
 func EmailEQ(v string) predicate.SystemUser {
 	return predicate.SystemUser(sql.FieldEQ(FieldEmail, v))
@@ -79,6 +79,12 @@ func (_c *SystemUserCreate) SetNillableUpdatedAt(v *time.Time) *SystemUserCreate
 	return _c
 }
 
+// SetTenantID sets the "tenant_id" field.
+func (_c *SystemUserCreate) SetTenantID(v string) *SystemUserCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (_c *SystemUserCreate) SetDeletedAt(v time.Time) *SystemUserCreate {
 	_c.mutation.SetDeletedAt(v)
@@ -93,12 +99,6 @@ func (_c *SystemUserCreate) SetNillableDeletedAt(v *time.Time) *SystemUserCreate
 	return _c
 }
 
-// SetTenantID sets the "tenant_id" field.
-func (_c *SystemUserCreate) SetTenantID(v string) *SystemUserCreate {
-	_c.mutation.SetTenantID(v)
-	return _c
-}
-
 // SetAccount sets the "account" field.
 func (_c *SystemUserCreate) SetAccount(v string) *SystemUserCreate {
 	_c.mutation.SetAccount(v)
@@ -161,20 +161,6 @@ func (_c *SystemUserCreate) SetNillableDeptID(v *string) *SystemUserCreate {
 	return _c
 }
 
-// SetPostIds sets the "post_ids" field.
-func (_c *SystemUserCreate) SetPostIds(v string) *SystemUserCreate {
-	_c.mutation.SetPostIds(v)
-	return _c
-}
-
-// SetNillablePostIds sets the "post_ids" field if the given value is not nil.
-func (_c *SystemUserCreate) SetNillablePostIds(v *string) *SystemUserCreate {
-	if v != nil {
-		_c.SetPostIds(*v)
-	}
-	return _c
-}
-
 // SetEmail sets the "email" field.
 func (_c *SystemUserCreate) SetEmail(v string) *SystemUserCreate {
 	_c.mutation.SetEmail(v)
@@ -397,14 +383,14 @@ func (_c *SystemUserCreate) createSpec() (*SystemUser, *sqlgraph.CreateSpec) {
 		_spec.SetField(systemuser.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = &value
 	}
-	if value, ok := _c.mutation.DeletedAt(); ok {
-		_spec.SetField(systemuser.FieldDeletedAt, field.TypeTime, value)
-		_node.DeletedAt = &value
-	}
 	if value, ok := _c.mutation.TenantID(); ok {
 		_spec.SetField(systemuser.FieldTenantID, field.TypeString, value)
 		_node.TenantID = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(systemuser.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := _c.mutation.Account(); ok {
 		_spec.SetField(systemuser.FieldAccount, field.TypeString, value)
 		_node.Account = value
@@ -425,10 +411,6 @@ func (_c *SystemUserCreate) createSpec() (*SystemUser, *sqlgraph.CreateSpec) {
 		_spec.SetField(systemuser.FieldDeptID, field.TypeString, value)
 		_node.DeptID = &value
 	}
-	if value, ok := _c.mutation.PostIds(); ok {
-		_spec.SetField(systemuser.FieldPostIds, field.TypeString, value)
-		_node.PostIds = &value
-	}
 	if value, ok := _c.mutation.Email(); ok {
 		_spec.SetField(systemuser.FieldEmail, field.TypeString, value)
 		_node.Email = &value
@@ -665,24 +647,6 @@ func (u *SystemUserUpsert) ClearDeptID() *SystemUserUpsert {
 	return u
 }
 
-// SetPostIds sets the "post_ids" field.
-func (u *SystemUserUpsert) SetPostIds(v string) *SystemUserUpsert {
-	u.Set(systemuser.FieldPostIds, v)
-	return u
-}
-
-// UpdatePostIds sets the "post_ids" field to the value that was provided on create.
-func (u *SystemUserUpsert) UpdatePostIds() *SystemUserUpsert {
-	u.SetExcluded(systemuser.FieldPostIds)
-	return u
-}
-
-// ClearPostIds clears the value of the "post_ids" field.
-func (u *SystemUserUpsert) ClearPostIds() *SystemUserUpsert {
-	u.SetNull(systemuser.FieldPostIds)
-	return u
-}
-
 // SetEmail sets the "email" field.
 func (u *SystemUserUpsert) SetEmail(v string) *SystemUserUpsert {
 	u.Set(systemuser.FieldEmail, v)
@@ -1057,27 +1021,6 @@ func (u *SystemUserUpsertOne) ClearDeptID() *SystemUserUpsertOne {
 	})
 }
 
-// SetPostIds sets the "post_ids" field.
-func (u *SystemUserUpsertOne) SetPostIds(v string) *SystemUserUpsertOne {
-	return u.Update(func(s *SystemUserUpsert) {
-		s.SetPostIds(v)
-	})
-}
-
-// UpdatePostIds sets the "post_ids" field to the value that was provided on create.
-func (u *SystemUserUpsertOne) UpdatePostIds() *SystemUserUpsertOne {
-	return u.Update(func(s *SystemUserUpsert) {
-		s.UpdatePostIds()
-	})
-}
-
-// ClearPostIds clears the value of the "post_ids" field.
-func (u *SystemUserUpsertOne) ClearPostIds() *SystemUserUpsertOne {
-	return u.Update(func(s *SystemUserUpsert) {
-		s.ClearPostIds()
-	})
-}
-
 // SetEmail sets the "email" field.
 func (u *SystemUserUpsertOne) SetEmail(v string) *SystemUserUpsertOne {
 	return u.Update(func(s *SystemUserUpsert) {
@@ -1642,27 +1585,6 @@ func (u *SystemUserUpsertBulk) ClearDeptID() *SystemUserUpsertBulk {
 	})
 }
 
-// SetPostIds sets the "post_ids" field.
-func (u *SystemUserUpsertBulk) SetPostIds(v string) *SystemUserUpsertBulk {
-	return u.Update(func(s *SystemUserUpsert) {
-		s.SetPostIds(v)
-	})
-}
-
-// UpdatePostIds sets the "post_ids" field to the value that was provided on create.
-func (u *SystemUserUpsertBulk) UpdatePostIds() *SystemUserUpsertBulk {
-	return u.Update(func(s *SystemUserUpsert) {
-		s.UpdatePostIds()
-	})
-}
-
-// ClearPostIds clears the value of the "post_ids" field.
-func (u *SystemUserUpsertBulk) ClearPostIds() *SystemUserUpsertBulk {
-	return u.Update(func(s *SystemUserUpsert) {
-		s.ClearPostIds()
-	})
-}
-
 // SetEmail sets the "email" field.
 func (u *SystemUserUpsertBulk) SetEmail(v string) *SystemUserUpsertBulk {
 	return u.Update(func(s *SystemUserUpsert) {
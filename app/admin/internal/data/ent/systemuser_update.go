@@ -203,26 +203,6 @@ func (_u *SystemUserUpdate) ClearDeptID() *SystemUserUpdate {
 	return _u
 }
 
-// SetPostIds sets the "post_ids" field.
-func (_u *SystemUserUpdate) SetPostIds(v string) *SystemUserUpdate {
-	_u.mutation.SetPostIds(v)
-	return _u
-}
-
-// SetNillablePostIds sets the "post_ids" field if the given value is not nil.
-func (_u *SystemUserUpdate) SetNillablePostIds(v *string) *SystemUserUpdate {
-	if v != nil {
-		_u.SetPostIds(*v)
-	}
-	return _u
-}
-
-// ClearPostIds clears the value of the "post_ids" field.
-func (_u *SystemUserUpdate) ClearPostIds() *SystemUserUpdate {
-	_u.mutation.ClearPostIds()
-	return _u
-}
-
 // SetEmail sets the "email" field.
 func (_u *SystemUserUpdate) SetEmail(v string) *SystemUserUpdate {
 	_u.mutation.SetEmail(v)
@@ -478,12 +458,6 @@ func (_u *SystemUserUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.DeptIDCleared() {
 		_spec.ClearField(systemuser.FieldDeptID, field.TypeString)
 	}
-	if value, ok := _u.mutation.PostIds(); ok {
-		_spec.SetField(systemuser.FieldPostIds, field.TypeString, value)
-	}
-	if _u.mutation.PostIdsCleared() {
-		_spec.ClearField(systemuser.FieldPostIds, field.TypeString)
-	}
 	if value, ok := _u.mutation.Email(); ok {
 		_spec.SetField(systemuser.FieldEmail, field.TypeString, value)
 	}
@@ -728,26 +702,6 @@ func (_u *SystemUserUpdateOne) ClearDeptID() *SystemUserUpdateOne {
 	return _u
 }
 
-// SetPostIds sets the "post_ids" field.
-func (_u *SystemUserUpdateOne) SetPostIds(v string) *SystemUserUpdateOne {
-	_u.mutation.SetPostIds(v)
-	return _u
-}
-
-// SetNillablePostIds sets the "post_ids" field if the given value is not nil.
-func (_u *SystemUserUpdateOne) SetNillablePostIds(v *string) *SystemUserUpdateOne {
-	if v != nil {
-		_u.SetPostIds(*v)
-	}
-	return _u
-}
-
-// ClearPostIds clears the value of the "post_ids" field.
-func (_u *SystemUserUpdateOne) ClearPostIds() *SystemUserUpdateOne {
-	_u.mutation.ClearPostIds()
-	return _u
-}
-
 // SetEmail sets the "email" field.
 func (_u *SystemUserUpdateOne) SetEmail(v string) *SystemUserUpdateOne {
 	_u.mutation.SetEmail(v)
@@ -1033,12 +987,6 @@ func (_u *SystemUserUpdateOne) sqlSave(ctx context.Context) (_node *SystemUser,
 	if _u.mutation.DeptIDCleared() {
 		_spec.ClearField(systemuser.FieldDeptID, field.TypeString)
 	}
-	if value, ok := _u.mutation.PostIds(); ok {
-		_spec.SetField(systemuser.FieldPostIds, field.TypeString, value)
-	}
-	if _u.mutation.PostIdsCleared() {
-		_spec.ClearField(systemuser.FieldPostIds, field.TypeString)
-	}
 	if value, ok := _u.mutation.Email(); ok {
 		_spec.SetField(systemuser.FieldEmail, field.TypeString, value)
 	}
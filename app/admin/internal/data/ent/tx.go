@@ -12,8 +12,32 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// SystemCasbinRule is the client for interacting with the SystemCasbinRule builders.
+	SystemCasbinRule *SystemCasbinRuleClient
+	// SystemDept is the client for interacting with the SystemDept builders.
+	SystemDept *SystemDeptClient
+	// SystemLoginLog is the client for interacting with the SystemLoginLog builders.
+	SystemLoginLog *SystemLoginLogClient
+	// SystemMenu is the client for interacting with the SystemMenu builders.
+	SystemMenu *SystemMenuClient
+	// SystemPost is the client for interacting with the SystemPost builders.
+	SystemPost *SystemPostClient
+	// SystemRole is the client for interacting with the SystemRole builders.
+	SystemRole *SystemRoleClient
+	// SystemRoleMenu is the client for interacting with the SystemRoleMenu builders.
+	SystemRoleMenu *SystemRoleMenuClient
+	// SystemTenant is the client for interacting with the SystemTenant builders.
+	SystemTenant *SystemTenantClient
+	// SystemTenantPackage is the client for interacting with the SystemTenantPackage builders.
+	SystemTenantPackage *SystemTenantPackageClient
 	// SystemUser is the client for interacting with the SystemUser builders.
 	SystemUser *SystemUserClient
+	// SystemUserMFA is the client for interacting with the SystemUserMFA builders.
+	SystemUserMFA *SystemUserMFAClient
+	// SystemUserPost is the client for interacting with the SystemUserPost builders.
+	SystemUserPost *SystemUserPostClient
+	// SystemUserRole is the client for interacting with the SystemUserRole builders.
+	SystemUserRole *SystemUserRoleClient
 
 	// lazily loaded.
 	client     *Client
@@ -145,7 +169,19 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.SystemCasbinRule = NewSystemCasbinRuleClient(tx.config)
+	tx.SystemDept = NewSystemDeptClient(tx.config)
+	tx.SystemLoginLog = NewSystemLoginLogClient(tx.config)
+	tx.SystemMenu = NewSystemMenuClient(tx.config)
+	tx.SystemPost = NewSystemPostClient(tx.config)
+	tx.SystemRole = NewSystemRoleClient(tx.config)
+	tx.SystemRoleMenu = NewSystemRoleMenuClient(tx.config)
+	tx.SystemTenant = NewSystemTenantClient(tx.config)
+	tx.SystemTenantPackage = NewSystemTenantPackageClient(tx.config)
 	tx.SystemUser = NewSystemUserClient(tx.config)
+	tx.SystemUserMFA = NewSystemUserMFAClient(tx.config)
+	tx.SystemUserPost = NewSystemUserPostClient(tx.config)
+	tx.SystemUserRole = NewSystemUserRoleClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -155,7 +191,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: SystemUser.QueryXXX(), the query will be executed
+// applies a query, for example: SystemCasbinRule.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
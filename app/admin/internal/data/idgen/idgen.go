@@ -1,36 +1,105 @@
 package idgen
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/go-kratos/kratos/v2/log"
-	"github.com/sony/sonyflake"
+	"qn-base/app/admin/internal/conf"
+	"qn-base/app/admin/internal/data/rdb"
+	"qn-base/pkg/ent/mixin"
 	"qn-base/pkg/util/idgen"
+
+	"github.com/go-kratos/kratos/v2/log"
 )
 
 // IDGenerator is a service for generating unique IDs
 type IDGenerator struct {
 	logger *log.Helper
+	sf     *idgen.Snowflake
 }
 
-// NewIDGenerator creates a new ID generator service
-func NewIDGenerator(logger log.Logger) (*IDGenerator, error) {
-	// Initialize the ID generator with default settings
-	idgen.Init(sonyflake.Settings{})
+// NewIDGenerator creates a new ID generator service from conf.Snowflake,
+// the worker ID is leased from redis so that instances of the cluster never share one.
+func NewIDGenerator(c *conf.Bootstrap, client *rdb.Client, logger log.Logger) (*IDGenerator, func(), error) {
+	helper := log.NewHelper(log.With(logger, "module", "data/idgen"))
+	sc := c.GetSnowflake()
+	sf, err := idgen.NewSnowflake(idgen.Options{
+		BaseTime:          sc.GetBaseTime(),
+		WorkerIDBitLength: uint8(sc.GetWorkerIdBitLength()),
+		SeqBitLength:      uint8(sc.GetSeqBitLength()),
+		MaxSeqNumber:      uint32(sc.GetMaxSeqNumber()),
+		MinSeqNumber:      uint32(sc.GetMinSeqNumber()),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 未配置的键使用默认值
+	keys := workerKeys{
+		lock:   sc.GetLockKey(),
+		worker: sc.GetWorkerIdKey(),
+		index:  sc.GetWorkerIdIndexKey(),
+	}
+	if keys.lock == "" {
+		keys.lock = client.Key("idgen", "workerid", "lock")
+	}
+	if keys.worker == "" {
+		keys.worker = client.Key("idgen", "workerid") + ":"
+	}
+	if keys.index == "" {
+		keys.index = client.Key("idgen", "workerid", "index")
+	}
+
+	lease := newWorkerLease(client, keys, sf.MaxWorkerID(), sf.SetWorkerID, helper)
+	workerID, err := lease.Acquire(context.Background())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed leasing snowflake worker id: %w", err)
+	}
+	if err := sf.SetWorkerID(workerID); err != nil {
+		lease.Release(context.Background())
+		return nil, nil, err
+	}
+	helper.Infof("snowflake worker id %d leased", workerID)
+
+	// 新实体的ID由 StringId 的钩子生成
+	g, err := entityGenerator(sc.GetGenerator(), sf)
+	if err != nil {
+		lease.Release(context.Background())
+		return nil, nil, err
+	}
+	mixin.SetIDGenerator(g)
 
 	return &IDGenerator{
-		logger: log.NewHelper(logger),
+		logger: helper,
+		sf:     sf,
+	}, func() {
+		helper.Info("message", "releasing the snowflake worker id")
+		lease.Release(context.Background())
 	}, nil
 }
 
+// entityGenerator returns the generator of the kind, the snowflake when empty.
+func entityGenerator(kind string, sf *idgen.Snowflake) (idgen.Generator, error) {
+	switch kind {
+	case "", idgen.KindSnowflake:
+		return sf, nil
+	case idgen.KindULID:
+		return idgen.NewULID(), nil
+	case idgen.KindUUIDv7:
+		return idgen.UUIDv7{}, nil
+	default:
+		return nil, fmt.Errorf("unknown id generator %q", kind)
+	}
+}
+
 // NextID generates and returns the next unique ID
 func (ig *IDGenerator) NextID() (uint64, error) {
-	id, err := idgen.NextID()
+	id, err := ig.sf.NextID()
 	if err != nil {
 		ig.logger.Errorf("failed to generate snowflake ID: %v", err)
 		return 0, fmt.Errorf("failed to generate snowflake ID: %w", err)
 	}
-	return id, nil
+	return uint64(id), nil
 }
 
 // NextStringID generates and returns the next unique ID as a string
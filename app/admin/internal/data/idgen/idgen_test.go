@@ -0,0 +1,167 @@
+package idgen
+
+import (
+	"context"
+	"testing"
+
+	"qn-base/app/admin/internal/conf"
+	"qn-base/app/admin/internal/data/rdb"
+	"qn-base/pkg/util/idgen"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, mr *miniredis.Miniredis) (*rdb.Client, *conf.Bootstrap) {
+	c := &conf.Bootstrap{
+		Data: &conf.Data{
+			Redis: &conf.Data_Redis{Addr: mr.Addr(), KeyPrefix: "test"},
+		},
+		Snowflake: &conf.Snowflake{
+			WorkerIdBitLength: 1,
+			SeqBitLength:      6,
+		},
+	}
+	client, cleanup, err := rdb.NewClient(c, log.DefaultLogger)
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+	return client, c
+}
+
+func TestNewIDGenerator(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client, c := newTestClient(t, mr)
+
+	t.Run("实例分配到不同的机器码", func(t *testing.T) {
+		g1, cleanup1, err := NewIDGenerator(c, client, log.DefaultLogger)
+		require.NoError(t, err)
+		g2, cleanup2, err := NewIDGenerator(c, client, log.DefaultLogger)
+		require.NoError(t, err)
+
+		// 断言
+		assert.True(t, mr.Exists("test:idgen:workerid:0"))
+		assert.True(t, mr.Exists("test:idgen:workerid:1"))
+		id1, err := g1.NextID()
+		require.NoError(t, err)
+		id2, err := g2.NextID()
+		require.NoError(t, err)
+		assert.NotEqual(t, id1, id2)
+
+		// 机器码用尽
+		_, _, err = NewIDGenerator(c, client, log.DefaultLogger)
+		assert.ErrorIs(t, err, errNoWorkerID)
+
+		// 释放后可重新分配
+		cleanup1()
+		cleanup2()
+		assert.False(t, mr.Exists("test:idgen:workerid:0"))
+		assert.False(t, mr.Exists("test:idgen:workerid:1"))
+		_, cleanup3, err := NewIDGenerator(c, client, log.DefaultLogger)
+		require.NoError(t, err)
+		cleanup3()
+	})
+
+	t.Run("使用配置的键", func(t *testing.T) {
+		keyed := &conf.Bootstrap{Snowflake: &conf.Snowflake{
+			LockKey:          "globalid:lock",
+			WorkerIdKey:      "globalid:workerid:",
+			WorkerIdIndexKey: "globalid:index",
+		}}
+		_, cleanup, err := NewIDGenerator(keyed, client, log.DefaultLogger)
+		require.NoError(t, err)
+		defer cleanup()
+
+		// 断言
+		assert.True(t, mr.Exists("globalid:index"))
+		assert.True(t, mr.Exists("globalid:workerid:0"))
+	})
+}
+
+func TestEntityGenerator(t *testing.T) {
+	sf, err := idgen.NewSnowflake(idgen.Options{})
+	require.NoError(t, err)
+
+	for kind, want := range map[string]idgen.Generator{
+		"":                  sf,
+		idgen.KindSnowflake: sf,
+		idgen.KindULID:      &idgen.ULID{},
+		idgen.KindUUIDv7:    idgen.UUIDv7{},
+	} {
+		g, err := entityGenerator(kind, sf)
+		require.NoError(t, err)
+		assert.IsType(t, want, g)
+	}
+
+	_, err = entityGenerator("uuidv4", sf)
+	assert.Error(t, err)
+}
+
+func TestWorkerLeaseRenew(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client, _ := newTestClient(t, mr)
+	ctx := context.Background()
+	keys := workerKeys{lock: "lock", worker: "worker:", index: "index"}
+	helper := log.NewHelper(log.DefaultLogger)
+
+	newLease := func(changed *uint16) *workerLease {
+		return newWorkerLease(client, keys, 1, func(id uint16) error {
+			*changed = id
+			return nil
+		}, helper)
+	}
+
+	t.Run("续期延长租约", func(t *testing.T) {
+		var changed uint16
+		lease := newLease(&changed)
+		workerID, err := lease.allocate(ctx)
+		require.NoError(t, err)
+		lease.workerID = workerID
+		mr.FastForward(workerIDTTL / 2)
+
+		// 执行测试
+		require.NoError(t, lease.renew(ctx))
+
+		// 断言
+		assert.Equal(t, workerIDTTL, mr.TTL(lease.workerKey(workerID)))
+		mr.FlushAll()
+	})
+
+	t.Run("租约过期后重新占用原机器码", func(t *testing.T) {
+		var changed uint16 = 99
+		lease := newLease(&changed)
+		workerID, err := lease.allocate(ctx)
+		require.NoError(t, err)
+		lease.workerID = workerID
+		mr.Del(lease.workerKey(workerID))
+
+		// 执行测试
+		require.NoError(t, lease.renew(ctx))
+
+		// 断言
+		assert.Equal(t, workerID, lease.WorkerID())
+		assert.Equal(t, uint16(99), changed)
+		got, err := mr.Get(lease.workerKey(workerID))
+		require.NoError(t, err)
+		assert.Equal(t, lease.token, got)
+		mr.FlushAll()
+	})
+
+	t.Run("原机器码被占用时重新分配", func(t *testing.T) {
+		var changed uint16 = 99
+		lease := newLease(&changed)
+		workerID, err := lease.allocate(ctx)
+		require.NoError(t, err)
+		lease.workerID = workerID
+		require.NoError(t, mr.Set(lease.workerKey(workerID), "other"))
+
+		// 执行测试
+		require.NoError(t, lease.renew(ctx))
+
+		// 断言
+		assert.NotEqual(t, workerID, lease.WorkerID())
+		assert.Equal(t, lease.WorkerID(), changed)
+		mr.FlushAll()
+	})
+}
@@ -0,0 +1,229 @@
+package idgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"qn-base/app/admin/internal/data/rdb"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// workerIDTTL is how long a worker ID is leased without renewal.
+	workerIDTTL = time.Minute
+	// heartbeatInterval is how often the lease is renewed.
+	heartbeatInterval = workerIDTTL / 3
+	// lockTTL is how long the allocation lock is held at most.
+	lockTTL = 10 * time.Second
+	// lockWait is how long to wait for the allocation lock.
+	lockWait = 15 * time.Second
+)
+
+// errNoWorkerID is returned when all the worker IDs are leased by other instances.
+var errNoWorkerID = errors.New("idgen: no worker id available")
+
+var (
+	// renewScript 仅在租约仍属于当前实例时续期
+	renewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+	// releaseScript 仅在键仍属于当前实例时删除
+	releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+)
+
+// workerKeys are the redis keys of the worker ID allocation.
+type workerKeys struct {
+	// lock 分配机器码时持有的锁
+	lock string
+	// worker 机器码租约键的前缀，后接机器码
+	worker string
+	// index 下一次分配的起始位置
+	index string
+}
+
+// workerLease leases a unique worker ID from redis, and renews it in the background.
+//
+// 实例之间在 lock 下分配：从 index 开始依次尝试 SET worker{id} NX，
+// 租约在心跳中续期，丢失后优先重新占用原机器码，被占用时重新分配并通知调用方
+type workerLease struct {
+	client    *rdb.Client
+	keys      workerKeys
+	maxWorker uint16
+	token     string
+	onChange  func(uint16) error
+	log       *log.Helper
+
+	mu       sync.Mutex
+	workerID uint16
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// newWorkerLease creates the lease, the token identifies the instance.
+func newWorkerLease(client *rdb.Client, keys workerKeys, maxWorker uint16, onChange func(uint16) error, logger *log.Helper) *workerLease {
+	host, _ := os.Hostname()
+	return &workerLease{
+		client:    client,
+		keys:      keys,
+		maxWorker: maxWorker,
+		token:     fmt.Sprintf("%s:%d:%s", host, os.Getpid(), uuid.NewString()),
+		onChange:  onChange,
+		log:       logger,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// Acquire leases a worker ID, and starts the heartbeat.
+func (l *workerLease) Acquire(ctx context.Context) (uint16, error) {
+	workerID, err := l.allocate(ctx)
+	if err != nil {
+		return 0, err
+	}
+	l.mu.Lock()
+	l.workerID = workerID
+	l.mu.Unlock()
+	go l.heartbeat()
+	return workerID, nil
+}
+
+// Release stops the heartbeat, and releases the worker ID.
+func (l *workerLease) Release(ctx context.Context) {
+	close(l.stop)
+	<-l.done
+	if err := releaseScript.Run(ctx, l.client, []string{l.workerKey(l.WorkerID())}, l.token).Err(); err != nil {
+		l.log.Errorf("failed releasing worker id %d: %v", l.WorkerID(), err)
+	}
+}
+
+// WorkerID returns the leased worker ID.
+func (l *workerLease) WorkerID() uint16 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.workerID
+}
+
+// allocate leases the first free worker ID after the index, under the lock.
+func (l *workerLease) allocate(ctx context.Context) (uint16, error) {
+	unlock, err := l.lock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	index, err := l.client.Incr(ctx, l.keys.index).Result()
+	if err != nil {
+		return 0, err
+	}
+	total := int64(l.maxWorker) + 1
+	for i := int64(0); i < total; i++ {
+		workerID := uint16((index - 1 + i) % total)
+		ok, err := l.client.SetNX(ctx, l.workerKey(workerID), l.token, workerIDTTL).Result()
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			return workerID, nil
+		}
+	}
+	return 0, errNoWorkerID
+}
+
+// lock acquires the allocation lock, and returns the function releasing it.
+func (l *workerLease) lock(ctx context.Context) (func(), error) {
+	ctx, cancel := context.WithTimeout(ctx, lockWait)
+	defer cancel()
+	for {
+		ok, err := l.client.SetNX(ctx, l.keys.lock, l.token, lockTTL).Result()
+		if err != nil {
+			return nil, fmt.Errorf("idgen: acquire worker id lock: %w", err)
+		}
+		if ok {
+			return func() {
+				if err := releaseScript.Run(context.Background(), l.client, []string{l.keys.lock}, l.token).Err(); err != nil {
+					l.log.Warnf("failed releasing worker id lock: %v", err)
+				}
+			}, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("idgen: acquire worker id lock: %w", ctx.Err())
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+// heartbeat renews the lease until released.
+func (l *workerLease) heartbeat() {
+	defer close(l.done)
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+			if err := l.renew(context.Background()); err != nil {
+				// 续期失败时保留当前机器码，租约过期前会再次尝试
+				l.log.Errorf("failed renewing worker id %d: %v", l.WorkerID(), err)
+			}
+		}
+	}
+}
+
+// renew extends the lease, reclaiming or reallocating the worker ID when the lease was lost.
+func (l *workerLease) renew(ctx context.Context) error {
+	workerID := l.WorkerID()
+	key := l.workerKey(workerID)
+	renewed, err := renewScript.Run(ctx, l.client, []string{key}, l.token, workerIDTTL.Milliseconds()).Int()
+	if err != nil {
+		return err
+	}
+	if renewed == 1 {
+		return nil
+	}
+
+	// 租约已过期，优先重新占用原机器码
+	ok, err := l.client.SetNX(ctx, key, l.token, workerIDTTL).Result()
+	if err != nil {
+		return err
+	}
+	if ok {
+		l.log.Warnf("worker id %d lease lost and reclaimed", workerID)
+		return nil
+	}
+
+	// 原机器码已被其他实例占用，重新分配
+	newID, err := l.allocate(ctx)
+	if err != nil {
+		return err
+	}
+	if err := l.onChange(newID); err != nil {
+		return err
+	}
+	l.mu.Lock()
+	l.workerID = newID
+	l.mu.Unlock()
+	l.log.Warnf("worker id %d taken by another instance, switched to %d", workerID, newID)
+	return nil
+}
+
+// workerKey returns the lease key of the worker ID.
+func (l *workerLease) workerKey(workerID uint16) string {
+	return l.keys.worker + strconv.Itoa(int(workerID))
+}
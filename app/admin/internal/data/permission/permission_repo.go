@@ -0,0 +1,120 @@
+package permission
+
+import (
+	"context"
+
+	bizpermission "qn-base/app/admin/internal/biz/permission"
+	"qn-base/app/admin/internal/data/data"
+	"qn-base/app/admin/internal/data/ent"
+	"qn-base/app/admin/internal/data/ent/systemrolemenu"
+	"qn-base/app/admin/internal/data/ent/systemuserrole"
+	"qn-base/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type permissionRepo struct {
+	data *data.Data
+	log  *log.Helper
+}
+
+// NewPermissionRepo .
+func NewPermissionRepo(data *data.Data, logger log.Logger) bizpermission.PermissionRepo {
+	return &permissionRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "permission/repo")),
+	}
+}
+
+// ListUserRoleIDs lists the role IDs of the user.
+func (s permissionRepo) ListUserRoleIDs(ctx context.Context, userID string) ([]string, error) {
+	return s.data.DB.SystemUserRole(ctx).Query().
+		Where(systemuserrole.UserID(userID)).
+		Unique(true).
+		Select(systemuserrole.FieldRoleID).
+		Strings(ctx)
+}
+
+// AddUserRoles adds the roles to the user.
+func (s permissionRepo) AddUserRoles(ctx context.Context, userID string, roleIDs []string) error {
+	if len(roleIDs) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.SystemUserRoleCreate, len(roleIDs))
+	for i, roleID := range roleIDs {
+		builders[i] = s.data.DB.SystemUserRole(ctx).Create().
+			SetUserID(userID).
+			SetRoleID(roleID)
+		// 设置租户ID
+		if tenantID := auth.TenantID(ctx); tenantID != "" {
+			builders[i].SetTenantID(tenantID)
+		}
+	}
+
+	return s.data.DB.SystemUserRole(ctx).CreateBulk(builders...).Exec(ctx)
+}
+
+// RemoveUserRoles removes all roles of the user.
+func (s permissionRepo) RemoveUserRoles(ctx context.Context, userID string) error {
+	_, err := s.data.DB.SystemUserRole(ctx).Delete().
+		Where(systemuserrole.UserID(userID)).
+		Exec(ctx)
+	return err
+}
+
+// RemoveMenusNotIn removes the menus not in menuIDs from all the roles of the tenant,
+// and returns the IDs of the roles changed.
+func (s permissionRepo) RemoveMenusNotIn(ctx context.Context, menuIDs []string) ([]string, error) {
+	query := s.data.DB.SystemRoleMenu(ctx).Query()
+	deletion := s.data.DB.SystemRoleMenu(ctx).Delete()
+	if len(menuIDs) > 0 {
+		query = query.Where(systemrolemenu.MenuIDNotIn(menuIDs...))
+		deletion = deletion.Where(systemrolemenu.MenuIDNotIn(menuIDs...))
+	}
+	roleIDs, err := query.Unique(true).Select(systemrolemenu.FieldRoleID).Strings(ctx)
+	if err != nil || len(roleIDs) == 0 {
+		return nil, err
+	}
+	if _, err := deletion.Exec(ctx); err != nil {
+		return nil, err
+	}
+	return roleIDs, nil
+}
+
+// ListRoleMenuIDs lists the menu IDs of the role.
+func (s permissionRepo) ListRoleMenuIDs(ctx context.Context, roleID string) ([]string, error) {
+	return s.data.DB.SystemRoleMenu(ctx).Query().
+		Where(systemrolemenu.RoleID(roleID)).
+		Unique(true).
+		Select(systemrolemenu.FieldMenuID).
+		Strings(ctx)
+}
+
+// AddRoleMenus adds the menus to the role.
+func (s permissionRepo) AddRoleMenus(ctx context.Context, roleID string, menuIDs []string) error {
+	if len(menuIDs) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.SystemRoleMenuCreate, len(menuIDs))
+	for i, menuID := range menuIDs {
+		builders[i] = s.data.DB.SystemRoleMenu(ctx).Create().
+			SetRoleID(roleID).
+			SetMenuID(menuID)
+		// 设置租户ID
+		if tenantID := auth.TenantID(ctx); tenantID != "" {
+			builders[i].SetTenantID(tenantID)
+		}
+	}
+
+	return s.data.DB.SystemRoleMenu(ctx).CreateBulk(builders...).Exec(ctx)
+}
+
+// RemoveRoleMenus removes all menus of the role.
+func (s permissionRepo) RemoveRoleMenus(ctx context.Context, roleID string) error {
+	_, err := s.data.DB.SystemRoleMenu(ctx).Delete().
+		Where(systemrolemenu.RoleID(roleID)).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,181 @@
+package policy
+
+import (
+	"context"
+
+	"qn-base/app/admin/internal/data/data"
+	"qn-base/app/admin/internal/data/ent"
+	"qn-base/app/admin/internal/data/ent/predicate"
+	"qn-base/app/admin/internal/data/ent/systemcasbinrule"
+
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+)
+
+// ruleFields is the number of rule fields stored in v0-v5.
+const ruleFields = 6
+
+// Adapter is a casbin adapter storing the rules in t_system_casbin_rule.
+type Adapter struct {
+	data *data.Data
+}
+
+var (
+	_ persist.Adapter      = (*Adapter)(nil)
+	_ persist.BatchAdapter = (*Adapter)(nil)
+)
+
+// NewAdapter .
+func NewAdapter(data *data.Data) *Adapter {
+	return &Adapter{data: data}
+}
+
+// LoadPolicy loads all policy rules from the storage.
+func (a *Adapter) LoadPolicy(m model.Model) error {
+	ctx := context.Background()
+	rules, err := a.data.DB.SystemCasbinRule(ctx).Query().
+		Order(ent.Asc(systemcasbinrule.FieldID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, rule := range rules {
+		if err := persist.LoadPolicyArray(toLine(rule), m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SavePolicy saves all policy rules to the storage, replacing the stored ones.
+func (a *Adapter) SavePolicy(m model.Model) error {
+	ctx := context.Background()
+	return a.data.DB.InTx(ctx, func(ctx context.Context) error {
+		if _, err := a.data.DB.SystemCasbinRule(ctx).Delete().Exec(ctx); err != nil {
+			return err
+		}
+		for _, sec := range []string{"p", "g"} {
+			for ptype, ast := range m[sec] {
+				if err := a.create(ctx, ptype, ast.Policy...); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// AddPolicy adds a policy rule to the storage.
+func (a *Adapter) AddPolicy(_ string, ptype string, rule []string) error {
+	return a.create(context.Background(), ptype, rule)
+}
+
+// AddPolicies adds policy rules to the storage.
+func (a *Adapter) AddPolicies(_ string, ptype string, rules [][]string) error {
+	return a.create(context.Background(), ptype, rules...)
+}
+
+// RemovePolicy removes a policy rule from the storage.
+func (a *Adapter) RemovePolicy(_ string, ptype string, rule []string) error {
+	ctx := context.Background()
+	_, err := a.data.DB.SystemCasbinRule(ctx).Delete().
+		Where(ruleEQ(ptype, rule)...).
+		Exec(ctx)
+	return err
+}
+
+// RemovePolicies removes policy rules from the storage.
+func (a *Adapter) RemovePolicies(_ string, ptype string, rules [][]string) error {
+	return a.data.DB.InTx(context.Background(), func(ctx context.Context) error {
+		for _, rule := range rules {
+			if _, err := a.data.DB.SystemCasbinRule(ctx).Delete().
+				Where(ruleEQ(ptype, rule)...).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// RemoveFilteredPolicy removes policy rules that match the filter from the storage.
+func (a *Adapter) RemoveFilteredPolicy(_ string, ptype string, fieldIndex int, fieldValues ...string) error {
+	ctx := context.Background()
+	predicates := []predicate.SystemCasbinRule{systemcasbinrule.Ptype(ptype)}
+	for i, value := range fieldValues {
+		// 空值表示不过滤该字段
+		if value == "" || fieldIndex+i >= ruleFields {
+			continue
+		}
+		predicates = append(predicates, fieldEQ(fieldIndex+i, value))
+	}
+	_, err := a.data.DB.SystemCasbinRule(ctx).Delete().
+		Where(predicates...).
+		Exec(ctx)
+	return err
+}
+
+// create stores the rules of the ptype.
+func (a *Adapter) create(ctx context.Context, ptype string, rules ...[]string) error {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.SystemCasbinRuleCreate, len(rules))
+	for i, rule := range rules {
+		values := padRule(rule)
+		builders[i] = a.data.DB.SystemCasbinRule(ctx).Create().
+			SetPtype(ptype).
+			SetV0(values[0]).
+			SetV1(values[1]).
+			SetV2(values[2]).
+			SetV3(values[3]).
+			SetV4(values[4]).
+			SetV5(values[5])
+	}
+	return a.data.DB.SystemCasbinRule(ctx).CreateBulk(builders...).Exec(ctx)
+}
+
+// ruleEQ returns the predicates matching exactly the rule of the ptype.
+func ruleEQ(ptype string, rule []string) []predicate.SystemCasbinRule {
+	values := padRule(rule)
+	predicates := []predicate.SystemCasbinRule{systemcasbinrule.Ptype(ptype)}
+	for i, value := range values {
+		predicates = append(predicates, fieldEQ(i, value))
+	}
+	return predicates
+}
+
+// fieldEQ returns the predicate of the rule field at index.
+func fieldEQ(index int, value string) predicate.SystemCasbinRule {
+	switch index {
+	case 0:
+		return systemcasbinrule.V0(value)
+	case 1:
+		return systemcasbinrule.V1(value)
+	case 2:
+		return systemcasbinrule.V2(value)
+	case 3:
+		return systemcasbinrule.V3(value)
+	case 4:
+		return systemcasbinrule.V4(value)
+	default:
+		return systemcasbinrule.V5(value)
+	}
+}
+
+// padRule returns the rule padded with empty fields to ruleFields.
+func padRule(rule []string) []string {
+	values := make([]string, ruleFields)
+	copy(values, rule)
+	return values
+}
+
+// toLine returns the rule as a casbin policy line, trailing empty fields are dropped.
+func toLine(rule *ent.SystemCasbinRule) []string {
+	line := []string{rule.Ptype, rule.V0, rule.V1, rule.V2, rule.V3, rule.V4, rule.V5}
+	for len(line) > 1 && line[len(line)-1] == "" {
+		line = line[:len(line)-1]
+	}
+	return line
+}
@@ -0,0 +1,67 @@
+package policy
+
+import (
+	"time"
+
+	"qn-base/app/admin/internal/conf"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// defaultModel is the RBAC with domains model, the domain is the tenant ID.
+//
+// 域为 * 的策略对所有租户生效；对象按 keyMatch 匹配接口操作；动作为权限标识，* 表示任意
+const defaultModel = `
+[request_definition]
+r = sub, dom, obj, act
+
+[policy_definition]
+p = sub, dom, obj, act
+
+[role_definition]
+g = _, _, _
+
+[policy_effect]
+e = some(where (p.eft == allow))
+
+[matchers]
+m = g(r.sub, p.sub, r.dom) && (p.dom == "*" || r.dom == p.dom) && keyMatch(r.obj, p.obj) && (p.act == "*" || r.act == p.act)
+`
+
+// NewEnforcer creates the casbin enforcer, returns nil when the policy engine is disabled.
+func NewEnforcer(c *conf.Bootstrap, adapter *Adapter, logger log.Logger) (*casbin.SyncedEnforcer, func(), error) {
+	helper := log.NewHelper(log.With(logger, "module", "policy/enforcer"))
+	cfg := c.GetCasbin()
+	if !cfg.GetEnabled() {
+		return nil, func() {}, nil
+	}
+
+	var (
+		m   model.Model
+		err error
+	)
+	if cfg.GetModelPath() != "" {
+		m, err = model.NewModelFromFile(cfg.GetModelPath())
+	} else {
+		m, err = model.NewModelFromString(defaultModel)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	e, err := casbin.NewSyncedEnforcer(m, adapter)
+	if err != nil {
+		return nil, nil, err
+	}
+	// 多实例部署时定时重新加载其他实例写入的策略
+	if interval := cfg.GetAutoLoadInterval(); interval > 0 {
+		e.StartAutoLoadPolicy(time.Duration(interval) * time.Second)
+	}
+
+	return e, func() {
+		helper.Info("message", "stopping the casbin enforcer")
+		e.StopAutoLoadPolicy()
+	}, nil
+}
@@ -0,0 +1,64 @@
+package policy
+
+import (
+	"context"
+
+	bizpolicy "qn-base/app/admin/internal/biz/policy"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type policyRepo struct {
+	enforcer *casbin.SyncedEnforcer
+	log      *log.Helper
+}
+
+// NewPolicyRepo . enforcer is nil when the policy engine is disabled.
+func NewPolicyRepo(enforcer *casbin.SyncedEnforcer, logger log.Logger) bizpolicy.PolicyRepo {
+	return &policyRepo{
+		enforcer: enforcer,
+		log:      log.NewHelper(log.With(logger, "module", "policy/repo")),
+	}
+}
+
+// Enabled reports whether the policy engine is enabled.
+func (s policyRepo) Enabled() bool {
+	return s.enforcer != nil
+}
+
+// ListPolicies lists the policies matching the request.
+func (s policyRepo) ListPolicies(_ context.Context, req *bizpolicy.ListPolicyRequest) ([]*bizpolicy.Policy, error) {
+	rules, err := s.enforcer.GetFilteredPolicy(0, req.Subject, req.Domain, req.Object)
+	if err != nil {
+		return nil, err
+	}
+	policies := make([]*bizpolicy.Policy, 0, len(rules))
+	for _, rule := range rules {
+		if len(rule) < 4 {
+			continue
+		}
+		policies = append(policies, &bizpolicy.Policy{
+			Subject: rule[0],
+			Domain:  rule[1],
+			Object:  rule[2],
+			Action:  rule[3],
+		})
+	}
+	return policies, nil
+}
+
+// AddPolicy adds the policy, returns false if it already exists.
+func (s policyRepo) AddPolicy(_ context.Context, p *bizpolicy.Policy) (bool, error) {
+	return s.enforcer.AddPolicy(p.Subject, p.Domain, p.Object, p.Action)
+}
+
+// RemovePolicy removes the policy, returns false if it does not exist.
+func (s policyRepo) RemovePolicy(_ context.Context, p *bizpolicy.Policy) (bool, error) {
+	return s.enforcer.RemovePolicy(p.Subject, p.Domain, p.Object, p.Action)
+}
+
+// Enforce decides whether the subject may perform the action on the object in the domain.
+func (s policyRepo) Enforce(_ context.Context, subject, domain, object, action string) (bool, error) {
+	return s.enforcer.Enforce(subject, domain, object, action)
+}
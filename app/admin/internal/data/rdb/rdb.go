@@ -1 +1,78 @@
 package rdb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"qn-base/app/admin/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// pingTimeout is how long the health check waits for redis.
+const pingTimeout = 3 * time.Second
+
+// Client is the redis client of the data layer,
+// shared by caching, token blacklists and locks.
+type Client struct {
+	*redis.Client
+
+	prefix string
+}
+
+// NewClient creates the redis client from the config, and checks the connection on startup.
+// 超时配置单位为秒
+func NewClient(c *conf.Bootstrap, logger log.Logger) (*Client, func(), error) {
+	helper := log.NewHelper(log.With(logger, "module", "data/rdb"))
+	rc := c.GetData().GetRedis()
+	if rc.GetAddr() == "" {
+		return nil, nil, fmt.Errorf("redis addr is not configured")
+	}
+
+	client := &Client{
+		Client: redis.NewClient(&redis.Options{
+			Network:      rc.GetNetwork(),
+			Addr:         rc.GetAddr(),
+			Password:     rc.GetPassword(),
+			DB:           int(rc.GetDb()),
+			ReadTimeout:  time.Duration(rc.GetReadTimeout()) * time.Second,
+			WriteTimeout: time.Duration(rc.GetWriteTimeout()) * time.Second,
+			PoolSize:     int(rc.GetPoolSize()),
+			MinIdleConns: int(rc.GetMinIdleConns()),
+		}),
+		prefix: strings.TrimSuffix(rc.GetKeyPrefix(), ":"),
+	}
+	if err := client.HealthCheck(context.Background()); err != nil {
+		_ = client.Close()
+		return nil, nil, err
+	}
+
+	return client, func() {
+		helper.Info("message", "closing the redis client")
+		if err := client.Close(); err != nil {
+			helper.Errorf("failed closing the redis client: %v", err)
+		}
+	}, nil
+}
+
+// HealthCheck pings redis.
+func (c *Client) HealthCheck(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+	if err := c.Ping(ctx).Err(); err != nil {
+		return fmt.Errorf("redis health check failed: %w", err)
+	}
+	return nil
+}
+
+// Key joins the parts with ":" under the configured prefix, e.g. Key("user", "1") is "qn-base:admin:user:1".
+func (c *Client) Key(parts ...string) string {
+	key := strings.Join(parts, ":")
+	if c.prefix == "" {
+		return key
+	}
+	return c.prefix + ":" + key
+}
@@ -0,0 +1,77 @@
+package rdb_test
+
+import (
+	"context"
+	"testing"
+
+	"qn-base/app/admin/internal/conf"
+	"qn-base/app/admin/internal/data/rdb"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newBootstrap(addr, prefix string) *conf.Bootstrap {
+	return &conf.Bootstrap{
+		Data: &conf.Data{
+			Redis: &conf.Data_Redis{
+				Addr:      addr,
+				KeyPrefix: prefix,
+			},
+		},
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	mr := miniredis.RunT(t)
+	ctx := context.Background()
+
+	t.Run("连接成功", func(t *testing.T) {
+		client, cleanup, err := rdb.NewClient(newBootstrap(mr.Addr(), "qn-base:admin:"), log.DefaultLogger)
+		require.NoError(t, err)
+
+		// 断言
+		assert.NoError(t, client.HealthCheck(ctx))
+		assert.NoError(t, client.Set(ctx, client.Key("user", "1"), "v", 0).Err())
+		assert.True(t, mr.Exists("qn-base:admin:user:1"))
+
+		cleanup()
+		assert.Error(t, client.HealthCheck(ctx))
+	})
+
+	t.Run("未配置地址", func(t *testing.T) {
+		_, _, err := rdb.NewClient(newBootstrap("", ""), log.DefaultLogger)
+		assert.Error(t, err)
+	})
+
+	t.Run("连接失败", func(t *testing.T) {
+		down := miniredis.RunT(t)
+		addr := down.Addr()
+		down.Close()
+
+		_, _, err := rdb.NewClient(newBootstrap(addr, ""), log.DefaultLogger)
+		assert.Error(t, err)
+	})
+}
+
+func TestClient_Key(t *testing.T) {
+	mr := miniredis.RunT(t)
+
+	t.Run("带前缀", func(t *testing.T) {
+		client, cleanup, err := rdb.NewClient(newBootstrap(mr.Addr(), "app"), log.DefaultLogger)
+		require.NoError(t, err)
+		defer cleanup()
+
+		assert.Equal(t, "app:token:blacklist:abc", client.Key("token", "blacklist", "abc"))
+	})
+
+	t.Run("无前缀", func(t *testing.T) {
+		client, cleanup, err := rdb.NewClient(newBootstrap(mr.Addr(), ""), log.DefaultLogger)
+		require.NoError(t, err)
+		defer cleanup()
+
+		assert.Equal(t, "lock:snowflake", client.Key("lock", "snowflake"))
+	})
+}
@@ -0,0 +1,235 @@
+package systemdept
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	bizsystemdept "qn-base/app/admin/internal/biz/systemdept"
+	"qn-base/app/admin/internal/data/data"
+	"qn-base/app/admin/internal/data/ent"
+	"qn-base/app/admin/internal/data/ent/predicate"
+	"qn-base/app/admin/internal/data/ent/systemdept"
+	"qn-base/app/admin/internal/data/ent/systemuser"
+	"qn-base/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type systemDeptRepo struct {
+	data *data.Data
+	log  *log.Helper
+}
+
+// convertToBizDept 将数据库实体转换为业务对象
+func (s systemDeptRepo) convertToBizDept(entity *ent.SystemDept) *bizsystemdept.SystemDept {
+	if entity == nil {
+		return nil
+	}
+
+	return &bizsystemdept.SystemDept{
+		ID:           &entity.ID,
+		CreateBy:     entity.CreateBy,
+		CreatedAt:    entity.CreatedAt,
+		UpdateBy:     entity.UpdateBy,
+		UpdatedAt:    entity.UpdatedAt,
+		TenantID:     &entity.TenantID,
+		Name:         &entity.Name,
+		ParentID:     &entity.ParentID,
+		Ancestors:    &entity.Ancestors,
+		Sort:         &entity.Sort,
+		LeaderUserID: entity.LeaderUserID,
+		Phone:        entity.Phone,
+		Email:        entity.Email,
+		Status:       &entity.Status,
+	}
+}
+
+// convertToBizDepts 批量转换数据库实体
+func (s systemDeptRepo) convertToBizDepts(entities []*ent.SystemDept) []*bizsystemdept.SystemDept {
+	depts := make([]*bizsystemdept.SystemDept, len(entities))
+	for i, entity := range entities {
+		depts[i] = s.convertToBizDept(entity)
+	}
+	return depts
+}
+
+// NewSystemDeptRepo .
+func NewSystemDeptRepo(data *data.Data, logger log.Logger) bizsystemdept.SystemDeptRepo {
+	return &systemDeptRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "systemdept/repo")),
+	}
+}
+
+func (s systemDeptRepo) Save(ctx context.Context, dept *bizsystemdept.SystemDept) (*bizsystemdept.SystemDept, error) {
+	// 创建部门
+	create := s.data.DB.SystemDept(ctx).Create().
+		SetName(*dept.Name).
+		SetNillableParentID(dept.ParentID).
+		SetNillableAncestors(dept.Ancestors).
+		SetNillableSort(dept.Sort).
+		SetNillableLeaderUserID(dept.LeaderUserID).
+		SetNillablePhone(dept.Phone).
+		SetNillableEmail(dept.Email).
+		SetNillableStatus(dept.Status)
+	if dept.TenantID != nil {
+		create.SetTenantID(*dept.TenantID)
+	}
+	result, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 转换为业务对象
+	return s.convertToBizDept(result), nil
+}
+
+func (s systemDeptRepo) Update(ctx context.Context, dept *bizsystemdept.SystemDept) (*bizsystemdept.SystemDept, error) {
+	// 检查ID是否为空
+	if dept.ID == nil {
+		return nil, fmt.Errorf("dept ID cannot be nil")
+	}
+
+	// 更新部门
+	result, err := s.data.DB.SystemDept(ctx).UpdateOneID(*dept.ID).
+		Where(systemdept.DeletedAtIsNil()).
+		SetNillableName(dept.Name).
+		SetNillableParentID(dept.ParentID).
+		SetNillableAncestors(dept.Ancestors).
+		SetNillableSort(dept.Sort).
+		SetNillableLeaderUserID(dept.LeaderUserID).
+		SetNillablePhone(dept.Phone).
+		SetNillableEmail(dept.Email).
+		SetNillableStatus(dept.Status).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, bizsystemdept.ErrDeptNotFound
+		}
+		return nil, err
+	}
+
+	// 转换为业务对象
+	return s.convertToBizDept(result), nil
+}
+
+func (s systemDeptRepo) Delete(ctx context.Context, id string) error {
+	// 删除部门（软删除，由 DeletedAt mixin 的 hook 转换为更新）
+	err := s.data.DB.SystemDept(ctx).DeleteOneID(id).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return bizsystemdept.ErrDeptNotFound
+	}
+	return err
+}
+
+func (s systemDeptRepo) FindByID(ctx context.Context, id string) (*bizsystemdept.SystemDept, error) {
+	result, err := s.data.DB.SystemDept(ctx).Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return s.convertToBizDept(result), nil
+}
+
+func (s systemDeptRepo) ListDepts(ctx context.Context, request *bizsystemdept.ListDeptRequest) ([]*bizsystemdept.SystemDept, error) {
+	query := s.data.DB.SystemDept(ctx).Query()
+
+	// 根据部门名称过滤（模糊匹配）
+	if request.Name != "" {
+		query = query.Where(systemdept.NameContains(request.Name))
+	}
+
+	// 根据状态过滤
+	if request.Status != nil {
+		query = query.Where(systemdept.Status(*request.Status))
+	}
+
+	// 部门数量有限，不分页，按显示顺序排序
+	results, err := query.
+		Order(ent.Asc(systemdept.FieldSort), ent.Asc(systemdept.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertToBizDepts(results), nil
+}
+
+// CountChildren counts the direct children of the dept.
+func (s systemDeptRepo) CountChildren(ctx context.Context, id string) (int, error) {
+	return s.data.DB.SystemDept(ctx).Query().
+		Where(systemdept.ParentID(id)).
+		Count(ctx)
+}
+
+// CountUsers counts the users of the dept, including those out of the data scope.
+func (s systemDeptRepo) CountUsers(ctx context.Context, id string) (int, error) {
+	ctx = auth.SkipDataScope(ctx)
+	return s.data.DB.SystemUser(ctx).Query().
+		Where(systemuser.DeptID(id)).
+		Count(ctx)
+}
+
+// ListDescendants lists the depts whose ancestors are path or start with path.
+// 按祖级列表前缀匹配，可以使用 ancestors 索引
+func (s systemDeptRepo) ListDescendants(ctx context.Context, path string) ([]*bizsystemdept.SystemDept, error) {
+	results, err := s.data.DB.SystemDept(ctx).Query().
+		Where(descendantsOf(path)).
+		Order(ent.Asc(systemdept.FieldSort), ent.Asc(systemdept.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertToBizDepts(results), nil
+}
+
+// Move sets the parent and ancestors of the dept, and rewrites the ancestors of its descendants.
+func (s systemDeptRepo) Move(ctx context.Context, id, parentID, ancestors string) error {
+	return s.data.DB.InTx(ctx, func(ctx context.Context) error {
+		dept, err := s.data.DB.SystemDept(ctx).Get(ctx, id)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return bizsystemdept.ErrDeptNotFound
+			}
+			return err
+		}
+		oldPath := dept.Ancestors + "," + id
+		newPath := ancestors + "," + id
+
+		if err := s.data.DB.SystemDept(ctx).UpdateOneID(id).
+			SetParentID(parentID).
+			SetAncestors(ancestors).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		descendants, err := s.data.DB.SystemDept(ctx).Query().
+			Where(descendantsOf(oldPath)).
+			ForUpdate().
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, descendant := range descendants {
+			if err := s.data.DB.SystemDept(ctx).UpdateOneID(descendant.ID).
+				SetAncestors(newPath + strings.TrimPrefix(descendant.Ancestors, oldPath)).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// descendantsOf returns the predicate of the depts under the ancestors path.
+func descendantsOf(path string) predicate.SystemDept {
+	return systemdept.Or(
+		systemdept.Ancestors(path),
+		systemdept.AncestorsHasPrefix(path+","),
+	)
+}
@@ -0,0 +1,142 @@
+package systemloginlog
+
+import (
+	"context"
+	"time"
+
+	bizsystemloginlog "qn-base/app/admin/internal/biz/systemloginlog"
+	"qn-base/app/admin/internal/data/data"
+	"qn-base/app/admin/internal/data/ent"
+	"qn-base/app/admin/internal/data/ent/systemloginlog"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type systemLoginLogRepo struct {
+	data *data.Data
+	log  *log.Helper
+}
+
+// convertToBizLoginLog 将数据库实体转换为业务对象
+func (s systemLoginLogRepo) convertToBizLoginLog(entity *ent.SystemLoginLog) *bizsystemloginlog.SystemLoginLog {
+	if entity == nil {
+		return nil
+	}
+
+	return &bizsystemloginlog.SystemLoginLog{
+		ID:        &entity.ID,
+		CreatedAt: entity.CreatedAt,
+		TenantID:  &entity.TenantID,
+		Event:     &entity.Event,
+		UserID:    entity.UserID,
+		Account:   &entity.Account,
+		Status:    &entity.Status,
+		Reason:    entity.Reason,
+		IP:        entity.IP,
+		UserAgent: entity.UserAgent,
+	}
+}
+
+// NewSystemLoginLogRepo .
+func NewSystemLoginLogRepo(data *data.Data, logger log.Logger) bizsystemloginlog.SystemLoginLogRepo {
+	return &systemLoginLogRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "systemloginlog/repo")),
+	}
+}
+
+func (s systemLoginLogRepo) Save(ctx context.Context, l *bizsystemloginlog.SystemLoginLog) (*bizsystemloginlog.SystemLoginLog, error) {
+	result, err := s.data.DB.SystemLoginLog(ctx).Create().
+		SetNillableEvent(l.Event).
+		SetNillableUserID(l.UserID).
+		SetAccount(*l.Account).
+		SetStatus(*l.Status).
+		SetNillableReason(l.Reason).
+		SetNillableIP(l.IP).
+		SetNillableUserAgent(l.UserAgent).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.convertToBizLoginLog(result), nil
+}
+
+// ListLoginLogs implements list login logs, latest first.
+func (s systemLoginLogRepo) ListLoginLogs(ctx context.Context, request *bizsystemloginlog.ListLoginLogRequest) ([]*bizsystemloginlog.SystemLoginLog, int32, error) {
+	query := s.data.DB.SystemLoginLog(ctx).Query()
+
+	// 根据事件类型过滤
+	if request.Event != "" {
+		query = query.Where(systemloginlog.Event(request.Event))
+	}
+
+	// 根据用户ID过滤
+	if request.UserID != "" {
+		query = query.Where(systemloginlog.UserID(request.UserID))
+	}
+
+	// 根据账号过滤（模糊匹配）
+	if request.Account != "" {
+		query = query.Where(systemloginlog.AccountContains(request.Account))
+	}
+
+	// 根据IP过滤（前缀匹配）
+	if request.IP != "" {
+		query = query.Where(systemloginlog.IPHasPrefix(request.IP))
+	}
+
+	// 根据结果过滤
+	if request.Status != nil {
+		query = query.Where(systemloginlog.Status(*request.Status))
+	}
+
+	// 根据失败原因过滤
+	if request.Reason != "" {
+		query = query.Where(systemloginlog.Reason(request.Reason))
+	}
+
+	// 根据登录时间范围过滤
+	if request.StartDate != "" {
+		startTime, err := time.Parse("2006-01-02", request.StartDate)
+		if err == nil {
+			query = query.Where(systemloginlog.CreatedAtGTE(startTime))
+		}
+	}
+	if request.EndDate != "" {
+		endTime, err := time.Parse("2006-01-02", request.EndDate)
+		if err == nil {
+			// 结束时间设置为当天的23:59:59
+			endTime = endTime.Add(24*time.Hour - time.Second)
+			query = query.Where(systemloginlog.CreatedAtLTE(endTime))
+		}
+	}
+
+	// 获取总数
+	count, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询，按登录时间倒序
+	results, err := query.
+		Offset(int((request.Page-1)*request.PageSize)).
+		Limit(int(request.PageSize)).
+		Order(ent.Desc(systemloginlog.FieldCreatedAt), ent.Desc(systemloginlog.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	logs := make([]*bizsystemloginlog.SystemLoginLog, len(results))
+	for i, result := range results {
+		logs[i] = s.convertToBizLoginLog(result)
+	}
+	return logs, int32(count), nil
+}
+
+// DeleteBefore implements delete the logs created before the time.
+func (s systemLoginLogRepo) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	return s.data.DB.SystemLoginLog(ctx).Delete().
+		Where(systemloginlog.CreatedAtLT(before)).
+		Exec(ctx)
+}
@@ -0,0 +1,233 @@
+package systemmenu
+
+import (
+	"context"
+	"fmt"
+
+	bizsystemmenu "qn-base/app/admin/internal/biz/systemmenu"
+	"qn-base/app/admin/internal/data/data"
+	"qn-base/app/admin/internal/data/ent"
+	"qn-base/app/admin/internal/data/ent/systemmenu"
+	"qn-base/app/admin/internal/data/ent/systemrole"
+	"qn-base/app/admin/internal/data/ent/systemrolemenu"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type systemMenuRepo struct {
+	data *data.Data
+	log  *log.Helper
+}
+
+// convertToBizMenu 将数据库实体转换为业务对象
+func (s systemMenuRepo) convertToBizMenu(entity *ent.SystemMenu) *bizsystemmenu.SystemMenu {
+	if entity == nil {
+		return nil
+	}
+
+	return &bizsystemmenu.SystemMenu{
+		ID:            &entity.ID,
+		CreateBy:      entity.CreateBy,
+		CreatedAt:     entity.CreatedAt,
+		UpdateBy:      entity.UpdateBy,
+		UpdatedAt:     entity.UpdatedAt,
+		Name:          &entity.Name,
+		Permission:    &entity.Permission,
+		Type:          &entity.Type,
+		Sort:          &entity.Sort,
+		ParentID:      &entity.ParentID,
+		Path:          entity.Path,
+		Icon:          entity.Icon,
+		Component:     entity.Component,
+		ComponentName: entity.ComponentName,
+		Status:        &entity.Status,
+		Visible:       &entity.Visible,
+		KeepAlive:     &entity.KeepAlive,
+		AlwaysShow:    &entity.AlwaysShow,
+	}
+}
+
+// convertToBizMenus 批量转换数据库实体
+func (s systemMenuRepo) convertToBizMenus(entities []*ent.SystemMenu) []*bizsystemmenu.SystemMenu {
+	menus := make([]*bizsystemmenu.SystemMenu, len(entities))
+	for i, entity := range entities {
+		menus[i] = s.convertToBizMenu(entity)
+	}
+	return menus
+}
+
+// NewSystemMenuRepo .
+func NewSystemMenuRepo(data *data.Data, logger log.Logger) bizsystemmenu.SystemMenuRepo {
+	return &systemMenuRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "systemmenu/repo")),
+	}
+}
+
+func (s systemMenuRepo) Save(ctx context.Context, menu *bizsystemmenu.SystemMenu) (*bizsystemmenu.SystemMenu, error) {
+	// 创建菜单
+	result, err := s.data.DB.SystemMenu(ctx).Create().
+		SetName(*menu.Name).
+		SetNillablePermission(menu.Permission).
+		SetType(*menu.Type).
+		SetNillableSort(menu.Sort).
+		SetNillableParentID(menu.ParentID).
+		SetNillablePath(menu.Path).
+		SetNillableIcon(menu.Icon).
+		SetNillableComponent(menu.Component).
+		SetNillableComponentName(menu.ComponentName).
+		SetNillableStatus(menu.Status).
+		SetNillableVisible(menu.Visible).
+		SetNillableKeepAlive(menu.KeepAlive).
+		SetNillableAlwaysShow(menu.AlwaysShow).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 转换为业务对象
+	return s.convertToBizMenu(result), nil
+}
+
+func (s systemMenuRepo) Update(ctx context.Context, menu *bizsystemmenu.SystemMenu) (*bizsystemmenu.SystemMenu, error) {
+	// 检查ID是否为空
+	if menu.ID == nil {
+		return nil, fmt.Errorf("menu ID cannot be nil")
+	}
+
+	// 更新菜单
+	result, err := s.data.DB.SystemMenu(ctx).UpdateOneID(*menu.ID).
+		Where(systemmenu.DeletedAtIsNil()).
+		SetNillableName(menu.Name).
+		SetNillablePermission(menu.Permission).
+		SetNillableType(menu.Type).
+		SetNillableSort(menu.Sort).
+		SetNillableParentID(menu.ParentID).
+		SetNillablePath(menu.Path).
+		SetNillableIcon(menu.Icon).
+		SetNillableComponent(menu.Component).
+		SetNillableComponentName(menu.ComponentName).
+		SetNillableStatus(menu.Status).
+		SetNillableVisible(menu.Visible).
+		SetNillableKeepAlive(menu.KeepAlive).
+		SetNillableAlwaysShow(menu.AlwaysShow).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, bizsystemmenu.ErrMenuNotFound
+		}
+		return nil, err
+	}
+
+	// 转换为业务对象
+	return s.convertToBizMenu(result), nil
+}
+
+func (s systemMenuRepo) Delete(ctx context.Context, id string) error {
+	// 删除菜单（软删除，由 DeletedAt mixin 的 hook 转换为更新）
+	err := s.data.DB.SystemMenu(ctx).DeleteOneID(id).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return bizsystemmenu.ErrMenuNotFound
+	}
+	return err
+}
+
+func (s systemMenuRepo) FindByID(ctx context.Context, id string) (*bizsystemmenu.SystemMenu, error) {
+	result, err := s.data.DB.SystemMenu(ctx).Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return s.convertToBizMenu(result), nil
+}
+
+// ListByIDs lists the menus with the given IDs, ordered by sort.
+func (s systemMenuRepo) ListByIDs(ctx context.Context, ids []string) ([]*bizsystemmenu.SystemMenu, error) {
+	results, err := s.data.DB.SystemMenu(ctx).Query().
+		Where(systemmenu.IDIn(ids...)).
+		Order(ent.Asc(systemmenu.FieldSort), ent.Asc(systemmenu.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertToBizMenus(results), nil
+}
+
+func (s systemMenuRepo) ListMenus(ctx context.Context, request *bizsystemmenu.ListMenuRequest) ([]*bizsystemmenu.SystemMenu, error) {
+	query := s.data.DB.SystemMenu(ctx).Query()
+
+	// 根据菜单名称过滤（模糊匹配）
+	if request.Name != "" {
+		query = query.Where(systemmenu.NameContains(request.Name))
+	}
+
+	// 根据状态过滤
+	if request.Status != nil {
+		query = query.Where(systemmenu.Status(*request.Status))
+	}
+
+	// 根据菜单类型过滤
+	if request.Type != nil {
+		query = query.Where(systemmenu.Type(*request.Type))
+	}
+
+	// 菜单数量有限，不分页，按显示顺序排序
+	results, err := query.
+		Order(ent.Asc(systemmenu.FieldSort), ent.Asc(systemmenu.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertToBizMenus(results), nil
+}
+
+// CountChildren counts the direct children of the menu.
+func (s systemMenuRepo) CountChildren(ctx context.Context, id string) (int, error) {
+	return s.data.DB.SystemMenu(ctx).Query().
+		Where(systemmenu.ParentID(id)).
+		Count(ctx)
+}
+
+// ListByRoleCodes lists the enabled menus granted to the enabled roles with the given codes.
+func (s systemMenuRepo) ListByRoleCodes(ctx context.Context, codes []string) ([]*bizsystemmenu.SystemMenu, error) {
+	if len(codes) == 0 {
+		return nil, nil
+	}
+
+	roleIDs, err := s.data.DB.SystemRole(ctx).Query().
+		Where(systemrole.CodeIn(codes...), systemrole.Status(1)).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(roleIDs) == 0 {
+		return nil, nil
+	}
+
+	menuIDs, err := s.data.DB.SystemRoleMenu(ctx).Query().
+		Where(systemrolemenu.RoleIDIn(roleIDs...)).
+		Unique(true).
+		Select(systemrolemenu.FieldMenuID).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(menuIDs) == 0 {
+		return nil, nil
+	}
+
+	results, err := s.data.DB.SystemMenu(ctx).Query().
+		Where(systemmenu.IDIn(menuIDs...), systemmenu.Status(1)).
+		Order(ent.Asc(systemmenu.FieldSort), ent.Asc(systemmenu.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertToBizMenus(results), nil
+}
@@ -0,0 +1,308 @@
+package systempost
+
+import (
+	"context"
+	"fmt"
+
+	bizsystempost "qn-base/app/admin/internal/biz/systempost"
+	"qn-base/app/admin/internal/data/data"
+	"qn-base/app/admin/internal/data/ent"
+	"qn-base/app/admin/internal/data/ent/systempost"
+	"qn-base/app/admin/internal/data/ent/systemuserpost"
+	"qn-base/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type systemPostRepo struct {
+	data *data.Data
+	log  *log.Helper
+}
+
+// convertToBizPost 将数据库实体转换为业务对象
+func (s systemPostRepo) convertToBizPost(entity *ent.SystemPost) *bizsystempost.SystemPost {
+	if entity == nil {
+		return nil
+	}
+
+	return &bizsystempost.SystemPost{
+		ID:        &entity.ID,
+		CreateBy:  entity.CreateBy,
+		CreatedAt: entity.CreatedAt,
+		UpdateBy:  entity.UpdateBy,
+		UpdatedAt: entity.UpdatedAt,
+		TenantID:  &entity.TenantID,
+		Code:      &entity.Code,
+		Name:      &entity.Name,
+		Sort:      &entity.Sort,
+		Status:    &entity.Status,
+		Remark:    entity.Remark,
+	}
+}
+
+// NewSystemPostRepo .
+func NewSystemPostRepo(data *data.Data, logger log.Logger) bizsystempost.SystemPostRepo {
+	return &systemPostRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "systempost/repo")),
+	}
+}
+
+func (s systemPostRepo) Save(ctx context.Context, post *bizsystempost.SystemPost) (*bizsystempost.SystemPost, error) {
+	// 创建岗位
+	create := s.data.DB.SystemPost(ctx).Create().
+		SetCode(*post.Code).
+		SetName(*post.Name).
+		SetNillableSort(post.Sort).
+		SetNillableStatus(post.Status).
+		SetNillableRemark(post.Remark)
+
+	// 设置租户ID
+	if post.TenantID != nil {
+		create.SetTenantID(*post.TenantID)
+	}
+
+	result, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 转换为业务对象
+	return s.convertToBizPost(result), nil
+}
+
+func (s systemPostRepo) Update(ctx context.Context, post *bizsystempost.SystemPost) (*bizsystempost.SystemPost, error) {
+	// 检查ID是否为空
+	if post.ID == nil {
+		return nil, fmt.Errorf("post ID cannot be nil")
+	}
+
+	// 更新岗位
+	result, err := s.data.DB.SystemPost(ctx).UpdateOneID(*post.ID).
+		Where(systempost.DeletedAtIsNil()).
+		SetNillableCode(post.Code).
+		SetNillableName(post.Name).
+		SetNillableSort(post.Sort).
+		SetNillableStatus(post.Status).
+		SetNillableRemark(post.Remark).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, bizsystempost.ErrPostNotFound
+		}
+		return nil, err
+	}
+
+	// 转换为业务对象
+	return s.convertToBizPost(result), nil
+}
+
+func (s systemPostRepo) Delete(ctx context.Context, id string) error {
+	// 删除岗位（软删除，由 DeletedAt mixin 的 hook 转换为更新）
+	err := s.data.DB.SystemPost(ctx).DeleteOneID(id).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return bizsystempost.ErrPostNotFound
+	}
+	return err
+}
+
+func (s systemPostRepo) FindByID(ctx context.Context, id string) (*bizsystempost.SystemPost, error) {
+	result, err := s.data.DB.SystemPost(ctx).Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return s.convertToBizPost(result), nil
+}
+
+func (s systemPostRepo) FindByCode(ctx context.Context, code string) (*bizsystempost.SystemPost, error) {
+	result, err := s.data.DB.SystemPost(ctx).Query().
+		Where(systempost.Code(code)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return s.convertToBizPost(result), nil
+}
+
+func (s systemPostRepo) FindByName(ctx context.Context, name string) (*bizsystempost.SystemPost, error) {
+	result, err := s.data.DB.SystemPost(ctx).Query().
+		Where(systempost.Name(name)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return s.convertToBizPost(result), nil
+}
+
+// ListByIDs lists the posts with the given IDs, ordered by sort.
+func (s systemPostRepo) ListByIDs(ctx context.Context, ids []string) ([]*bizsystempost.SystemPost, error) {
+	results, err := s.data.DB.SystemPost(ctx).Query().
+		Where(systempost.IDIn(ids...)).
+		Order(ent.Asc(systempost.FieldSort), ent.Asc(systempost.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertToBizPosts(results), nil
+}
+
+func (s systemPostRepo) ListPosts(ctx context.Context, request *bizsystempost.ListPostRequest) ([]*bizsystempost.SystemPost, int32, error) {
+	query := s.data.DB.SystemPost(ctx).Query()
+
+	// 根据岗位编码过滤（模糊匹配）
+	if request.Code != "" {
+		query = query.Where(systempost.CodeContains(request.Code))
+	}
+
+	// 根据岗位名称过滤（模糊匹配）
+	if request.Name != "" {
+		query = query.Where(systempost.NameContains(request.Name))
+	}
+
+	// 根据状态过滤
+	if request.Status != nil {
+		query = query.Where(systempost.Status(*request.Status))
+	}
+
+	// 获取总数
+	count, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询，按显示顺序排序
+	results, err := query.
+		Offset(int((request.Page-1)*request.PageSize)).
+		Limit(int(request.PageSize)).
+		Order(ent.Asc(systempost.FieldSort), ent.Desc(systempost.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return s.convertToBizPosts(results), int32(count), nil
+}
+
+// ChangeStatus implements change post status.
+func (s systemPostRepo) ChangeStatus(ctx context.Context, id string, status int8) error {
+	affected, err := s.data.DB.SystemPost(ctx).Update().
+		Where(systempost.ID(id)).
+		Where(systempost.DeletedAtIsNil()).
+		SetStatus(status).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return bizsystempost.ErrPostNotFound
+	}
+
+	return nil
+}
+
+// UpdateSort implements update post sort.
+func (s systemPostRepo) UpdateSort(ctx context.Context, id string, sort int32) error {
+	affected, err := s.data.DB.SystemPost(ctx).Update().
+		Where(systempost.ID(id)).
+		Where(systempost.DeletedAtIsNil()).
+		SetSort(sort).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return bizsystempost.ErrPostNotFound
+	}
+
+	return nil
+}
+
+// CountUsers counts the users of the post.
+func (s systemPostRepo) CountUsers(ctx context.Context, id string) (int, error) {
+	return s.data.DB.SystemUserPost(ctx).Query().
+		Where(systemuserpost.PostID(id)).
+		Count(ctx)
+}
+
+// ListUserPosts lists the posts of the users, keyed by user ID, deleted posts are not returned.
+func (s systemPostRepo) ListUserPosts(ctx context.Context, userIDs []string) (map[string][]*bizsystempost.SystemPost, error) {
+	relations, err := s.data.DB.SystemUserPost(ctx).Query().
+		Where(systemuserpost.UserIDIn(userIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(relations) == 0 {
+		return map[string][]*bizsystempost.SystemPost{}, nil
+	}
+
+	postIDs := make([]string, 0, len(relations))
+	postUsers := make(map[string][]string, len(relations))
+	for _, r := range relations {
+		postIDs = append(postIDs, r.PostID)
+		postUsers[r.PostID] = append(postUsers[r.PostID], r.UserID)
+	}
+	posts, err := s.ListByIDs(ctx, postIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// 按岗位的显示顺序返回
+	result := make(map[string][]*bizsystempost.SystemPost, len(userIDs))
+	for _, post := range posts {
+		for _, userID := range postUsers[*post.ID] {
+			result[userID] = append(result[userID], post)
+		}
+	}
+	return result, nil
+}
+
+// ReplaceUserPosts replaces all posts of the user, an empty postIDs clears them.
+func (s systemPostRepo) ReplaceUserPosts(ctx context.Context, userID string, postIDs []string) error {
+	return s.data.DB.InTx(ctx, func(ctx context.Context) error {
+		if _, err := s.data.DB.SystemUserPost(ctx).Delete().
+			Where(systemuserpost.UserID(userID)).
+			Exec(ctx); err != nil {
+			return err
+		}
+		if len(postIDs) == 0 {
+			return nil
+		}
+
+		builders := make([]*ent.SystemUserPostCreate, len(postIDs))
+		for i, postID := range postIDs {
+			builders[i] = s.data.DB.SystemUserPost(ctx).Create().
+				SetUserID(userID).
+				SetPostID(postID)
+			// 设置租户ID
+			if tenantID := auth.TenantID(ctx); tenantID != "" {
+				builders[i].SetTenantID(tenantID)
+			}
+		}
+		return s.data.DB.SystemUserPost(ctx).CreateBulk(builders...).Exec(ctx)
+	})
+}
+
+// convertToBizPosts 将数据库实体列表转换为业务对象列表
+func (s systemPostRepo) convertToBizPosts(results []*ent.SystemPost) []*bizsystempost.SystemPost {
+	posts := make([]*bizsystempost.SystemPost, len(results))
+	for i, result := range results {
+		posts[i] = s.convertToBizPost(result)
+	}
+	return posts
+}
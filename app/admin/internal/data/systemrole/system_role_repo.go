@@ -0,0 +1,262 @@
+package systemrole
+
+import (
+	"context"
+	"fmt"
+
+	bizsystemrole "qn-base/app/admin/internal/biz/systemrole"
+	"qn-base/app/admin/internal/data/data"
+	"qn-base/app/admin/internal/data/ent"
+	"qn-base/app/admin/internal/data/ent/systemrole"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type systemRoleRepo struct {
+	data *data.Data
+	log  *log.Helper
+}
+
+// convertToBizRole 将数据库实体转换为业务对象
+func (s systemRoleRepo) convertToBizRole(entity *ent.SystemRole) *bizsystemrole.SystemRole {
+	if entity == nil {
+		return nil
+	}
+
+	return &bizsystemrole.SystemRole{
+		ID:               &entity.ID,
+		CreateBy:         entity.CreateBy,
+		CreatedAt:        entity.CreatedAt,
+		UpdateBy:         entity.UpdateBy,
+		UpdatedAt:        entity.UpdatedAt,
+		TenantID:         &entity.TenantID,
+		Name:             &entity.Name,
+		Code:             &entity.Code,
+		Sort:             &entity.Sort,
+		DataScope:        &entity.DataScope,
+		DataScopeDeptIDs: entity.DataScopeDeptIds,
+		Status:           &entity.Status,
+		Type:             &entity.Type,
+		Remark:           entity.Remark,
+	}
+}
+
+// NewSystemRoleRepo .
+func NewSystemRoleRepo(data *data.Data, logger log.Logger) bizsystemrole.SystemRoleRepo {
+	return &systemRoleRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "systemrole/repo")),
+	}
+}
+
+func (s systemRoleRepo) Save(ctx context.Context, role *bizsystemrole.SystemRole) (*bizsystemrole.SystemRole, error) {
+	// 创建角色
+	create := s.data.DB.SystemRole(ctx).Create().
+		SetName(*role.Name).
+		SetCode(*role.Code).
+		SetNillableSort(role.Sort).
+		SetNillableDataScope(role.DataScope).
+		SetNillableStatus(role.Status).
+		SetNillableType(role.Type).
+		SetNillableRemark(role.Remark)
+
+	if role.DataScopeDeptIDs != nil {
+		create.SetDataScopeDeptIds(role.DataScopeDeptIDs)
+	}
+
+	// 设置租户ID
+	if role.TenantID != nil {
+		create.SetTenantID(*role.TenantID)
+	}
+
+	result, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 转换为业务对象
+	return s.convertToBizRole(result), nil
+}
+
+func (s systemRoleRepo) Update(ctx context.Context, role *bizsystemrole.SystemRole) (*bizsystemrole.SystemRole, error) {
+	// 检查ID是否为空
+	if role.ID == nil {
+		return nil, fmt.Errorf("role ID cannot be nil")
+	}
+
+	// 更新角色
+	update := s.data.DB.SystemRole(ctx).UpdateOneID(*role.ID).
+		Where(systemrole.DeletedAtIsNil()).
+		SetNillableName(role.Name).
+		SetNillableCode(role.Code).
+		SetNillableSort(role.Sort).
+		SetNillableDataScope(role.DataScope).
+		SetNillableStatus(role.Status).
+		SetNillableType(role.Type).
+		SetNillableRemark(role.Remark)
+
+	if role.DataScopeDeptIDs != nil {
+		update.SetDataScopeDeptIds(role.DataScopeDeptIDs)
+	}
+
+	result, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, bizsystemrole.ErrRoleNotFound
+		}
+		return nil, err
+	}
+
+	// 转换为业务对象
+	return s.convertToBizRole(result), nil
+}
+
+func (s systemRoleRepo) Delete(ctx context.Context, id string) error {
+	// 删除角色（软删除，由 DeletedAt mixin 的 hook 转换为更新）
+	err := s.data.DB.SystemRole(ctx).DeleteOneID(id).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return bizsystemrole.ErrRoleNotFound
+	}
+	return err
+}
+
+func (s systemRoleRepo) FindByID(ctx context.Context, id string) (*bizsystemrole.SystemRole, error) {
+	result, err := s.data.DB.SystemRole(ctx).Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return s.convertToBizRole(result), nil
+}
+
+func (s systemRoleRepo) FindByCode(ctx context.Context, code string) (*bizsystemrole.SystemRole, error) {
+	result, err := s.data.DB.SystemRole(ctx).Query().
+		Where(systemrole.Code(code)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return s.convertToBizRole(result), nil
+}
+
+func (s systemRoleRepo) FindByName(ctx context.Context, name string) (*bizsystemrole.SystemRole, error) {
+	result, err := s.data.DB.SystemRole(ctx).Query().
+		Where(systemrole.Name(name)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return s.convertToBizRole(result), nil
+}
+
+// ListByIDs lists the roles with the given IDs, ordered by sort.
+func (s systemRoleRepo) ListByIDs(ctx context.Context, ids []string) ([]*bizsystemrole.SystemRole, error) {
+	results, err := s.data.DB.SystemRole(ctx).Query().
+		Where(systemrole.IDIn(ids...)).
+		Order(ent.Asc(systemrole.FieldSort), ent.Asc(systemrole.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]*bizsystemrole.SystemRole, len(results))
+	for i, result := range results {
+		roles[i] = s.convertToBizRole(result)
+	}
+
+	return roles, nil
+}
+
+func (s systemRoleRepo) ListRoles(ctx context.Context, request *bizsystemrole.ListRoleRequest) ([]*bizsystemrole.SystemRole, int32, error) {
+	query := s.data.DB.SystemRole(ctx).Query()
+
+	// 根据角色名称过滤（模糊匹配）
+	if request.Name != "" {
+		query = query.Where(systemrole.NameContains(request.Name))
+	}
+
+	// 根据角色编码过滤（模糊匹配）
+	if request.Code != "" {
+		query = query.Where(systemrole.CodeContains(request.Code))
+	}
+
+	// 根据状态过滤
+	if request.Status != nil {
+		query = query.Where(systemrole.Status(*request.Status))
+	}
+
+	// 根据角色类型过滤
+	if request.Type != nil {
+		query = query.Where(systemrole.Type(*request.Type))
+	}
+
+	// 获取总数
+	count, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询，按显示顺序排序
+	results, err := query.
+		Offset(int((request.Page-1)*request.PageSize)).
+		Limit(int(request.PageSize)).
+		Order(ent.Asc(systemrole.FieldSort), ent.Desc(systemrole.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	roles := make([]*bizsystemrole.SystemRole, len(results))
+	for i, result := range results {
+		roles[i] = s.convertToBizRole(result)
+	}
+
+	return roles, int32(count), nil
+}
+
+// ChangeStatus implements change role status.
+func (s systemRoleRepo) ChangeStatus(ctx context.Context, id string, status int8) error {
+	affected, err := s.data.DB.SystemRole(ctx).Update().
+		Where(systemrole.ID(id)).
+		Where(systemrole.DeletedAtIsNil()).
+		SetStatus(status).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return bizsystemrole.ErrRoleNotFound
+	}
+
+	return nil
+}
+
+// UpdateSort implements update role sort.
+func (s systemRoleRepo) UpdateSort(ctx context.Context, id string, sort int32) error {
+	affected, err := s.data.DB.SystemRole(ctx).Update().
+		Where(systemrole.ID(id)).
+		Where(systemrole.DeletedAtIsNil()).
+		SetSort(sort).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return bizsystemrole.ErrRoleNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package systemtenant
+
+import (
+	"context"
+	"fmt"
+
+	bizsystemtenant "qn-base/app/admin/internal/biz/systemtenant"
+	"qn-base/app/admin/internal/data/data"
+	"qn-base/app/admin/internal/data/ent"
+	"qn-base/app/admin/internal/data/ent/systemtenantpackage"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type systemTenantPackageRepo struct {
+	data *data.Data
+	log  *log.Helper
+}
+
+// convertToBizPackage 将数据库实体转换为业务对象
+func (s systemTenantPackageRepo) convertToBizPackage(entity *ent.SystemTenantPackage) *bizsystemtenant.SystemTenantPackage {
+	if entity == nil {
+		return nil
+	}
+
+	return &bizsystemtenant.SystemTenantPackage{
+		ID:        &entity.ID,
+		CreateBy:  entity.CreateBy,
+		CreatedAt: entity.CreatedAt,
+		UpdateBy:  entity.UpdateBy,
+		UpdatedAt: entity.UpdatedAt,
+		Name:      &entity.Name,
+		Status:    &entity.Status,
+		MenuIDs:   entity.MenuIds,
+		Remark:    entity.Remark,
+	}
+}
+
+// NewSystemTenantPackageRepo .
+func NewSystemTenantPackageRepo(data *data.Data, logger log.Logger) bizsystemtenant.SystemTenantPackageRepo {
+	return &systemTenantPackageRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "systemtenant/repo/package")),
+	}
+}
+
+func (s systemTenantPackageRepo) Save(ctx context.Context, pkg *bizsystemtenant.SystemTenantPackage) (*bizsystemtenant.SystemTenantPackage, error) {
+	// 创建套餐
+	result, err := s.data.DB.SystemTenantPackage(ctx).Create().
+		SetName(*pkg.Name).
+		SetNillableStatus(pkg.Status).
+		SetMenuIds(pkg.MenuIDs).
+		SetNillableRemark(pkg.Remark).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 转换为业务对象
+	return s.convertToBizPackage(result), nil
+}
+
+func (s systemTenantPackageRepo) Update(ctx context.Context, pkg *bizsystemtenant.SystemTenantPackage) (*bizsystemtenant.SystemTenantPackage, error) {
+	// 检查ID是否为空
+	if pkg.ID == nil {
+		return nil, fmt.Errorf("tenant package ID cannot be nil")
+	}
+
+	// 更新套餐
+	update := s.data.DB.SystemTenantPackage(ctx).UpdateOneID(*pkg.ID).
+		Where(systemtenantpackage.DeletedAtIsNil()).
+		SetNillableName(pkg.Name).
+		SetNillableStatus(pkg.Status).
+		SetNillableRemark(pkg.Remark)
+
+	if pkg.MenuIDs != nil {
+		update.SetMenuIds(pkg.MenuIDs)
+	}
+
+	result, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, bizsystemtenant.ErrTenantPackageNotFound
+		}
+		return nil, err
+	}
+
+	// 转换为业务对象
+	return s.convertToBizPackage(result), nil
+}
+
+func (s systemTenantPackageRepo) Delete(ctx context.Context, id string) error {
+	// 删除套餐（软删除，由 DeletedAt mixin 的 hook 转换为更新）
+	err := s.data.DB.SystemTenantPackage(ctx).DeleteOneID(id).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return bizsystemtenant.ErrTenantPackageNotFound
+	}
+	return err
+}
+
+func (s systemTenantPackageRepo) FindByID(ctx context.Context, id string) (*bizsystemtenant.SystemTenantPackage, error) {
+	result, err := s.data.DB.SystemTenantPackage(ctx).Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return s.convertToBizPackage(result), nil
+}
+
+func (s systemTenantPackageRepo) FindByName(ctx context.Context, name string) (*bizsystemtenant.SystemTenantPackage, error) {
+	result, err := s.data.DB.SystemTenantPackage(ctx).Query().
+		Where(systemtenantpackage.Name(name)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return s.convertToBizPackage(result), nil
+}
+
+func (s systemTenantPackageRepo) ListTenantPackages(ctx context.Context, request *bizsystemtenant.ListTenantPackageRequest) ([]*bizsystemtenant.SystemTenantPackage, int32, error) {
+	query := s.data.DB.SystemTenantPackage(ctx).Query()
+
+	// 根据套餐名过滤（模糊匹配）
+	if request.Name != "" {
+		query = query.Where(systemtenantpackage.NameContains(request.Name))
+	}
+
+	// 根据状态过滤
+	if request.Status != nil {
+		query = query.Where(systemtenantpackage.Status(*request.Status))
+	}
+
+	// 获取总数
+	count, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询，按创建时间倒序
+	results, err := query.
+		Offset(int((request.Page - 1) * request.PageSize)).
+		Limit(int(request.PageSize)).
+		Order(ent.Desc(systemtenantpackage.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	packages := make([]*bizsystemtenant.SystemTenantPackage, len(results))
+	for i, result := range results {
+		packages[i] = s.convertToBizPackage(result)
+	}
+
+	return packages, int32(count), nil
+}
+
+// ChangeStatus implements change tenant package status.
+func (s systemTenantPackageRepo) ChangeStatus(ctx context.Context, id string, status int8) error {
+	affected, err := s.data.DB.SystemTenantPackage(ctx).Update().
+		Where(systemtenantpackage.ID(id)).
+		Where(systemtenantpackage.DeletedAtIsNil()).
+		SetStatus(status).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return bizsystemtenant.ErrTenantPackageNotFound
+	}
+
+	return nil
+}
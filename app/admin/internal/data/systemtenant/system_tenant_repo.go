@@ -0,0 +1,240 @@
+package systemtenant
+
+import (
+	"context"
+	"fmt"
+
+	bizsystemtenant "qn-base/app/admin/internal/biz/systemtenant"
+	"qn-base/app/admin/internal/data/data"
+	"qn-base/app/admin/internal/data/ent"
+	"qn-base/app/admin/internal/data/ent/systemtenant"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type systemTenantRepo struct {
+	data *data.Data
+	log  *log.Helper
+}
+
+// convertToBizTenant 将数据库实体转换为业务对象
+func (s systemTenantRepo) convertToBizTenant(entity *ent.SystemTenant) *bizsystemtenant.SystemTenant {
+	if entity == nil {
+		return nil
+	}
+
+	return &bizsystemtenant.SystemTenant{
+		ID:            &entity.ID,
+		CreateBy:      entity.CreateBy,
+		CreatedAt:     entity.CreatedAt,
+		UpdateBy:      entity.UpdateBy,
+		UpdatedAt:     entity.UpdatedAt,
+		Name:          &entity.Name,
+		ContactUserID: entity.ContactUserID,
+		ContactName:   &entity.ContactName,
+		ContactMobile: entity.ContactMobile,
+		Status:        &entity.Status,
+		Website:       entity.Website,
+		PackageID:     &entity.PackageID,
+		ExpireTime:    &entity.ExpireTime,
+		AccountCount:  &entity.AccountCount,
+		MFARequired:   &entity.MfaRequired,
+	}
+}
+
+// NewSystemTenantRepo .
+func NewSystemTenantRepo(data *data.Data, logger log.Logger) bizsystemtenant.SystemTenantRepo {
+	return &systemTenantRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "systemtenant/repo")),
+	}
+}
+
+func (s systemTenantRepo) Save(ctx context.Context, tenant *bizsystemtenant.SystemTenant) (*bizsystemtenant.SystemTenant, error) {
+	// 创建租户
+	result, err := s.data.DB.SystemTenant(ctx).Create().
+		SetName(*tenant.Name).
+		SetNillableContactUserID(tenant.ContactUserID).
+		SetContactName(*tenant.ContactName).
+		SetNillableContactMobile(tenant.ContactMobile).
+		SetNillableStatus(tenant.Status).
+		SetNillableWebsite(tenant.Website).
+		SetPackageID(*tenant.PackageID).
+		SetExpireTime(*tenant.ExpireTime).
+		SetAccountCount(*tenant.AccountCount).
+		SetNillableMfaRequired(tenant.MFARequired).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 转换为业务对象
+	return s.convertToBizTenant(result), nil
+}
+
+func (s systemTenantRepo) Update(ctx context.Context, tenant *bizsystemtenant.SystemTenant) (*bizsystemtenant.SystemTenant, error) {
+	// 检查ID是否为空
+	if tenant.ID == nil {
+		return nil, fmt.Errorf("tenant ID cannot be nil")
+	}
+
+	// 更新租户
+	result, err := s.data.DB.SystemTenant(ctx).UpdateOneID(*tenant.ID).
+		Where(systemtenant.DeletedAtIsNil()).
+		SetNillableName(tenant.Name).
+		SetNillableContactUserID(tenant.ContactUserID).
+		SetNillableContactName(tenant.ContactName).
+		SetNillableContactMobile(tenant.ContactMobile).
+		SetNillableStatus(tenant.Status).
+		SetNillableWebsite(tenant.Website).
+		SetNillablePackageID(tenant.PackageID).
+		SetNillableExpireTime(tenant.ExpireTime).
+		SetNillableAccountCount(tenant.AccountCount).
+		SetNillableMfaRequired(tenant.MFARequired).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, bizsystemtenant.ErrTenantNotFound
+		}
+		return nil, err
+	}
+
+	// 转换为业务对象
+	return s.convertToBizTenant(result), nil
+}
+
+func (s systemTenantRepo) Delete(ctx context.Context, id string) error {
+	// 删除租户（软删除，由 DeletedAt mixin 的 hook 转换为更新）
+	err := s.data.DB.SystemTenant(ctx).DeleteOneID(id).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return bizsystemtenant.ErrTenantNotFound
+	}
+	return err
+}
+
+func (s systemTenantRepo) FindByID(ctx context.Context, id string) (*bizsystemtenant.SystemTenant, error) {
+	result, err := s.data.DB.SystemTenant(ctx).Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return s.convertToBizTenant(result), nil
+}
+
+func (s systemTenantRepo) FindByName(ctx context.Context, name string) (*bizsystemtenant.SystemTenant, error) {
+	result, err := s.data.DB.SystemTenant(ctx).Query().
+		Where(systemtenant.Name(name)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return s.convertToBizTenant(result), nil
+}
+
+func (s systemTenantRepo) FindByWebsite(ctx context.Context, website string) (*bizsystemtenant.SystemTenant, error) {
+	result, err := s.data.DB.SystemTenant(ctx).Query().
+		Where(systemtenant.Website(website)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return s.convertToBizTenant(result), nil
+}
+
+func (s systemTenantRepo) ListTenants(ctx context.Context, request *bizsystemtenant.ListTenantRequest) ([]*bizsystemtenant.SystemTenant, int32, error) {
+	query := s.data.DB.SystemTenant(ctx).Query()
+
+	// 根据租户名过滤（模糊匹配）
+	if request.Name != "" {
+		query = query.Where(systemtenant.NameContains(request.Name))
+	}
+
+	// 根据联系人过滤（模糊匹配）
+	if request.ContactName != "" {
+		query = query.Where(systemtenant.ContactNameContains(request.ContactName))
+	}
+
+	// 根据联系手机过滤（模糊匹配）
+	if request.ContactMobile != "" {
+		query = query.Where(systemtenant.ContactMobileContains(request.ContactMobile))
+	}
+
+	// 根据状态过滤
+	if request.Status != nil {
+		query = query.Where(systemtenant.Status(*request.Status))
+	}
+
+	// 根据套餐过滤
+	if request.PackageID != "" {
+		query = query.Where(systemtenant.PackageID(request.PackageID))
+	}
+
+	// 获取总数
+	count, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询，按创建时间倒序
+	results, err := query.
+		Offset(int((request.Page - 1) * request.PageSize)).
+		Limit(int(request.PageSize)).
+		Order(ent.Desc(systemtenant.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	tenants := make([]*bizsystemtenant.SystemTenant, len(results))
+	for i, result := range results {
+		tenants[i] = s.convertToBizTenant(result)
+	}
+
+	return tenants, int32(count), nil
+}
+
+// ChangeStatus implements change tenant status.
+func (s systemTenantRepo) ChangeStatus(ctx context.Context, id string, status int8) error {
+	affected, err := s.data.DB.SystemTenant(ctx).Update().
+		Where(systemtenant.ID(id)).
+		Where(systemtenant.DeletedAtIsNil()).
+		SetStatus(status).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return bizsystemtenant.ErrTenantNotFound
+	}
+
+	return nil
+}
+
+// ListByPackageID lists the tenants of the package.
+func (s systemTenantRepo) ListByPackageID(ctx context.Context, packageID string) ([]*bizsystemtenant.SystemTenant, error) {
+	results, err := s.data.DB.SystemTenant(ctx).Query().
+		Where(systemtenant.PackageID(packageID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tenants := make([]*bizsystemtenant.SystemTenant, len(results))
+	for i, result := range results {
+		tenants[i] = s.convertToBizTenant(result)
+	}
+
+	return tenants, nil
+}
@@ -0,0 +1,376 @@
+package systemuser
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	bizsystemuser "qn-base/app/admin/internal/biz/systemuser"
+	"qn-base/app/admin/internal/data/ent"
+	"qn-base/app/admin/internal/data/rdb"
+	"qn-base/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// userCacheTTL is how long a user is cached in redis.
+	userCacheTTL = 5 * time.Minute
+	// userNegativeTTL is how long a missing user is cached in redis.
+	userNegativeTTL = 30 * time.Second
+	// localCacheSize is the number of entries cached in process.
+	localCacheSize = 4096
+	// localCacheTTL bounds how long other instances serve a user after it changed.
+	localCacheTTL = 10 * time.Second
+
+	// 索引键的字段
+	indexAccount = "account"
+	indexEmail   = "email"
+	indexMobile  = "mobile"
+)
+
+// cachedSystemUserRepo decorates SystemUserRepo with a read-through cache of the user lookups.
+//
+// 缓存分为进程内 LRU 与 redis 两级，键按租户隔离：
+//   - user:{tenant}:id:{id} 保存不受数据范围限制加载的用户，读取时再按调用方的数据范围过滤
+//   - user:{tenant}:{account|email|mobile}:{value} 保存用户ID，命中后校验用户字段仍然匹配
+//
+// 不存在的用户以空值缓存；跨租户查询和事务中的查询不经过缓存，
+// 写操作立即删除相关键，在事务中时提交后再删除一次
+type cachedSystemUserRepo struct {
+	bizsystemuser.SystemUserRepo
+
+	client *rdb.Client
+	local  *expirable.LRU[string, string]
+	group  singleflight.Group
+	log    *log.Helper
+}
+
+// newCachedSystemUserRepo wraps repo with the cache.
+func newCachedSystemUserRepo(repo bizsystemuser.SystemUserRepo, client *rdb.Client, logger log.Logger) bizsystemuser.SystemUserRepo {
+	return &cachedSystemUserRepo{
+		SystemUserRepo: repo,
+		client:         client,
+		local:          expirable.NewLRU[string, string](localCacheSize, nil, localCacheTTL),
+		log:            log.NewHelper(log.With(logger, "module", "systemuser/cache")),
+	}
+}
+
+// FindByID finds the user by id through the cache, limited by the data scope of ctx.
+func (c *cachedSystemUserRepo) FindByID(ctx context.Context, id string) (*bizsystemuser.SystemUser, error) {
+	tenantID, ok := c.cacheable(ctx)
+	if !ok {
+		return c.SystemUserRepo.FindByID(ctx, id)
+	}
+	user, err := c.loadUser(ctx, tenantID, id)
+	if err != nil || user == nil {
+		return nil, err
+	}
+
+	// 按调用方的数据范围过滤，与 mixin.DataScope 的条件一致
+	scope, err := auth.DataScopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if scope != nil && !scope.All && scope.UserID != id && (user.DeptID == nil || !scope.Allows(*user.DeptID)) {
+		return nil, nil
+	}
+	return user, nil
+}
+
+// FindByUsername finds the user by account through the cache.
+func (c *cachedSystemUserRepo) FindByUsername(ctx context.Context, username string) (*bizsystemuser.SystemUser, error) {
+	return c.findByIndex(ctx, indexAccount, username, c.SystemUserRepo.FindByUsername)
+}
+
+// FindByEmail finds the user by email through the cache.
+func (c *cachedSystemUserRepo) FindByEmail(ctx context.Context, email string) (*bizsystemuser.SystemUser, error) {
+	return c.findByIndex(ctx, indexEmail, email, c.SystemUserRepo.FindByEmail)
+}
+
+// FindByMobile finds the user by mobile through the cache.
+func (c *cachedSystemUserRepo) FindByMobile(ctx context.Context, mobile string) (*bizsystemuser.SystemUser, error) {
+	return c.findByIndex(ctx, indexMobile, mobile, c.SystemUserRepo.FindByMobile)
+}
+
+// Save creates the user, and drops the cached misses of its account, email and mobile.
+func (c *cachedSystemUserRepo) Save(ctx context.Context, user *bizsystemuser.SystemUser) (*bizsystemuser.SystemUser, error) {
+	result, err := c.SystemUserRepo.Save(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidate(ctx, result)
+	return result, nil
+}
+
+// Update updates the user, and drops it from the cache.
+func (c *cachedSystemUserRepo) Update(ctx context.Context, user *bizsystemuser.SystemUser) (*bizsystemuser.SystemUser, error) {
+	result, err := c.SystemUserRepo.Update(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidate(ctx, result)
+	return result, nil
+}
+
+// Delete deletes the user, and drops it from the cache.
+func (c *cachedSystemUserRepo) Delete(ctx context.Context, id string) error {
+	if err := c.SystemUserRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	c.invalidateIDs(ctx, id)
+	return nil
+}
+
+// BatchDelete deletes the users, and drops them from the cache.
+func (c *cachedSystemUserRepo) BatchDelete(ctx context.Context, ids []string) (int32, int32, []string, error) {
+	successCount, failedCount, failedIDs, err := c.SystemUserRepo.BatchDelete(ctx, ids)
+	if err != nil {
+		return successCount, failedCount, failedIDs, err
+	}
+	c.invalidateIDs(ctx, ids...)
+	return successCount, failedCount, failedIDs, nil
+}
+
+// ChangeStatus changes the status of the user, and drops it from the cache.
+func (c *cachedSystemUserRepo) ChangeStatus(ctx context.Context, id string, status int8) error {
+	if err := c.SystemUserRepo.ChangeStatus(ctx, id, status); err != nil {
+		return err
+	}
+	c.invalidateIDs(ctx, id)
+	return nil
+}
+
+// Restore restores the user, and drops it and the cached misses of its account, email and mobile.
+func (c *cachedSystemUserRepo) Restore(ctx context.Context, id string) error {
+	if err := c.SystemUserRepo.Restore(ctx, id); err != nil {
+		return err
+	}
+	user, err := c.SystemUserRepo.FindByID(auth.SkipDataScope(ctx), id)
+	if err != nil || user == nil {
+		// 无法获取账号等字段时，索引键由过期时间兜底
+		c.invalidateIDs(ctx, id)
+		return nil
+	}
+	c.invalidate(ctx, user)
+	return nil
+}
+
+// Purge purges the user, and drops it from the cache.
+func (c *cachedSystemUserRepo) Purge(ctx context.Context, id string) error {
+	if err := c.SystemUserRepo.Purge(ctx, id); err != nil {
+		return err
+	}
+	c.invalidateIDs(ctx, id)
+	return nil
+}
+
+// cacheable returns the tenant of ctx, and whether lookups in ctx may use the cache.
+func (c *cachedSystemUserRepo) cacheable(ctx context.Context) (string, bool) {
+	if auth.IsCrossTenant(ctx) || ent.TxFromContext(ctx) != nil || bizsystemuser.IsWithCredentials(ctx) {
+		return "", false
+	}
+	tenantID := auth.TenantID(ctx)
+	return tenantID, tenantID != ""
+}
+
+// loadUser returns the user of the tenant by id, regardless of the data scope.
+func (c *cachedSystemUserRepo) loadUser(ctx context.Context, tenantID, id string) (*bizsystemuser.SystemUser, error) {
+	key := c.idKey(tenantID, id)
+	if value, ok := c.get(ctx, key); ok {
+		return decodeUser(value)
+	}
+	value, err, _ := c.group.Do(key, func() (interface{}, error) {
+		user, err := c.SystemUserRepo.FindByID(auth.SkipDataScope(ctx), id)
+		if err != nil {
+			return "", err
+		}
+		return c.setUser(ctx, tenantID, key, user), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return decodeUser(value.(string))
+}
+
+// findByIndex returns the user of the tenant whose field equals value.
+func (c *cachedSystemUserRepo) findByIndex(ctx context.Context, field, value string, load func(context.Context, string) (*bizsystemuser.SystemUser, error)) (*bizsystemuser.SystemUser, error) {
+	tenantID, ok := c.cacheable(ctx)
+	if !ok {
+		return load(ctx, value)
+	}
+	key := c.indexKey(tenantID, field, value)
+	if id, ok := c.get(ctx, key); ok {
+		if id == "" {
+			return nil, nil
+		}
+		user, err := c.loadUser(ctx, tenantID, id)
+		if err != nil {
+			return nil, err
+		}
+		// 字段已修改或用户已删除时重新查找
+		if user != nil && indexValue(user, field) == value {
+			return user, nil
+		}
+	}
+
+	result, err, _ := c.group.Do(key, func() (interface{}, error) {
+		user, err := load(ctx, value)
+		if err != nil {
+			return "", err
+		}
+		if user == nil || user.ID == nil {
+			c.set(ctx, key, "", userNegativeTTL)
+			return "", nil
+		}
+		c.set(ctx, key, *user.ID, userCacheTTL)
+		return c.setUser(ctx, tenantID, c.idKey(tenantID, *user.ID), user), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return decodeUser(result.(string))
+}
+
+// setUser caches the user under key, and returns the cached value.
+func (c *cachedSystemUserRepo) setUser(ctx context.Context, tenantID, key string, user *bizsystemuser.SystemUser) string {
+	if user == nil {
+		c.set(ctx, key, "", userNegativeTTL)
+		return ""
+	}
+	// 密码哈希不写入共享缓存，登录时通过 WithCredentials 从数据库读取
+	cached := *user
+	cached.Password = nil
+	data, err := json.Marshal(&cached)
+	if err != nil {
+		c.log.WithContext(ctx).Errorf("failed encoding user %s: %v", key, err)
+		return ""
+	}
+	c.set(ctx, key, string(data), userCacheTTL)
+	return string(data)
+}
+
+// get returns the cached value of key from the local cache, then from redis.
+func (c *cachedSystemUserRepo) get(ctx context.Context, key string) (string, bool) {
+	if value, ok := c.local.Get(key); ok {
+		return value, true
+	}
+	value, err := c.client.Get(ctx, key).Result()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			c.log.WithContext(ctx).Warnf("failed reading user cache %s: %v", key, err)
+		}
+		return "", false
+	}
+	c.local.Add(key, value)
+	return value, true
+}
+
+// set caches the value of key in both tiers, redis errors are only logged.
+func (c *cachedSystemUserRepo) set(ctx context.Context, key, value string, ttl time.Duration) {
+	c.local.Add(key, value)
+	if err := c.client.Set(ctx, key, value, ttl).Err(); err != nil {
+		c.log.WithContext(ctx).Warnf("failed writing user cache %s: %v", key, err)
+	}
+}
+
+// invalidate drops the user and the index keys of its account, email and mobile.
+func (c *cachedSystemUserRepo) invalidate(ctx context.Context, user *bizsystemuser.SystemUser) {
+	tenantID := auth.TenantID(ctx)
+	if user.TenantID != nil {
+		tenantID = *user.TenantID
+	}
+	var keys []string
+	if user.ID != nil {
+		keys = append(keys, c.idKey(tenantID, *user.ID))
+	}
+	for _, field := range []string{indexAccount, indexEmail, indexMobile} {
+		if value := indexValue(user, field); value != "" {
+			keys = append(keys, c.indexKey(tenantID, field, value))
+		}
+	}
+	c.del(ctx, keys...)
+}
+
+// invalidateIDs drops the users of the tenant of ctx, their index keys are checked on read.
+func (c *cachedSystemUserRepo) invalidateIDs(ctx context.Context, ids ...string) {
+	tenantID := auth.TenantID(ctx)
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, c.idKey(tenantID, id))
+	}
+	c.del(ctx, keys...)
+}
+
+// del drops the keys now, and again after the transaction of ctx commits,
+// so that lookups racing with the transaction cannot keep the old value.
+func (c *cachedSystemUserRepo) del(ctx context.Context, keys ...string) {
+	if len(keys) == 0 {
+		return
+	}
+	c.delNow(ctx, keys)
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		tx.OnCommit(func(next ent.Committer) ent.Committer {
+			return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+				if err := next.Commit(ctx, tx); err != nil {
+					return err
+				}
+				c.delNow(ctx, keys)
+				return nil
+			})
+		})
+	}
+}
+
+// delNow drops the keys from both tiers.
+func (c *cachedSystemUserRepo) delNow(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		c.local.Remove(key)
+	}
+	if err := c.client.Del(ctx, keys...).Err(); err != nil {
+		c.log.WithContext(ctx).Errorf("failed invalidating user cache %v: %v", keys, err)
+	}
+}
+
+// idKey returns the key of the user of the tenant.
+func (c *cachedSystemUserRepo) idKey(tenantID, id string) string {
+	return c.client.Key("user", tenantID, "id", id)
+}
+
+// indexKey returns the key of the user id of the tenant whose field equals value.
+func (c *cachedSystemUserRepo) indexKey(tenantID, field, value string) string {
+	return c.client.Key("user", tenantID, field, value)
+}
+
+// indexValue returns the value of the indexed field of the user.
+func indexValue(user *bizsystemuser.SystemUser, field string) string {
+	var value *string
+	switch field {
+	case indexAccount:
+		value = user.Account
+	case indexEmail:
+		value = user.Email
+	case indexMobile:
+		value = user.Mobile
+	}
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// decodeUser decodes the cached user, an empty value is a cached miss.
+func decodeUser(value string) (*bizsystemuser.SystemUser, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var user bizsystemuser.SystemUser
+	if err := json.Unmarshal([]byte(value), &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
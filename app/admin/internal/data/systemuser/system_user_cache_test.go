@@ -0,0 +1,343 @@
+package systemuser
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	bizsystemuser "qn-base/app/admin/internal/biz/systemuser"
+	"qn-base/app/admin/internal/biz/systemuser/mocks"
+	"qn-base/app/admin/internal/conf"
+	"qn-base/app/admin/internal/data/rdb"
+	"qn-base/pkg/auth"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCache(t *testing.T) (*cachedSystemUserRepo, *mocks.MockSystemUserRepo, *miniredis.Miniredis) {
+	ctrl := gomock.NewController(t)
+	repo := mocks.NewMockSystemUserRepo(ctrl)
+	mr := miniredis.RunT(t)
+	client, cleanup, err := rdb.NewClient(&conf.Bootstrap{
+		Data: &conf.Data{Redis: &conf.Data_Redis{Addr: mr.Addr(), KeyPrefix: "test"}},
+	}, log.DefaultLogger)
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+	return newCachedSystemUserRepo(repo, client, log.DefaultLogger).(*cachedSystemUserRepo), repo, mr
+}
+
+func newTestUser(id, account, deptID string) *bizsystemuser.SystemUser {
+	tenantID := "tenant1"
+	return &bizsystemuser.SystemUser{ID: &id, TenantID: &tenantID, Account: &account, DeptID: &deptID}
+}
+
+func TestCachedSystemUserRepo_FindByID(t *testing.T) {
+	ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "admin", TenantID: "tenant1"})
+
+	t.Run("命中缓存时不查询数据库", func(t *testing.T) {
+		cache, repo, mr := newTestCache(t)
+
+		// Mock 期望
+		repo.EXPECT().FindByID(gomock.Any(), "1").Return(newTestUser("1", "alice", "d1"), nil).Times(1)
+
+		// 执行测试
+		first, err := cache.FindByID(ctx, "1")
+		require.NoError(t, err)
+		second, err := cache.FindByID(ctx, "1")
+		require.NoError(t, err)
+
+		// 断言
+		assert.Equal(t, "alice", *first.Account)
+		assert.Equal(t, first, second)
+		assert.True(t, mr.Exists("test:user:tenant1:id:1"))
+
+		// 本地缓存失效后从 redis 读取
+		cache.local.Purge()
+		third, err := cache.FindByID(ctx, "1")
+		require.NoError(t, err)
+		assert.Equal(t, first, third)
+	})
+
+	t.Run("缓存不存在的用户", func(t *testing.T) {
+		cache, repo, mr := newTestCache(t)
+
+		// Mock 期望
+		repo.EXPECT().FindByID(gomock.Any(), "404").Return(nil, nil).Times(1)
+
+		// 执行测试
+		for i := 0; i < 2; i++ {
+			user, err := cache.FindByID(ctx, "404")
+			require.NoError(t, err)
+			assert.Nil(t, user)
+		}
+
+		// 断言
+		assert.True(t, mr.Exists("test:user:tenant1:id:404"))
+		assert.Equal(t, userNegativeTTL, mr.TTL("test:user:tenant1:id:404"))
+	})
+
+	t.Run("按数据范围过滤缓存的用户", func(t *testing.T) {
+		cache, repo, _ := newTestCache(t)
+
+		// Mock 期望
+		repo.EXPECT().FindByID(gomock.Any(), "1").Return(newTestUser("1", "alice", "d1"), nil).Times(1)
+
+		scoped := func(scope *auth.DataScope) context.Context {
+			return auth.NewDataScopeContext(ctx, func(context.Context) (*auth.DataScope, error) {
+				return scope, nil
+			})
+		}
+
+		// 执行测试 & 断言
+		user, err := cache.FindByID(scoped(&auth.DataScope{DeptIDs: []string{"d1"}}), "1")
+		require.NoError(t, err)
+		assert.NotNil(t, user)
+
+		user, err = cache.FindByID(scoped(&auth.DataScope{DeptIDs: []string{"d2"}}), "1")
+		require.NoError(t, err)
+		assert.Nil(t, user)
+
+		user, err = cache.FindByID(scoped(&auth.DataScope{UserID: "1"}), "1")
+		require.NoError(t, err)
+		assert.NotNil(t, user)
+	})
+
+	t.Run("跨租户查询不经过缓存", func(t *testing.T) {
+		cache, repo, mr := newTestCache(t)
+		crossCtx := auth.CrossTenant(ctx)
+
+		// Mock 期望
+		repo.EXPECT().FindByID(crossCtx, "1").Return(newTestUser("1", "alice", "d1"), nil).Times(2)
+
+		// 执行测试
+		for i := 0; i < 2; i++ {
+			_, err := cache.FindByID(crossCtx, "1")
+			require.NoError(t, err)
+		}
+
+		// 断言
+		assert.Empty(t, mr.Keys())
+	})
+
+	t.Run("并发查询合并为一次", func(t *testing.T) {
+		cache, repo, _ := newTestCache(t)
+		release := make(chan struct{})
+
+		// Mock 期望
+		repo.EXPECT().FindByID(gomock.Any(), "1").DoAndReturn(func(context.Context, string) (*bizsystemuser.SystemUser, error) {
+			<-release
+			return newTestUser("1", "alice", "d1"), nil
+		}).Times(1)
+
+		// 执行测试
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				user, err := cache.FindByID(ctx, "1")
+				assert.NoError(t, err)
+				assert.NotNil(t, user)
+			}()
+		}
+		close(release)
+		wg.Wait()
+	})
+
+	t.Run("查询失败不缓存", func(t *testing.T) {
+		cache, repo, mr := newTestCache(t)
+
+		// Mock 期望
+		repo.EXPECT().FindByID(gomock.Any(), "1").Return(nil, errors.New("db down"))
+
+		// 执行测试
+		_, err := cache.FindByID(ctx, "1")
+
+		// 断言
+		assert.Error(t, err)
+		assert.Empty(t, mr.Keys())
+	})
+}
+
+func TestCachedSystemUserRepo_FindByUsername(t *testing.T) {
+	ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "admin", TenantID: "tenant1"})
+
+	t.Run("按账号查询后按ID命中缓存", func(t *testing.T) {
+		cache, repo, mr := newTestCache(t)
+
+		// Mock 期望
+		repo.EXPECT().FindByUsername(ctx, "alice").Return(newTestUser("1", "alice", "d1"), nil).Times(1)
+
+		// 执行测试
+		user, err := cache.FindByUsername(ctx, "alice")
+		require.NoError(t, err)
+		again, err := cache.FindByUsername(ctx, "alice")
+		require.NoError(t, err)
+		byID, err := cache.FindByID(ctx, "1")
+		require.NoError(t, err)
+
+		// 断言
+		assert.Equal(t, user, again)
+		assert.Equal(t, user, byID)
+		got, err := mr.Get("test:user:tenant1:account:alice")
+		require.NoError(t, err)
+		assert.Equal(t, "1", got)
+	})
+
+	t.Run("创建用户后清除不存在的缓存", func(t *testing.T) {
+		cache, repo, mr := newTestCache(t)
+		created := newTestUser("1", "bob", "d1")
+
+		// Mock 期望
+		gomock.InOrder(
+			repo.EXPECT().FindByUsername(ctx, "bob").Return(nil, nil),
+			repo.EXPECT().Save(ctx, gomock.Any()).Return(created, nil),
+			repo.EXPECT().FindByUsername(ctx, "bob").Return(created, nil),
+		)
+
+		// 执行测试
+		user, err := cache.FindByUsername(ctx, "bob")
+		require.NoError(t, err)
+		assert.Nil(t, user)
+		assert.True(t, mr.Exists("test:user:tenant1:account:bob"))
+
+		_, err = cache.Save(ctx, created)
+		require.NoError(t, err)
+		assert.False(t, mr.Exists("test:user:tenant1:account:bob"))
+
+		// 断言
+		user, err = cache.FindByUsername(ctx, "bob")
+		require.NoError(t, err)
+		assert.Equal(t, "1", *user.ID)
+	})
+
+	t.Run("字段修改后不返回过期的用户", func(t *testing.T) {
+		cache, repo, _ := newTestCache(t)
+		user := newTestUser("1", "alice", "d1")
+		oldEmail, newEmail := "old@example.com", "new@example.com"
+		user.Email = &oldEmail
+		updated := newTestUser("1", "alice", "d1")
+		updated.Email = &newEmail
+
+		// Mock 期望
+		gomock.InOrder(
+			repo.EXPECT().FindByEmail(ctx, oldEmail).Return(user, nil),
+			repo.EXPECT().Update(ctx, gomock.Any()).Return(updated, nil),
+			repo.EXPECT().FindByID(gomock.Any(), "1").Return(updated, nil),
+			repo.EXPECT().FindByEmail(ctx, oldEmail).Return(nil, nil),
+		)
+
+		// 执行测试
+		_, err := cache.FindByEmail(ctx, oldEmail)
+		require.NoError(t, err)
+		_, err = cache.Update(ctx, updated)
+		require.NoError(t, err)
+		found, err := cache.FindByEmail(ctx, oldEmail)
+
+		// 断言
+		require.NoError(t, err)
+		assert.Nil(t, found)
+	})
+
+	t.Run("缓存中不保存密码", func(t *testing.T) {
+		cache, repo, mr := newTestCache(t)
+		credCtx := bizsystemuser.WithCredentials(ctx)
+		hash := "$2a$10$hash"
+		withPassword := func() *bizsystemuser.SystemUser {
+			user := newTestUser("1", "alice", "d1")
+			user.Password = &hash
+			return user
+		}
+
+		// Mock 期望
+		repo.EXPECT().FindByUsername(ctx, "alice").Return(withPassword(), nil).Times(1)
+		repo.EXPECT().FindByUsername(credCtx, "alice").Return(withPassword(), nil).Times(2)
+
+		// 执行测试
+		user, err := cache.FindByUsername(ctx, "alice")
+		require.NoError(t, err)
+
+		// 断言
+		assert.Nil(t, user.Password)
+		got, err := mr.Get("test:user:tenant1:id:1")
+		require.NoError(t, err)
+		assert.NotContains(t, got, hash)
+
+		// 需要密码时绕过缓存
+		for i := 0; i < 2; i++ {
+			user, err = cache.FindByUsername(credCtx, "alice")
+			require.NoError(t, err)
+			assert.Equal(t, hash, *user.Password)
+		}
+	})
+}
+
+func TestCachedSystemUserRepo_Invalidate(t *testing.T) {
+	ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "admin", TenantID: "tenant1"})
+
+	tests := []struct {
+		name   string
+		expect func(repo *mocks.MockSystemUserRepo)
+		write  func(cache *cachedSystemUserRepo) error
+	}{
+		{
+			name: "修改状态",
+			expect: func(repo *mocks.MockSystemUserRepo) {
+				repo.EXPECT().ChangeStatus(ctx, "1", int8(0)).Return(nil)
+			},
+			write: func(cache *cachedSystemUserRepo) error { return cache.ChangeStatus(ctx, "1", 0) },
+		},
+		{
+			name: "删除",
+			expect: func(repo *mocks.MockSystemUserRepo) {
+				repo.EXPECT().Delete(ctx, "1").Return(nil)
+			},
+			write: func(cache *cachedSystemUserRepo) error { return cache.Delete(ctx, "1") },
+		},
+		{
+			name: "批量删除",
+			expect: func(repo *mocks.MockSystemUserRepo) {
+				repo.EXPECT().BatchDelete(ctx, []string{"1", "2"}).Return(int32(2), int32(0), nil, nil)
+			},
+			write: func(cache *cachedSystemUserRepo) error {
+				_, _, _, err := cache.BatchDelete(ctx, []string{"1", "2"})
+				return err
+			},
+		},
+		{
+			name: "更新",
+			expect: func(repo *mocks.MockSystemUserRepo) {
+				repo.EXPECT().Update(ctx, gomock.Any()).Return(newTestUser("1", "alice", "d2"), nil)
+			},
+			write: func(cache *cachedSystemUserRepo) error {
+				_, err := cache.Update(ctx, newTestUser("1", "alice", "d2"))
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache, repo, mr := newTestCache(t)
+
+			// Mock 期望
+			repo.EXPECT().FindByID(gomock.Any(), "1").Return(newTestUser("1", "alice", "d1"), nil).Times(2)
+			tt.expect(repo)
+
+			// 执行测试
+			_, err := cache.FindByID(ctx, "1")
+			require.NoError(t, err)
+			require.NoError(t, tt.write(cache))
+
+			// 断言
+			assert.False(t, mr.Exists("test:user:tenant1:id:1"))
+			_, err = cache.FindByID(ctx, "1")
+			require.NoError(t, err)
+		})
+	}
+}
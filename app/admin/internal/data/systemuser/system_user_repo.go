@@ -3,21 +3,23 @@ package systemuser
 import (
 	"context"
 	"fmt"
-	"qn-base/app/admin/internal/data/idgen"
+	"strings"
 	"time"
 
 	bizsystemuser "qn-base/app/admin/internal/biz/systemuser"
 	"qn-base/app/admin/internal/data/data"
 	"qn-base/app/admin/internal/data/ent"
+	"qn-base/app/admin/internal/data/ent/schema"
 	"qn-base/app/admin/internal/data/ent/systemuser"
+	"qn-base/pkg/auth"
+	"qn-base/pkg/ent/mixin"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
 
 type systemUserRepo struct {
-	data  *data.Data
-	log   *log.Helper
-	idGen *idgen.IDGenerator
+	data *data.Data
+	log  *log.Helper
 }
 
 // convertToBizUser 将数据库实体转换为业务对象
@@ -39,7 +41,6 @@ func (s systemUserRepo) convertToBizUser(entity *ent.SystemUser) *bizsystemuser.
 		Nickname:  entity.Nickname,
 		Remark:    entity.Remark,
 		DeptID:    entity.DeptID,
-		PostIds:   entity.PostIds,
 		Email:     entity.Email,
 		Mobile:    entity.Mobile,
 		Sex:       entity.Sex,
@@ -50,13 +51,31 @@ func (s systemUserRepo) convertToBizUser(entity *ent.SystemUser) *bizsystemuser.
 	}
 }
 
+// convertUniqueError 将唯一索引冲突转换为业务错误，并发创建时由数据库保证唯一
+func convertUniqueError(err error) error {
+	if !ent.IsConstraintError(err) {
+		return err
+	}
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, schema.UserAccountUniqueIndex):
+		return bizsystemuser.ErrUserAlreadyExists
+	case strings.Contains(msg, schema.UserEmailUniqueIndex):
+		return bizsystemuser.ErrEmailAlreadyExists
+	case strings.Contains(msg, schema.UserMobileUniqueIndex):
+		return bizsystemuser.ErrMobileAlreadyExists
+	}
+	return err
+}
+
 // NewSystemUserRepo .
-func NewSystemUserRepo(data *data.Data, idGen *idgen.IDGenerator, logger log.Logger) bizsystemuser.SystemUserRepo {
-	return &systemUserRepo{
-		data:  data,
-		log:   log.NewHelper(log.With(logger, "module", "systemuser/repo")),
-		idGen: idGen,
+// 用户ID由 mixin.StringId 的钩子生成；按ID、账号、邮箱和手机号的查询经过 redis 缓存
+func NewSystemUserRepo(data *data.Data, logger log.Logger) bizsystemuser.SystemUserRepo {
+	repo := &systemUserRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "systemuser/repo")),
 	}
+	return newCachedSystemUserRepo(repo, data.Redis(), logger)
 }
 
 func (s systemUserRepo) Save(ctx context.Context, user *bizsystemuser.SystemUser) (*bizsystemuser.SystemUser, error) {
@@ -67,7 +86,6 @@ func (s systemUserRepo) Save(ctx context.Context, user *bizsystemuser.SystemUser
 		SetNillableNickname(user.Nickname).
 		SetNillableRemark(user.Remark).
 		SetNillableDeptID(user.DeptID).
-		SetNillablePostIds(user.PostIds).
 		SetNillableEmail(user.Email).
 		SetNillableMobile(user.Mobile).
 		SetNillableSex(user.Sex).
@@ -88,7 +106,7 @@ func (s systemUserRepo) Save(ctx context.Context, user *bizsystemuser.SystemUser
 
 	result, err := create.Save(ctx)
 	if err != nil {
-		return nil, err
+		return nil, convertUniqueError(err)
 	}
 
 	// 转换为业务对象
@@ -107,7 +125,6 @@ func (s systemUserRepo) Update(ctx context.Context, user *bizsystemuser.SystemUs
 		SetNillableNickname(user.Nickname).
 		SetNillableRemark(user.Remark).
 		SetNillableDeptID(user.DeptID).
-		SetNillablePostIds(user.PostIds).
 		SetNillableEmail(user.Email).
 		SetNillableMobile(user.Mobile).
 		SetNillableSex(user.Sex).
@@ -123,7 +140,7 @@ func (s systemUserRepo) Update(ctx context.Context, user *bizsystemuser.SystemUs
 
 	result, err := update.Save(ctx)
 	if err != nil {
-		return nil, err
+		return nil, convertUniqueError(err)
 	}
 
 	// 转换为业务对象
@@ -131,24 +148,12 @@ func (s systemUserRepo) Update(ctx context.Context, user *bizsystemuser.SystemUs
 }
 
 func (s systemUserRepo) Delete(ctx context.Context, id string) error {
-	// 删除系统用户（软删除）
-	// 使用 Update 方法加条件，确保只删除未删除的记录
-	affected, err := s.data.DB.SystemUser(ctx).Update().
-		Where(systemuser.ID(id)).
-		Where(systemuser.DeletedAtIsNil()). // 只删除未删除的记录
-		SetDeletedAt(time.Now()).
-		Save(ctx)
-
-	if err != nil {
-		return err
-	}
-
-	// 检查是否有记录被影响
-	if affected == 0 {
-		return &ent.NotFoundError{}
+	// 删除系统用户（软删除，由 DeletedAt mixin 的 hook 转换为更新）
+	err := s.data.DB.SystemUser(ctx).DeleteOneID(id).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return bizsystemuser.ErrUserNotFound
 	}
-
-	return nil
+	return err
 }
 
 func (s systemUserRepo) FindByID(ctx context.Context, id string) (*bizsystemuser.SystemUser, error) {
@@ -167,14 +172,19 @@ func (s systemUserRepo) FindByID(ctx context.Context, id string) (*bizsystemuser
 
 func (s systemUserRepo) FindByUsername(ctx context.Context, username string) (*bizsystemuser.SystemUser, error) {
 	// 根据用户名查找系统用户
+	// 唯一性校验需在租户的全部数据中查找，不受数据范围限制；登录时由调用方指定跨租户查找
+	ctx = auth.SkipDataScope(ctx)
 	result, err := s.data.DB.SystemUser(ctx).Query().
 		Where(systemuser.Account(username)).
-		Where(systemuser.DeletedAtIsNil()). // 只查找未删除的用户
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, nil
 		}
+		// 账号仅在租户内唯一，跨租户查找时可能匹配多个用户
+		if ent.IsNotSingular(err) {
+			return nil, bizsystemuser.ErrUserAmbiguous
+		}
 		return nil, err
 	}
 
@@ -184,54 +194,7 @@ func (s systemUserRepo) FindByUsername(ctx context.Context, username string) (*b
 
 func (s systemUserRepo) ListSystemUsers(ctx context.Context, request *bizsystemuser.ListUserRequest) ([]*bizsystemuser.SystemUser, int32, error) {
 	// 查询系统用户列表
-	query := s.data.DB.SystemUser(ctx).Query().
-		Where(systemuser.DeletedAtIsNil()) // 只查询未删除的用户
-
-	// 根据用户名过滤（模糊匹配）
-	if request.Username != "" {
-		query = query.Where(systemuser.AccountContains(request.Username))
-	}
-
-	// 根据邮箱过滤（模糊匹配）
-	if request.Email != "" {
-		query = query.Where(systemuser.EmailContains(request.Email))
-	}
-
-	// 根据手机号过滤（模糊匹配）
-	if request.Mobile != "" {
-		query = query.Where(systemuser.MobileContains(request.Mobile))
-	}
-
-	// 根据状态过滤
-	if request.Status != nil {
-		query = query.Where(systemuser.Status(*request.Status))
-	}
-
-	// 根据部门ID过滤
-	if request.DeptID != "" {
-		query = query.Where(systemuser.DeptID(request.DeptID))
-	}
-
-	// 根据租户ID过滤
-	if request.TenantID != "" {
-		query = query.Where(systemuser.TenantID(request.TenantID))
-	}
-
-	// 根据创建时间范围过滤
-	if request.StartDate != "" {
-		startTime, err := time.Parse("2006-01-02", request.StartDate)
-		if err == nil {
-			query = query.Where(systemuser.CreatedAtGTE(startTime))
-		}
-	}
-	if request.EndDate != "" {
-		endTime, err := time.Parse("2006-01-02", request.EndDate)
-		if err == nil {
-			// 结束时间设置为当天的23:59:59
-			endTime = endTime.Add(24*time.Hour - time.Second)
-			query = query.Where(systemuser.CreatedAtLTE(endTime))
-		}
-	}
+	query := applyListFilter(s.data.DB.SystemUser(ctx).Query(), request)
 
 	// 获取总数
 	count, err := query.Count(ctx)
@@ -270,11 +233,9 @@ func (s systemUserRepo) BatchDelete(ctx context.Context, ids []string) (int32, i
 
 	for _, id := range ids {
 		// 软删除单个用户
-		affected, err := s.data.DB.SystemUser(ctx).Update().
+		affected, err := s.data.DB.SystemUser(ctx).Delete().
 			Where(systemuser.ID(id)).
-			Where(systemuser.DeletedAtIsNil()).
-			SetDeletedAt(time.Now()).
-			Save(ctx)
+			Exec(ctx)
 
 		if err != nil || affected == 0 {
 			failedCount++
@@ -289,9 +250,10 @@ func (s systemUserRepo) BatchDelete(ctx context.Context, ids []string) (int32, i
 
 // FindByEmail finds user by email.
 func (s systemUserRepo) FindByEmail(ctx context.Context, email string) (*bizsystemuser.SystemUser, error) {
+	// 唯一性校验需在租户的全部数据中查找，不受数据范围限制
+	ctx = auth.SkipDataScope(ctx)
 	result, err := s.data.DB.SystemUser(ctx).Query().
 		Where(systemuser.Email(email)).
-		Where(systemuser.DeletedAtIsNil()).
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -305,9 +267,10 @@ func (s systemUserRepo) FindByEmail(ctx context.Context, email string) (*bizsyst
 
 // FindByMobile finds user by mobile.
 func (s systemUserRepo) FindByMobile(ctx context.Context, mobile string) (*bizsystemuser.SystemUser, error) {
+	// 唯一性校验需在租户的全部数据中查找，不受数据范围限制
+	ctx = auth.SkipDataScope(ctx)
 	result, err := s.data.DB.SystemUser(ctx).Query().
 		Where(systemuser.Mobile(mobile)).
-		Where(systemuser.DeletedAtIsNil()).
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -340,8 +303,7 @@ func (s systemUserRepo) ChangeStatus(ctx context.Context, id string, status int8
 
 // GetUserStats implements get user statistics.
 func (s systemUserRepo) GetUserStats(ctx context.Context, tenantID string) (*bizsystemuser.UserStats, error) {
-	query := s.data.DB.SystemUser(ctx).Query().
-		Where(systemuser.DeletedAtIsNil())
+	query := s.data.DB.SystemUser(ctx).Query()
 
 	// 如果提供了租户ID，则按租户过滤
 	if tenantID != "" {
@@ -408,3 +370,118 @@ func (s systemUserRepo) GetUserStats(ctx context.Context, tenantID string) (*biz
 		ThisMonthRegistered: int32(thisMonthRegistered),
 	}, nil
 }
+
+// ListDeleted lists soft-deleted users.
+func (s systemUserRepo) ListDeleted(ctx context.Context, request *bizsystemuser.ListUserRequest) ([]*bizsystemuser.SystemUser, int32, error) {
+	// 跳过软删除过滤，只查询已删除的用户
+	ctx = mixin.SkipSoftDelete(ctx)
+	query := applyListFilter(s.data.DB.SystemUser(ctx).Query(), request).
+		Where(systemuser.DeletedAtNotNil())
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	results, err := query.
+		Offset(int((request.Page - 1) * request.PageSize)).
+		Limit(int(request.PageSize)).
+		Order(ent.Desc(systemuser.FieldDeletedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	users := make([]*bizsystemuser.SystemUser, len(results))
+	for i, result := range results {
+		users[i] = s.convertToBizUser(result)
+	}
+
+	return users, int32(count), nil
+}
+
+// Restore restores a soft-deleted user.
+func (s systemUserRepo) Restore(ctx context.Context, id string) error {
+	affected, err := s.data.DB.SystemUser(ctx).Update().
+		Where(systemuser.ID(id)).
+		Where(systemuser.DeletedAtNotNil()).
+		ClearDeletedAt().
+		Save(ctx)
+	if err != nil {
+		return convertUniqueError(err)
+	}
+
+	if affected == 0 {
+		return bizsystemuser.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// Purge permanently deletes a soft-deleted user.
+func (s systemUserRepo) Purge(ctx context.Context, id string) error {
+	affected, err := s.data.DB.SystemUser(ctx).Delete().
+		Where(systemuser.ID(id)).
+		Where(systemuser.DeletedAtNotNil()).
+		Exec(mixin.SkipSoftDelete(ctx))
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return bizsystemuser.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// applyListFilter 根据列表请求添加查询条件
+func applyListFilter(query *ent.SystemUserQuery, request *bizsystemuser.ListUserRequest) *ent.SystemUserQuery {
+	// 根据用户名过滤（模糊匹配）
+	if request.Username != "" {
+		query = query.Where(systemuser.AccountContains(request.Username))
+	}
+
+	// 根据邮箱过滤（模糊匹配）
+	if request.Email != "" {
+		query = query.Where(systemuser.EmailContains(request.Email))
+	}
+
+	// 根据手机号过滤（模糊匹配）
+	if request.Mobile != "" {
+		query = query.Where(systemuser.MobileContains(request.Mobile))
+	}
+
+	// 根据状态过滤
+	if request.Status != nil {
+		query = query.Where(systemuser.Status(*request.Status))
+	}
+
+	// 根据部门ID过滤
+	if request.DeptID != "" {
+		query = query.Where(systemuser.DeptID(request.DeptID))
+	}
+
+	// 根据租户ID过滤
+	if request.TenantID != "" {
+		query = query.Where(systemuser.TenantID(request.TenantID))
+	}
+
+	// 根据创建时间范围过滤
+	if request.StartDate != "" {
+		startTime, err := time.Parse("2006-01-02", request.StartDate)
+		if err == nil {
+			query = query.Where(systemuser.CreatedAtGTE(startTime))
+		}
+	}
+	if request.EndDate != "" {
+		endTime, err := time.Parse("2006-01-02", request.EndDate)
+		if err == nil {
+			// 结束时间设置为当天的23:59:59
+			endTime = endTime.Add(24*time.Hour - time.Second)
+			query = query.Where(systemuser.CreatedAtLTE(endTime))
+		}
+	}
+
+	return query
+}
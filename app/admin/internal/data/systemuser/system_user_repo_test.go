@@ -0,0 +1,79 @@
+package systemuser
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	bizsystemuser "qn-base/app/admin/internal/biz/systemuser"
+	"qn-base/app/admin/internal/data/ent"
+	_ "qn-base/app/admin/internal/data/ent/runtime"
+	"qn-base/app/admin/internal/data/ent/schema"
+	"qn-base/pkg/auth"
+	"qn-base/pkg/ent/mixin"
+
+	"entgo.io/ent/dialect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// execErrDriver 执行语句时返回指定错误的数据库驱动
+type execErrDriver struct {
+	dialect.Driver
+	err error
+}
+
+func (d execErrDriver) Exec(context.Context, string, any, any) error { return d.err }
+
+func (d execErrDriver) Dialect() string { return dialect.MySQL }
+
+// newConstraintError 通过 ent 生成唯一约束冲突错误
+func newConstraintError(t *testing.T, index string) error {
+	drvErr := errors.New("Error 1062 (23000): Duplicate entry 'x' for key 't_system_user." + index + "'")
+	client := ent.NewClient(ent.Driver(execErrDriver{err: drvErr}))
+	ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "admin", TenantID: "tenant1"})
+	_, err := client.SystemUser.Delete().Exec(mixin.SkipSoftDelete(ctx))
+	require.True(t, ent.IsConstraintError(err), "%v", err)
+	return err
+}
+
+func TestConvertUniqueError(t *testing.T) {
+	otherErr := errors.New("connection refused")
+
+	tests := []struct {
+		name    string
+		err     error
+		wantErr error
+	}{
+		{
+			name:    "账号重复",
+			err:     newConstraintError(t, schema.UserAccountUniqueIndex),
+			wantErr: bizsystemuser.ErrUserAlreadyExists,
+		},
+		{
+			name:    "邮箱重复",
+			err:     newConstraintError(t, schema.UserEmailUniqueIndex),
+			wantErr: bizsystemuser.ErrEmailAlreadyExists,
+		},
+		{
+			name:    "手机号重复",
+			err:     newConstraintError(t, schema.UserMobileUniqueIndex),
+			wantErr: bizsystemuser.ErrMobileAlreadyExists,
+		},
+		{
+			name:    "其他错误原样返回",
+			err:     otherErr,
+			wantErr: otherErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 执行测试
+			err := convertUniqueError(tt.err)
+
+			// 断言
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
@@ -2,11 +2,24 @@ package wire
 
 import (
 	"github.com/google/wire"
+	bizauth "qn-base/app/admin/internal/biz/auth"
+	bizpermission "qn-base/app/admin/internal/biz/permission"
+	bizsystemuser "qn-base/app/admin/internal/biz/systemuser"
+	"qn-base/app/admin/internal/data/auth"
 	"qn-base/app/admin/internal/data/data"
 	"qn-base/app/admin/internal/data/db"
 	"qn-base/app/admin/internal/data/idgen"
+	"qn-base/app/admin/internal/data/permission"
+	"qn-base/app/admin/internal/data/policy"
+	"qn-base/app/admin/internal/data/rdb"
+	"qn-base/app/admin/internal/data/systemdept"
+	"qn-base/app/admin/internal/data/systemloginlog"
+	"qn-base/app/admin/internal/data/systemmenu"
+	"qn-base/app/admin/internal/data/systempost"
+	"qn-base/app/admin/internal/data/systemrole"
+	"qn-base/app/admin/internal/data/systemtenant"
 	"qn-base/app/admin/internal/data/systemuser"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(data.NewData, data.NewTransaction, systemuser.NewSystemUserRepo, db.NewDB, idgen.NewIDGenerator)
+var ProviderSet = wire.NewSet(data.NewData, data.NewTransaction, systemuser.NewSystemUserRepo, systemrole.NewSystemRoleRepo, systemmenu.NewSystemMenuRepo, systemdept.NewSystemDeptRepo, systempost.NewSystemPostRepo, systemtenant.NewSystemTenantRepo, systemtenant.NewSystemTenantPackageRepo, permission.NewPermissionRepo, policy.NewAdapter, policy.NewEnforcer, policy.NewPolicyRepo, db.NewDB, rdb.NewClient, idgen.NewIDGenerator, auth.NewRevocationStore, auth.NewSessionStore, auth.NewLockoutRepo, auth.NewUserMFARepo, systemloginlog.NewSystemLoginLogRepo, wire.Bind(new(bizsystemuser.UserRoleRepo), new(bizpermission.PermissionRepo)), wire.Bind(new(bizsystemuser.UserMFARepo), new(bizauth.UserMFARepo)))
@@ -3,19 +3,28 @@ package server
 import (
 	adminV1 "qn-base/api/gen/go/admin/v1"
 	"qn-base/app/admin/internal/conf"
+	"qn-base/app/admin/internal/service/auth"
+	"qn-base/app/admin/internal/service/permission"
+	"qn-base/app/admin/internal/service/policy"
+	"qn-base/app/admin/internal/service/systemdept"
+	"qn-base/app/admin/internal/service/systemloginlog"
+	"qn-base/app/admin/internal/service/systemmenu"
+	"qn-base/app/admin/internal/service/systempost"
+	"qn-base/app/admin/internal/service/systemrole"
+	"qn-base/app/admin/internal/service/systemtenant"
 	"qn-base/app/admin/internal/service/systemuser"
+	pkgAuth "qn-base/pkg/auth"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Bootstrap, userService *systemuser.UserService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Bootstrap, userService *systemuser.UserService, authService *auth.AuthService, roleService *systemrole.RoleService, menuService *systemmenu.MenuService, permissionService *permission.PermissionService, policyService *policy.PolicyService, deptService *systemdept.DeptService, postService *systempost.PostService, tenantService *systemtenant.TenantService, tenantPackageService *systemtenant.TenantPackageService, loginLogService *systemloginlog.LoginLogService, authorizer *pkgAuth.Authorizer, dataScope pkgAuth.DataScopeResolver, tenantResolver *pkgAuth.TenantResolver, revocation pkgAuth.RevocationStore, sessions pkgAuth.SessionStore, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
-			recovery.Recovery(),
+			newServerMiddleware(c, authorizer, dataScope, tenantResolver, revocation, sessions, logger)...,
 		),
 	}
 	if c.Server.Grpc.Network != "" {
@@ -29,5 +38,15 @@ func NewGRPCServer(c *conf.Bootstrap, userService *systemuser.UserService, logge
 	}
 	srv := grpc.NewServer(opts...)
 	adminV1.RegisterUserServer(srv, userService)
+	adminV1.RegisterAuthServer(srv, authService)
+	adminV1.RegisterRoleServer(srv, roleService)
+	adminV1.RegisterMenuServer(srv, menuService)
+	adminV1.RegisterPermissionServer(srv, permissionService)
+	adminV1.RegisterPolicyServer(srv, policyService)
+	adminV1.RegisterDeptServer(srv, deptService)
+	adminV1.RegisterPostServer(srv, postService)
+	adminV1.RegisterTenantServer(srv, tenantService)
+	adminV1.RegisterTenantPackageServer(srv, tenantPackageService)
+	adminV1.RegisterLoginLogServer(srv, loginLogService)
 	return srv
 }
@@ -1,30 +1,30 @@
 package server
 
 import (
-	"context"
 	adminV1 "qn-base/api/gen/go/admin/v1"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/go-kratos/kratos/v2/middleware"
-	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
-	"github.com/go-kratos/kratos/v2/middleware/logging"
-	"github.com/go-kratos/kratos/v2/middleware/recovery"
-	"github.com/go-kratos/kratos/v2/middleware/selector"
-	"github.com/go-kratos/kratos/v2/middleware/tracing"
-	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/go-kratos/kratos/v2/transport/http"
-	jwtV5 "github.com/golang-jwt/jwt/v5"
 	"qn-base/app/admin/internal/conf"
+	"qn-base/app/admin/internal/service/auth"
+	"qn-base/app/admin/internal/service/permission"
+	"qn-base/app/admin/internal/service/policy"
+	"qn-base/app/admin/internal/service/systemdept"
+	"qn-base/app/admin/internal/service/systemloginlog"
+	"qn-base/app/admin/internal/service/systemmenu"
+	"qn-base/app/admin/internal/service/systempost"
+	"qn-base/app/admin/internal/service/systemrole"
+	"qn-base/app/admin/internal/service/systemtenant"
 	"qn-base/app/admin/internal/service/systemuser"
-	pkgLogger "qn-base/pkg/logger"
+	pkgAuth "qn-base/pkg/auth"
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Bootstrap, userService *systemuser.UserService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Bootstrap, userService *systemuser.UserService, authService *auth.AuthService, roleService *systemrole.RoleService, menuService *systemmenu.MenuService, permissionService *permission.PermissionService, policyService *policy.PolicyService, deptService *systemdept.DeptService, postService *systempost.PostService, tenantService *systemtenant.TenantService, tenantPackageService *systemtenant.TenantPackageService, loginLogService *systemloginlog.LoginLogService, authorizer *pkgAuth.Authorizer, dataScope pkgAuth.DataScopeResolver, tenantResolver *pkgAuth.TenantResolver, revocation pkgAuth.RevocationStore, sessions pkgAuth.SessionStore, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
-			newHTTPServerMiddleware(c, logger)...,
+			newServerMiddleware(c, authorizer, dataScope, tenantResolver, revocation, sessions, logger)...,
 		),
 	}
 	if c.Server.Http.Network != "" {
@@ -38,47 +38,15 @@ func NewHTTPServer(c *conf.Bootstrap, userService *systemuser.UserService, logge
 	}
 	srv := http.NewServer(opts...)
 	adminV1.RegisterUserHTTPServer(srv, userService)
+	adminV1.RegisterAuthHTTPServer(srv, authService)
+	adminV1.RegisterRoleHTTPServer(srv, roleService)
+	adminV1.RegisterMenuHTTPServer(srv, menuService)
+	adminV1.RegisterPermissionHTTPServer(srv, permissionService)
+	adminV1.RegisterPolicyHTTPServer(srv, policyService)
+	adminV1.RegisterDeptHTTPServer(srv, deptService)
+	adminV1.RegisterPostHTTPServer(srv, postService)
+	adminV1.RegisterTenantHTTPServer(srv, tenantService)
+	adminV1.RegisterTenantPackageHTTPServer(srv, tenantPackageService)
+	adminV1.RegisterLoginLogHTTPServer(srv, loginLogService)
 	return srv
 }
-
-var options = []jwt.Option{
-	jwt.WithClaims(func() jwtV5.Claims {
-		return jwtV5.MapClaims{}
-	}),
-}
-
-func newHTTPServerMiddleware(
-	config *conf.Bootstrap,
-	logger log.Logger,
-) []middleware.Middleware {
-	var ms []middleware.Middleware
-	ms = append(ms, recovery.Recovery())
-	ms = append(ms, tracing.Server())
-	ms = append(ms, pkgLogger.SimpleTraceIdProvider())
-	ms = append(ms, logging.Server(logger))
-
-	ms = append(ms, selector.Server(
-		// 认证
-		jwt.Server(func(token *jwtV5.Token) (interface{}, error) {
-			return []byte(config.Jwt.System.Secret), nil
-		}, options...),
-		// 处理ctx参数，将token解析出来的信息放到ctx中
-
-		// 鉴权
-
-	).Match(newHTTPServerWhiteListMatcher()).Build())
-	ms = append(ms, validate.Validator())
-	return ms
-}
-
-// NewWhiteListMatcher 创建jwt白名单
-func newHTTPServerWhiteListMatcher() selector.MatchFunc {
-	whiteList := make(map[string]struct{})
-	whiteList["demo"] = struct{}{}
-	return func(ctx context.Context, operation string) bool {
-		if _, ok := whiteList[operation]; ok {
-			return false
-		}
-		return true
-	}
-}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"qn-base/app/admin/internal/biz/systemloginlog"
+	"qn-base/app/admin/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// defaultLoginLogPurgeInterval 清理过期登录日志的默认间隔
+const defaultLoginLogPurgeInterval = time.Hour
+
+var _ transport.Server = (*JobServer)(nil)
+
+// Job is a background job run periodically.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// JobServer runs the background jobs periodically, started and stopped with the app.
+// 每个实例都会执行任务，任务需可重复执行
+type JobServer struct {
+	jobs []Job
+	log  *log.Helper
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewJobServer creates the server of the background jobs.
+func NewJobServer(c *conf.Bootstrap, loginLog systemloginlog.LoginLogUsecase, logger log.Logger) *JobServer {
+	purgeInterval := time.Duration(c.GetLoginLog().GetPurgeInterval()) * time.Second
+	if purgeInterval <= 0 {
+		purgeInterval = defaultLoginLogPurgeInterval
+	}
+	return &JobServer{
+		jobs: []Job{
+			{
+				Name:     "purge-login-log",
+				Interval: purgeInterval,
+				Run: func(ctx context.Context) error {
+					_, err := loginLog.PurgeExpiredLoginLogs(ctx)
+					return err
+				},
+			},
+		},
+		log: log.NewHelper(log.With(logger, "module", "server/job")),
+	}
+}
+
+// Start starts the jobs, each job runs once on start and then at its interval.
+func (s *JobServer) Start(ctx context.Context) error {
+	ctx, s.cancel = context.WithCancel(ctx)
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go func(job Job) {
+			defer s.wg.Done()
+			s.loop(ctx, job)
+		}(job)
+	}
+	return nil
+}
+
+// Stop stops the jobs, and waits for the running ones to return.
+func (s *JobServer) Stop(ctx context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// loop runs the job until ctx is done.
+func (s *JobServer) loop(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+	for {
+		if err := job.Run(ctx); err != nil && ctx.Err() == nil {
+			s.log.Errorf("job %s failed: %v", job.Name, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+
+	adminV1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/permission"
+	"qn-base/app/admin/internal/biz/policy"
+	"qn-base/app/admin/internal/biz/systemtenant"
+	"qn-base/app/admin/internal/conf"
+	pkgAuth "qn-base/pkg/auth"
+	pkgLogger "qn-base/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/middleware/logging"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/middleware/validate"
+	jwtV5 "github.com/golang-jwt/jwt/v5"
+)
+
+var options = []jwt.Option{
+	pkgAuth.WithClaims(),
+}
+
+// NewAuthorizer creates the authorizer, the permission of each operation is
+// declared by the (admin.v1.permission) method option in the proto files.
+// 启用 casbin 策略引擎时，角色权限不足的请求再由策略判定
+// 角色权限的缓存由角色和角色菜单的用例在变更后清除
+func NewAuthorizer(loader pkgAuth.RolePermissionLoader, policyUc policy.PolicyUsecase) (*pkgAuth.Authorizer, error) {
+	var opts []pkgAuth.AuthorizerOption
+	if policyUc.Enabled() {
+		opts = append(opts, pkgAuth.WithPolicyEnforcer(policyUc.Enforce))
+	}
+	authorizer := pkgAuth.NewAuthorizer(loader, opts...)
+	if err := authorizer.RegisterFromProto(nil, adminV1.E_Permission); err != nil {
+		return nil, err
+	}
+	return authorizer, nil
+}
+
+// NewDataScopeResolver creates the resolver of the data scope of the principal from its roles.
+func NewDataScopeResolver(uc permission.PermissionUsecase) pkgAuth.DataScopeResolver {
+	return uc.GetDataScope
+}
+
+// NewTenantResolver creates the resolver of the tenant of the request,
+// unauthenticated requests such as login are routed by the X-Tenant-Id header or the bound website.
+func NewTenantResolver(uc systemtenant.TenantUsecase) *pkgAuth.TenantResolver {
+	return pkgAuth.NewTenantResolver(uc.ResolveTenantByWebsite)
+}
+
+// newServerMiddleware returns the middlewares shared by the HTTP and gRPC servers.
+func newServerMiddleware(
+	config *conf.Bootstrap,
+	authorizer *pkgAuth.Authorizer,
+	dataScope pkgAuth.DataScopeResolver,
+	tenantResolver *pkgAuth.TenantResolver,
+	revocation pkgAuth.RevocationStore,
+	sessions pkgAuth.SessionStore,
+	logger log.Logger,
+) []middleware.Middleware {
+	var ms []middleware.Middleware
+	ms = append(ms, recovery.Recovery())
+	ms = append(ms, tracing.Server())
+	ms = append(ms, pkgLogger.SimpleTraceIdProvider())
+	ms = append(ms, logging.Server(logger))
+
+	ms = append(ms, selector.Server(
+		// 认证
+		jwt.Server(func(token *jwtV5.Token) (interface{}, error) {
+			return []byte(config.Jwt.System.Secret), nil
+		}, options...),
+		// 处理ctx参数，将token解析出来的信息放到ctx中
+		pkgAuth.Server(),
+		// 拒绝已吊销的令牌，如已停用、已重置密码或被强制下线的用户
+		pkgAuth.RevocationServer(revocation),
+		// 更新会话的最后活跃时间，同一会话每分钟最多写入一次
+		pkgAuth.SessionServer(sessions, pkgAuth.DefaultTouchInterval),
+
+		// 鉴权
+		authorizer.Server(),
+		// 数据权限，按角色的数据范围限制用户数据的读写
+		pkgAuth.DataScopeServer(dataScope),
+	).Match(newWhiteListMatcher()).Build())
+	// 租户解析，依次取令牌中的租户、X-Tenant-Id 请求头、域名绑定的租户
+	ms = append(ms, tenantResolver.Server())
+	ms = append(ms, validate.Validator())
+	return ms
+}
+
+// newWhiteListMatcher 创建jwt白名单
+func newWhiteListMatcher() selector.MatchFunc {
+	whiteList := make(map[string]struct{})
+	whiteList["demo"] = struct{}{}
+	whiteList[adminV1.OperationAuthLogin] = struct{}{}
+	whiteList[adminV1.OperationAuthRefreshToken] = struct{}{}
+	// 两步验证登录使用待验证令牌，由认证服务自行校验
+	whiteList[adminV1.OperationAuthLoginMFA] = struct{}{}
+	whiteList[adminV1.OperationAuthEnrollLoginMFA] = struct{}{}
+	return func(ctx context.Context, operation string) bool {
+		if _, ok := whiteList[operation]; ok {
+			return false
+		}
+		return true
+	}
+}
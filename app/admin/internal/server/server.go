@@ -1,8 +1,10 @@
 package server
 
 import (
+	pkgAuth "qn-base/pkg/auth"
+
 	"github.com/google/wire"
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer)
+var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewAuthorizer, wire.Bind(new(pkgAuth.PermissionInvalidator), new(*pkgAuth.Authorizer)), NewDataScopeResolver, NewTenantResolver, NewJobServer)
@@ -0,0 +1,239 @@
+package auth
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	v1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/auth"
+	"qn-base/app/admin/internal/service/auth/convertor"
+	pkgAuth "qn-base/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
+)
+
+// AuthService is an auth service.
+type AuthService struct {
+	v1.UnimplementedAuthServer
+
+	uc  auth.AuthUsecase
+	log *log.Helper
+}
+
+// NewAuthService new an auth service.
+func NewAuthService(logger log.Logger, uc auth.AuthUsecase) *AuthService {
+	l := log.NewHelper(log.With(logger, "module", "admin/service/auth-service"))
+	return &AuthService{
+		uc:  uc,
+		log: l,
+	}
+}
+
+// Login implements admin.AuthServer.
+func (s *AuthService) Login(ctx context.Context, in *v1.LoginRequest) (*v1.LoginReply, error) {
+	s.log.WithContext(ctx).Infof("Login: %v", in.Account)
+
+	result, err := s.uc.Login(ctx, &auth.LoginRequest{
+		Account:   in.Account,
+		Password:  in.Password,
+		ClientIP:  clientIP(ctx),
+		UserAgent: userAgent(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertor.ToLoginReply(result), nil
+}
+
+// Logout implements admin.AuthServer.
+func (s *AuthService) Logout(ctx context.Context, _ *v1.LogoutRequest) (*v1.LogoutReply, error) {
+	s.log.WithContext(ctx).Infof("Logout: %v", pkgAuth.UserID(ctx))
+
+	if err := s.uc.Logout(ctx); err != nil {
+		return nil, err
+	}
+
+	return &v1.LogoutReply{
+		Success: true,
+	}, nil
+}
+
+// RefreshToken implements admin.AuthServer.
+func (s *AuthService) RefreshToken(ctx context.Context, in *v1.RefreshTokenRequest) (*v1.RefreshTokenReply, error) {
+	token, err := s.uc.RefreshToken(ctx, in.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.RefreshTokenReply{
+		Token: convertor.ToTokenInfo(token),
+	}, nil
+}
+
+// ListOnlineSessions implements admin.AuthServer.
+func (s *AuthService) ListOnlineSessions(ctx context.Context, in *v1.ListOnlineSessionsRequest) (*v1.ListOnlineSessionsReply, error) {
+	s.log.WithContext(ctx).Infof("ListOnlineSessions: page=%d, page_size=%d", in.GetPage(), in.GetPageSize())
+
+	sessions, total, err := s.uc.ListOnlineSessions(ctx, convertor.ToListOnlineSessionsRequestBiz(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListOnlineSessionsReply{
+		Sessions: convertor.ToOnlineSessionInfos(sessions),
+		Total:    total,
+	}, nil
+}
+
+// TerminateSession implements admin.AuthServer.
+func (s *AuthService) TerminateSession(ctx context.Context, in *v1.TerminateSessionRequest) (*v1.TerminateSessionReply, error) {
+	s.log.WithContext(ctx).Infof("TerminateSession: tokenID=%s", in.TokenId)
+
+	if err := s.uc.TerminateSession(ctx, in.TokenId); err != nil {
+		return nil, err
+	}
+
+	return &v1.TerminateSessionReply{
+		Success: true,
+	}, nil
+}
+
+// UnlockLogin implements admin.AuthServer.
+func (s *AuthService) UnlockLogin(ctx context.Context, in *v1.UnlockLoginRequest) (*v1.UnlockLoginReply, error) {
+	s.log.WithContext(ctx).Infof("UnlockLogin: account=%s, ip=%s", in.Account, in.Ip)
+
+	if err := s.uc.UnlockLogin(ctx, in.Account, in.Ip); err != nil {
+		return nil, err
+	}
+
+	return &v1.UnlockLoginReply{
+		Success: true,
+	}, nil
+}
+
+// LoginMFA implements admin.AuthServer.
+func (s *AuthService) LoginMFA(ctx context.Context, in *v1.LoginMFARequest) (*v1.LoginReply, error) {
+	result, err := s.uc.LoginMFA(ctx, &auth.MFALoginRequest{
+		MFAToken:  in.MfaToken,
+		Code:      in.Code,
+		ClientIP:  clientIP(ctx),
+		UserAgent: userAgent(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertor.ToLoginReply(result), nil
+}
+
+// EnrollLoginMFA implements admin.AuthServer.
+func (s *AuthService) EnrollLoginMFA(ctx context.Context, in *v1.EnrollLoginMFARequest) (*v1.EnrollMFAReply, error) {
+	enrollment, err := s.uc.EnrollMFA(ctx, in.MfaToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertor.ToEnrollMFAReply(enrollment), nil
+}
+
+// GetMFAStatus implements admin.AuthServer.
+func (s *AuthService) GetMFAStatus(ctx context.Context, _ *v1.GetMFAStatusRequest) (*v1.GetMFAStatusReply, error) {
+	status, err := s.uc.GetMFAStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetMFAStatusReply{
+		Enabled:                status.Enabled,
+		Required:               status.Required,
+		RecoveryCodesRemaining: int32(status.RecoveryCodesRemaining),
+	}, nil
+}
+
+// EnrollMFA implements admin.AuthServer.
+func (s *AuthService) EnrollMFA(ctx context.Context, _ *v1.EnrollMFARequest) (*v1.EnrollMFAReply, error) {
+	s.log.WithContext(ctx).Infof("EnrollMFA: %v", pkgAuth.UserID(ctx))
+
+	enrollment, err := s.uc.EnrollMFA(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return convertor.ToEnrollMFAReply(enrollment), nil
+}
+
+// ActivateMFA implements admin.AuthServer.
+func (s *AuthService) ActivateMFA(ctx context.Context, in *v1.ActivateMFARequest) (*v1.ActivateMFAReply, error) {
+	codes, err := s.uc.ActivateMFA(ctx, in.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ActivateMFAReply{
+		RecoveryCodes: codes,
+	}, nil
+}
+
+// DisableMFA implements admin.AuthServer.
+func (s *AuthService) DisableMFA(ctx context.Context, in *v1.DisableMFARequest) (*v1.DisableMFAReply, error) {
+	s.log.WithContext(ctx).Infof("DisableMFA: %v", pkgAuth.UserID(ctx))
+
+	if err := s.uc.DisableMFA(ctx, in.Code); err != nil {
+		return nil, err
+	}
+
+	return &v1.DisableMFAReply{
+		Success: true,
+	}, nil
+}
+
+// ResetUserMFA implements admin.AuthServer.
+func (s *AuthService) ResetUserMFA(ctx context.Context, in *v1.ResetUserMFARequest) (*v1.ResetUserMFAReply, error) {
+	s.log.WithContext(ctx).Infof("ResetUserMFA: userID=%s", in.UserId)
+
+	if err := s.uc.ResetUserMFA(ctx, in.UserId); err != nil {
+		return nil, err
+	}
+
+	return &v1.ResetUserMFAReply{
+		Success: true,
+	}, nil
+}
+
+// userAgent 获取客户端的 User-Agent
+func userAgent(ctx context.Context) string {
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		return tr.RequestHeader().Get("User-Agent")
+	}
+	return ""
+}
+
+// clientIP 获取客户端IP，优先使用代理转发的请求头
+func clientIP(ctx context.Context) string {
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		if xff := tr.RequestHeader().Get("X-Forwarded-For"); xff != "" {
+			return strings.TrimSpace(strings.Split(xff, ",")[0])
+		}
+		if xri := tr.RequestHeader().Get("X-Real-IP"); xri != "" {
+			return xri
+		}
+	}
+	if req, ok := http.RequestFromServerContext(ctx); ok {
+		if host, _, err := net.SplitHostPort(req.RemoteAddr); err == nil {
+			return host
+		}
+		return req.RemoteAddr
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+	return ""
+}
@@ -0,0 +1,94 @@
+package convertor
+
+import (
+	v1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/auth"
+	userconvertor "qn-base/app/admin/internal/service/systemuser/convertor"
+	pkgAuth "qn-base/pkg/auth"
+)
+
+// ToTokenInfo converts TokenPair (biz) to TokenInfo (proto).
+func ToTokenInfo(token *auth.TokenPair) *v1.TokenInfo {
+	if token == nil {
+		return nil
+	}
+
+	return &v1.TokenInfo{
+		AccessToken:      token.AccessToken,
+		RefreshToken:     token.RefreshToken,
+		TokenType:        token.TokenType,
+		ExpiresAt:        token.ExpiresAt.Unix(),
+		RefreshExpiresAt: token.RefreshExpiresAt.Unix(),
+	}
+}
+
+// ToLoginReply converts LoginResult (biz) to LoginReply (proto).
+func ToLoginReply(result *auth.LoginResult) *v1.LoginReply {
+	return &v1.LoginReply{
+		Token:         ToTokenInfo(result.Token),
+		User:          userconvertor.ToUserInfo(result.User),
+		Mfa:           ToMFAChallenge(result.MFA),
+		RecoveryCodes: result.RecoveryCodes,
+	}
+}
+
+// ToMFAChallenge converts MFAChallenge (biz) to MFAChallenge (proto).
+func ToMFAChallenge(challenge *auth.MFAChallenge) *v1.MFAChallenge {
+	if challenge == nil {
+		return nil
+	}
+
+	return &v1.MFAChallenge{
+		MfaToken:       challenge.Token,
+		ExpiresAt:      challenge.ExpiresAt.Unix(),
+		EnrollRequired: challenge.EnrollRequired,
+	}
+}
+
+// ToEnrollMFAReply converts MFAEnrollment (biz) to EnrollMFAReply (proto).
+func ToEnrollMFAReply(enrollment *auth.MFAEnrollment) *v1.EnrollMFAReply {
+	return &v1.EnrollMFAReply{
+		Secret: enrollment.Secret,
+		Uri:    enrollment.URI,
+	}
+}
+
+// ToListOnlineSessionsRequestBiz converts ListOnlineSessionsRequest (proto) to ListOnlineSessionsRequest (biz).
+func ToListOnlineSessionsRequestBiz(in *v1.ListOnlineSessionsRequest) *auth.ListOnlineSessionsRequest {
+	return &auth.ListOnlineSessionsRequest{
+		Page:     in.GetPage(),
+		PageSize: in.GetPageSize(),
+		UserID:   in.GetUserId(),
+		Account:  in.GetAccount(),
+		IP:       in.GetIp(),
+	}
+}
+
+// ToOnlineSessionInfo converts Session to OnlineSessionInfo (proto).
+func ToOnlineSessionInfo(session *pkgAuth.Session) *v1.OnlineSessionInfo {
+	if session == nil {
+		return nil
+	}
+
+	return &v1.OnlineSessionInfo{
+		TokenId:    session.TokenID,
+		UserId:     session.UserID,
+		Account:    session.Account,
+		TenantId:   session.TenantID,
+		ClientType: string(session.ClientType),
+		Ip:         session.IP,
+		UserAgent:  session.UserAgent,
+		IssuedAt:   session.IssuedAt.Unix(),
+		LastSeenAt: session.LastSeenAt.Unix(),
+		ExpiresAt:  session.ExpiresAt.Unix(),
+	}
+}
+
+// ToOnlineSessionInfos converts Sessions to OnlineSessionInfos (proto).
+func ToOnlineSessionInfos(sessions []*pkgAuth.Session) []*v1.OnlineSessionInfo {
+	infos := make([]*v1.OnlineSessionInfo, 0, len(sessions))
+	for _, session := range sessions {
+		infos = append(infos, ToOnlineSessionInfo(session))
+	}
+	return infos
+}
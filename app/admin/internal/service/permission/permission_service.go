@@ -0,0 +1,122 @@
+package permission
+
+import (
+	"context"
+
+	v1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/permission"
+	roleconvertor "qn-base/app/admin/internal/service/systemrole/convertor"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// PermissionService is a permission assignment service.
+type PermissionService struct {
+	v1.UnimplementedPermissionServer
+
+	uc  permission.PermissionUsecase
+	log *log.Helper
+}
+
+// NewPermissionService new a permission service.
+func NewPermissionService(logger log.Logger, uc permission.PermissionUsecase) *PermissionService {
+	l := log.NewHelper(log.With(logger, "module", "admin/service/permission-service"))
+	return &PermissionService{
+		uc:  uc,
+		log: l,
+	}
+}
+
+// AssignUserRoles implements admin.PermissionServer.
+func (s *PermissionService) AssignUserRoles(ctx context.Context, in *v1.AssignUserRolesRequest) (*v1.AssignUserRolesReply, error) {
+	s.log.WithContext(ctx).Infof("AssignUserRoles: %v", in.UserId)
+
+	if err := s.uc.AssignUserRoles(ctx, in.UserId, in.RoleIds); err != nil {
+		return nil, err
+	}
+
+	return &v1.AssignUserRolesReply{
+		Success: true,
+	}, nil
+}
+
+// ReplaceUserRoles implements admin.PermissionServer.
+func (s *PermissionService) ReplaceUserRoles(ctx context.Context, in *v1.ReplaceUserRolesRequest) (*v1.ReplaceUserRolesReply, error) {
+	s.log.WithContext(ctx).Infof("ReplaceUserRoles: %v", in.UserId)
+
+	if err := s.uc.ReplaceUserRoles(ctx, in.UserId, in.RoleIds); err != nil {
+		return nil, err
+	}
+
+	return &v1.ReplaceUserRolesReply{
+		Success: true,
+	}, nil
+}
+
+// ListUserRoles implements admin.PermissionServer.
+func (s *PermissionService) ListUserRoles(ctx context.Context, in *v1.ListUserRolesRequest) (*v1.ListUserRolesReply, error) {
+	s.log.WithContext(ctx).Infof("ListUserRoles: %v", in.UserId)
+
+	roles, err := s.uc.ListUserRoles(ctx, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListUserRolesReply{
+		Roles: roleconvertor.ToRoleInfos(roles),
+	}, nil
+}
+
+// AssignRoleMenus implements admin.PermissionServer.
+func (s *PermissionService) AssignRoleMenus(ctx context.Context, in *v1.AssignRoleMenusRequest) (*v1.AssignRoleMenusReply, error) {
+	s.log.WithContext(ctx).Infof("AssignRoleMenus: %v", in.RoleId)
+
+	if err := s.uc.AssignRoleMenus(ctx, in.RoleId, in.MenuIds); err != nil {
+		return nil, err
+	}
+
+	return &v1.AssignRoleMenusReply{
+		Success: true,
+	}, nil
+}
+
+// ReplaceRoleMenus implements admin.PermissionServer.
+func (s *PermissionService) ReplaceRoleMenus(ctx context.Context, in *v1.ReplaceRoleMenusRequest) (*v1.ReplaceRoleMenusReply, error) {
+	s.log.WithContext(ctx).Infof("ReplaceRoleMenus: %v", in.RoleId)
+
+	if err := s.uc.ReplaceRoleMenus(ctx, in.RoleId, in.MenuIds); err != nil {
+		return nil, err
+	}
+
+	return &v1.ReplaceRoleMenusReply{
+		Success: true,
+	}, nil
+}
+
+// ListRoleMenus implements admin.PermissionServer.
+func (s *PermissionService) ListRoleMenus(ctx context.Context, in *v1.ListRoleMenusRequest) (*v1.ListRoleMenusReply, error) {
+	s.log.WithContext(ctx).Infof("ListRoleMenus: %v", in.RoleId)
+
+	menuIDs, err := s.uc.ListRoleMenuIDs(ctx, in.RoleId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListRoleMenusReply{
+		MenuIds: menuIDs,
+	}, nil
+}
+
+// GetUserPermissions implements admin.PermissionServer.
+func (s *PermissionService) GetUserPermissions(ctx context.Context, in *v1.GetUserPermissionsRequest) (*v1.GetUserPermissionsReply, error) {
+	s.log.WithContext(ctx).Infof("GetUserPermissions: %v", in.UserId)
+
+	permissions, err := s.uc.GetUserPermissions(ctx, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetUserPermissionsReply{
+		Permissions: permissions,
+	}, nil
+}
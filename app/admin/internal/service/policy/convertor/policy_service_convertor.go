@@ -0,0 +1,61 @@
+package convertor
+
+import (
+	v1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/policy"
+)
+
+// ToListPolicyRequestBiz converts ListPoliciesRequest to ListPolicyRequest (biz).
+func ToListPolicyRequestBiz(req *v1.ListPoliciesRequest) *policy.ListPolicyRequest {
+	if req == nil {
+		return &policy.ListPolicyRequest{}
+	}
+
+	return &policy.ListPolicyRequest{
+		Subject: req.Subject,
+		Domain:  req.Domain,
+		Object:  req.Object,
+	}
+}
+
+// ToAddPolicyBiz converts AddPolicyRequest to Policy (biz).
+func ToAddPolicyBiz(req *v1.AddPolicyRequest) *policy.Policy {
+	if req == nil {
+		return nil
+	}
+
+	return &policy.Policy{
+		Subject: req.Subject,
+		Domain:  req.Domain,
+		Object:  req.Object,
+		Action:  req.Action,
+	}
+}
+
+// ToRemovePolicyBiz converts RemovePolicyRequest to Policy (biz).
+func ToRemovePolicyBiz(req *v1.RemovePolicyRequest) *policy.Policy {
+	if req == nil {
+		return nil
+	}
+
+	return &policy.Policy{
+		Subject: req.Subject,
+		Domain:  req.Domain,
+		Object:  req.Object,
+		Action:  req.Action,
+	}
+}
+
+// ToPolicyInfos converts Policy (biz) list to PolicyInfo list.
+func ToPolicyInfos(policies []*policy.Policy) []*v1.PolicyInfo {
+	infos := make([]*v1.PolicyInfo, 0, len(policies))
+	for _, p := range policies {
+		infos = append(infos, &v1.PolicyInfo{
+			Subject: p.Subject,
+			Domain:  p.Domain,
+			Object:  p.Object,
+			Action:  p.Action,
+		})
+	}
+	return infos
+}
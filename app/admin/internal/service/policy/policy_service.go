@@ -0,0 +1,68 @@
+package policy
+
+import (
+	"context"
+
+	v1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/policy"
+	"qn-base/app/admin/internal/service/policy/convertor"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// PolicyService is a casbin policy service.
+type PolicyService struct {
+	v1.UnimplementedPolicyServer
+
+	uc  policy.PolicyUsecase
+	log *log.Helper
+}
+
+// NewPolicyService new a policy service.
+func NewPolicyService(logger log.Logger, uc policy.PolicyUsecase) *PolicyService {
+	l := log.NewHelper(log.With(logger, "module", "admin/service/policy-service"))
+	return &PolicyService{
+		uc:  uc,
+		log: l,
+	}
+}
+
+// ListPolicies implements admin.PolicyServer.
+func (s *PolicyService) ListPolicies(ctx context.Context, in *v1.ListPoliciesRequest) (*v1.ListPoliciesReply, error) {
+	s.log.WithContext(ctx).Infof("ListPolicies: %v", in)
+
+	policies, err := s.uc.ListPolicies(ctx, convertor.ToListPolicyRequestBiz(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListPoliciesReply{
+		Policies: convertor.ToPolicyInfos(policies),
+	}, nil
+}
+
+// AddPolicy implements admin.PolicyServer.
+func (s *PolicyService) AddPolicy(ctx context.Context, in *v1.AddPolicyRequest) (*v1.AddPolicyReply, error) {
+	s.log.WithContext(ctx).Infof("AddPolicy: %v", in)
+
+	if err := s.uc.AddPolicy(ctx, convertor.ToAddPolicyBiz(in)); err != nil {
+		return nil, err
+	}
+
+	return &v1.AddPolicyReply{
+		Success: true,
+	}, nil
+}
+
+// RemovePolicy implements admin.PolicyServer.
+func (s *PolicyService) RemovePolicy(ctx context.Context, in *v1.RemovePolicyRequest) (*v1.RemovePolicyReply, error) {
+	s.log.WithContext(ctx).Infof("RemovePolicy: %v", in)
+
+	if err := s.uc.RemovePolicy(ctx, convertor.ToRemovePolicyBiz(in)); err != nil {
+		return nil, err
+	}
+
+	return &v1.RemovePolicyReply{
+		Success: true,
+	}, nil
+}
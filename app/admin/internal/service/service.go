@@ -1,10 +1,19 @@
 package service
 
 import (
+	"qn-base/app/admin/internal/service/auth"
+	"qn-base/app/admin/internal/service/permission"
+	"qn-base/app/admin/internal/service/policy"
+	"qn-base/app/admin/internal/service/systemdept"
+	"qn-base/app/admin/internal/service/systemloginlog"
+	"qn-base/app/admin/internal/service/systemmenu"
+	"qn-base/app/admin/internal/service/systempost"
+	"qn-base/app/admin/internal/service/systemrole"
+	"qn-base/app/admin/internal/service/systemtenant"
 	"qn-base/app/admin/internal/service/systemuser"
 
 	"github.com/google/wire"
 )
 
 // ProviderSet is service providers.
-var ProviderSet = wire.NewSet(systemuser.NewUserService)
+var ProviderSet = wire.NewSet(systemuser.NewUserService, auth.NewAuthService, systemrole.NewRoleService, systemmenu.NewMenuService, permission.NewPermissionService, policy.NewPolicyService, systemdept.NewDeptService, systempost.NewPostService, systemtenant.NewTenantService, systemtenant.NewTenantPackageService, systemloginlog.NewLoginLogService)
@@ -0,0 +1,113 @@
+package convertor
+
+import (
+	v1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/systemdept"
+	"qn-base/pkg/lang/conv"
+	"qn-base/pkg/lang/ptr"
+)
+
+// ToCreateDeptBiz converts CreateDeptRequest to SystemDept (biz).
+func ToCreateDeptBiz(req *v1.CreateDeptRequest) *systemdept.SystemDept {
+	if req == nil {
+		return nil
+	}
+
+	return &systemdept.SystemDept{
+		Name:         &req.Name,
+		ParentID:     req.ParentId,
+		Sort:         req.Sort,
+		LeaderUserID: req.LeaderUserId,
+		Phone:        req.Phone,
+		Email:        req.Email,
+		Status:       toInt8(req.Status),
+	}
+}
+
+// ToUpdateDeptBiz converts UpdateDeptRequest to SystemDept (biz).
+func ToUpdateDeptBiz(req *v1.UpdateDeptRequest) *systemdept.SystemDept {
+	if req == nil {
+		return nil
+	}
+
+	return &systemdept.SystemDept{
+		ID:           &req.Id,
+		Name:         req.Name,
+		ParentID:     req.ParentId,
+		Sort:         req.Sort,
+		LeaderUserID: req.LeaderUserId,
+		Phone:        req.Phone,
+		Email:        req.Email,
+		Status:       toInt8(req.Status),
+	}
+}
+
+// ToListDeptRequestBiz converts ListDeptsRequest to ListDeptRequest (biz).
+func ToListDeptRequestBiz(req *v1.ListDeptsRequest) *systemdept.ListDeptRequest {
+	if req == nil {
+		return nil
+	}
+
+	return &systemdept.ListDeptRequest{
+		Name:   ptr.From(req.Name),
+		Status: toInt8(req.Status),
+	}
+}
+
+// ToDeptTreeRequestBiz converts GetDeptTreeRequest to ListDeptRequest (biz).
+func ToDeptTreeRequestBiz(req *v1.GetDeptTreeRequest) *systemdept.ListDeptRequest {
+	if req == nil {
+		return nil
+	}
+
+	return &systemdept.ListDeptRequest{
+		Name:   ptr.From(req.Name),
+		Status: toInt8(req.Status),
+	}
+}
+
+// ToDeptInfo converts SystemDept (biz) to DeptInfo (proto), including its children.
+func ToDeptInfo(dept *systemdept.SystemDept) *v1.DeptInfo {
+	if dept == nil {
+		return nil
+	}
+
+	return &v1.DeptInfo{
+		Id:           ptr.From(dept.ID),
+		Name:         ptr.From(dept.Name),
+		ParentId:     ptr.From(dept.ParentID),
+		Ancestors:    ptr.From(dept.Ancestors),
+		Sort:         ptr.From(dept.Sort),
+		LeaderUserId: ptr.From(dept.LeaderUserID),
+		Phone:        ptr.From(dept.Phone),
+		Email:        ptr.From(dept.Email),
+		Status:       int32(ptr.From(dept.Status)),
+		TenantId:     ptr.From(dept.TenantID),
+		Children:     ToDeptInfos(dept.Children),
+		CreatedAt:    conv.TimeToDefaultStr(ptr.From(dept.CreatedAt)),
+		UpdatedAt:    conv.TimeToDefaultStr(ptr.From(dept.UpdatedAt)),
+		CreatedBy:    ptr.From(dept.CreateBy),
+		UpdatedBy:    ptr.From(dept.UpdateBy),
+	}
+}
+
+// ToDeptInfos converts a slice of SystemDept (biz) to DeptInfo (proto).
+func ToDeptInfos(depts []*systemdept.SystemDept) []*v1.DeptInfo {
+	if depts == nil {
+		return nil
+	}
+
+	deptInfos := make([]*v1.DeptInfo, len(depts))
+	for i, dept := range depts {
+		deptInfos[i] = ToDeptInfo(dept)
+	}
+	return deptInfos
+}
+
+// toInt8 converts an optional int32 to an optional int8.
+func toInt8(v *int32) *int8 {
+	if v == nil {
+		return nil
+	}
+	return ptr.Of(int8(*v))
+}
@@ -0,0 +1,139 @@
+package systemdept
+
+import (
+	"context"
+
+	v1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/systemdept"
+	"qn-base/app/admin/internal/service/systemdept/convertor"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// DeptService is a dept service.
+type DeptService struct {
+	v1.UnimplementedDeptServer
+
+	uc  systemdept.DeptUsecase
+	log *log.Helper
+}
+
+// NewDeptService new a dept service.
+func NewDeptService(logger log.Logger, uc systemdept.DeptUsecase) *DeptService {
+	l := log.NewHelper(log.With(logger, "module", "admin/service/dept-service"))
+	return &DeptService{
+		uc:  uc,
+		log: l,
+	}
+}
+
+// CreateDept implements admin.DeptServer.
+func (s *DeptService) CreateDept(ctx context.Context, in *v1.CreateDeptRequest) (*v1.CreateDeptReply, error) {
+	s.log.WithContext(ctx).Infof("CreateDept: %v", in.Name)
+
+	dept, err := s.uc.CreateDept(ctx, convertor.ToCreateDeptBiz(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.CreateDeptReply{
+		Dept: convertor.ToDeptInfo(dept),
+	}, nil
+}
+
+// GetDeptTree implements admin.DeptServer.
+func (s *DeptService) GetDeptTree(ctx context.Context, in *v1.GetDeptTreeRequest) (*v1.GetDeptTreeReply, error) {
+	s.log.WithContext(ctx).Info("GetDeptTree")
+
+	depts, err := s.uc.GetDeptTree(ctx, convertor.ToDeptTreeRequestBiz(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetDeptTreeReply{
+		Depts: convertor.ToDeptInfos(depts),
+	}, nil
+}
+
+// GetDept implements admin.DeptServer.
+func (s *DeptService) GetDept(ctx context.Context, in *v1.GetDeptRequest) (*v1.GetDeptReply, error) {
+	s.log.WithContext(ctx).Infof("GetDept: %v", in.Id)
+
+	dept, err := s.uc.GetDept(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetDeptReply{
+		Dept: convertor.ToDeptInfo(dept),
+	}, nil
+}
+
+// UpdateDept implements admin.DeptServer.
+func (s *DeptService) UpdateDept(ctx context.Context, in *v1.UpdateDeptRequest) (*v1.UpdateDeptReply, error) {
+	s.log.WithContext(ctx).Infof("UpdateDept: %v", in.Id)
+
+	dept, err := s.uc.UpdateDept(ctx, convertor.ToUpdateDeptBiz(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.UpdateDeptReply{
+		Dept: convertor.ToDeptInfo(dept),
+	}, nil
+}
+
+// MoveDept implements admin.DeptServer.
+func (s *DeptService) MoveDept(ctx context.Context, in *v1.MoveDeptRequest) (*v1.MoveDeptReply, error) {
+	s.log.WithContext(ctx).Infof("MoveDept: %v -> %v", in.Id, in.ParentId)
+
+	if err := s.uc.MoveDept(ctx, in.Id, in.ParentId); err != nil {
+		return nil, err
+	}
+
+	return &v1.MoveDeptReply{
+		Success: true,
+	}, nil
+}
+
+// DeleteDept implements admin.DeptServer.
+func (s *DeptService) DeleteDept(ctx context.Context, in *v1.DeleteDeptRequest) (*v1.DeleteDeptReply, error) {
+	s.log.WithContext(ctx).Infof("DeleteDept: %v", in.Id)
+
+	err := s.uc.DeleteDept(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.DeleteDeptReply{
+		Success: true,
+	}, nil
+}
+
+// ListDeptDescendants implements admin.DeptServer.
+func (s *DeptService) ListDeptDescendants(ctx context.Context, in *v1.ListDeptDescendantsRequest) (*v1.ListDeptDescendantsReply, error) {
+	s.log.WithContext(ctx).Infof("ListDeptDescendants: %v", in.Id)
+
+	ids, err := s.uc.ListDescendantIDs(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListDeptDescendantsReply{
+		Ids: ids,
+	}, nil
+}
+
+// ListDepts implements admin.DeptServer.
+func (s *DeptService) ListDepts(ctx context.Context, in *v1.ListDeptsRequest) (*v1.ListDeptsReply, error) {
+	s.log.WithContext(ctx).Info("ListDepts")
+
+	depts, err := s.uc.ListDepts(ctx, convertor.ToListDeptRequestBiz(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListDeptsReply{
+		Depts: convertor.ToDeptInfos(depts),
+	}, nil
+}
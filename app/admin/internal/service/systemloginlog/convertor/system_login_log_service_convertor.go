@@ -0,0 +1,65 @@
+package convertor
+
+import (
+	v1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/systemloginlog"
+	"qn-base/pkg/lang/conv"
+	"qn-base/pkg/lang/ptr"
+)
+
+// ToListLoginLogRequestBiz converts ListLoginLogsRequest to ListLoginLogRequest (biz).
+func ToListLoginLogRequestBiz(req *v1.ListLoginLogsRequest) *systemloginlog.ListLoginLogRequest {
+	if req == nil {
+		return nil
+	}
+
+	var status *int8
+	if req.Status != nil {
+		status = ptr.Of(int8(*req.Status))
+	}
+	return &systemloginlog.ListLoginLogRequest{
+		Page:      ptr.From(req.Page),
+		PageSize:  ptr.From(req.PageSize),
+		Event:     ptr.From(req.Event),
+		UserID:    ptr.From(req.UserId),
+		Account:   ptr.From(req.Account),
+		IP:        ptr.From(req.Ip),
+		Status:    status,
+		Reason:    ptr.From(req.Reason),
+		StartDate: ptr.From(req.StartDate),
+		EndDate:   ptr.From(req.EndDate),
+	}
+}
+
+// ToLoginLogInfo converts SystemLoginLog (biz) to LoginLogInfo (proto).
+func ToLoginLogInfo(l *systemloginlog.SystemLoginLog) *v1.LoginLogInfo {
+	if l == nil {
+		return nil
+	}
+
+	return &v1.LoginLogInfo{
+		Id:        ptr.From(l.ID),
+		Event:     ptr.From(l.Event),
+		UserId:    ptr.From(l.UserID),
+		Account:   ptr.From(l.Account),
+		Status:    int32(ptr.From(l.Status)),
+		Reason:    ptr.From(l.Reason),
+		Ip:        ptr.From(l.IP),
+		UserAgent: ptr.From(l.UserAgent),
+		TenantId:  ptr.From(l.TenantID),
+		CreatedAt: conv.TimeToDefaultStr(ptr.From(l.CreatedAt)),
+	}
+}
+
+// ToLoginLogInfos converts a slice of SystemLoginLog (biz) to LoginLogInfo (proto).
+func ToLoginLogInfos(logs []*systemloginlog.SystemLoginLog) []*v1.LoginLogInfo {
+	if logs == nil {
+		return nil
+	}
+
+	infos := make([]*v1.LoginLogInfo, len(logs))
+	for i, l := range logs {
+		infos[i] = ToLoginLogInfo(l)
+	}
+	return infos
+}
@@ -0,0 +1,44 @@
+package systemloginlog
+
+import (
+	"context"
+
+	v1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/systemloginlog"
+	"qn-base/app/admin/internal/service/systemloginlog/convertor"
+	"qn-base/pkg/lang/ptr"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// LoginLogService is a login log service.
+type LoginLogService struct {
+	v1.UnimplementedLoginLogServer
+
+	uc  systemloginlog.LoginLogUsecase
+	log *log.Helper
+}
+
+// NewLoginLogService new a login log service.
+func NewLoginLogService(logger log.Logger, uc systemloginlog.LoginLogUsecase) *LoginLogService {
+	l := log.NewHelper(log.With(logger, "module", "admin/service/login-log-service"))
+	return &LoginLogService{
+		uc:  uc,
+		log: l,
+	}
+}
+
+// ListLoginLogs implements admin.LoginLogServer.
+func (s *LoginLogService) ListLoginLogs(ctx context.Context, in *v1.ListLoginLogsRequest) (*v1.ListLoginLogsReply, error) {
+	s.log.WithContext(ctx).Infof("ListLoginLogs: page=%d, page_size=%d", ptr.From(in.Page), ptr.From(in.PageSize))
+
+	logs, total, err := s.uc.ListLoginLogs(ctx, convertor.ToListLoginLogRequestBiz(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListLoginLogsReply{
+		Logs:  convertor.ToLoginLogInfos(logs),
+		Total: total,
+	}, nil
+}
@@ -0,0 +1,155 @@
+package convertor
+
+import (
+	v1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/systemmenu"
+	"qn-base/pkg/lang/conv"
+	"qn-base/pkg/lang/ptr"
+)
+
+// ToCreateMenuBiz converts CreateMenuRequest to SystemMenu (biz).
+func ToCreateMenuBiz(req *v1.CreateMenuRequest) *systemmenu.SystemMenu {
+	if req == nil {
+		return nil
+	}
+
+	return &systemmenu.SystemMenu{
+		Name:          &req.Name,
+		Permission:    req.Permission,
+		Type:          ptr.Of(int8(req.Type)),
+		Sort:          req.Sort,
+		ParentID:      req.ParentId,
+		Path:          req.Path,
+		Icon:          req.Icon,
+		Component:     req.Component,
+		ComponentName: req.ComponentName,
+		Status:        toInt8(req.Status),
+		Visible:       req.Visible,
+		KeepAlive:     req.KeepAlive,
+		AlwaysShow:    req.AlwaysShow,
+	}
+}
+
+// ToUpdateMenuBiz converts UpdateMenuRequest to SystemMenu (biz).
+func ToUpdateMenuBiz(req *v1.UpdateMenuRequest) *systemmenu.SystemMenu {
+	if req == nil {
+		return nil
+	}
+
+	return &systemmenu.SystemMenu{
+		ID:            &req.Id,
+		Name:          req.Name,
+		Permission:    req.Permission,
+		Type:          toInt8(req.Type),
+		Sort:          req.Sort,
+		ParentID:      req.ParentId,
+		Path:          req.Path,
+		Icon:          req.Icon,
+		Component:     req.Component,
+		ComponentName: req.ComponentName,
+		Status:        toInt8(req.Status),
+		Visible:       req.Visible,
+		KeepAlive:     req.KeepAlive,
+		AlwaysShow:    req.AlwaysShow,
+	}
+}
+
+// ToListMenuRequestBiz converts ListMenusRequest to ListMenuRequest (biz).
+func ToListMenuRequestBiz(req *v1.ListMenusRequest) *systemmenu.ListMenuRequest {
+	if req == nil {
+		return nil
+	}
+
+	return &systemmenu.ListMenuRequest{
+		Name:   ptr.From(req.Name),
+		Status: toInt8(req.Status),
+		Type:   toInt8(req.Type),
+	}
+}
+
+// ToMenuTreeRequestBiz converts GetMenuTreeRequest to ListMenuRequest (biz).
+func ToMenuTreeRequestBiz(req *v1.GetMenuTreeRequest) *systemmenu.ListMenuRequest {
+	if req == nil {
+		return nil
+	}
+
+	return &systemmenu.ListMenuRequest{
+		Name:   ptr.From(req.Name),
+		Status: toInt8(req.Status),
+	}
+}
+
+// ToMenuInfo converts SystemMenu (biz) to MenuInfo (proto), including its children.
+func ToMenuInfo(menu *systemmenu.SystemMenu) *v1.MenuInfo {
+	if menu == nil {
+		return nil
+	}
+
+	return &v1.MenuInfo{
+		Id:            ptr.From(menu.ID),
+		Name:          ptr.From(menu.Name),
+		Permission:    ptr.From(menu.Permission),
+		Type:          int32(ptr.From(menu.Type)),
+		Sort:          ptr.From(menu.Sort),
+		ParentId:      ptr.From(menu.ParentID),
+		Path:          ptr.From(menu.Path),
+		Icon:          ptr.From(menu.Icon),
+		Component:     ptr.From(menu.Component),
+		ComponentName: ptr.From(menu.ComponentName),
+		Status:        int32(ptr.From(menu.Status)),
+		Visible:       ptr.From(menu.Visible),
+		KeepAlive:     ptr.From(menu.KeepAlive),
+		AlwaysShow:    ptr.From(menu.AlwaysShow),
+		Children:      ToMenuInfos(menu.Children),
+		CreatedAt:     conv.TimeToDefaultStr(ptr.From(menu.CreatedAt)),
+		UpdatedAt:     conv.TimeToDefaultStr(ptr.From(menu.UpdatedAt)),
+		CreatedBy:     ptr.From(menu.CreateBy),
+		UpdatedBy:     ptr.From(menu.UpdateBy),
+	}
+}
+
+// ToMenuInfos converts a slice of SystemMenu (biz) to MenuInfo (proto).
+func ToMenuInfos(menus []*systemmenu.SystemMenu) []*v1.MenuInfo {
+	if menus == nil {
+		return nil
+	}
+
+	menuInfos := make([]*v1.MenuInfo, len(menus))
+	for i, menu := range menus {
+		menuInfos[i] = ToMenuInfo(menu)
+	}
+	return menuInfos
+}
+
+// ToRouteInfos converts a SystemMenu (biz) tree to RouteInfo (proto) tree.
+func ToRouteInfos(menus []*systemmenu.SystemMenu) []*v1.RouteInfo {
+	if menus == nil {
+		return nil
+	}
+
+	routes := make([]*v1.RouteInfo, len(menus))
+	for i, menu := range menus {
+		routes[i] = &v1.RouteInfo{
+			Id:            ptr.From(menu.ID),
+			ParentId:      ptr.From(menu.ParentID),
+			Name:          ptr.From(menu.Name),
+			Path:          ptr.From(menu.Path),
+			Component:     ptr.From(menu.Component),
+			ComponentName: ptr.From(menu.ComponentName),
+			Icon:          ptr.From(menu.Icon),
+			Visible:       ptr.From(menu.Visible),
+			KeepAlive:     ptr.From(menu.KeepAlive),
+			AlwaysShow:    ptr.From(menu.AlwaysShow),
+			Children:      ToRouteInfos(menu.Children),
+		}
+	}
+	return routes
+}
+
+// toInt8 converts an optional int32 to an optional int8.
+func toInt8(v *int32) *int8 {
+	if v == nil {
+		return nil
+	}
+	return ptr.Of(int8(*v))
+}
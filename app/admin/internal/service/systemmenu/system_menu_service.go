@@ -0,0 +1,127 @@
+package systemmenu
+
+import (
+	"context"
+
+	v1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/systemmenu"
+	"qn-base/app/admin/internal/service/systemmenu/convertor"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// MenuService is a menu service.
+type MenuService struct {
+	v1.UnimplementedMenuServer
+
+	uc  systemmenu.MenuUsecase
+	log *log.Helper
+}
+
+// NewMenuService new a menu service.
+func NewMenuService(logger log.Logger, uc systemmenu.MenuUsecase) *MenuService {
+	l := log.NewHelper(log.With(logger, "module", "admin/service/menu-service"))
+	return &MenuService{
+		uc:  uc,
+		log: l,
+	}
+}
+
+// CreateMenu implements admin.MenuServer.
+func (s *MenuService) CreateMenu(ctx context.Context, in *v1.CreateMenuRequest) (*v1.CreateMenuReply, error) {
+	s.log.WithContext(ctx).Infof("CreateMenu: %v", in.Name)
+
+	menu, err := s.uc.CreateMenu(ctx, convertor.ToCreateMenuBiz(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.CreateMenuReply{
+		Menu: convertor.ToMenuInfo(menu),
+	}, nil
+}
+
+// GetMenuTree implements admin.MenuServer.
+func (s *MenuService) GetMenuTree(ctx context.Context, in *v1.GetMenuTreeRequest) (*v1.GetMenuTreeReply, error) {
+	s.log.WithContext(ctx).Info("GetMenuTree")
+
+	menus, err := s.uc.GetMenuTree(ctx, convertor.ToMenuTreeRequestBiz(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetMenuTreeReply{
+		Menus: convertor.ToMenuInfos(menus),
+	}, nil
+}
+
+// GetUserRoutes implements admin.MenuServer.
+func (s *MenuService) GetUserRoutes(ctx context.Context, _ *v1.GetUserRoutesRequest) (*v1.GetUserRoutesReply, error) {
+	s.log.WithContext(ctx).Info("GetUserRoutes")
+
+	routes, err := s.uc.GetUserRoutes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetUserRoutesReply{
+		Routes:      convertor.ToRouteInfos(routes.Routes),
+		Permissions: routes.Permissions,
+	}, nil
+}
+
+// GetMenu implements admin.MenuServer.
+func (s *MenuService) GetMenu(ctx context.Context, in *v1.GetMenuRequest) (*v1.GetMenuReply, error) {
+	s.log.WithContext(ctx).Infof("GetMenu: %v", in.Id)
+
+	menu, err := s.uc.GetMenu(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetMenuReply{
+		Menu: convertor.ToMenuInfo(menu),
+	}, nil
+}
+
+// UpdateMenu implements admin.MenuServer.
+func (s *MenuService) UpdateMenu(ctx context.Context, in *v1.UpdateMenuRequest) (*v1.UpdateMenuReply, error) {
+	s.log.WithContext(ctx).Infof("UpdateMenu: %v", in.Id)
+
+	menu, err := s.uc.UpdateMenu(ctx, convertor.ToUpdateMenuBiz(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.UpdateMenuReply{
+		Menu: convertor.ToMenuInfo(menu),
+	}, nil
+}
+
+// DeleteMenu implements admin.MenuServer.
+func (s *MenuService) DeleteMenu(ctx context.Context, in *v1.DeleteMenuRequest) (*v1.DeleteMenuReply, error) {
+	s.log.WithContext(ctx).Infof("DeleteMenu: %v", in.Id)
+
+	err := s.uc.DeleteMenu(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.DeleteMenuReply{
+		Success: true,
+	}, nil
+}
+
+// ListMenus implements admin.MenuServer.
+func (s *MenuService) ListMenus(ctx context.Context, in *v1.ListMenusRequest) (*v1.ListMenusReply, error) {
+	s.log.WithContext(ctx).Info("ListMenus")
+
+	menus, err := s.uc.ListMenus(ctx, convertor.ToListMenuRequestBiz(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListMenusReply{
+		Menus: convertor.ToMenuInfos(menus),
+	}, nil
+}
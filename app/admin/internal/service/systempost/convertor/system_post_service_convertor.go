@@ -0,0 +1,113 @@
+package convertor
+
+import (
+	v1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/systempost"
+	"qn-base/pkg/lang/conv"
+	"qn-base/pkg/lang/ptr"
+)
+
+// ToCreatePostBiz converts CreatePostRequest to SystemPost (biz).
+func ToCreatePostBiz(req *v1.CreatePostRequest) *systempost.SystemPost {
+	if req == nil {
+		return nil
+	}
+
+	return &systempost.SystemPost{
+		Code:   &req.Code,
+		Name:   &req.Name,
+		Sort:   req.Sort,
+		Status: toInt8(req.Status),
+		Remark: req.Remark,
+	}
+}
+
+// ToUpdatePostBiz converts UpdatePostRequest to SystemPost (biz).
+func ToUpdatePostBiz(req *v1.UpdatePostRequest) *systempost.SystemPost {
+	if req == nil {
+		return nil
+	}
+
+	return &systempost.SystemPost{
+		ID:     &req.Id,
+		Code:   req.Code,
+		Name:   req.Name,
+		Sort:   req.Sort,
+		Status: toInt8(req.Status),
+		Remark: req.Remark,
+	}
+}
+
+// ToListPostRequestBiz converts ListPostsRequest to ListPostRequest (biz).
+func ToListPostRequestBiz(req *v1.ListPostsRequest) *systempost.ListPostRequest {
+	if req == nil {
+		return nil
+	}
+
+	return &systempost.ListPostRequest{
+		Page:     ptr.From(req.Page),
+		PageSize: ptr.From(req.PageSize),
+		Code:     ptr.From(req.Code),
+		Name:     ptr.From(req.Name),
+		Status:   toInt8(req.Status),
+	}
+}
+
+// ToPostInfo converts SystemPost (biz) to PostInfo (proto).
+func ToPostInfo(post *systempost.SystemPost) *v1.PostInfo {
+	if post == nil {
+		return nil
+	}
+
+	return &v1.PostInfo{
+		Id:        ptr.From(post.ID),
+		Code:      ptr.From(post.Code),
+		Name:      ptr.From(post.Name),
+		Sort:      ptr.From(post.Sort),
+		Status:    int32(ptr.From(post.Status)),
+		Remark:    ptr.From(post.Remark),
+		TenantId:  ptr.From(post.TenantID),
+		CreatedAt: conv.TimeToDefaultStr(ptr.From(post.CreatedAt)),
+		UpdatedAt: conv.TimeToDefaultStr(ptr.From(post.UpdatedAt)),
+		CreatedBy: ptr.From(post.CreateBy),
+		UpdatedBy: ptr.From(post.UpdateBy),
+	}
+}
+
+// ToPostInfos converts a slice of SystemPost (biz) to PostInfo (proto).
+func ToPostInfos(posts []*systempost.SystemPost) []*v1.PostInfo {
+	if posts == nil {
+		return nil
+	}
+
+	postInfos := make([]*v1.PostInfo, len(posts))
+	for i, post := range posts {
+		postInfos[i] = ToPostInfo(post)
+	}
+	return postInfos
+}
+
+// ToPostRefs converts a slice of SystemPost (biz) to PostRef (proto).
+func ToPostRefs(posts []*systempost.SystemPost) []*v1.PostRef {
+	if posts == nil {
+		return nil
+	}
+
+	refs := make([]*v1.PostRef, len(posts))
+	for i, post := range posts {
+		refs[i] = &v1.PostRef{
+			Id:   ptr.From(post.ID),
+			Code: ptr.From(post.Code),
+			Name: ptr.From(post.Name),
+		}
+	}
+	return refs
+}
+
+// toInt8 converts an optional int32 to an optional int8.
+func toInt8(v *int32) *int8 {
+	if v == nil {
+		return nil
+	}
+	return ptr.Of(int8(*v))
+}
@@ -0,0 +1,128 @@
+package systempost
+
+import (
+	"context"
+
+	v1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/systempost"
+	"qn-base/app/admin/internal/service/systempost/convertor"
+	"qn-base/pkg/lang/ptr"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// PostService is a post service.
+type PostService struct {
+	v1.UnimplementedPostServer
+
+	uc  systempost.PostUsecase
+	log *log.Helper
+}
+
+// NewPostService new a post service.
+func NewPostService(logger log.Logger, uc systempost.PostUsecase) *PostService {
+	l := log.NewHelper(log.With(logger, "module", "admin/service/post-service"))
+	return &PostService{
+		uc:  uc,
+		log: l,
+	}
+}
+
+// CreatePost implements admin.PostServer.
+func (s *PostService) CreatePost(ctx context.Context, in *v1.CreatePostRequest) (*v1.CreatePostReply, error) {
+	s.log.WithContext(ctx).Infof("CreatePost: %v", in.Code)
+
+	post, err := s.uc.CreatePost(ctx, convertor.ToCreatePostBiz(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.CreatePostReply{
+		Post: convertor.ToPostInfo(post),
+	}, nil
+}
+
+// GetPost implements admin.PostServer.
+func (s *PostService) GetPost(ctx context.Context, in *v1.GetPostRequest) (*v1.GetPostReply, error) {
+	s.log.WithContext(ctx).Infof("GetPost: %v", in.Id)
+
+	post, err := s.uc.GetPost(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetPostReply{
+		Post: convertor.ToPostInfo(post),
+	}, nil
+}
+
+// UpdatePost implements admin.PostServer.
+func (s *PostService) UpdatePost(ctx context.Context, in *v1.UpdatePostRequest) (*v1.UpdatePostReply, error) {
+	s.log.WithContext(ctx).Infof("UpdatePost: %v", in.Id)
+
+	post, err := s.uc.UpdatePost(ctx, convertor.ToUpdatePostBiz(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.UpdatePostReply{
+		Post: convertor.ToPostInfo(post),
+	}, nil
+}
+
+// DeletePost implements admin.PostServer.
+func (s *PostService) DeletePost(ctx context.Context, in *v1.DeletePostRequest) (*v1.DeletePostReply, error) {
+	s.log.WithContext(ctx).Infof("DeletePost: %v", in.Id)
+
+	err := s.uc.DeletePost(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.DeletePostReply{
+		Success: true,
+	}, nil
+}
+
+// ListPosts implements admin.PostServer.
+func (s *PostService) ListPosts(ctx context.Context, in *v1.ListPostsRequest) (*v1.ListPostsReply, error) {
+	s.log.WithContext(ctx).Infof("ListPosts: page=%d, page_size=%d", ptr.From(in.Page), ptr.From(in.PageSize))
+
+	posts, total, err := s.uc.ListPosts(ctx, convertor.ToListPostRequestBiz(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListPostsReply{
+		Posts: convertor.ToPostInfos(posts),
+		Total: total,
+	}, nil
+}
+
+// ChangePostStatus implements admin.PostServer.
+func (s *PostService) ChangePostStatus(ctx context.Context, in *v1.ChangePostStatusRequest) (*v1.ChangePostStatusReply, error) {
+	s.log.WithContext(ctx).Infof("ChangePostStatus: id=%s, status=%d", in.Id, in.Status)
+
+	err := s.uc.ChangePostStatus(ctx, in.Id, int8(in.Status))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ChangePostStatusReply{
+		Success: true,
+	}, nil
+}
+
+// UpdatePostSort implements admin.PostServer.
+func (s *PostService) UpdatePostSort(ctx context.Context, in *v1.UpdatePostSortRequest) (*v1.UpdatePostSortReply, error) {
+	s.log.WithContext(ctx).Infof("UpdatePostSort: id=%s, sort=%d", in.Id, in.Sort)
+
+	err := s.uc.UpdatePostSort(ctx, in.Id, in.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.UpdatePostSortReply{
+		Success: true,
+	}, nil
+}
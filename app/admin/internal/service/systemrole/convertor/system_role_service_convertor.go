@@ -0,0 +1,103 @@
+package convertor
+
+import (
+	v1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/systemrole"
+	"qn-base/pkg/lang/conv"
+	"qn-base/pkg/lang/ptr"
+)
+
+// ToCreateRoleBiz converts CreateRoleRequest to SystemRole (biz).
+func ToCreateRoleBiz(req *v1.CreateRoleRequest) *systemrole.SystemRole {
+	if req == nil {
+		return nil
+	}
+
+	return &systemrole.SystemRole{
+		Name:             &req.Name,
+		Code:             &req.Code,
+		Sort:             req.Sort,
+		DataScope:        toInt8(req.DataScope),
+		DataScopeDeptIDs: req.DataScopeDeptIds,
+		Status:           toInt8(req.Status),
+		Remark:           req.Remark,
+	}
+}
+
+// ToUpdateRoleBiz converts UpdateRoleRequest to SystemRole (biz).
+func ToUpdateRoleBiz(req *v1.UpdateRoleRequest) *systemrole.SystemRole {
+	if req == nil {
+		return nil
+	}
+
+	return &systemrole.SystemRole{
+		ID:               &req.Id,
+		Name:             req.Name,
+		Code:             req.Code,
+		Sort:             req.Sort,
+		DataScope:        toInt8(req.DataScope),
+		DataScopeDeptIDs: req.DataScopeDeptIds,
+		Remark:           req.Remark,
+	}
+}
+
+// ToListRoleRequestBiz converts ListRolesRequest to ListRoleRequest (biz).
+func ToListRoleRequestBiz(req *v1.ListRolesRequest) *systemrole.ListRoleRequest {
+	if req == nil {
+		return nil
+	}
+
+	return &systemrole.ListRoleRequest{
+		Page:     ptr.From(req.Page),
+		PageSize: ptr.From(req.PageSize),
+		Name:     ptr.From(req.Name),
+		Code:     ptr.From(req.Code),
+		Status:   toInt8(req.Status),
+		Type:     toInt8(req.Type),
+	}
+}
+
+// ToRoleInfo converts SystemRole (biz) to RoleInfo (proto).
+func ToRoleInfo(role *systemrole.SystemRole) *v1.RoleInfo {
+	if role == nil {
+		return nil
+	}
+
+	return &v1.RoleInfo{
+		Id:               ptr.From(role.ID),
+		Name:             ptr.From(role.Name),
+		Code:             ptr.From(role.Code),
+		Sort:             ptr.From(role.Sort),
+		DataScope:        int32(ptr.From(role.DataScope)),
+		DataScopeDeptIds: role.DataScopeDeptIDs,
+		Status:           int32(ptr.From(role.Status)),
+		Type:             int32(ptr.From(role.Type)),
+		Remark:           ptr.From(role.Remark),
+		TenantId:         ptr.From(role.TenantID),
+		CreatedAt:        conv.TimeToDefaultStr(ptr.From(role.CreatedAt)),
+		UpdatedAt:        conv.TimeToDefaultStr(ptr.From(role.UpdatedAt)),
+		CreatedBy:        ptr.From(role.CreateBy),
+		UpdatedBy:        ptr.From(role.UpdateBy),
+	}
+}
+
+// ToRoleInfos converts a slice of SystemRole (biz) to RoleInfo (proto).
+func ToRoleInfos(roles []*systemrole.SystemRole) []*v1.RoleInfo {
+	if roles == nil {
+		return nil
+	}
+
+	roleInfos := make([]*v1.RoleInfo, len(roles))
+	for i, role := range roles {
+		roleInfos[i] = ToRoleInfo(role)
+	}
+	return roleInfos
+}
+
+// toInt8 converts an optional int32 to an optional int8.
+func toInt8(v *int32) *int8 {
+	if v == nil {
+		return nil
+	}
+	return ptr.Of(int8(*v))
+}
@@ -0,0 +1,128 @@
+package systemrole
+
+import (
+	"context"
+
+	v1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/systemrole"
+	"qn-base/app/admin/internal/service/systemrole/convertor"
+	"qn-base/pkg/lang/ptr"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// RoleService is a role service.
+type RoleService struct {
+	v1.UnimplementedRoleServer
+
+	uc  systemrole.RoleUsecase
+	log *log.Helper
+}
+
+// NewRoleService new a role service.
+func NewRoleService(logger log.Logger, uc systemrole.RoleUsecase) *RoleService {
+	l := log.NewHelper(log.With(logger, "module", "admin/service/role-service"))
+	return &RoleService{
+		uc:  uc,
+		log: l,
+	}
+}
+
+// CreateRole implements admin.RoleServer.
+func (s *RoleService) CreateRole(ctx context.Context, in *v1.CreateRoleRequest) (*v1.CreateRoleReply, error) {
+	s.log.WithContext(ctx).Infof("CreateRole: %v", in.Code)
+
+	role, err := s.uc.CreateRole(ctx, convertor.ToCreateRoleBiz(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.CreateRoleReply{
+		Role: convertor.ToRoleInfo(role),
+	}, nil
+}
+
+// GetRole implements admin.RoleServer.
+func (s *RoleService) GetRole(ctx context.Context, in *v1.GetRoleRequest) (*v1.GetRoleReply, error) {
+	s.log.WithContext(ctx).Infof("GetRole: %v", in.Id)
+
+	role, err := s.uc.GetRole(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetRoleReply{
+		Role: convertor.ToRoleInfo(role),
+	}, nil
+}
+
+// UpdateRole implements admin.RoleServer.
+func (s *RoleService) UpdateRole(ctx context.Context, in *v1.UpdateRoleRequest) (*v1.UpdateRoleReply, error) {
+	s.log.WithContext(ctx).Infof("UpdateRole: %v", in.Id)
+
+	role, err := s.uc.UpdateRole(ctx, convertor.ToUpdateRoleBiz(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.UpdateRoleReply{
+		Role: convertor.ToRoleInfo(role),
+	}, nil
+}
+
+// DeleteRole implements admin.RoleServer.
+func (s *RoleService) DeleteRole(ctx context.Context, in *v1.DeleteRoleRequest) (*v1.DeleteRoleReply, error) {
+	s.log.WithContext(ctx).Infof("DeleteRole: %v", in.Id)
+
+	err := s.uc.DeleteRole(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.DeleteRoleReply{
+		Success: true,
+	}, nil
+}
+
+// ListRoles implements admin.RoleServer.
+func (s *RoleService) ListRoles(ctx context.Context, in *v1.ListRolesRequest) (*v1.ListRolesReply, error) {
+	s.log.WithContext(ctx).Infof("ListRoles: page=%d, page_size=%d", ptr.From(in.Page), ptr.From(in.PageSize))
+
+	roles, total, err := s.uc.ListRoles(ctx, convertor.ToListRoleRequestBiz(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListRolesReply{
+		Roles: convertor.ToRoleInfos(roles),
+		Total: total,
+	}, nil
+}
+
+// ChangeRoleStatus implements admin.RoleServer.
+func (s *RoleService) ChangeRoleStatus(ctx context.Context, in *v1.ChangeRoleStatusRequest) (*v1.ChangeRoleStatusReply, error) {
+	s.log.WithContext(ctx).Infof("ChangeRoleStatus: id=%s, status=%d", in.Id, in.Status)
+
+	err := s.uc.ChangeRoleStatus(ctx, in.Id, int8(in.Status))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ChangeRoleStatusReply{
+		Success: true,
+	}, nil
+}
+
+// UpdateRoleSort implements admin.RoleServer.
+func (s *RoleService) UpdateRoleSort(ctx context.Context, in *v1.UpdateRoleSortRequest) (*v1.UpdateRoleSortReply, error) {
+	s.log.WithContext(ctx).Infof("UpdateRoleSort: id=%s, sort=%d", in.Id, in.Sort)
+
+	err := s.uc.UpdateRoleSort(ctx, in.Id, in.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.UpdateRoleSortReply{
+		Success: true,
+	}, nil
+}
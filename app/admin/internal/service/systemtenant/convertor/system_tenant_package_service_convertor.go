@@ -0,0 +1,89 @@
+package convertor
+
+import (
+	v1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/systemtenant"
+	"qn-base/pkg/lang/conv"
+	"qn-base/pkg/lang/ptr"
+)
+
+// ToCreateTenantPackageBiz converts CreateTenantPackageRequest to SystemTenantPackage (biz).
+func ToCreateTenantPackageBiz(req *v1.CreateTenantPackageRequest) *systemtenant.SystemTenantPackage {
+	if req == nil {
+		return nil
+	}
+
+	return &systemtenant.SystemTenantPackage{
+		Name:    &req.Name,
+		Status:  toInt8(req.Status),
+		MenuIDs: req.MenuIds,
+		Remark:  req.Remark,
+	}
+}
+
+// ToUpdateTenantPackageBiz converts UpdateTenantPackageRequest to SystemTenantPackage (biz),
+// empty menu ids leave the menus of the package unchanged.
+func ToUpdateTenantPackageBiz(req *v1.UpdateTenantPackageRequest) *systemtenant.SystemTenantPackage {
+	if req == nil {
+		return nil
+	}
+
+	var menuIDs []string
+	if len(req.MenuIds) > 0 {
+		menuIDs = req.MenuIds
+	}
+
+	return &systemtenant.SystemTenantPackage{
+		ID:      &req.Id,
+		Name:    req.Name,
+		Status:  toInt8(req.Status),
+		MenuIDs: menuIDs,
+		Remark:  req.Remark,
+	}
+}
+
+// ToListTenantPackageRequestBiz converts ListTenantPackagesRequest to ListTenantPackageRequest (biz).
+func ToListTenantPackageRequestBiz(req *v1.ListTenantPackagesRequest) *systemtenant.ListTenantPackageRequest {
+	if req == nil {
+		return nil
+	}
+
+	return &systemtenant.ListTenantPackageRequest{
+		Page:     ptr.From(req.Page),
+		PageSize: ptr.From(req.PageSize),
+		Name:     ptr.From(req.Name),
+		Status:   toInt8(req.Status),
+	}
+}
+
+// ToTenantPackageInfo converts SystemTenantPackage (biz) to TenantPackageInfo (proto).
+func ToTenantPackageInfo(pkg *systemtenant.SystemTenantPackage) *v1.TenantPackageInfo {
+	if pkg == nil {
+		return nil
+	}
+
+	return &v1.TenantPackageInfo{
+		Id:        ptr.From(pkg.ID),
+		Name:      ptr.From(pkg.Name),
+		Status:    int32(ptr.From(pkg.Status)),
+		MenuIds:   pkg.MenuIDs,
+		Remark:    ptr.From(pkg.Remark),
+		CreatedAt: conv.TimeToDefaultStr(ptr.From(pkg.CreatedAt)),
+		UpdatedAt: conv.TimeToDefaultStr(ptr.From(pkg.UpdatedAt)),
+		CreatedBy: ptr.From(pkg.CreateBy),
+		UpdatedBy: ptr.From(pkg.UpdateBy),
+	}
+}
+
+// ToTenantPackageInfos converts a slice of SystemTenantPackage (biz) to TenantPackageInfo (proto).
+func ToTenantPackageInfos(pkgs []*systemtenant.SystemTenantPackage) []*v1.TenantPackageInfo {
+	if pkgs == nil {
+		return nil
+	}
+
+	infos := make([]*v1.TenantPackageInfo, len(pkgs))
+	for i, pkg := range pkgs {
+		infos[i] = ToTenantPackageInfo(pkg)
+	}
+	return infos
+}
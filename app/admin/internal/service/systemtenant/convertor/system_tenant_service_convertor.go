@@ -0,0 +1,141 @@
+package convertor
+
+import (
+	"time"
+
+	v1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/systemtenant"
+	"qn-base/pkg/lang/conv"
+	"qn-base/pkg/lang/ptr"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// ToCreateTenantBiz converts CreateTenantRequest to SystemTenant and TenantAdmin (biz).
+func ToCreateTenantBiz(req *v1.CreateTenantRequest) (*systemtenant.SystemTenant, *systemtenant.TenantAdmin, error) {
+	if req == nil {
+		return nil, nil, nil
+	}
+
+	expireTime, err := toTime(&req.ExpireTime)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tenant := &systemtenant.SystemTenant{
+		Name:          &req.Name,
+		ContactName:   &req.ContactName,
+		ContactMobile: req.ContactMobile,
+		Status:        toInt8(req.Status),
+		Website:       req.Website,
+		PackageID:     &req.PackageId,
+		ExpireTime:    expireTime,
+		AccountCount:  &req.AccountCount,
+		MFARequired:   req.MfaRequired,
+	}
+	admin := &systemtenant.TenantAdmin{
+		Account:  req.Username,
+		Password: req.Password,
+	}
+	return tenant, admin, nil
+}
+
+// ToUpdateTenantBiz converts UpdateTenantRequest to SystemTenant (biz).
+func ToUpdateTenantBiz(req *v1.UpdateTenantRequest) (*systemtenant.SystemTenant, error) {
+	if req == nil {
+		return nil, nil
+	}
+
+	expireTime, err := toTime(req.ExpireTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &systemtenant.SystemTenant{
+		ID:            &req.Id,
+		Name:          req.Name,
+		ContactName:   req.ContactName,
+		ContactMobile: req.ContactMobile,
+		Status:        toInt8(req.Status),
+		Website:       req.Website,
+		PackageID:     req.PackageId,
+		ExpireTime:    expireTime,
+		AccountCount:  req.AccountCount,
+		MFARequired:   req.MfaRequired,
+	}, nil
+}
+
+// ToListTenantRequestBiz converts ListTenantsRequest to ListTenantRequest (biz).
+func ToListTenantRequestBiz(req *v1.ListTenantsRequest) *systemtenant.ListTenantRequest {
+	if req == nil {
+		return nil
+	}
+
+	return &systemtenant.ListTenantRequest{
+		Page:          ptr.From(req.Page),
+		PageSize:      ptr.From(req.PageSize),
+		Name:          ptr.From(req.Name),
+		ContactName:   ptr.From(req.ContactName),
+		ContactMobile: ptr.From(req.ContactMobile),
+		Status:        toInt8(req.Status),
+		PackageID:     ptr.From(req.PackageId),
+	}
+}
+
+// ToTenantInfo converts SystemTenant (biz) to TenantInfo (proto).
+func ToTenantInfo(tenant *systemtenant.SystemTenant) *v1.TenantInfo {
+	if tenant == nil {
+		return nil
+	}
+
+	return &v1.TenantInfo{
+		Id:            ptr.From(tenant.ID),
+		Name:          ptr.From(tenant.Name),
+		ContactUserId: ptr.From(tenant.ContactUserID),
+		ContactName:   ptr.From(tenant.ContactName),
+		ContactMobile: ptr.From(tenant.ContactMobile),
+		Status:        int32(ptr.From(tenant.Status)),
+		Website:       ptr.From(tenant.Website),
+		PackageId:     ptr.From(tenant.PackageID),
+		ExpireTime:    conv.TimeToDefaultStr(ptr.From(tenant.ExpireTime)),
+		AccountCount:  ptr.From(tenant.AccountCount),
+		MfaRequired:   ptr.From(tenant.MFARequired),
+		CreatedAt:     conv.TimeToDefaultStr(ptr.From(tenant.CreatedAt)),
+		UpdatedAt:     conv.TimeToDefaultStr(ptr.From(tenant.UpdatedAt)),
+		CreatedBy:     ptr.From(tenant.CreateBy),
+		UpdatedBy:     ptr.From(tenant.UpdateBy),
+	}
+}
+
+// ToTenantInfos converts a slice of SystemTenant (biz) to TenantInfo (proto).
+func ToTenantInfos(tenants []*systemtenant.SystemTenant) []*v1.TenantInfo {
+	if tenants == nil {
+		return nil
+	}
+
+	tenantInfos := make([]*v1.TenantInfo, len(tenants))
+	for i, tenant := range tenants {
+		tenantInfos[i] = ToTenantInfo(tenant)
+	}
+	return tenantInfos
+}
+
+// toTime parses an optional time string in the default layout.
+func toTime(v *string) (*time.Time, error) {
+	if v == nil {
+		return nil, nil
+	}
+	t, err := conv.DefaultStrToTime(*v)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_PARAMETER", "过期时间格式错误，应为 yyyy-MM-dd HH:mm:ss")
+	}
+	return &t, nil
+}
+
+// toInt8 converts an optional int32 to an optional int8.
+func toInt8(v *int32) *int8 {
+	if v == nil {
+		return nil
+	}
+	return ptr.Of(int8(*v))
+}
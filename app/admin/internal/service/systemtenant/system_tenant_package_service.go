@@ -0,0 +1,114 @@
+package systemtenant
+
+import (
+	"context"
+
+	v1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/systemtenant"
+	"qn-base/app/admin/internal/service/systemtenant/convertor"
+	"qn-base/pkg/lang/ptr"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// TenantPackageService is a tenant package service.
+type TenantPackageService struct {
+	v1.UnimplementedTenantPackageServer
+
+	uc  systemtenant.TenantPackageUsecase
+	log *log.Helper
+}
+
+// NewTenantPackageService new a tenant package service.
+func NewTenantPackageService(logger log.Logger, uc systemtenant.TenantPackageUsecase) *TenantPackageService {
+	l := log.NewHelper(log.With(logger, "module", "admin/service/tenant-package-service"))
+	return &TenantPackageService{
+		uc:  uc,
+		log: l,
+	}
+}
+
+// CreateTenantPackage implements admin.TenantPackageServer.
+func (s *TenantPackageService) CreateTenantPackage(ctx context.Context, in *v1.CreateTenantPackageRequest) (*v1.CreateTenantPackageReply, error) {
+	s.log.WithContext(ctx).Infof("CreateTenantPackage: %v", in.Name)
+
+	pkg, err := s.uc.CreateTenantPackage(ctx, convertor.ToCreateTenantPackageBiz(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.CreateTenantPackageReply{
+		Package: convertor.ToTenantPackageInfo(pkg),
+	}, nil
+}
+
+// GetTenantPackage implements admin.TenantPackageServer.
+func (s *TenantPackageService) GetTenantPackage(ctx context.Context, in *v1.GetTenantPackageRequest) (*v1.GetTenantPackageReply, error) {
+	s.log.WithContext(ctx).Infof("GetTenantPackage: %v", in.Id)
+
+	pkg, err := s.uc.GetTenantPackage(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetTenantPackageReply{
+		Package: convertor.ToTenantPackageInfo(pkg),
+	}, nil
+}
+
+// UpdateTenantPackage implements admin.TenantPackageServer.
+func (s *TenantPackageService) UpdateTenantPackage(ctx context.Context, in *v1.UpdateTenantPackageRequest) (*v1.UpdateTenantPackageReply, error) {
+	s.log.WithContext(ctx).Infof("UpdateTenantPackage: %v", in.Id)
+
+	pkg, err := s.uc.UpdateTenantPackage(ctx, convertor.ToUpdateTenantPackageBiz(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.UpdateTenantPackageReply{
+		Package: convertor.ToTenantPackageInfo(pkg),
+	}, nil
+}
+
+// DeleteTenantPackage implements admin.TenantPackageServer.
+func (s *TenantPackageService) DeleteTenantPackage(ctx context.Context, in *v1.DeleteTenantPackageRequest) (*v1.DeleteTenantPackageReply, error) {
+	s.log.WithContext(ctx).Infof("DeleteTenantPackage: %v", in.Id)
+
+	err := s.uc.DeleteTenantPackage(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.DeleteTenantPackageReply{
+		Success: true,
+	}, nil
+}
+
+// ListTenantPackages implements admin.TenantPackageServer.
+func (s *TenantPackageService) ListTenantPackages(ctx context.Context, in *v1.ListTenantPackagesRequest) (*v1.ListTenantPackagesReply, error) {
+	s.log.WithContext(ctx).Infof("ListTenantPackages: page=%d, page_size=%d", ptr.From(in.Page), ptr.From(in.PageSize))
+
+	pkgs, total, err := s.uc.ListTenantPackages(ctx, convertor.ToListTenantPackageRequestBiz(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListTenantPackagesReply{
+		Packages: convertor.ToTenantPackageInfos(pkgs),
+		Total:    total,
+	}, nil
+}
+
+// ChangeTenantPackageStatus implements admin.TenantPackageServer.
+func (s *TenantPackageService) ChangeTenantPackageStatus(ctx context.Context, in *v1.ChangeTenantPackageStatusRequest) (*v1.ChangeTenantPackageStatusReply, error) {
+	s.log.WithContext(ctx).Infof("ChangeTenantPackageStatus: id=%s, status=%d", in.Id, in.Status)
+
+	err := s.uc.ChangeTenantPackageStatus(ctx, in.Id, int8(in.Status))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ChangeTenantPackageStatusReply{
+		Success: true,
+	}, nil
+}
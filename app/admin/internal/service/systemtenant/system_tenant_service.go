@@ -0,0 +1,124 @@
+package systemtenant
+
+import (
+	"context"
+
+	v1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/app/admin/internal/biz/systemtenant"
+	"qn-base/app/admin/internal/service/systemtenant/convertor"
+	"qn-base/pkg/lang/ptr"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// TenantService is a tenant service.
+type TenantService struct {
+	v1.UnimplementedTenantServer
+
+	uc  systemtenant.TenantUsecase
+	log *log.Helper
+}
+
+// NewTenantService new a tenant service.
+func NewTenantService(logger log.Logger, uc systemtenant.TenantUsecase) *TenantService {
+	l := log.NewHelper(log.With(logger, "module", "admin/service/tenant-service"))
+	return &TenantService{
+		uc:  uc,
+		log: l,
+	}
+}
+
+// CreateTenant implements admin.TenantServer.
+func (s *TenantService) CreateTenant(ctx context.Context, in *v1.CreateTenantRequest) (*v1.CreateTenantReply, error) {
+	s.log.WithContext(ctx).Infof("CreateTenant: %v", in.Name)
+
+	tenant, admin, err := convertor.ToCreateTenantBiz(in)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, err = s.uc.CreateTenant(ctx, tenant, admin)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.CreateTenantReply{
+		Tenant: convertor.ToTenantInfo(tenant),
+	}, nil
+}
+
+// GetTenant implements admin.TenantServer.
+func (s *TenantService) GetTenant(ctx context.Context, in *v1.GetTenantRequest) (*v1.GetTenantReply, error) {
+	s.log.WithContext(ctx).Infof("GetTenant: %v", in.Id)
+
+	tenant, err := s.uc.GetTenant(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetTenantReply{
+		Tenant: convertor.ToTenantInfo(tenant),
+	}, nil
+}
+
+// UpdateTenant implements admin.TenantServer.
+func (s *TenantService) UpdateTenant(ctx context.Context, in *v1.UpdateTenantRequest) (*v1.UpdateTenantReply, error) {
+	s.log.WithContext(ctx).Infof("UpdateTenant: %v", in.Id)
+
+	tenant, err := convertor.ToUpdateTenantBiz(in)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, err = s.uc.UpdateTenant(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.UpdateTenantReply{
+		Tenant: convertor.ToTenantInfo(tenant),
+	}, nil
+}
+
+// DeleteTenant implements admin.TenantServer.
+func (s *TenantService) DeleteTenant(ctx context.Context, in *v1.DeleteTenantRequest) (*v1.DeleteTenantReply, error) {
+	s.log.WithContext(ctx).Infof("DeleteTenant: %v", in.Id)
+
+	err := s.uc.DeleteTenant(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.DeleteTenantReply{
+		Success: true,
+	}, nil
+}
+
+// ListTenants implements admin.TenantServer.
+func (s *TenantService) ListTenants(ctx context.Context, in *v1.ListTenantsRequest) (*v1.ListTenantsReply, error) {
+	s.log.WithContext(ctx).Infof("ListTenants: page=%d, page_size=%d", ptr.From(in.Page), ptr.From(in.PageSize))
+
+	tenants, total, err := s.uc.ListTenants(ctx, convertor.ToListTenantRequestBiz(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListTenantsReply{
+		Tenants: convertor.ToTenantInfos(tenants),
+		Total:   total,
+	}, nil
+}
+
+// ChangeTenantStatus implements admin.TenantServer.
+func (s *TenantService) ChangeTenantStatus(ctx context.Context, in *v1.ChangeTenantStatusRequest) (*v1.ChangeTenantStatusReply, error) {
+	s.log.WithContext(ctx).Infof("ChangeTenantStatus: id=%s, status=%d", in.Id, in.Status)
+
+	err := s.uc.ChangeTenantStatus(ctx, in.Id, int8(in.Status))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ChangeTenantStatusReply{
+		Success: true,
+	}, nil
+}
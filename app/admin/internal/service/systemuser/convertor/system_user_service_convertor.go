@@ -3,6 +3,7 @@ package convertor
 import (
 	v1 "qn-base/api/gen/go/admin/v1"
 	"qn-base/app/admin/internal/biz/systemuser"
+	postconvertor "qn-base/app/admin/internal/service/systempost/convertor"
 	"qn-base/pkg/lang/conv"
 	"qn-base/pkg/lang/ptr"
 	"time"
@@ -30,7 +31,7 @@ func ToCreateUserBiz(req *v1.CreateUserRequest) (*systemuser.SystemUser, error)
 		Nickname:  req.Nickname,
 		Remark:    req.Remark,
 		DeptID:    req.DeptId,
-		PostIds:   req.PostIds,
+		PostIDs:   req.PostIds,
 		Email:     req.Email,
 		Mobile:    req.Mobile,
 		Sex:       ptr.Of(int8(ptr.From(req.Sex))),
@@ -62,12 +63,12 @@ func ToUpdateUserBiz(req *v1.UpdateUserRequest) (*systemuser.SystemUser, error)
 		Nickname:  req.Nickname,
 		Remark:    req.Remark,
 		DeptID:    req.DeptId,
-		PostIds:   req.PostIds,
+		PostIDs:   postIDs(req.PostIds),
 		Email:     req.Email,
 		Mobile:    req.Mobile,
 		Sex:       ptr.Of(int8(ptr.From(req.Sex))),
 		Avatar:    req.Avatar,
-		Status:    ptr.Of(int8(ptr.From(req.Status))),
+		Status:    toInt8(req.Status),
 		LoginIP:   req.LoginIp,
 		LoginDate: loginDate,
 		TenantID:  req.TenantId,
@@ -99,6 +100,35 @@ func ToListUserRequestBiz(req *v1.ListUsersRequest) *systemuser.ListUserRequest
 	return bizReq
 }
 
+// ToListDeletedUsersRequestBiz converts ListDeletedUsersRequest to ListUserRequest (biz).
+func ToListDeletedUsersRequestBiz(req *v1.ListDeletedUsersRequest) *systemuser.ListUserRequest {
+	if req == nil {
+		return nil
+	}
+
+	return &systemuser.ListUserRequest{
+		Page:     ptr.From(req.Page),
+		PageSize: ptr.From(req.PageSize),
+		Username: ptr.From(req.Account),
+	}
+}
+
+// postIDs returns nil for empty post IDs, which means the posts are not changed.
+func postIDs(ids []string) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+	return ids
+}
+
+// toInt8 converts an optional int32 to an optional int8.
+func toInt8(v *int32) *int8 {
+	if v == nil {
+		return nil
+	}
+	return ptr.Of(int8(*v))
+}
+
 // ToUserInfo converts SystemUser (biz) to UserInfo (proto).
 func ToUserInfo(user *systemuser.SystemUser) *v1.UserInfo {
 	if user == nil {
@@ -111,7 +141,7 @@ func ToUserInfo(user *systemuser.SystemUser) *v1.UserInfo {
 		Nickname:  ptr.From(user.Nickname),
 		Remark:    ptr.From(user.Remark),
 		DeptId:    ptr.From(user.DeptID),
-		PostIds:   ptr.From(user.PostIds),
+		Posts:     postconvertor.ToPostRefs(user.Posts),
 		Email:     ptr.From(user.Email),
 		Mobile:    ptr.From(user.Mobile),
 		Sex:       int32(ptr.From(user.Sex)),
@@ -124,6 +154,7 @@ func ToUserInfo(user *systemuser.SystemUser) *v1.UserInfo {
 		UpdatedAt: conv.TimeToDefaultStr(ptr.From(user.UpdatedAt)),
 		CreatedBy: ptr.From(user.CreateBy),
 		UpdatedBy: ptr.From(user.UpdateBy),
+		DeletedAt: conv.TimeToDefaultStr(ptr.From(user.DeletedAt)),
 	}
 }
 
@@ -12,21 +12,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 )
 
-// UserUsecaseInterface represents the UserUsecase interface
-type UserUsecaseInterface interface {
-	CreateUser(ctx context.Context, u *systemuser.SystemUser) (*systemuser.SystemUser, error)
-	GetUser(ctx context.Context, id string) (*systemuser.SystemUser, error)
-	UpdateUser(ctx context.Context, u *systemuser.SystemUser) (*systemuser.SystemUser, error)
-	DeleteUser(ctx context.Context, id string) error
-	BatchDeleteUsers(ctx context.Context, ids []string) (*systemuser.BatchDeleteResult, error)
-	ChangeUserStatus(ctx context.Context, id string, status int8) error
-	ResetPassword(ctx context.Context, id, newPassword string) error
-	CheckAccountExists(ctx context.Context, account string) (bool, error)
-	GetUserStats(ctx context.Context, tenantID string) (*systemuser.UserStats, error)
-	ListUsers(ctx context.Context, req *systemuser.ListUserRequest) ([]*systemuser.SystemUser, int32, error)
-}
-
-// MockUserUsecase is a mock of UserUsecaseInterface interface.
+// MockUserUsecase is a mock of UserUsecase interface.
 type MockUserUsecase struct {
 	ctrl     *gomock.Controller
 	recorder *MockUserUsecaseMockRecorder
@@ -49,6 +35,50 @@ func (m *MockUserUsecase) EXPECT() *MockUserUsecaseMockRecorder {
 	return m.recorder
 }
 
+// BatchDeleteUsers mocks base method.
+func (m *MockUserUsecase) BatchDeleteUsers(ctx context.Context, ids []string) (*systemuser.BatchDeleteResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchDeleteUsers", ctx, ids)
+	ret0, _ := ret[0].(*systemuser.BatchDeleteResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchDeleteUsers indicates an expected call of BatchDeleteUsers.
+func (mr *MockUserUsecaseMockRecorder) BatchDeleteUsers(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDeleteUsers", reflect.TypeOf((*MockUserUsecase)(nil).BatchDeleteUsers), ctx, ids)
+}
+
+// ChangeUserStatus mocks base method.
+func (m *MockUserUsecase) ChangeUserStatus(ctx context.Context, id string, status int8) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeUserStatus", ctx, id, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeUserStatus indicates an expected call of ChangeUserStatus.
+func (mr *MockUserUsecaseMockRecorder) ChangeUserStatus(ctx, id, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeUserStatus", reflect.TypeOf((*MockUserUsecase)(nil).ChangeUserStatus), ctx, id, status)
+}
+
+// CheckAccountExists mocks base method.
+func (m *MockUserUsecase) CheckAccountExists(ctx context.Context, account string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAccountExists", ctx, account)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckAccountExists indicates an expected call of CheckAccountExists.
+func (mr *MockUserUsecaseMockRecorder) CheckAccountExists(ctx, account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccountExists", reflect.TypeOf((*MockUserUsecase)(nil).CheckAccountExists), ctx, account)
+}
+
 // CreateUser mocks base method.
 func (m *MockUserUsecase) CreateUser(ctx context.Context, u *systemuser.SystemUser) (*systemuser.SystemUser, error) {
 	m.ctrl.T.Helper()
@@ -64,6 +94,20 @@ func (mr *MockUserUsecaseMockRecorder) CreateUser(ctx, u interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserUsecase)(nil).CreateUser), ctx, u)
 }
 
+// DeleteUser mocks base method.
+func (m *MockUserUsecase) DeleteUser(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockUserUsecaseMockRecorder) DeleteUser(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUserUsecase)(nil).DeleteUser), ctx, id)
+}
+
 // GetUser mocks base method.
 func (m *MockUserUsecase) GetUser(ctx context.Context, id string) (*systemuser.SystemUser, error) {
 	m.ctrl.T.Helper()
@@ -79,62 +123,93 @@ func (mr *MockUserUsecaseMockRecorder) GetUser(ctx, id interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockUserUsecase)(nil).GetUser), ctx, id)
 }
 
-// UpdateUser mocks base method.
-func (m *MockUserUsecase) UpdateUser(ctx context.Context, u *systemuser.SystemUser) (*systemuser.SystemUser, error) {
+// GetUserStats mocks base method.
+func (m *MockUserUsecase) GetUserStats(ctx context.Context, tenantID string) (*systemuser.UserStats, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUser", ctx, u)
-	ret0, _ := ret[0].(*systemuser.SystemUser)
+	ret := m.ctrl.Call(m, "GetUserStats", ctx, tenantID)
+	ret0, _ := ret[0].(*systemuser.UserStats)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateUser indicates an expected call of UpdateUser.
-func (mr *MockUserUsecaseMockRecorder) UpdateUser(ctx, u interface{}) *gomock.Call {
+// GetUserStats indicates an expected call of GetUserStats.
+func (mr *MockUserUsecaseMockRecorder) GetUserStats(ctx, tenantID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserUsecase)(nil).UpdateUser), ctx, u)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserStats", reflect.TypeOf((*MockUserUsecase)(nil).GetUserStats), ctx, tenantID)
 }
 
-// DeleteUser mocks base method.
-func (m *MockUserUsecase) DeleteUser(ctx context.Context, id string) error {
+// KickUser mocks base method.
+func (m *MockUserUsecase) KickUser(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUser", ctx, id)
+	ret := m.ctrl.Call(m, "KickUser", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteUser indicates an expected call of DeleteUser.
-func (mr *MockUserUsecaseMockRecorder) DeleteUser(ctx, id interface{}) *gomock.Call {
+// KickUser indicates an expected call of KickUser.
+func (mr *MockUserUsecaseMockRecorder) KickUser(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUserUsecase)(nil).DeleteUser), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KickUser", reflect.TypeOf((*MockUserUsecase)(nil).KickUser), ctx, id)
 }
 
-// BatchDeleteUsers mocks base method.
-func (m *MockUserUsecase) BatchDeleteUsers(ctx context.Context, ids []string) (*systemuser.BatchDeleteResult, error) {
+// ListDeletedUsers mocks base method.
+func (m *MockUserUsecase) ListDeletedUsers(ctx context.Context, req *systemuser.ListUserRequest) ([]*systemuser.SystemUser, int32, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BatchDeleteUsers", ctx, ids)
-	ret0, _ := ret[0].(*systemuser.BatchDeleteResult)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ListDeletedUsers", ctx, req)
+	ret0, _ := ret[0].([]*systemuser.SystemUser)
+	ret1, _ := ret[1].(int32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// BatchDeleteUsers indicates an expected call of BatchDeleteUsers.
-func (mr *MockUserUsecaseMockRecorder) BatchDeleteUsers(ctx, ids interface{}) *gomock.Call {
+// ListDeletedUsers indicates an expected call of ListDeletedUsers.
+func (mr *MockUserUsecaseMockRecorder) ListDeletedUsers(ctx, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDeleteUsers", reflect.TypeOf((*MockUserUsecase)(nil).BatchDeleteUsers), ctx, ids)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedUsers", reflect.TypeOf((*MockUserUsecase)(nil).ListDeletedUsers), ctx, req)
 }
 
-// ChangeUserStatus mocks base method.
-func (m *MockUserUsecase) ChangeUserStatus(ctx context.Context, id string, status int8) error {
+// ListUsers mocks base method.
+func (m *MockUserUsecase) ListUsers(ctx context.Context, req *systemuser.ListUserRequest) ([]*systemuser.SystemUser, int32, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChangeUserStatus", ctx, id, status)
+	ret := m.ctrl.Call(m, "ListUsers", ctx, req)
+	ret0, _ := ret[0].([]*systemuser.SystemUser)
+	ret1, _ := ret[1].(int32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockUserUsecaseMockRecorder) ListUsers(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUserUsecase)(nil).ListUsers), ctx, req)
+}
+
+// PurgeUser mocks base method.
+func (m *MockUserUsecase) PurgeUser(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeUser", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// ChangeUserStatus indicates an expected call of ChangeUserStatus.
-func (mr *MockUserUsecaseMockRecorder) ChangeUserStatus(ctx, id, status interface{}) *gomock.Call {
+// PurgeUser indicates an expected call of PurgeUser.
+func (mr *MockUserUsecaseMockRecorder) PurgeUser(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeUserStatus", reflect.TypeOf((*MockUserUsecase)(nil).ChangeUserStatus), ctx, id, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeUser", reflect.TypeOf((*MockUserUsecase)(nil).PurgeUser), ctx, id)
+}
+
+// ReplaceUserPosts mocks base method.
+func (m *MockUserUsecase) ReplaceUserPosts(ctx context.Context, id string, postIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceUserPosts", ctx, id, postIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceUserPosts indicates an expected call of ReplaceUserPosts.
+func (mr *MockUserUsecaseMockRecorder) ReplaceUserPosts(ctx, id, postIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceUserPosts", reflect.TypeOf((*MockUserUsecase)(nil).ReplaceUserPosts), ctx, id, postIDs)
 }
 
 // ResetPassword mocks base method.
@@ -151,48 +226,31 @@ func (mr *MockUserUsecaseMockRecorder) ResetPassword(ctx, id, newPassword interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockUserUsecase)(nil).ResetPassword), ctx, id, newPassword)
 }
 
-// CheckAccountExists mocks base method.
-func (m *MockUserUsecase) CheckAccountExists(ctx context.Context, account string) (bool, error) {
+// RestoreUser mocks base method.
+func (m *MockUserUsecase) RestoreUser(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckAccountExists", ctx, account)
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RestoreUser", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CheckAccountExists indicates an expected call of CheckAccountExists.
-func (mr *MockUserUsecaseMockRecorder) CheckAccountExists(ctx, account interface{}) *gomock.Call {
+// RestoreUser indicates an expected call of RestoreUser.
+func (mr *MockUserUsecaseMockRecorder) RestoreUser(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccountExists", reflect.TypeOf((*MockUserUsecase)(nil).CheckAccountExists), ctx, account)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreUser", reflect.TypeOf((*MockUserUsecase)(nil).RestoreUser), ctx, id)
 }
 
-// GetUserStats mocks base method.
-func (m *MockUserUsecase) GetUserStats(ctx context.Context, tenantID string) (*systemuser.UserStats, error) {
+// UpdateUser mocks base method.
+func (m *MockUserUsecase) UpdateUser(ctx context.Context, u *systemuser.SystemUser) (*systemuser.SystemUser, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserStats", ctx, tenantID)
-	ret0, _ := ret[0].(*systemuser.UserStats)
+	ret := m.ctrl.Call(m, "UpdateUser", ctx, u)
+	ret0, _ := ret[0].(*systemuser.SystemUser)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserStats indicates an expected call of GetUserStats.
-func (mr *MockUserUsecaseMockRecorder) GetUserStats(ctx, tenantID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserStats", reflect.TypeOf((*MockUserUsecase)(nil).GetUserStats), ctx, tenantID)
-}
-
-// ListUsers mocks base method.
-func (m *MockUserUsecase) ListUsers(ctx context.Context, req *systemuser.ListUserRequest) ([]*systemuser.SystemUser, int32, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUsers", ctx, req)
-	ret0, _ := ret[0].([]*systemuser.SystemUser)
-	ret1, _ := ret[1].(int32)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
-}
-
-// ListUsers indicates an expected call of ListUsers.
-func (mr *MockUserUsecaseMockRecorder) ListUsers(ctx, req interface{}) *gomock.Call {
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockUserUsecaseMockRecorder) UpdateUser(ctx, u interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUserUsecase)(nil).ListUsers), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserUsecase)(nil).UpdateUser), ctx, u)
 }
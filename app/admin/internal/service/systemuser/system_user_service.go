@@ -30,7 +30,6 @@ func NewUserService(logger log.Logger, uc systemuser.UserUsecase) *UserService {
 // CreateUser implements admin.UserServer.
 func (s *UserService) CreateUser(ctx context.Context, in *v1.CreateUserRequest) (*v1.CreateUserReply, error) {
 	s.log.WithContext(ctx).Infof("CreateUser: %v", in.Account)
-	v1.UserHTTPClientImpl
 	// 使用转换函数将请求转换为biz层对象
 	bizUser, err := convertor.ToCreateUserBiz(in)
 	if err != nil {
@@ -135,6 +134,19 @@ func (s *UserService) ResetPassword(ctx context.Context, in *v1.ResetPasswordReq
 	}, nil
 }
 
+// KickUser implements admin.UserServer.
+func (s *UserService) KickUser(ctx context.Context, in *v1.KickUserRequest) (*v1.KickUserReply, error) {
+	s.log.WithContext(ctx).Infof("KickUser: id=%s", in.Id)
+
+	if err := s.uc.KickUser(ctx, in.Id); err != nil {
+		return nil, err
+	}
+
+	return &v1.KickUserReply{
+		Success: true,
+	}, nil
+}
+
 // CheckAccountExists implements admin.UserServer.
 func (s *UserService) CheckAccountExists(ctx context.Context, in *v1.CheckAccountExistsRequest) (*v1.CheckAccountExistsReply, error) {
 	s.log.WithContext(ctx).Infof("CheckAccountExists: account=%s", in.Account)
@@ -180,3 +192,60 @@ func (s *UserService) ListUsers(ctx context.Context, in *v1.ListUsersRequest) (*
 		Total: total,
 	}, nil
 }
+
+// ListDeletedUsers implements admin.UserServer.
+func (s *UserService) ListDeletedUsers(ctx context.Context, in *v1.ListDeletedUsersRequest) (*v1.ListDeletedUsersReply, error) {
+	s.log.WithContext(ctx).Infof("ListDeletedUsers: page=%d, page_size=%d", ptr.From(in.Page), ptr.From(in.PageSize))
+
+	users, total, err := s.uc.ListDeletedUsers(ctx, convertor.ToListDeletedUsersRequestBiz(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListDeletedUsersReply{
+		Users: convertor.ToUserInfos(users),
+		Total: total,
+	}, nil
+}
+
+// RestoreUser implements admin.UserServer.
+func (s *UserService) RestoreUser(ctx context.Context, in *v1.RestoreUserRequest) (*v1.RestoreUserReply, error) {
+	s.log.WithContext(ctx).Infof("RestoreUser: id=%s", in.Id)
+
+	err := s.uc.RestoreUser(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.RestoreUserReply{
+		Success: true,
+	}, nil
+}
+
+// PurgeUser implements admin.UserServer.
+func (s *UserService) PurgeUser(ctx context.Context, in *v1.PurgeUserRequest) (*v1.PurgeUserReply, error) {
+	s.log.WithContext(ctx).Infof("PurgeUser: id=%s", in.Id)
+
+	err := s.uc.PurgeUser(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.PurgeUserReply{
+		Success: true,
+	}, nil
+}
+
+// ReplaceUserPosts implements admin.UserServer.
+func (s *UserService) ReplaceUserPosts(ctx context.Context, in *v1.ReplaceUserPostsRequest) (*v1.ReplaceUserPostsReply, error) {
+	s.log.WithContext(ctx).Infof("ReplaceUserPosts: id=%s, post_ids=%v", in.Id, in.PostIds)
+
+	err := s.uc.ReplaceUserPosts(ctx, in.Id, in.PostIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ReplaceUserPostsReply{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"qn-base/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServer(t *testing.T) {
+	principal := &auth.Principal{
+		UserID:     "user123",
+		TenantID:   "tenant1",
+		Roles:      []string{"admin"},
+		ClientType: auth.ClientTypeSystem,
+	}
+	now := time.Now()
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		p, ok := auth.FromContext(ctx)
+		assert.True(t, ok)
+		return p, nil
+	}
+
+	t.Run("访问令牌", func(t *testing.T) {
+		claims := auth.NewClaims(principal, auth.TokenUseAccess, now, now.Add(time.Hour))
+		ctx := jwt.NewContext(context.Background(), claims)
+
+		reply, err := auth.Server()(handler)(ctx, nil)
+
+		assert.NoError(t, err)
+		p := reply.(*auth.Principal)
+		assert.Equal(t, "user123", p.UserID)
+		assert.Equal(t, "tenant1", p.TenantID)
+		assert.Equal(t, []string{"admin"}, p.Roles)
+		assert.Equal(t, claims.ID, p.TokenID)
+		assert.Equal(t, auth.ClientTypeSystem, p.ClientType)
+	})
+
+	t.Run("刷新令牌", func(t *testing.T) {
+		claims := auth.NewClaims(principal, auth.TokenUseRefresh, now, now.Add(time.Hour))
+		ctx := jwt.NewContext(context.Background(), claims)
+
+		_, err := auth.Server()(handler)(ctx, nil)
+
+		assert.True(t, errors.Is(err, auth.ErrIncorrectAccessToken))
+	})
+
+	t.Run("缺少令牌", func(t *testing.T) {
+		_, err := auth.Server()(handler)(context.Background(), nil)
+
+		assert.True(t, errors.IsUnauthorized(err))
+	})
+}
+
+func TestAccessors(t *testing.T) {
+	ctx := context.Background()
+	assert.Empty(t, auth.UserID(ctx))
+	assert.Empty(t, auth.TenantID(ctx))
+	assert.Nil(t, auth.Roles(ctx))
+	assert.False(t, auth.IsSuperAdmin(ctx))
+
+	ctx = auth.NewContext(ctx, &auth.Principal{UserID: "u1", TenantID: "t1", Roles: []string{"r1"}, TokenID: "j1"})
+	assert.Equal(t, "u1", auth.UserID(ctx))
+	assert.Equal(t, "t1", auth.TenantID(ctx))
+	assert.Equal(t, []string{"r1"}, auth.Roles(ctx))
+	assert.Equal(t, "j1", auth.TokenID(ctx))
+	assert.False(t, auth.IsSuperAdmin(ctx))
+
+	ctx = auth.NewContext(ctx, &auth.Principal{UserID: "u1", TenantID: "t1", Roles: []string{"r1", auth.SuperAdminRoleCode}})
+	assert.False(t, auth.IsSuperAdmin(ctx))
+
+	ctx = auth.NewContext(ctx, &auth.Principal{UserID: "u1", TenantID: auth.PlatformTenantID, Roles: []string{"r1", auth.SuperAdminRoleCode}})
+	assert.True(t, auth.IsSuperAdmin(ctx))
+}
+
+func TestWithTenant(t *testing.T) {
+	ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "u1", TenantID: "t1"})
+
+	tenantCtx := auth.WithTenant(ctx, "t2")
+
+	assert.Equal(t, "t2", auth.TenantID(tenantCtx))
+	assert.Equal(t, "u1", auth.UserID(tenantCtx))
+	// 原上下文的主体不受影响
+	assert.Equal(t, "t1", auth.TenantID(ctx))
+	assert.Equal(t, "t3", auth.TenantID(auth.WithTenant(context.Background(), "t3")))
+}
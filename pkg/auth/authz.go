@@ -0,0 +1,252 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// ErrForbidden is returned when the principal lacks the permission required by the operation.
+var ErrForbidden = errors.Forbidden("FORBIDDEN", "permission denied")
+
+// defaultPermissionTTL is how long the permissions of a role are cached.
+const defaultPermissionTTL = time.Minute
+
+// RolePermissionLoader loads the permission strings granted to a role of the tenant.
+type RolePermissionLoader func(ctx context.Context, tenantID, role string) ([]string, error)
+
+// PermissionInvalidator drops the cached permissions of the roles of the tenant,
+// called after the menus, status or existence of the roles changed.
+type PermissionInvalidator interface {
+	Invalidate(tenantID string, roles ...string)
+}
+
+// PolicyEnforcer decides whether the subject may perform the action on the object in the domain.
+//
+// 用于接入 casbin 等策略引擎，domain 为租户ID
+type PolicyEnforcer func(ctx context.Context, subject, domain, object, action string) (bool, error)
+
+// AuthorizerOption is an Authorizer option.
+type AuthorizerOption func(*Authorizer)
+
+// WithPermissionTTL sets how long the permissions of a role are cached.
+func WithPermissionTTL(ttl time.Duration) AuthorizerOption {
+	return func(a *Authorizer) {
+		a.ttl = ttl
+	}
+}
+
+// WithPolicyEnforcer sets the policy enforcer consulted when the roles lack the permission.
+func WithPolicyEnforcer(enforcer PolicyEnforcer) AuthorizerOption {
+	return func(a *Authorizer) {
+		a.enforcer = enforcer
+	}
+}
+
+type permissionEntry struct {
+	permissions map[string]struct{}
+	expiresAt   time.Time
+}
+
+var _ PermissionInvalidator = (*Authorizer)(nil)
+
+// Authorizer maps operations to required permissions and checks them against
+// the permissions of the principal's roles.
+//
+// 未登记权限的操作对所有已登录用户开放，平台租户的超级管理员跳过检查；
+// 角色权限不足时再由策略引擎按 (用户ID或角色编码, 租户, 操作, 权限标识) 判定
+type Authorizer struct {
+	loader   RolePermissionLoader
+	enforcer PolicyEnforcer
+	ttl      time.Duration
+	now      func() time.Time
+
+	mu          sync.RWMutex
+	permissions map[string]string
+	cache       map[string]permissionEntry
+}
+
+// NewAuthorizer creates an Authorizer that loads role permissions with loader.
+func NewAuthorizer(loader RolePermissionLoader, opts ...AuthorizerOption) *Authorizer {
+	a := &Authorizer{
+		loader:      loader,
+		ttl:         defaultPermissionTTL,
+		now:         time.Now,
+		permissions: make(map[string]string),
+		cache:       make(map[string]permissionEntry),
+	}
+	for _, o := range opts {
+		o(a)
+	}
+	return a
+}
+
+// Register sets the permission required by the operation, e.g. /admin.v1.User/DeleteUser.
+func (a *Authorizer) Register(operation, permission string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.permissions[operation] = permission
+}
+
+// RegisterFromProto registers the permissions declared by the string method option ext
+// on the services in files. A nil files uses protoregistry.GlobalFiles.
+func (a *Authorizer) RegisterFromProto(files *protoregistry.Files, ext protoreflect.ExtensionType) error {
+	if ext.TypeDescriptor().Kind() != protoreflect.StringKind {
+		return fmt.Errorf("permission option %s must be a string", ext.TypeDescriptor().FullName())
+	}
+	if files == nil {
+		files = protoregistry.GlobalFiles
+	}
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		services := fd.Services()
+		for i := 0; i < services.Len(); i++ {
+			sd := services.Get(i)
+			methods := sd.Methods()
+			for j := 0; j < methods.Len(); j++ {
+				md := methods.Get(j)
+				permission, _ := proto.GetExtension(md.Options(), ext).(string)
+				if permission == "" {
+					continue
+				}
+				a.Register(fmt.Sprintf("/%s/%s", sd.FullName(), md.Name()), permission)
+			}
+		}
+		return true
+	})
+	return nil
+}
+
+// Permission returns the permission required by the operation, or "" if none.
+func (a *Authorizer) Permission(operation string) string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.permissions[operation]
+}
+
+// Invalidate drops the cached permissions of the roles in the tenant,
+// or the whole cache when no role is given.
+func (a *Authorizer) Invalidate(tenantID string, roles ...string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if len(roles) == 0 {
+		a.cache = make(map[string]permissionEntry)
+		return
+	}
+	for _, role := range roles {
+		delete(a.cache, cacheKey(tenantID, role))
+	}
+}
+
+// Check checks that the principal in ctx may call the operation.
+func (a *Authorizer) Check(ctx context.Context, operation string) error {
+	permission := a.Permission(operation)
+	if permission == "" {
+		return nil
+	}
+	p, ok := FromContext(ctx)
+	if !ok {
+		return ErrMissingClaims
+	}
+	if p.IsSuperAdmin() {
+		return nil
+	}
+	for _, role := range p.Roles {
+		granted, err := a.rolePermissions(ctx, p.TenantID, role)
+		if err != nil {
+			return err
+		}
+		if _, ok := granted[permission]; ok {
+			return nil
+		}
+	}
+	allowed, err := a.enforce(ctx, p, operation, permission)
+	if err != nil {
+		return err
+	}
+	if allowed {
+		return nil
+	}
+	return ErrForbidden
+}
+
+// enforce asks the policy enforcer whether the user or any of its roles may call the operation.
+func (a *Authorizer) enforce(ctx context.Context, p *Principal, operation, permission string) (bool, error) {
+	if a.enforcer == nil {
+		return false, nil
+	}
+	subjects := append([]string{p.UserID}, p.Roles...)
+	for _, subject := range subjects {
+		if subject == "" {
+			continue
+		}
+		allowed, err := a.enforcer(ctx, subject, p.TenantID, operation, permission)
+		if err != nil {
+			return false, err
+		}
+		if allowed {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Server returns a middleware that authorizes the operation of the request.
+// 需放在 Server() 之后，以便从 ctx 中获取登录信息
+func (a *Authorizer) Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, ErrForbidden
+			}
+			if err := a.Check(ctx, tr.Operation()); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// rolePermissions returns the cached permissions of the role, loading them when missing or expired.
+func (a *Authorizer) rolePermissions(ctx context.Context, tenantID, role string) (map[string]struct{}, error) {
+	key := cacheKey(tenantID, role)
+	now := a.now()
+
+	a.mu.RLock()
+	entry, ok := a.cache[key]
+	a.mu.RUnlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.permissions, nil
+	}
+
+	permissions, err := a.loader(ctx, tenantID, role)
+	if err != nil {
+		return nil, err
+	}
+	entry = permissionEntry{
+		permissions: make(map[string]struct{}, len(permissions)),
+		expiresAt:   now.Add(a.ttl),
+	}
+	for _, permission := range permissions {
+		entry.permissions[permission] = struct{}{}
+	}
+
+	a.mu.Lock()
+	a.cache[key] = entry
+	a.mu.Unlock()
+	return entry.permissions, nil
+}
+
+// cacheKey returns the cache key of the role, role codes are only unique within a tenant.
+func cacheKey(tenantID, role string) string {
+	return strings.Join([]string{tenantID, role}, "/")
+}
@@ -0,0 +1,140 @@
+package auth_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	adminV1 "qn-base/api/gen/go/admin/v1"
+	"qn-base/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeTransport is a server transport carrying only the operation.
+type fakeTransport struct {
+	operation string
+}
+
+func (t *fakeTransport) Kind() transport.Kind            { return transport.KindGRPC }
+func (t *fakeTransport) Endpoint() string                { return "" }
+func (t *fakeTransport) Operation() string               { return t.operation }
+func (t *fakeTransport) RequestHeader() transport.Header { return nil }
+func (t *fakeTransport) ReplyHeader() transport.Header   { return nil }
+
+func TestAuthorizer_Check(t *testing.T) {
+	loads := 0
+	authorizer := auth.NewAuthorizer(func(_ context.Context, tenantID, role string) ([]string, error) {
+		loads++
+		if tenantID == "t1" && role == "operator" {
+			return []string{"system:user:query"}, nil
+		}
+		return nil, nil
+	}, auth.WithPermissionTTL(time.Hour))
+	authorizer.Register("/admin.v1.User/GetUser", "system:user:query")
+	authorizer.Register("/admin.v1.User/DeleteUser", "system:user:delete")
+
+	operator := auth.NewContext(context.Background(), &auth.Principal{UserID: "u1", TenantID: "t1", Roles: []string{"guest", "operator"}})
+
+	t.Run("拥有权限", func(t *testing.T) {
+		assert.NoError(t, authorizer.Check(operator, "/admin.v1.User/GetUser"))
+	})
+
+	t.Run("缺少权限", func(t *testing.T) {
+		err := authorizer.Check(operator, "/admin.v1.User/DeleteUser")
+		assert.True(t, errors.IsForbidden(err))
+		assert.Equal(t, "FORBIDDEN", errors.Reason(err))
+	})
+
+	t.Run("角色权限被缓存", func(t *testing.T) {
+		before := loads
+		assert.NoError(t, authorizer.Check(operator, "/admin.v1.User/GetUser"))
+		assert.Equal(t, before, loads)
+
+		authorizer.Invalidate("t1", "operator")
+		assert.NoError(t, authorizer.Check(operator, "/admin.v1.User/GetUser"))
+		assert.Equal(t, before+1, loads)
+	})
+
+	t.Run("同名角色按租户区分", func(t *testing.T) {
+		ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "u2", TenantID: "t2", Roles: []string{"operator"}})
+		assert.True(t, errors.IsForbidden(authorizer.Check(ctx, "/admin.v1.User/GetUser")))
+	})
+
+	t.Run("超级管理员", func(t *testing.T) {
+		ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "u3", TenantID: auth.PlatformTenantID, Roles: []string{auth.SuperAdminRoleCode}})
+		assert.NoError(t, authorizer.Check(ctx, "/admin.v1.User/DeleteUser"))
+	})
+
+	t.Run("其他租户的超级管理员编码不跳过检查", func(t *testing.T) {
+		ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "u4", TenantID: "t2", Roles: []string{auth.SuperAdminRoleCode}})
+		assert.True(t, errors.IsForbidden(authorizer.Check(ctx, "/admin.v1.User/DeleteUser")))
+	})
+
+	t.Run("未登记权限的操作", func(t *testing.T) {
+		assert.NoError(t, authorizer.Check(operator, "/admin.v1.Menu/GetUserRoutes"))
+	})
+}
+
+func TestAuthorizer_PolicyEnforcer(t *testing.T) {
+	var requests []string
+	authorizer := auth.NewAuthorizer(func(context.Context, string, string) ([]string, error) {
+		return nil, nil
+	}, auth.WithPolicyEnforcer(func(_ context.Context, subject, domain, object, action string) (bool, error) {
+		requests = append(requests, strings.Join([]string{subject, domain, object, action}, ","))
+		return subject == "operator" && domain == "t1" && object == "/admin.v1.User/DeleteUser", nil
+	}))
+	authorizer.Register("/admin.v1.User/DeleteUser", "system:user:delete")
+	authorizer.Register("/admin.v1.User/PurgeUser", "system:user:purge")
+
+	ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "u1", TenantID: "t1", Roles: []string{"operator"}})
+
+	t.Run("策略授权", func(t *testing.T) {
+		requests = nil
+		assert.NoError(t, authorizer.Check(ctx, "/admin.v1.User/DeleteUser"))
+		assert.Equal(t, []string{
+			"u1,t1,/admin.v1.User/DeleteUser,system:user:delete",
+			"operator,t1,/admin.v1.User/DeleteUser,system:user:delete",
+		}, requests)
+	})
+
+	t.Run("策略未授权", func(t *testing.T) {
+		assert.True(t, errors.IsForbidden(authorizer.Check(ctx, "/admin.v1.User/PurgeUser")))
+	})
+}
+
+func TestAuthorizer_Server(t *testing.T) {
+	authorizer := auth.NewAuthorizer(func(context.Context, string, string) ([]string, error) {
+		return nil, nil
+	})
+	authorizer.Register("/admin.v1.User/DeleteUser", "system:user:delete")
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "u1", Roles: []string{"operator"}})
+
+	t.Run("拒绝访问", func(t *testing.T) {
+		ctx := transport.NewServerContext(ctx, &fakeTransport{operation: "/admin.v1.User/DeleteUser"})
+		_, err := authorizer.Server()(handler)(ctx, nil)
+		assert.True(t, errors.IsForbidden(err))
+	})
+
+	t.Run("允许访问", func(t *testing.T) {
+		ctx := transport.NewServerContext(ctx, &fakeTransport{operation: "/admin.v1.User/GetUser"})
+		reply, err := authorizer.Server()(handler)(ctx, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", reply)
+	})
+}
+
+func TestAuthorizer_RegisterFromProto(t *testing.T) {
+	authorizer := auth.NewAuthorizer(nil)
+
+	assert.NoError(t, authorizer.RegisterFromProto(nil, adminV1.E_Permission))
+	assert.Equal(t, "system:user:delete", authorizer.Permission(adminV1.OperationUserDeleteUser))
+	assert.Equal(t, "system:role:create", authorizer.Permission(adminV1.OperationRoleCreateRole))
+	assert.Empty(t, authorizer.Permission(adminV1.OperationAuthLogin))
+}
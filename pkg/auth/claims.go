@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"time"
+
+	jwtV5 "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// ClientType 令牌所属的客户端类型
+type ClientType string
+
+const (
+	// ClientTypeSystem 后台管理端，使用 Jwt.System 签发
+	ClientTypeSystem ClientType = "system"
+	// ClientTypeClient 用户端，使用 Jwt.Client 签发
+	ClientTypeClient ClientType = "client"
+)
+
+// TokenUse 令牌用途
+type TokenUse string
+
+const (
+	// TokenUseAccess 访问令牌
+	TokenUseAccess TokenUse = "access"
+	// TokenUseRefresh 刷新令牌
+	TokenUseRefresh TokenUse = "refresh"
+	// TokenUseMFA 两步验证的待验证令牌，仅用于完成登录，不能访问接口
+	TokenUseMFA TokenUse = "mfa"
+)
+
+var _ jwtV5.Claims = (*Claims)(nil)
+
+// Claims is the typed JWT claims issued by the auth service.
+type Claims struct {
+	jwtV5.RegisteredClaims
+
+	TenantID   string     `json:"tenant_id,omitempty"`
+	Roles      []string   `json:"roles,omitempty"`
+	ClientType ClientType `json:"client_type,omitempty"`
+	Use        TokenUse   `json:"use,omitempty"`
+}
+
+// NewClaims creates claims of the given use for the principal.
+// 未指定 TokenID 时自动生成
+func NewClaims(p *Principal, use TokenUse, issuedAt, expiresAt time.Time) *Claims {
+	tokenID := p.TokenID
+	if tokenID == "" {
+		tokenID = uuid.NewString()
+	}
+	return &Claims{
+		RegisteredClaims: jwtV5.RegisteredClaims{
+			ID:        tokenID,
+			Subject:   p.UserID,
+			IssuedAt:  jwtV5.NewNumericDate(issuedAt),
+			ExpiresAt: jwtV5.NewNumericDate(expiresAt),
+		},
+		TenantID:   p.TenantID,
+		Roles:      p.Roles,
+		ClientType: p.ClientType,
+		Use:        use,
+	}
+}
+
+// Principal returns the authenticated principal described by the claims.
+func (c *Claims) Principal() *Principal {
+	return &Principal{
+		UserID:     c.Subject,
+		TenantID:   c.TenantID,
+		Roles:      c.Roles,
+		TokenID:    c.ID,
+		ClientType: c.ClientType,
+	}
+}
@@ -0,0 +1,44 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"qn-base/pkg/auth"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataScopeServer(t *testing.T) {
+	var calls int
+	resolver := func(ctx context.Context) (*auth.DataScope, error) {
+		calls++
+		// 解析数据范围时不受数据范围限制
+		scope, err := auth.DataScopeFromContext(ctx)
+		assert.NoError(t, err)
+		assert.Nil(t, scope)
+		return &auth.DataScope{UserID: "user123", DeptIDs: []string{"d1"}}, nil
+	}
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		for i := 0; i < 2; i++ {
+			scope, err := auth.DataScopeFromContext(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"d1"}, scope.DeptIDs)
+		}
+		return nil, nil
+	}
+
+	_, err := auth.DataScopeServer(resolver)(handler)(context.Background(), nil)
+
+	assert.NoError(t, err)
+	// 每个请求只解析一次
+	assert.Equal(t, 1, calls)
+}
+
+func TestDataScope_Allows(t *testing.T) {
+	scope := &auth.DataScope{DeptIDs: []string{"d1", "d2"}}
+
+	assert.True(t, scope.Allows("d2"))
+	assert.False(t, scope.Allows("d3"))
+	assert.True(t, (&auth.DataScope{All: true}).Allows("d3"))
+}
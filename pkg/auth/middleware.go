@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	jwtV5 "github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	// ErrMissingClaims is the jwt middleware did not run before this one.
+	ErrMissingClaims = errors.Unauthorized("UNAUTHORIZED", "missing token claims")
+	// ErrIncorrectAccessToken is the token is not a valid access token.
+	ErrIncorrectAccessToken = errors.Unauthorized("INCORRECT_ACCESS_TOKEN", "incorrect access token")
+)
+
+// WithClaims is the jwt.Option that parses tokens into *Claims.
+func WithClaims() jwt.Option {
+	return jwt.WithClaims(func() jwtV5.Claims {
+		return &Claims{}
+	})
+}
+
+// Server is a server middleware that converts the claims parsed by
+// jwt.Server into a Principal, and puts it into the context.
+// It must run after jwt.Server configured with WithClaims.
+func Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tokenClaims, ok := jwt.FromContext(ctx)
+			if !ok {
+				return nil, ErrMissingClaims
+			}
+			claims, ok := tokenClaims.(*Claims)
+			if !ok {
+				return nil, ErrIncorrectAccessToken
+			}
+			// 刷新令牌不能用于访问接口
+			if claims.Use != TokenUseAccess || claims.Subject == "" {
+				return nil, ErrIncorrectAccessToken
+			}
+			return handler(NewContext(ctx, claims.Principal()), req)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package auth
+
+import "context"
+
+type principalKey struct{}
+
+// Principal is the authenticated caller of the current request.
+type Principal struct {
+	UserID     string
+	TenantID   string
+	Roles      []string
+	TokenID    string
+	ClientType ClientType
+}
+
+// NewContext returns a new context that carries the principal.
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the principal stored in ctx, if any.
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok && p != nil
+}
+
+// WithTenant returns a new context whose principal is a copy of the current one with the tenant replaced,
+// used to operate on data of the given tenant, e.g. provisioning a new tenant.
+func WithTenant(ctx context.Context, tenantID string) context.Context {
+	p := &Principal{}
+	if current, ok := FromContext(ctx); ok {
+		*p = *current
+	}
+	p.TenantID = tenantID
+	return NewContext(ctx, p)
+}
+
+// UserID returns the user ID of the current principal, or "" if unauthenticated.
+func UserID(ctx context.Context) string {
+	if p, ok := FromContext(ctx); ok {
+		return p.UserID
+	}
+	return ""
+}
+
+// TenantID returns the tenant ID of the current principal, or "" if unauthenticated.
+func TenantID(ctx context.Context) string {
+	if p, ok := FromContext(ctx); ok {
+		return p.TenantID
+	}
+	return ""
+}
+
+// Roles returns the role codes of the current principal.
+func Roles(ctx context.Context) []string {
+	if p, ok := FromContext(ctx); ok {
+		return p.Roles
+	}
+	return nil
+}
+
+// TokenID returns the token ID (jti) of the current principal.
+func TokenID(ctx context.Context) string {
+	if p, ok := FromContext(ctx); ok {
+		return p.TokenID
+	}
+	return ""
+}
+
+const (
+	// SuperAdminRoleCode is the role code of the built-in super administrator,
+	// which bypasses menu and permission checks. 该编码为保留编码，不能通过接口创建
+	SuperAdminRoleCode = "super_admin"
+	// PlatformTenantID is the ID of the platform tenant operating the other tenants,
+	// the super administrator role is only honored in it.
+	PlatformTenantID = "1"
+)
+
+// IsPlatformTenant reports whether the tenant is the platform tenant.
+func IsPlatformTenant(tenantID string) bool {
+	return tenantID == PlatformTenantID
+}
+
+// IsSuperAdmin reports whether the principal holds the super administrator role of the platform tenant,
+// a role with the same code in other tenants grants nothing.
+func (p *Principal) IsSuperAdmin() bool {
+	if !IsPlatformTenant(p.TenantID) {
+		return false
+	}
+	for _, role := range p.Roles {
+		if role == SuperAdminRoleCode {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSuperAdmin reports whether the current principal is a super administrator.
+func IsSuperAdmin(ctx context.Context) bool {
+	if p, ok := FromContext(ctx); ok {
+		return p.IsSuperAdmin()
+	}
+	return false
+}
@@ -0,0 +1,164 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+)
+
+// ErrTokenRevoked is returned when the token was revoked, e.g. the user was disabled or kicked out.
+var ErrTokenRevoked = errors.Unauthorized("TOKEN_REVOKED", "token has been revoked")
+
+// RevocationStore records the revoked tokens by jti.
+//
+// 签发令牌时通过 Track 记录用户的令牌，RevokeUser 据此吊销用户全部未过期的令牌；
+// 吊销记录保留到令牌过期为止
+type RevocationStore interface {
+	// Track records the token issued to the user, which expires at expiresAt.
+	Track(ctx context.Context, userID, tokenID string, expiresAt time.Time) error
+	// Revoke revokes the token, which expires at expiresAt.
+	Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error
+	// TryRevoke revokes the token like Revoke, and reports whether this call revoked it,
+	// false if it had been revoked or has expired.
+	// 检查与吊销是原子的，用于只能使用一次的令牌，如刷新令牌
+	TryRevoke(ctx context.Context, tokenID string, expiresAt time.Time) (bool, error)
+	// RevokeUser revokes all the live tokens of the user.
+	RevokeUser(ctx context.Context, userID string) error
+	// IsRevoked reports whether the token was revoked.
+	IsRevoked(ctx context.Context, tokenID string) (bool, error)
+}
+
+// RevocationServer returns a middleware that rejects revoked tokens.
+// 需放在 Server() 之后，以便从 ctx 中获取令牌ID
+func RevocationServer(store RevocationStore) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tokenID := TokenID(ctx)
+			if tokenID == "" {
+				return nil, ErrMissingClaims
+			}
+			revoked, err := store.IsRevoked(ctx, tokenID)
+			if err != nil {
+				return nil, err
+			}
+			if revoked {
+				return nil, ErrTokenRevoked
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+var _ RevocationStore = (*MemoryRevocationStore)(nil)
+
+// MemoryRevocationStore is a RevocationStore kept in process, for a single instance or tests.
+type MemoryRevocationStore struct {
+	now func() time.Time
+
+	mu        sync.Mutex
+	revoked   map[string]time.Time
+	tokens    map[string]map[string]time.Time
+	lastPurge time.Time
+}
+
+// memoryPurgeInterval is how often the records of expired tokens are dropped.
+const memoryPurgeInterval = time.Minute
+
+// NewMemoryRevocationStore creates an in-memory RevocationStore.
+func NewMemoryRevocationStore() *MemoryRevocationStore {
+	return &MemoryRevocationStore{
+		now:     time.Now,
+		revoked: make(map[string]time.Time),
+		tokens:  make(map[string]map[string]time.Time),
+	}
+}
+
+// Track records the token issued to the user.
+func (s *MemoryRevocationStore) Track(_ context.Context, userID, tokenID string, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.purgeExpired()
+	tokens, ok := s.tokens[userID]
+	if !ok {
+		tokens = make(map[string]time.Time)
+		s.tokens[userID] = tokens
+	}
+	tokens[tokenID] = expiresAt
+	return nil
+}
+
+// Revoke revokes the token.
+func (s *MemoryRevocationStore) Revoke(_ context.Context, tokenID string, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.purgeExpired()
+	if expiresAt.After(s.now()) {
+		s.revoked[tokenID] = expiresAt
+	}
+	return nil
+}
+
+// TryRevoke revokes the token, and reports whether this call revoked it.
+func (s *MemoryRevocationStore) TryRevoke(_ context.Context, tokenID string, expiresAt time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.purgeExpired()
+	now := s.now()
+	if !expiresAt.After(now) {
+		return false, nil
+	}
+	if revokedUntil, ok := s.revoked[tokenID]; ok && revokedUntil.After(now) {
+		return false, nil
+	}
+	s.revoked[tokenID] = expiresAt
+	return true, nil
+}
+
+// RevokeUser revokes all the live tokens of the user.
+func (s *MemoryRevocationStore) RevokeUser(_ context.Context, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	for tokenID, expiresAt := range s.tokens[userID] {
+		if expiresAt.After(now) {
+			s.revoked[tokenID] = expiresAt
+		}
+	}
+	delete(s.tokens, userID)
+	return nil
+}
+
+// IsRevoked reports whether the token was revoked.
+func (s *MemoryRevocationStore) IsRevoked(_ context.Context, tokenID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	expiresAt, ok := s.revoked[tokenID]
+	return ok && expiresAt.After(s.now()), nil
+}
+
+// purgeExpired drops the records of expired tokens at most once per interval, s.mu must be held.
+func (s *MemoryRevocationStore) purgeExpired() {
+	now := s.now()
+	if now.Sub(s.lastPurge) < memoryPurgeInterval {
+		return
+	}
+	s.lastPurge = now
+	for tokenID, expiresAt := range s.revoked {
+		if !expiresAt.After(now) {
+			delete(s.revoked, tokenID)
+		}
+	}
+	for userID, tokens := range s.tokens {
+		for tokenID, expiresAt := range tokens {
+			if !expiresAt.After(now) {
+				delete(tokens, tokenID)
+			}
+		}
+		if len(tokens) == 0 {
+			delete(s.tokens, userID)
+		}
+	}
+}
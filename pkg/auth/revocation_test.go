@@ -0,0 +1,127 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryRevocationStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("吊销单个令牌", func(t *testing.T) {
+		store := NewMemoryRevocationStore()
+		require.NoError(t, store.Revoke(ctx, "t1", now.Add(time.Hour)))
+
+		// 断言
+		revoked, err := store.IsRevoked(ctx, "t1")
+		require.NoError(t, err)
+		assert.True(t, revoked)
+		revoked, _ = store.IsRevoked(ctx, "t2")
+		assert.False(t, revoked)
+	})
+
+	t.Run("令牌只能成功吊销一次", func(t *testing.T) {
+		store := NewMemoryRevocationStore()
+
+		// 执行测试 & 断言
+		ok, err := store.TryRevoke(ctx, "t1", now.Add(time.Hour))
+		require.NoError(t, err)
+		assert.True(t, ok)
+		ok, err = store.TryRevoke(ctx, "t1", now.Add(time.Hour))
+		require.NoError(t, err)
+		assert.False(t, ok)
+		revoked, _ := store.IsRevoked(ctx, "t1")
+		assert.True(t, revoked)
+
+		// 被用户吊销的令牌
+		require.NoError(t, store.Track(ctx, "u1", "t2", now.Add(time.Hour)))
+		require.NoError(t, store.RevokeUser(ctx, "u1"))
+		ok, _ = store.TryRevoke(ctx, "t2", now.Add(time.Hour))
+		assert.False(t, ok)
+
+		// 已过期的令牌
+		ok, _ = store.TryRevoke(ctx, "t3", now.Add(-time.Minute))
+		assert.False(t, ok)
+	})
+
+	t.Run("吊销用户全部未过期的令牌", func(t *testing.T) {
+		store := NewMemoryRevocationStore()
+		require.NoError(t, store.Track(ctx, "u1", "t1", now.Add(time.Hour)))
+		require.NoError(t, store.Track(ctx, "u1", "t2", now.Add(time.Hour)))
+		require.NoError(t, store.Track(ctx, "u2", "t3", now.Add(time.Hour)))
+
+		// 执行测试
+		require.NoError(t, store.RevokeUser(ctx, "u1"))
+
+		// 断言
+		for tokenID, want := range map[string]bool{"t1": true, "t2": true, "t3": false} {
+			revoked, err := store.IsRevoked(ctx, tokenID)
+			require.NoError(t, err)
+			assert.Equal(t, want, revoked, tokenID)
+		}
+
+		// 之后签发的令牌不受影响
+		require.NoError(t, store.Track(ctx, "u1", "t4", now.Add(time.Hour)))
+		revoked, _ := store.IsRevoked(ctx, "t4")
+		assert.False(t, revoked)
+	})
+
+	t.Run("令牌过期后清除记录", func(t *testing.T) {
+		store := NewMemoryRevocationStore()
+		clock := now
+		store.now = func() time.Time { return clock }
+		require.NoError(t, store.Revoke(ctx, "t1", now.Add(time.Minute)))
+		require.NoError(t, store.Track(ctx, "u1", "t2", now.Add(time.Minute)))
+
+		// 执行测试
+		clock = now.Add(2 * memoryPurgeInterval)
+		revoked, _ := store.IsRevoked(ctx, "t1")
+		assert.False(t, revoked)
+		require.NoError(t, store.Track(ctx, "u2", "t3", clock.Add(time.Minute)))
+
+		// 断言
+		assert.NotContains(t, store.revoked, "t1")
+		assert.NotContains(t, store.tokens, "u1")
+	})
+}
+
+func TestRevocationServer(t *testing.T) {
+	store := NewMemoryRevocationStore()
+	require.NoError(t, store.Revoke(context.Background(), "revoked", time.Now().Add(time.Hour)))
+	handler := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+
+	tests := []struct {
+		name    string
+		tokenID string
+		wantErr *errors.Error
+	}{
+		{name: "有效令牌", tokenID: "valid"},
+		{name: "已吊销的令牌", tokenID: "revoked", wantErr: ErrTokenRevoked},
+		{name: "缺少令牌", wantErr: ErrMissingClaims},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.tokenID != "" {
+				ctx = NewContext(ctx, &Principal{UserID: "u1", TokenID: tt.tokenID})
+			}
+
+			// 执行测试
+			reply, err := RevocationServer(store)(handler)(ctx, nil)
+
+			// 断言
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "ok", reply)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package auth
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+)
+
+// DefaultTouchInterval is the default minimum interval between two last-seen writes of a session.
+const DefaultTouchInterval = time.Minute
+
+// touchCacheSize 最近写入过最后活跃时间的会话数上限
+const touchCacheSize = 10000
+
+// Session is an online session, i.e. a token pair issued on login or refresh.
+type Session struct {
+	TokenID    string     `json:"token_id"`
+	UserID     string     `json:"user_id"`
+	Account    string     `json:"account"`
+	TenantID   string     `json:"tenant_id"`
+	ClientType ClientType `json:"client_type"`
+	IP         string     `json:"ip"`
+	UserAgent  string     `json:"user_agent"`
+	IssuedAt   time.Time  `json:"issued_at"`
+	LastSeenAt time.Time  `json:"last_seen_at"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+}
+
+// SessionStore is the registry of the online sessions by token ID.
+//
+// 会话保留到刷新令牌过期为止，过期的会话不再返回
+type SessionStore interface {
+	// Save records the session until it expires.
+	Save(ctx context.Context, s *Session) error
+	// Touch updates the last-seen time of the session, if it still exists.
+	Touch(ctx context.Context, tokenID string, at time.Time) error
+	// Get returns the session, or nil if it does not exist or has expired.
+	Get(ctx context.Context, tokenID string) (*Session, error)
+	// List returns the live sessions of the tenant.
+	List(ctx context.Context, tenantID string) ([]*Session, error)
+	// Delete removes the session.
+	Delete(ctx context.Context, tokenID string) error
+}
+
+// SessionServer returns a middleware that updates the last-seen time of the current session.
+// 同一会话在 interval 内只写入一次，写入失败不影响请求；需放在 Server() 之后
+func SessionServer(store SessionStore, interval time.Duration) middleware.Middleware {
+	if interval <= 0 {
+		interval = DefaultTouchInterval
+	}
+	touched := expirable.NewLRU[string, struct{}](touchCacheSize, nil, interval)
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tokenID := TokenID(ctx); tokenID != "" {
+				if _, ok := touched.Get(tokenID); !ok {
+					touched.Add(tokenID, struct{}{})
+					if err := store.Touch(ctx, tokenID, time.Now()); err != nil {
+						log.Context(ctx).Warnf("touch session failed, tokenID=%s, err=%v", tokenID, err)
+					}
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+var _ SessionStore = (*MemorySessionStore)(nil)
+
+// MemorySessionStore is a SessionStore kept in process, for a single instance or tests.
+type MemorySessionStore struct {
+	now func() time.Time
+
+	mu       sync.Mutex
+	sessions map[string]*Session
+}
+
+// NewMemorySessionStore creates an in-memory SessionStore.
+func NewMemorySessionStore() *MemorySessionStore {
+	return &MemorySessionStore{
+		now:      time.Now,
+		sessions: make(map[string]*Session),
+	}
+}
+
+// Save records the session.
+func (s *MemorySessionStore) Save(_ context.Context, session *Session) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	for tokenID, existing := range s.sessions {
+		if !existing.ExpiresAt.After(now) {
+			delete(s.sessions, tokenID)
+		}
+	}
+	cp := *session
+	s.sessions[session.TokenID] = &cp
+	return nil
+}
+
+// Touch updates the last-seen time of the session.
+func (s *MemorySessionStore) Touch(_ context.Context, tokenID string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if session, ok := s.sessions[tokenID]; ok {
+		session.LastSeenAt = at
+	}
+	return nil
+}
+
+// Get returns the session.
+func (s *MemorySessionStore) Get(_ context.Context, tokenID string) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session, ok := s.sessions[tokenID]
+	if !ok || !session.ExpiresAt.After(s.now()) {
+		return nil, nil
+	}
+	cp := *session
+	return &cp, nil
+}
+
+// List returns the live sessions of the tenant, latest issued first.
+func (s *MemorySessionStore) List(_ context.Context, tenantID string) ([]*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	var sessions []*Session
+	for _, session := range s.sessions {
+		if session.TenantID == tenantID && session.ExpiresAt.After(now) {
+			cp := *session
+			sessions = append(sessions, &cp)
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].IssuedAt.After(sessions[j].IssuedAt)
+	})
+	return sessions, nil
+}
+
+// Delete removes the session.
+func (s *MemorySessionStore) Delete(_ context.Context, tokenID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.sessions, tokenID)
+	return nil
+}
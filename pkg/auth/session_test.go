@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemorySessionStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("按租户列出未过期的会话", func(t *testing.T) {
+		store := NewMemorySessionStore()
+		require.NoError(t, store.Save(ctx, &Session{TokenID: "t1", TenantID: "tenant1", IssuedAt: now.Add(-time.Minute), ExpiresAt: now.Add(time.Hour)}))
+		require.NoError(t, store.Save(ctx, &Session{TokenID: "t2", TenantID: "tenant1", IssuedAt: now, ExpiresAt: now.Add(time.Hour)}))
+		require.NoError(t, store.Save(ctx, &Session{TokenID: "t3", TenantID: "tenant2", IssuedAt: now, ExpiresAt: now.Add(time.Hour)}))
+		require.NoError(t, store.Save(ctx, &Session{TokenID: "t4", TenantID: "tenant1", IssuedAt: now, ExpiresAt: now.Add(-time.Second)}))
+
+		// 执行测试
+		sessions, err := store.List(ctx, "tenant1")
+
+		// 断言
+		require.NoError(t, err)
+		require.Len(t, sessions, 2)
+		assert.Equal(t, "t2", sessions[0].TokenID)
+		assert.Equal(t, "t1", sessions[1].TokenID)
+		expired, err := store.Get(ctx, "t4")
+		require.NoError(t, err)
+		assert.Nil(t, expired)
+	})
+
+	t.Run("更新最后活跃时间和删除会话", func(t *testing.T) {
+		store := NewMemorySessionStore()
+		require.NoError(t, store.Save(ctx, &Session{TokenID: "t1", TenantID: "tenant1", LastSeenAt: now, ExpiresAt: now.Add(time.Hour)}))
+
+		// 执行测试
+		require.NoError(t, store.Touch(ctx, "t1", now.Add(time.Minute)))
+		require.NoError(t, store.Touch(ctx, "missing", now))
+
+		// 断言
+		session, err := store.Get(ctx, "t1")
+		require.NoError(t, err)
+		assert.Equal(t, now.Add(time.Minute), session.LastSeenAt)
+		require.NoError(t, store.Delete(ctx, "t1"))
+		session, _ = store.Get(ctx, "t1")
+		assert.Nil(t, session)
+	})
+}
+
+type countingSessionStore struct {
+	SessionStore
+	touches map[string]int
+}
+
+func (s *countingSessionStore) Touch(_ context.Context, tokenID string, _ time.Time) error {
+	s.touches[tokenID]++
+	return nil
+}
+
+func TestSessionServer(t *testing.T) {
+	store := &countingSessionStore{touches: make(map[string]int)}
+	handler := SessionServer(store, time.Hour)(func(context.Context, interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+
+	// 执行测试
+	for _, tokenID := range []string{"t1", "t1", "t2", "t1", ""} {
+		ctx := context.Background()
+		if tokenID != "" {
+			ctx = NewContext(ctx, &Principal{UserID: "u1", TokenID: tokenID})
+		}
+		reply, err := handler(ctx, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", reply)
+	}
+
+	// 断言：间隔内同一会话只写入一次
+	assert.Equal(t, map[string]int{"t1": 1, "t2": 1}, store.touches)
+}
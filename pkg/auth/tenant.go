@@ -0,0 +1,29 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+var (
+	// ErrTenantRequired is returned when tenant-scoped data is accessed without a tenant.
+	ErrTenantRequired = errors.Unauthorized("TENANT_REQUIRED", "tenant is required")
+	// ErrTenantDenied is returned when a write targets data of another tenant.
+	ErrTenantDenied = errors.Forbidden("TENANT_DENIED", "data of another tenant")
+)
+
+type crossTenantKey struct{}
+
+// CrossTenant returns a new context that is not limited to the tenant of the principal,
+// e.g. for platform administrators managing all tenants, or for looking up the user on login.
+// 仅用于受信任的代码路径，不要根据请求参数开启
+func CrossTenant(parent context.Context) context.Context {
+	return context.WithValue(parent, crossTenantKey{}, true)
+}
+
+// IsCrossTenant reports whether ctx is not limited to the tenant of the principal.
+func IsCrossTenant(ctx context.Context) bool {
+	cross, _ := ctx.Value(crossTenantKey{}).(bool)
+	return cross
+}
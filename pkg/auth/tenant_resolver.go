@@ -0,0 +1,145 @@
+package auth
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// TenantHeader is the header (HTTP) or metadata (gRPC) key carrying the tenant ID.
+const TenantHeader = "X-Tenant-Id"
+
+// defaultWebsiteTTL is how long the tenant bound to a website is cached.
+const defaultWebsiteTTL = 5 * time.Minute
+
+// WebsiteTenantLookup returns the ID of the tenant bound to the website, or "" if none.
+type WebsiteTenantLookup func(ctx context.Context, website string) (string, error)
+
+// TenantResolverOption is a TenantResolver option.
+type TenantResolverOption func(*TenantResolver)
+
+// WithWebsiteTTL sets how long the tenant bound to a website is cached.
+func WithWebsiteTTL(ttl time.Duration) TenantResolverOption {
+	return func(r *TenantResolver) {
+		r.ttl = ttl
+	}
+}
+
+type websiteEntry struct {
+	tenantID  string
+	expiresAt time.Time
+}
+
+// TenantResolver resolves the tenant of the request, trying in order
+// the token claim, the X-Tenant-Id header or metadata, then the website bound to the Host.
+//
+// 未绑定租户的域名同样缓存，避免每个请求都查询数据库
+type TenantResolver struct {
+	lookup WebsiteTenantLookup
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu    sync.RWMutex
+	cache map[string]websiteEntry
+}
+
+// NewTenantResolver creates a TenantResolver that looks up the tenant of websites with lookup.
+func NewTenantResolver(lookup WebsiteTenantLookup, opts ...TenantResolverOption) *TenantResolver {
+	r := &TenantResolver{
+		lookup: lookup,
+		ttl:    defaultWebsiteTTL,
+		now:    time.Now,
+		cache:  make(map[string]websiteEntry),
+	}
+	for _, o := range opts {
+		o(r)
+	}
+	return r
+}
+
+// Resolve returns the tenant of the request in ctx, or "" if it cannot be resolved.
+func (r *TenantResolver) Resolve(ctx context.Context) (string, error) {
+	if tenantID := TenantID(ctx); tenantID != "" {
+		return tenantID, nil
+	}
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	if tenantID := strings.TrimSpace(tr.RequestHeader().Get(TenantHeader)); tenantID != "" {
+		return tenantID, nil
+	}
+	host := requestHost(tr)
+	if host == "" || r.lookup == nil {
+		return "", nil
+	}
+	return r.websiteTenant(ctx, host)
+}
+
+// Invalidate drops the cached tenant of the website, e.g. after the website of a tenant changed.
+func (r *TenantResolver) Invalidate(website string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.cache, website)
+}
+
+// Server returns a middleware that puts the resolved tenant into the principal of ctx.
+// 需放在 Server() 之后，已登录时以令牌中的租户为准
+func (r *TenantResolver) Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if TenantID(ctx) != "" {
+				return handler(ctx, req)
+			}
+			tenantID, err := r.Resolve(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if tenantID != "" {
+				ctx = WithTenant(ctx, tenantID)
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// websiteTenant returns the tenant bound to the website, using the cache.
+func (r *TenantResolver) websiteTenant(ctx context.Context, website string) (string, error) {
+	now := r.now()
+	r.mu.RLock()
+	entry, ok := r.cache[website]
+	r.mu.RUnlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.tenantID, nil
+	}
+
+	tenantID, err := r.lookup(ctx, website)
+	if err != nil {
+		return "", err
+	}
+	r.mu.Lock()
+	r.cache[website] = websiteEntry{tenantID: tenantID, expiresAt: now.Add(r.ttl)}
+	r.mu.Unlock()
+	return tenantID, nil
+}
+
+// requestHost returns the host of the request without the port, lower-cased.
+func requestHost(tr transport.Transporter) string {
+	var host string
+	if ht, ok := tr.(http.Transporter); ok {
+		host = ht.Request().Host
+	} else {
+		// gRPC 的 Host 在 :authority 伪头中
+		host = tr.RequestHeader().Get(":authority")
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.ToLower(strings.TrimSpace(host))
+}
@@ -0,0 +1,80 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"qn-base/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+// headerCarrier is a transport.Header backed by a map.
+type headerCarrier map[string]string
+
+func (h headerCarrier) Get(key string) string      { return h[key] }
+func (h headerCarrier) Set(key, value string)      { h[key] = value }
+func (h headerCarrier) Add(key, value string)      { h[key] = value }
+func (h headerCarrier) Keys() []string             { return nil }
+func (h headerCarrier) Values(key string) []string { return []string{h[key]} }
+
+// headerTransport is a server transport carrying only the request header.
+type headerTransport struct {
+	fakeTransport
+	header headerCarrier
+}
+
+func (t *headerTransport) RequestHeader() transport.Header { return t.header }
+
+func TestTenantResolver_Server(t *testing.T) {
+	lookups := 0
+	resolver := auth.NewTenantResolver(func(_ context.Context, website string) (string, error) {
+		lookups++
+		if website == "a.example.com" {
+			return "t-site", nil
+		}
+		return "", nil
+	}, auth.WithWebsiteTTL(time.Hour))
+
+	// resolve 执行中间件，返回下游看到的租户
+	resolve := func(ctx context.Context) string {
+		var tenantID string
+		_, err := resolver.Server()(func(ctx context.Context, _ interface{}) (interface{}, error) {
+			tenantID = auth.TenantID(ctx)
+			return nil, nil
+		})(ctx, nil)
+		assert.NoError(t, err)
+		return tenantID
+	}
+	request := func(header headerCarrier) context.Context {
+		return transport.NewServerContext(context.Background(), &headerTransport{header: header})
+	}
+
+	t.Run("令牌中的租户优先", func(t *testing.T) {
+		ctx := auth.NewContext(request(headerCarrier{auth.TenantHeader: "t-header"}), &auth.Principal{UserID: "u1", TenantID: "t-token"})
+		assert.Equal(t, "t-token", resolve(ctx))
+	})
+
+	t.Run("请求头", func(t *testing.T) {
+		ctx := request(headerCarrier{auth.TenantHeader: "t-header", ":authority": "a.example.com"})
+		assert.Equal(t, "t-header", resolve(ctx))
+	})
+
+	t.Run("域名绑定的租户被缓存", func(t *testing.T) {
+		before := lookups
+		assert.Equal(t, "t-site", resolve(request(headerCarrier{":authority": "A.example.com:443"})))
+		assert.Equal(t, "t-site", resolve(request(headerCarrier{":authority": "a.example.com"})))
+		assert.Equal(t, before+1, lookups)
+
+		resolver.Invalidate("a.example.com")
+		assert.Equal(t, "t-site", resolve(request(headerCarrier{":authority": "a.example.com"})))
+		assert.Equal(t, before+2, lookups)
+	})
+
+	t.Run("无法解析", func(t *testing.T) {
+		assert.Equal(t, "", resolve(request(headerCarrier{":authority": "unknown.example.com"})))
+		assert.Equal(t, "", resolve(context.Background()))
+	})
+}
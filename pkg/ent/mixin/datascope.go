@@ -0,0 +1,108 @@
+package mixin
+
+import (
+	"context"
+	"fmt"
+
+	"qn-base/pkg/auth"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/mixin"
+)
+
+var _ ent.Mixin = (*DataScope)(nil)
+
+// DataScope limits the queries and mutations of the entity to the data scope of the principal,
+// by its dept_id field, data of the principal itself is always accessible.
+// 需放在 DeletedAt 之前，否则软删除转换后的更新不受限制
+type DataScope struct{ mixin.Schema }
+
+// dataScopePredicate returns the predicate of the entities within the scope.
+func dataScopePredicate(scope *auth.DataScope) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		var preds []*sql.Predicate
+		if len(scope.DeptIDs) > 0 {
+			args := make([]any, len(scope.DeptIDs))
+			for i, id := range scope.DeptIDs {
+				args[i] = id
+			}
+			preds = append(preds, sql.In(s.C("dept_id"), args...))
+		}
+		if scope.UserID != "" {
+			preds = append(preds, sql.EQ(s.C("id"), scope.UserID))
+		}
+		if len(preds) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.Or(preds...))
+	}
+}
+
+// Interceptors of the DataScope.
+func (DataScope) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		ent.TraverseFunc(func(ctx context.Context, q ent.Query) error {
+			scope, err := auth.DataScopeFromContext(ctx)
+			if err != nil || scope == nil || scope.All {
+				return err
+			}
+			w, ok := q.(interface{ WhereP(...func(*sql.Selector)) })
+			if !ok {
+				return fmt.Errorf("data scope: unexpected query type %T", q)
+			}
+			w.WhereP(dataScopePredicate(scope))
+			return nil
+		}),
+	}
+}
+
+// Hooks of the DataScope.
+func (DataScope) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				scope, err := auth.DataScopeFromContext(ctx)
+				if err != nil {
+					return nil, err
+				}
+				if scope == nil || scope.All {
+					return next.Mutate(ctx, m)
+				}
+				if err := checkDataScopeDept(scope, m); err != nil {
+					return nil, err
+				}
+				if m.Op().Is(ent.OpCreate) {
+					return next.Mutate(ctx, m)
+				}
+				w, ok := m.(interface{ WhereP(...func(*sql.Selector)) })
+				if !ok {
+					return nil, fmt.Errorf("data scope: unexpected mutation type %T", m)
+				}
+				// 范围外的数据不会被更新或删除
+				w.WhereP(dataScopePredicate(scope))
+				return next.Mutate(ctx, m)
+			})
+		},
+	}
+}
+
+// checkDataScopeDept checks that the dept set by the mutation is within the scope,
+// creating entities without a dept or clearing the dept is denied.
+func checkDataScopeDept(scope *auth.DataScope, m ent.Mutation) error {
+	if m.FieldCleared("dept_id") {
+		return auth.ErrDataScopeDenied
+	}
+	v, exists := m.Field("dept_id")
+	if !exists {
+		if m.Op().Is(ent.OpCreate) {
+			return auth.ErrDataScopeDenied
+		}
+		return nil
+	}
+	if deptID, _ := v.(string); !scope.Allows(deptID) {
+		return auth.ErrDataScopeDenied
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package mixin_test
+
+import (
+	"context"
+	"testing"
+
+	"qn-base/pkg/auth"
+	"qn-base/pkg/ent/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// scopeQuery 记录 WhereP 条件的查询
+type scopeQuery struct {
+	preds []func(*sql.Selector)
+}
+
+func (q *scopeQuery) WhereP(ps ...func(*sql.Selector)) { q.preds = append(q.preds, ps...) }
+
+// where 返回条件生成的 SQL
+func where(preds []func(*sql.Selector)) (string, []any) {
+	s := sql.Select("*").From(sql.Table("t_system_user"))
+	for _, p := range preds {
+		p(s)
+	}
+	return s.Query()
+}
+
+// scopeMutation 仅实现数据范围相关方法的 mutation
+type scopeMutation struct {
+	ent.Mutation
+	scopeQuery
+
+	op          ent.Op
+	deptID      *string
+	deptCleared bool
+}
+
+func (m *scopeMutation) Op() ent.Op { return m.op }
+
+func (m *scopeMutation) Field(name string) (ent.Value, bool) {
+	if name != "dept_id" || m.deptID == nil {
+		return nil, false
+	}
+	return *m.deptID, true
+}
+
+func (m *scopeMutation) FieldCleared(name string) bool { return name == "dept_id" && m.deptCleared }
+
+// mutateWithScope 执行数据范围 hook，返回是否调用了下一个 mutator
+func mutateWithScope(ctx context.Context, m ent.Mutation) (bool, error) {
+	var called bool
+	mutator := mixin.DataScope{}.Hooks()[0](ent.MutateFunc(func(context.Context, ent.Mutation) (ent.Value, error) {
+		called = true
+		return nil, nil
+	}))
+	_, err := mutator.Mutate(ctx, m)
+	return called, err
+}
+
+func withScope(scope *auth.DataScope) context.Context {
+	return auth.NewDataScopeContext(context.Background(), func(context.Context) (*auth.DataScope, error) {
+		return scope, nil
+	})
+}
+
+func TestDataScopeInterceptor(t *testing.T) {
+	traverse := func(ctx context.Context) *scopeQuery {
+		q := &scopeQuery{}
+		err := mixin.DataScope{}.Interceptors()[0].(ent.TraverseFunc).Traverse(ctx, q)
+		assert.NoError(t, err)
+		return q
+	}
+
+	t.Run("部门及本人", func(t *testing.T) {
+		q := traverse(withScope(&auth.DataScope{UserID: "u1", DeptIDs: []string{"d1", "d2"}}))
+
+		query, args := where(q.preds)
+		assert.Contains(t, query, "`t_system_user`.`dept_id` IN (?, ?) OR `t_system_user`.`id` = ?")
+		assert.Equal(t, []any{"d1", "d2", "u1"}, args)
+	})
+
+	t.Run("没有可访问的数据", func(t *testing.T) {
+		q := traverse(withScope(&auth.DataScope{}))
+
+		query, _ := where(q.preds)
+		assert.Contains(t, query, "FALSE")
+	})
+
+	t.Run("全部数据", func(t *testing.T) {
+		q := traverse(withScope(&auth.DataScope{All: true}))
+
+		assert.Empty(t, q.preds)
+	})
+
+	t.Run("未设置数据范围", func(t *testing.T) {
+		q := traverse(context.Background())
+
+		assert.Empty(t, q.preds)
+	})
+
+	t.Run("跳过数据范围", func(t *testing.T) {
+		q := traverse(auth.SkipDataScope(withScope(&auth.DataScope{})))
+
+		assert.Empty(t, q.preds)
+	})
+}
+
+func TestDataScopeHook(t *testing.T) {
+	ctx := withScope(&auth.DataScope{UserID: "u1", DeptIDs: []string{"d1"}})
+	deptID := func(s string) *string { return &s }
+
+	t.Run("创建范围内部门的用户", func(t *testing.T) {
+		m := &scopeMutation{op: ent.OpCreate, deptID: deptID("d1")}
+		called, err := mutateWithScope(ctx, m)
+
+		assert.NoError(t, err)
+		assert.True(t, called)
+		assert.Empty(t, m.preds)
+	})
+
+	t.Run("创建范围外部门的用户", func(t *testing.T) {
+		called, err := mutateWithScope(ctx, &scopeMutation{op: ent.OpCreate, deptID: deptID("d9")})
+
+		assert.True(t, errors.Is(err, auth.ErrDataScopeDenied))
+		assert.False(t, called)
+	})
+
+	t.Run("创建没有部门的用户", func(t *testing.T) {
+		_, err := mutateWithScope(ctx, &scopeMutation{op: ent.OpCreate})
+
+		assert.True(t, errors.Is(err, auth.ErrDataScopeDenied))
+	})
+
+	t.Run("更新限制在范围内", func(t *testing.T) {
+		m := &scopeMutation{op: ent.OpUpdateOne}
+		called, err := mutateWithScope(ctx, m)
+
+		assert.NoError(t, err)
+		assert.True(t, called)
+		query, args := where(m.preds)
+		assert.Contains(t, query, "`t_system_user`.`dept_id` IN (?) OR `t_system_user`.`id` = ?")
+		assert.Equal(t, []any{"d1", "u1"}, args)
+	})
+
+	t.Run("删除限制在范围内", func(t *testing.T) {
+		m := &scopeMutation{op: ent.OpDeleteOne}
+		_, err := mutateWithScope(ctx, m)
+
+		assert.NoError(t, err)
+		assert.Len(t, m.preds, 1)
+	})
+
+	t.Run("移动到范围外部门", func(t *testing.T) {
+		_, err := mutateWithScope(ctx, &scopeMutation{op: ent.OpUpdateOne, deptID: deptID("d9")})
+
+		assert.True(t, errors.Is(err, auth.ErrDataScopeDenied))
+	})
+
+	t.Run("清空部门", func(t *testing.T) {
+		_, err := mutateWithScope(ctx, &scopeMutation{op: ent.OpUpdate, deptCleared: true})
+
+		assert.True(t, errors.Is(err, auth.ErrDataScopeDenied))
+	})
+
+	t.Run("全部数据不限制", func(t *testing.T) {
+		m := &scopeMutation{op: ent.OpCreate, deptID: deptID("d9")}
+		called, err := mutateWithScope(withScope(&auth.DataScope{All: true}), m)
+
+		assert.NoError(t, err)
+		assert.True(t, called)
+	})
+}
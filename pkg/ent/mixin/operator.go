@@ -2,8 +2,11 @@ package mixin
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"qn-base/pkg/auth"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/schema/field"
@@ -23,6 +26,27 @@ func (CreateBy) Fields() []ent.Field {
 	}
 }
 
+// Hooks of the CreateBy.
+func (CreateBy) Hooks() []ent.Hook {
+	return []ent.Hook{
+		auditHook(ent.OpCreate, func(ctx context.Context, m ent.Mutation) {
+			mut, ok := m.(interface {
+				CreateBy() (string, bool)
+				SetCreateBy(string)
+			})
+			if !ok {
+				return
+			}
+			if _, exists := mut.CreateBy(); exists {
+				return
+			}
+			if userID := auth.UserID(ctx); userID != "" {
+				mut.SetCreateBy(userID)
+			}
+		}),
+	}
+}
+
 var _ ent.Mixin = (*UpdateBy)(nil)
 
 type UpdateBy struct{ mixin.Schema }
@@ -36,6 +60,27 @@ func (UpdateBy) Fields() []ent.Field {
 	}
 }
 
+// Hooks of the UpdateBy.
+func (UpdateBy) Hooks() []ent.Hook {
+	return []ent.Hook{
+		auditHook(ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne, func(ctx context.Context, m ent.Mutation) {
+			mut, ok := m.(interface {
+				UpdateBy() (string, bool)
+				SetUpdateBy(string)
+			})
+			if !ok {
+				return
+			}
+			if _, exists := mut.UpdateBy(); exists {
+				return
+			}
+			if userID := auth.UserID(ctx); userID != "" {
+				mut.SetUpdateBy(userID)
+			}
+		}),
+	}
+}
+
 var _ ent.Mixin = (*CreateAt)(nil)
 
 type CreateAt struct{ mixin.Schema }
@@ -51,6 +96,24 @@ func (CreateAt) Fields() []ent.Field {
 	}
 }
 
+// Hooks of the CreateAt.
+func (CreateAt) Hooks() []ent.Hook {
+	return []ent.Hook{
+		auditHook(ent.OpCreate, func(_ context.Context, m ent.Mutation) {
+			mut, ok := m.(interface {
+				CreatedAt() (time.Time, bool)
+				SetCreatedAt(time.Time)
+			})
+			if !ok {
+				return
+			}
+			if _, exists := mut.CreatedAt(); !exists {
+				mut.SetCreatedAt(time.Now())
+			}
+		}),
+	}
+}
+
 var _ ent.Mixin = (*UpdateAt)(nil)
 
 type UpdateAt struct{ mixin.Schema }
@@ -65,6 +128,44 @@ func (UpdateAt) Fields() []ent.Field {
 	}
 }
 
+// Hooks of the UpdateAt.
+func (UpdateAt) Hooks() []ent.Hook {
+	return []ent.Hook{
+		auditHook(ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne, func(_ context.Context, m ent.Mutation) {
+			mut, ok := m.(interface {
+				UpdatedAt() (time.Time, bool)
+				SetUpdatedAt(time.Time)
+			})
+			if !ok {
+				return
+			}
+			if _, exists := mut.UpdatedAt(); !exists {
+				mut.SetUpdatedAt(time.Now())
+			}
+		}),
+	}
+}
+
+type skipAuditKey struct{}
+
+// SkipAudit returns a new context that skips filling the audit fields,
+// e.g. for system jobs that set them explicitly or must not touch them.
+func SkipAudit(parent context.Context) context.Context {
+	return context.WithValue(parent, skipAuditKey{}, true)
+}
+
+// auditHook returns a hook that calls fill before the mutations of the given ops.
+func auditHook(op ent.Op, fill func(context.Context, ent.Mutation)) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if skip, _ := ctx.Value(skipAuditKey{}).(bool); !skip && m.Op().Is(op) {
+				fill(ctx, m)
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
 var _ ent.Mixin = (*DeletedAt)(nil)
 
 type DeletedAt struct{ mixin.Schema }
@@ -94,10 +195,12 @@ func (d DeletedAt) Interceptors() []ent.Interceptor {
 			if skip, _ := ctx.Value(softDeleteKey{}).(bool); skip {
 				return nil
 			}
-			// Add filter for non-deleted entities
-			if w, ok := q.(interface{ Where(...interface{}) }); ok {
-				w.Where(sql.FieldIsNull("deleted_at"))
+			w, ok := q.(interface{ WhereP(...func(*sql.Selector)) })
+			if !ok {
+				return fmt.Errorf("soft delete: unexpected query type %T", q)
 			}
+			// Add filter for non-deleted entities
+			w.WhereP(sql.FieldIsNull("deleted_at"))
 			return nil
 		}),
 	}
@@ -119,14 +222,21 @@ func (d DeletedAt) Hooks() []ent.Hook {
 					return next.Mutate(ctx, m)
 				}
 
-				// Convert delete to update by setting deleted_at
-				if setter, ok := m.(interface{ SetDeletedAt(time.Time) }); ok {
-					// Note: In practice, you would need to create a proper update mutation
-					// This is a simplified version for demonstration
-					setter.SetDeletedAt(time.Now())
+				mx, ok := m.(interface {
+					SetOp(ent.Op)
+					SetDeletedAt(time.Time)
+					WhereP(...func(*sql.Selector))
+					Mutate(context.Context) (ent.Value, error)
+				})
+				if !ok {
+					return nil, fmt.Errorf("soft delete: unexpected mutation type %T", m)
 				}
 
-				return next.Mutate(ctx, m)
+				// Convert delete to update by setting deleted_at, already deleted entities are not touched
+				mx.WhereP(sql.FieldIsNull("deleted_at"))
+				mx.SetOp(ent.OpUpdate)
+				mx.SetDeletedAt(time.Now())
+				return mx.Mutate(ctx)
 			})
 		},
 	}
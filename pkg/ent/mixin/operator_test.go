@@ -0,0 +1,130 @@
+package mixin_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"qn-base/pkg/auth"
+	"qn-base/pkg/ent/mixin"
+
+	"entgo.io/ent"
+	"github.com/stretchr/testify/assert"
+)
+
+// auditMutation 仅实现审计字段相关方法的 mutation
+type auditMutation struct {
+	ent.Mutation
+
+	op        ent.Op
+	createBy  *string
+	updateBy  *string
+	createdAt *time.Time
+	updatedAt *time.Time
+}
+
+func (m *auditMutation) Op() ent.Op { return m.op }
+
+func (m *auditMutation) SetCreateBy(s string) { m.createBy = &s }
+
+func (m *auditMutation) CreateBy() (string, bool) {
+	if m.createBy == nil {
+		return "", false
+	}
+	return *m.createBy, true
+}
+
+func (m *auditMutation) SetUpdateBy(s string) { m.updateBy = &s }
+
+func (m *auditMutation) UpdateBy() (string, bool) {
+	if m.updateBy == nil {
+		return "", false
+	}
+	return *m.updateBy, true
+}
+
+func (m *auditMutation) SetCreatedAt(t time.Time) { m.createdAt = &t }
+
+func (m *auditMutation) CreatedAt() (time.Time, bool) {
+	if m.createdAt == nil {
+		return time.Time{}, false
+	}
+	return *m.createdAt, true
+}
+
+func (m *auditMutation) SetUpdatedAt(t time.Time) { m.updatedAt = &t }
+
+func (m *auditMutation) UpdatedAt() (time.Time, bool) {
+	if m.updatedAt == nil {
+		return time.Time{}, false
+	}
+	return *m.updatedAt, true
+}
+
+// mutate 依次执行所有审计 mixin 的 hooks
+func mutate(ctx context.Context, m ent.Mutation) {
+	var mutator ent.Mutator = ent.MutateFunc(func(context.Context, ent.Mutation) (ent.Value, error) {
+		return nil, nil
+	})
+	var hooks []ent.Hook
+	hooks = append(hooks, mixin.CreateBy{}.Hooks()...)
+	hooks = append(hooks, mixin.CreateAt{}.Hooks()...)
+	hooks = append(hooks, mixin.UpdateBy{}.Hooks()...)
+	hooks = append(hooks, mixin.UpdateAt{}.Hooks()...)
+	for i := len(hooks) - 1; i >= 0; i-- {
+		mutator = hooks[i](mutator)
+	}
+	_, _ = mutator.Mutate(ctx, m)
+}
+
+func TestAuditHooks(t *testing.T) {
+	ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "user123"})
+
+	t.Run("创建", func(t *testing.T) {
+		m := &auditMutation{op: ent.OpCreate}
+		mutate(ctx, m)
+
+		assert.Equal(t, "user123", *m.createBy)
+		assert.Equal(t, "user123", *m.updateBy)
+		assert.NotNil(t, m.createdAt)
+		assert.NotNil(t, m.updatedAt)
+	})
+
+	t.Run("更新", func(t *testing.T) {
+		m := &auditMutation{op: ent.OpUpdateOne}
+		mutate(ctx, m)
+
+		assert.Nil(t, m.createBy)
+		assert.Nil(t, m.createdAt)
+		assert.Equal(t, "user123", *m.updateBy)
+		assert.NotNil(t, m.updatedAt)
+	})
+
+	t.Run("保留显式设置的值", func(t *testing.T) {
+		createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		m := &auditMutation{op: ent.OpCreate}
+		m.SetCreateBy("other")
+		m.SetCreatedAt(createdAt)
+		mutate(ctx, m)
+
+		assert.Equal(t, "other", *m.createBy)
+		assert.Equal(t, createdAt, *m.createdAt)
+	})
+
+	t.Run("未登录", func(t *testing.T) {
+		m := &auditMutation{op: ent.OpCreate}
+		mutate(context.Background(), m)
+
+		assert.Nil(t, m.createBy)
+		assert.NotNil(t, m.createdAt)
+	})
+
+	t.Run("跳过审计字段", func(t *testing.T) {
+		m := &auditMutation{op: ent.OpCreate}
+		mutate(mixin.SkipAudit(ctx), m)
+
+		assert.Nil(t, m.createBy)
+		assert.Nil(t, m.createdAt)
+		assert.Nil(t, m.updatedAt)
+	})
+}
@@ -1,12 +1,53 @@
 package mixin
 
 import (
+	"context"
+	"fmt"
+	"sync"
+
+	"qn-base/pkg/util/idgen"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 )
 
+var (
+	idGeneratorMu sync.RWMutex
+	idGenerator   idgen.Generator
+)
+
+// SetIDGenerator sets the generator assigning the ids of new entities.
+// 未设置时使用机器码为 0 的雪花算法，集群部署时需设置租用了机器码的生成器
+func SetIDGenerator(g idgen.Generator) {
+	idGeneratorMu.Lock()
+	defer idGeneratorMu.Unlock()
+	idGenerator = g
+}
+
+// currentIDGenerator returns the generator set by SetIDGenerator, or the default snowflake.
+func currentIDGenerator() (idgen.Generator, error) {
+	idGeneratorMu.RLock()
+	g := idGenerator
+	idGeneratorMu.RUnlock()
+	if g != nil {
+		return g, nil
+	}
+
+	idGeneratorMu.Lock()
+	defer idGeneratorMu.Unlock()
+	if idGenerator == nil {
+		sf, err := idgen.NewSnowflake(idgen.Options{})
+		if err != nil {
+			return nil, err
+		}
+		idGenerator = sf
+	}
+	return idGenerator, nil
+}
+
+// StringId is a string primary key, assigned by the id generator on create when not set.
 type StringId struct {
 	mixin.Schema
 }
@@ -27,3 +68,36 @@ func (StringId) Indexes() []ent.Index {
 		index.Fields("id"),
 	}
 }
+
+// Hooks of the StringId.
+func (StringId) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				if !m.Op().Is(ent.OpCreate) {
+					return next.Mutate(ctx, m)
+				}
+				im, ok := m.(interface {
+					ID() (string, bool)
+					SetID(string)
+				})
+				if !ok {
+					return nil, fmt.Errorf("string id: unexpected mutation type %T", m)
+				}
+				if id, exists := im.ID(); exists && id != "" {
+					return next.Mutate(ctx, m)
+				}
+				g, err := currentIDGenerator()
+				if err != nil {
+					return nil, err
+				}
+				id, err := g.NextStringID()
+				if err != nil {
+					return nil, err
+				}
+				im.SetID(id)
+				return next.Mutate(ctx, m)
+			})
+		},
+	}
+}
@@ -0,0 +1,119 @@
+package mixin_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"qn-base/pkg/ent/mixin"
+
+	"entgo.io/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// idMutation 仅实现 ID 相关方法的 mutation
+type idMutation struct {
+	ent.Mutation
+
+	op ent.Op
+	id *string
+}
+
+func (m *idMutation) Op() ent.Op { return m.op }
+
+func (m *idMutation) SetID(id string) { m.id = &id }
+
+func (m *idMutation) ID() (string, bool) {
+	if m.id == nil {
+		return "", false
+	}
+	return *m.id, true
+}
+
+// fixedGenerator 依次返回固定的 ID
+type fixedGenerator struct {
+	ids []string
+	err error
+}
+
+func (g *fixedGenerator) NextStringID() (string, error) {
+	if g.err != nil {
+		return "", g.err
+	}
+	id := g.ids[0]
+	g.ids = g.ids[1:]
+	return id, nil
+}
+
+func mutateID(m ent.Mutation) error {
+	mutator := mixin.StringId{}.Hooks()[0](ent.MutateFunc(func(context.Context, ent.Mutation) (ent.Value, error) {
+		return nil, nil
+	}))
+	_, err := mutator.Mutate(context.Background(), m)
+	return err
+}
+
+func TestStringIdHook(t *testing.T) {
+	t.Cleanup(func() { mixin.SetIDGenerator(nil) })
+
+	t.Run("默认使用雪花算法生成十进制ID", func(t *testing.T) {
+		mixin.SetIDGenerator(nil)
+		m := &idMutation{op: ent.OpCreate}
+
+		// 执行测试
+		require.NoError(t, mutateID(m))
+
+		// 断言
+		id, ok := m.ID()
+		assert.True(t, ok)
+		assert.Regexp(t, `^[1-9][0-9]+$`, id)
+	})
+
+	t.Run("使用设置的生成器", func(t *testing.T) {
+		mixin.SetIDGenerator(&fixedGenerator{ids: []string{"a1"}})
+		m := &idMutation{op: ent.OpCreate}
+
+		// 执行测试
+		require.NoError(t, mutateID(m))
+
+		// 断言
+		id, _ := m.ID()
+		assert.Equal(t, "a1", id)
+	})
+
+	t.Run("保留已设置的ID", func(t *testing.T) {
+		mixin.SetIDGenerator(&fixedGenerator{err: errors.New("unused")})
+		id := "given"
+		m := &idMutation{op: ent.OpCreate, id: &id}
+
+		// 执行测试
+		require.NoError(t, mutateID(m))
+
+		// 断言
+		got, _ := m.ID()
+		assert.Equal(t, "given", got)
+	})
+
+	t.Run("更新时不生成ID", func(t *testing.T) {
+		mixin.SetIDGenerator(&fixedGenerator{err: errors.New("unused")})
+		m := &idMutation{op: ent.OpUpdateOne}
+
+		// 执行测试
+		require.NoError(t, mutateID(m))
+
+		// 断言
+		_, ok := m.ID()
+		assert.False(t, ok)
+	})
+
+	t.Run("生成失败", func(t *testing.T) {
+		mixin.SetIDGenerator(&fixedGenerator{err: errors.New("boom")})
+
+		// 执行测试
+		err := mutateID(&idMutation{op: ent.OpCreate})
+
+		// 断言
+		assert.EqualError(t, err, "boom")
+	})
+}
@@ -1,7 +1,13 @@
 package mixin
 
 import (
+	"context"
+	"fmt"
+
+	"qn-base/pkg/auth"
+
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
@@ -9,6 +15,9 @@ import (
 
 var _ ent.Mixin = (*TenantID)(nil)
 
+// TenantID limits the queries and mutations of the entity to the tenant of the principal,
+// and stamps the tenant on creates. auth.CrossTenant lifts the limit.
+// 需放在 DeletedAt 之前，否则软删除转换后的更新不受限制
 type TenantID struct{ mixin.Schema }
 
 func (TenantID) Fields() []ent.Field {
@@ -16,7 +25,6 @@ func (TenantID) Fields() []ent.Field {
 		field.String("tenant_id").
 			Comment("租户id").
 			NotEmpty().
-			Unique().
 			Immutable(),
 	}
 }
@@ -27,3 +35,71 @@ func (TenantID) Indexes() []ent.Index {
 		index.Fields("tenant_id"),
 	}
 }
+
+// Interceptors of the TenantID.
+func (TenantID) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		ent.TraverseFunc(func(ctx context.Context, q ent.Query) error {
+			if auth.IsCrossTenant(ctx) {
+				return nil
+			}
+			tenantID := auth.TenantID(ctx)
+			if tenantID == "" {
+				return auth.ErrTenantRequired
+			}
+			w, ok := q.(interface{ WhereP(...func(*sql.Selector)) })
+			if !ok {
+				return fmt.Errorf("tenant: unexpected query type %T", q)
+			}
+			w.WhereP(sql.FieldEQ("tenant_id", tenantID))
+			return nil
+		}),
+	}
+}
+
+// Hooks of the TenantID.
+func (TenantID) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				cross := auth.IsCrossTenant(ctx)
+				tenantID := auth.TenantID(ctx)
+				if !cross && tenantID == "" {
+					return nil, auth.ErrTenantRequired
+				}
+				if m.Op().Is(ent.OpCreate) {
+					if err := setTenantID(m, tenantID, cross); err != nil {
+						return nil, err
+					}
+					return next.Mutate(ctx, m)
+				}
+				if cross {
+					return next.Mutate(ctx, m)
+				}
+				w, ok := m.(interface{ WhereP(...func(*sql.Selector)) })
+				if !ok {
+					return nil, fmt.Errorf("tenant: unexpected mutation type %T", m)
+				}
+				// 其他租户的数据不会被更新或删除
+				w.WhereP(sql.FieldEQ("tenant_id", tenantID))
+				return next.Mutate(ctx, m)
+			})
+		},
+	}
+}
+
+// setTenantID stamps the tenant of the principal on the created entity,
+// an explicit tenant must be the tenant of the principal unless ctx is cross-tenant.
+func setTenantID(m ent.Mutation, tenantID string, cross bool) error {
+	v, exists := m.Field("tenant_id")
+	if !exists {
+		if tenantID == "" {
+			return auth.ErrTenantRequired
+		}
+		return m.SetField("tenant_id", tenantID)
+	}
+	if explicit, _ := v.(string); !cross && explicit != tenantID {
+		return auth.ErrTenantDenied
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package idgen
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+)
+
+// Generator kinds.
+const (
+	KindSnowflake = "snowflake"
+	KindULID      = "ulid"
+	KindUUIDv7    = "uuidv7"
+)
+
+// Generator generates string IDs.
+type Generator interface {
+	NextStringID() (string, error)
+}
+
+var (
+	_ Generator = (*Snowflake)(nil)
+	_ Generator = (*ULID)(nil)
+	_ Generator = UUIDv7{}
+)
+
+// NextStringID generates the next ID as a decimal string.
+func (s *Snowflake) NextStringID() (string, error) {
+	id, err := s.NextID()
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(id, 10), nil
+}
+
+// ULID generates monotonic ULIDs, e.g. 01JA2X5Q3K7ZC9T6W8V4Y1M0BN.
+type ULID struct {
+	mu      sync.Mutex
+	entropy *ulid.MonotonicEntropy
+}
+
+// NewULID creates a ULID generator.
+func NewULID() *ULID {
+	return &ULID{entropy: ulid.Monotonic(rand.Reader, 0)}
+}
+
+// NextStringID generates the next ULID.
+func (g *ULID) NextStringID() (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	id, err := ulid.New(ulid.Timestamp(time.Now()), g.entropy)
+	if err != nil {
+		return "", fmt.Errorf("idgen: generate ulid: %w", err)
+	}
+	return id.String(), nil
+}
+
+// UUIDv7 generates time ordered UUIDs (RFC 9562) without hyphens, fitting varchar(32).
+type UUIDv7 struct{}
+
+// NextStringID generates the next UUIDv7.
+func (UUIDv7) NextStringID() (string, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", fmt.Errorf("idgen: generate uuidv7: %w", err)
+	}
+	return hex.EncodeToString(id[:]), nil
+}
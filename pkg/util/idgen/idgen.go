@@ -1,50 +1,189 @@
 package idgen
 
 import (
+	"errors"
 	"fmt"
 	"sync"
-
-	"github.com/sony/sonyflake"
+	"time"
 )
 
-var (
-	once     sync.Once
-	instance *sonyflake.Sonyflake
+const (
+	// DefaultBaseTime is 2025-01-01 00:00:00 +08:00 in milliseconds.
+	DefaultBaseTime int64 = 1735660800000
+	// DefaultWorkerIDBitLength is the default bit length of the worker ID.
+	DefaultWorkerIDBitLength uint8 = 6
+	// DefaultSeqBitLength is the default bit length of the sequence number.
+	DefaultSeqBitLength uint8 = 6
+	// DefaultMinSeqNumber is the default minimum sequence number,
+	// 0 is reserved for manual IDs and 1-4 for clock rollback.
+	DefaultMinSeqNumber uint32 = 5
+
+	// maxTurnBackIndex is the last sequence number reserved for clock rollback.
+	maxTurnBackIndex uint32 = 4
 )
 
-// Init initializes the ID generator with the given settings
-func Init(settings sonyflake.Settings) {
-	once.Do(func() {
-		instance = sonyflake.NewSonyflake(settings)
-		if instance == nil {
-			panic("failed to initialize sonyflake")
-		}
-	})
+// ErrTimestampOverflow is returned when the timestamp no longer fits in the ID.
+var ErrTimestampOverflow = errors.New("idgen: timestamp overflow")
+
+// Options configures a Snowflake, the zero values take the defaults.
+type Options struct {
+	// BaseTime 基础时间（ms），不能超过当前系统时间
+	BaseTime int64
+	// WorkerID 机器码，取值范围 [0, 2^WorkerIDBitLength-1]
+	WorkerID uint16
+	// WorkerIDBitLength 机器码位长，取值范围 [1, 15]
+	WorkerIDBitLength uint8
+	// SeqBitLength 序列数位长，取值范围 [3, 21]，与机器码位长之和不超过 22
+	SeqBitLength uint8
+	// MaxSeqNumber 最大序列数（含），0 表示 2^SeqBitLength-1
+	MaxSeqNumber uint32
+	// MinSeqNumber 最小序列数（含），不小于 5
+	MinSeqNumber uint32
 }
 
-// GetInstance returns the singleton instance of the ID generator
-func GetInstance() *sonyflake.Sonyflake {
-	if instance == nil {
-		// Initialize with default settings if not initialized
-		Init(sonyflake.Settings{})
+// Snowflake generates IDs laid out as timestamp | worker ID | sequence number.
+//
+// 时间回拨时不等待，而是从回拨前的上一毫秒开始逐毫秒向前，
+// 使用每毫秒保留的序列数 1-4 生成 ID，正常生成的 ID 序列数不小于 MinSeqNumber，因此不会重复；
+// 连续回拨超过 4 次后保留序列数循环使用
+type Snowflake struct {
+	baseTime       int64
+	workerIDBits   uint8
+	seqBits        uint8
+	maxSeq         uint32
+	minSeq         uint32
+	timestampShift uint8
+	maxTimestamp   int64
+	now            func() time.Time
+
+	mu            sync.Mutex
+	workerID      int64
+	lastTick      int64
+	seq           uint32
+	turnBackTick  int64
+	turnBackIndex uint32
+}
+
+// NewSnowflake creates a Snowflake, and validates the options.
+func NewSnowflake(o Options) (*Snowflake, error) {
+	if o.BaseTime == 0 {
+		o.BaseTime = DefaultBaseTime
+	}
+	if o.WorkerIDBitLength == 0 {
+		o.WorkerIDBitLength = DefaultWorkerIDBitLength
+	}
+	if o.SeqBitLength == 0 {
+		o.SeqBitLength = DefaultSeqBitLength
+	}
+	if o.MinSeqNumber == 0 {
+		o.MinSeqNumber = DefaultMinSeqNumber
+	}
+
+	if o.BaseTime > time.Now().UnixMilli() {
+		return nil, fmt.Errorf("idgen: base time %d is later than now", o.BaseTime)
+	}
+	if o.WorkerIDBitLength > 15 {
+		return nil, fmt.Errorf("idgen: worker id bit length %d out of [1, 15]", o.WorkerIDBitLength)
+	}
+	if o.SeqBitLength < 3 || o.SeqBitLength > 21 {
+		return nil, fmt.Errorf("idgen: seq bit length %d out of [3, 21]", o.SeqBitLength)
+	}
+	if o.WorkerIDBitLength+o.SeqBitLength > 22 {
+		return nil, fmt.Errorf("idgen: worker id bit length + seq bit length %d exceeds 22", o.WorkerIDBitLength+o.SeqBitLength)
+	}
+	maxSeq := uint32(1)<<o.SeqBitLength - 1
+	if o.MaxSeqNumber == 0 {
+		o.MaxSeqNumber = maxSeq
+	}
+	if o.MaxSeqNumber > maxSeq {
+		return nil, fmt.Errorf("idgen: max seq number %d exceeds %d", o.MaxSeqNumber, maxSeq)
+	}
+	if o.MinSeqNumber < DefaultMinSeqNumber || o.MinSeqNumber > o.MaxSeqNumber {
+		return nil, fmt.Errorf("idgen: min seq number %d out of [%d, %d]", o.MinSeqNumber, DefaultMinSeqNumber, o.MaxSeqNumber)
+	}
+
+	s := &Snowflake{
+		baseTime:       o.BaseTime,
+		workerIDBits:   o.WorkerIDBitLength,
+		seqBits:        o.SeqBitLength,
+		maxSeq:         o.MaxSeqNumber,
+		minSeq:         o.MinSeqNumber,
+		timestampShift: o.WorkerIDBitLength + o.SeqBitLength,
+		maxTimestamp:   int64(1)<<(63-o.WorkerIDBitLength-o.SeqBitLength) - 1,
+		now:            time.Now,
+		seq:            o.MinSeqNumber,
 	}
-	return instance
+	if err := s.SetWorkerID(o.WorkerID); err != nil {
+		return nil, err
+	}
+	return s, nil
 }
 
-// NextID generates and returns the next unique ID
-func NextID() (uint64, error) {
-	sf := GetInstance()
-	if sf == nil {
-		return 0, fmt.Errorf("id generator not initialized")
+// MaxWorkerID returns the largest worker ID allowed by the worker ID bit length.
+func (s *Snowflake) MaxWorkerID() uint16 {
+	return uint16(1)<<s.workerIDBits - 1
+}
+
+// SetWorkerID changes the worker ID, e.g. after the lease of the worker ID was lost.
+func (s *Snowflake) SetWorkerID(workerID uint16) error {
+	if workerID > s.MaxWorkerID() {
+		return fmt.Errorf("idgen: worker id %d exceeds %d", workerID, s.MaxWorkerID())
 	}
-	return sf.NextID()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.workerID = int64(workerID)
+	return nil
 }
 
-// NextStringID generates and returns the next unique ID as a string
-func NextStringID() (string, error) {
-	id, err := NextID()
-	if err != nil {
-		return "", err
+// NextID generates the next ID.
+func (s *Snowflake) NextID() (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tick := s.currentTick()
+	if tick < s.lastTick {
+		// 时间回拨
+		if s.turnBackTick < 1 {
+			s.turnBackTick = s.lastTick - 1
+			s.turnBackIndex++
+			if s.turnBackIndex > maxTurnBackIndex {
+				s.turnBackIndex = 1
+			}
+		}
+		id := s.calc(s.turnBackTick, s.turnBackIndex)
+		s.turnBackTick--
+		return id, nil
 	}
-	return fmt.Sprintf("%d", id), nil
+	// 时间已追上，结束回拨
+	s.turnBackTick = 0
+
+	if tick > s.lastTick {
+		s.lastTick = tick
+		s.seq = s.minSeq
+	} else if s.seq > s.maxSeq {
+		// 当前毫秒的序列数已用完，等待下一毫秒
+		for tick <= s.lastTick {
+			time.Sleep(100 * time.Microsecond)
+			tick = s.currentTick()
+		}
+		s.lastTick = tick
+		s.seq = s.minSeq
+	}
+	if s.lastTick > s.maxTimestamp {
+		return 0, ErrTimestampOverflow
+	}
+
+	id := s.calc(s.lastTick, s.seq)
+	s.seq++
+	return id, nil
+}
+
+// calc lays out the ID.
+func (s *Snowflake) calc(tick int64, seq uint32) int64 {
+	return tick<<s.timestampShift | s.workerID<<s.seqBits | int64(seq)
+}
+
+// currentTick returns the milliseconds since the base time.
+func (s *Snowflake) currentTick() int64 {
+	return s.now().UnixMilli() - s.baseTime
 }
@@ -0,0 +1,156 @@
+package idgen
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock 可手动调整的时钟
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time { return c.t }
+
+func newTestSnowflake(t *testing.T, o Options) (*Snowflake, *fakeClock) {
+	s, err := NewSnowflake(o)
+	require.NoError(t, err)
+	clock := &fakeClock{t: time.UnixMilli(DefaultBaseTime + 1000)}
+	s.now = clock.now
+	return s, clock
+}
+
+// split 拆分 ID 为时间戳、机器码和序列数
+func split(s *Snowflake, id int64) (int64, int64, uint32) {
+	return id >> s.timestampShift, id >> s.seqBits & int64(s.MaxWorkerID()), uint32(id & (1<<s.seqBits - 1))
+}
+
+func TestNewSnowflake(t *testing.T) {
+	t.Run("默认配置", func(t *testing.T) {
+		s, err := NewSnowflake(Options{})
+		assert.NoError(t, err)
+		assert.Equal(t, uint16(63), s.MaxWorkerID())
+		assert.Equal(t, uint32(63), s.maxSeq)
+	})
+
+	t.Run("无效配置", func(t *testing.T) {
+		for name, o := range map[string]Options{
+			"基础时间晚于当前时间": {BaseTime: time.Now().Add(time.Hour).UnixMilli()},
+			"位长之和超过22":   {WorkerIDBitLength: 12, SeqBitLength: 12},
+			"序列数位长过小":    {SeqBitLength: 2},
+			"最小序列数占用保留位": {MinSeqNumber: 3},
+			"最大序列数超出位长":  {SeqBitLength: 4, MaxSeqNumber: 16},
+			"机器码超出位长":    {WorkerIDBitLength: 2, WorkerID: 4},
+		} {
+			_, err := NewSnowflake(o)
+			assert.Error(t, err, name)
+		}
+	})
+}
+
+func TestSnowflake_NextID(t *testing.T) {
+	t.Run("ID 布局", func(t *testing.T) {
+		s, _ := newTestSnowflake(t, Options{WorkerID: 9})
+
+		id, err := s.NextID()
+		assert.NoError(t, err)
+		tick, workerID, seq := split(s, id)
+		assert.Equal(t, int64(1000), tick)
+		assert.Equal(t, int64(9), workerID)
+		assert.Equal(t, DefaultMinSeqNumber, seq)
+	})
+
+	t.Run("序列数用完后等待下一毫秒", func(t *testing.T) {
+		s, err := NewSnowflake(Options{SeqBitLength: 3})
+		require.NoError(t, err)
+
+		seen := make(map[int64]struct{})
+		for i := 0; i < 100; i++ {
+			id, err := s.NextID()
+			assert.NoError(t, err)
+			_, _, seq := split(s, id)
+			assert.GreaterOrEqual(t, seq, DefaultMinSeqNumber)
+			seen[id] = struct{}{}
+		}
+		assert.Len(t, seen, 100)
+	})
+
+	t.Run("时间回拨使用保留序列数", func(t *testing.T) {
+		s, clock := newTestSnowflake(t, Options{})
+
+		seen := make(map[int64]struct{})
+		next := func() int64 {
+			id, err := s.NextID()
+			assert.NoError(t, err)
+			_, ok := seen[id]
+			assert.False(t, ok, "duplicate id %d", id)
+			seen[id] = struct{}{}
+			return id
+		}
+		for i := 0; i < 10; i++ {
+			next()
+			clock.t = clock.t.Add(time.Millisecond)
+		}
+
+		// 回拨 5 毫秒
+		clock.t = clock.t.Add(-5 * time.Millisecond)
+		first := next()
+		second := next()
+		tick, _, seq := split(s, first)
+		assert.Equal(t, int64(1008), tick)
+		assert.Equal(t, uint32(1), seq)
+		tick, _, seq = split(s, second)
+		assert.Equal(t, int64(1007), tick)
+		assert.Equal(t, uint32(1), seq)
+
+		// 时间追上后恢复正常，再次回拨使用下一个保留序列数
+		clock.t = clock.t.Add(10 * time.Millisecond)
+		_, _, seq = split(s, next())
+		assert.Equal(t, DefaultMinSeqNumber, seq)
+		clock.t = clock.t.Add(-time.Millisecond)
+		_, _, seq = split(s, next())
+		assert.Equal(t, uint32(2), seq)
+	})
+
+	t.Run("修改机器码", func(t *testing.T) {
+		s, _ := newTestSnowflake(t, Options{})
+
+		assert.NoError(t, s.SetWorkerID(3))
+		id, err := s.NextID()
+		assert.NoError(t, err)
+		_, workerID, _ := split(s, id)
+		assert.Equal(t, int64(3), workerID)
+		assert.Error(t, s.SetWorkerID(64))
+	})
+}
+
+func TestGenerators(t *testing.T) {
+	t.Run("ULID 单调递增", func(t *testing.T) {
+		g := NewULID()
+		prev := ""
+		for i := 0; i < 100; i++ {
+			id, err := g.NextStringID()
+			require.NoError(t, err)
+			assert.Len(t, id, 26)
+			assert.Greater(t, id, prev)
+			prev = id
+		}
+	})
+
+	t.Run("UUIDv7 为32位十六进制", func(t *testing.T) {
+		id, err := UUIDv7{}.NextStringID()
+		require.NoError(t, err)
+		assert.Regexp(t, `^[0-9a-f]{12}7[0-9a-f]{19}$`, id)
+	})
+
+	t.Run("雪花算法十进制字符串", func(t *testing.T) {
+		sf, err := NewSnowflake(Options{})
+		require.NoError(t, err)
+		id, err := sf.NextStringID()
+		require.NoError(t, err)
+		assert.Regexp(t, `^[1-9][0-9]+$`, id)
+	})
+}
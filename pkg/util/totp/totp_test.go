@@ -0,0 +1,82 @@
+package totp
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret RFC 6238 附录 B 中 SHA1 的测试密钥
+var rfcSecret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestCode(t *testing.T) {
+	t.Run("RFC 6238 测试向量", func(t *testing.T) {
+		// 附录 B 为 8 位验证码，6 位验证码取其后 6 位
+		for unix, want := range map[int64]string{
+			59:         "287082",
+			1111111109: "081804",
+			1111111111: "050471",
+			1234567890: "005924",
+			2000000000: "279037",
+		} {
+			code, err := Code(rfcSecret, Step(time.Unix(unix, 0)))
+			require.NoError(t, err)
+			assert.Equal(t, want, code, unix)
+		}
+	})
+
+	t.Run("无效密钥", func(t *testing.T) {
+		_, err := Code("not-base32!", 1)
+		assert.Error(t, err)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	now := time.Unix(1700000000, 0)
+
+	t.Run("容许前后一个时间步", func(t *testing.T) {
+		for _, offset := range []int64{-1, 0, 1} {
+			code, err := Code(secret, Step(now)+offset)
+			require.NoError(t, err)
+
+			// 执行测试
+			step, ok := Validate(secret, code, now)
+
+			// 断言
+			assert.True(t, ok)
+			assert.Equal(t, Step(now)+offset, step)
+		}
+	})
+
+	t.Run("超出时间偏差", func(t *testing.T) {
+		code, err := Code(secret, Step(now)+2)
+		require.NoError(t, err)
+
+		_, ok := Validate(secret, code, now)
+		assert.False(t, ok)
+	})
+
+	t.Run("验证码格式错误", func(t *testing.T) {
+		_, ok := Validate(secret, "12345", now)
+		assert.False(t, ok)
+	})
+}
+
+func TestURI(t *testing.T) {
+	u, err := url.Parse(URI("qn-base", "admin", "JBSWY3DPEHPK3PXP"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, "/qn-base:admin", u.Path)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", u.Query().Get("secret"))
+	assert.Equal(t, "qn-base", u.Query().Get("issuer"))
+	assert.Equal(t, "6", u.Query().Get("digits"))
+	assert.Equal(t, "30", u.Query().Get("period"))
+}
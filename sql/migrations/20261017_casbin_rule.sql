@@ -0,0 +1,20 @@
+-- ----------------------------
+-- casbin 规则表，一行对应一条规则，v0-v5 依次为规则的各个字段，仅启用 casbin 时使用
+-- 规则字段为用户、角色、租户编号和接口路径，使用 ascii 字符集使唯一索引不超过 3072 字节，区分大小写
+-- ----------------------------
+CREATE TABLE IF NOT EXISTS t_system_casbin_rule
+(
+    id         varchar(32) comment '编号'
+        primary key,
+    ptype      varchar(16)                            not null comment '规则类型，p 或 g',
+    v0         varchar(128) default ''                not null comment '规则字段0',
+    v1         varchar(128) default ''                not null comment '规则字段1',
+    v2         varchar(256) default ''                not null comment '规则字段2',
+    v3         varchar(128) default ''                not null comment '规则字段3',
+    v4         varchar(128) default ''                not null comment '规则字段4',
+    v5         varchar(128) default ''                not null comment '规则字段5',
+    create_by  varchar(64)  default ''                null comment '创建者',
+    created_at datetime     default CURRENT_TIMESTAMP null comment '创建时间',
+    unique index systemcasbinrule_ptype_v0_v1_v2_v3_v4_v5 (ptype, v0, v1, v2, v3, v4, v5)
+)
+    comment 'casbin 规则表' charset = ascii collate = ascii_bin;
@@ -0,0 +1,22 @@
+-- ----------------------------
+-- 登录日志表，记录每次登录尝试和登出，过期数据由定时任务按保留期清理
+-- ----------------------------
+CREATE TABLE IF NOT EXISTS t_system_login_log
+(
+    id         varchar(32) comment '日志ID'
+        primary key,
+    event      varchar(32)  default 'LOGIN'           not null comment '事件类型（LOGIN登录 LOGOUT登出）',
+    user_id    varchar(32)                            null comment '用户ID，账号不存在时为空',
+    account    varchar(64)                            not null comment '登录账号',
+    status     tinyint                                not null comment '结果（0失败 1成功）',
+    reason     varchar(64)                            null comment '失败原因',
+    ip         varchar(64)                            null comment '登录IP',
+    user_agent varchar(512)                           null comment '浏览器 User-Agent',
+    created_at datetime     default CURRENT_TIMESTAMP null comment '登录时间',
+    tenant_id  varchar(32)                            not null comment '租户编号',
+    index idx_tenant_created_at (tenant_id, created_at),
+    index idx_account (account),
+    index idx_user_id (user_id),
+    index idx_created_at (created_at)
+)
+    comment '登录日志表' collate = utf8mb4_unicode_ci;
@@ -0,0 +1,22 @@
+-- ----------------------------
+-- 两步验证：用户的 TOTP 绑定和恢复码，租户可要求所有用户启用
+-- ----------------------------
+CREATE TABLE IF NOT EXISTS t_system_user_mfa
+(
+    id             varchar(32) comment '编号'
+        primary key,
+    user_id        varchar(32)                            not null comment '用户ID',
+    secret         varchar(64)                            not null comment 'TOTP 密钥（base32）',
+    enabled        bit          default b'0'              not null comment '是否已启用',
+    recovery_codes json                                   null comment '恢复码哈希，使用后移除',
+    last_used_step bigint       default 0                 not null comment '最近使用的验证码时间步，防止重放',
+    enabled_at     datetime                               null comment '启用时间',
+    created_at     datetime     default CURRENT_TIMESTAMP null comment '创建时间',
+    updated_at     datetime     default CURRENT_TIMESTAMP null on update CURRENT_TIMESTAMP comment '更新时间',
+    tenant_id      varchar(32)                            not null comment '租户编号',
+    unique index uk_tenant_user (tenant_id, user_id)
+)
+    comment '用户两步验证表' collate = utf8mb4_unicode_ci;
+
+ALTER TABLE t_system_tenant
+    ADD COLUMN mfa_required bit default b'0' not null comment '是否要求两步验证' AFTER account_count;
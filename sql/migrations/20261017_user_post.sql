@@ -0,0 +1,26 @@
+-- ----------------------------
+-- 用户岗位迁移：t_system_user.post_ids -> t_system_user_post
+-- 需要 MySQL 8.0（JSON_TABLE）
+-- post_ids 兼容 JSON 数组（["1","2"]）和逗号分隔（1,2）两种格式，
+-- 仅迁移存在且未删除的岗位，重复的岗位只保留一条
+-- ----------------------------
+INSERT INTO t_system_user_post (id, user_id, post_id, create_by, created_at, update_by, updated_at, tenant_id)
+SELECT REPLACE(UUID(), '-', ''), t.user_id, t.post_id, t.create_by, NOW(), t.update_by, NOW(), t.tenant_id
+FROM (SELECT DISTINCT u.id AS user_id, p.id AS post_id, u.create_by, u.update_by, u.tenant_id
+      FROM t_system_user u
+               JOIN JSON_TABLE(
+                       CONCAT('["',
+                              REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(u.post_ids, '[', ''), ']', ''), '"', ''), ' ', ''),
+                                      ',', '","'),
+                              '"]'),
+                       '$[*]' COLUMNS (post_id VARCHAR(32) PATH '$')) j
+               JOIN t_system_post p ON p.id = j.post_id AND p.deleted_at IS NULL
+      WHERE u.post_ids IS NOT NULL
+        AND u.post_ids <> ''
+        AND NOT EXISTS (SELECT 1
+                        FROM t_system_user_post up
+                        WHERE up.user_id = u.id
+                          AND up.post_id = p.id
+                          AND up.deleted_at IS NULL)) t;
+
+ALTER TABLE t_system_user DROP COLUMN post_ids;
@@ -0,0 +1,13 @@
+-- ----------------------------
+-- 用户账号、邮箱和手机号改为租户内唯一，只计算未删除的数据
+-- 适用于 ent 管理的 t_system_user 表，需要 MySQL 8.0.13（函数索引），执行前需清理租户内重复的未删除数据
+-- ent schema 无法声明函数索引，唯一索引只由本迁移维护；索引名与 schema 中的 UserAccountUniqueIndex 等保持一致，数据层据此转换唯一约束冲突
+-- account、tenant_id 是 ent 为原来的单列唯一字段生成的索引
+-- ----------------------------
+ALTER TABLE t_system_user
+    DROP INDEX account,
+    DROP INDEX tenant_id,
+    ADD INDEX systemuser_tenant_id_account (tenant_id, account),
+    ADD UNIQUE INDEX uk_system_user_tenant_account (tenant_id, (IF(deleted_at IS NULL, account, NULL))),
+    ADD UNIQUE INDEX uk_system_user_tenant_email (tenant_id, (IF(deleted_at IS NULL AND email <> '', email, NULL))),
+    ADD UNIQUE INDEX uk_system_user_tenant_mobile (tenant_id, (IF(deleted_at IS NULL AND mobile <> '', mobile, NULL)));
@@ -8,7 +8,6 @@ create table t_system_users
     nickname   varchar(32)                            not null comment '用户昵称',
     remark     varchar(512)                           null comment '备注',
     dept_id    varchar(32)                                 null comment '部门ID',
-    post_ids   varchar(256)                           null comment '岗位编号数组',
     email      varchar(64)  default ''                null comment '用户邮箱',
     mobile     varchar(16)  default ''                null comment '手机号码',
     sex        tinyint      default 0                 null comment '用户性别',
@@ -22,8 +21,13 @@ create table t_system_users
     update_at  datetime     default CURRENT_TIMESTAMP not null on update CURRENT_TIMESTAMP comment '更新时间',
     delete_at  datetime                               null comment '删除时间',
     tenant_id  varchar(32)       default ''                 not null comment '租户编号',
-    constraint idx_username
-        unique (username, update_at, tenant_id)
+    -- 账号、邮箱和手机号在租户内唯一，只计算未删除的数据
+    constraint uk_system_user_tenant_account
+        unique (tenant_id, (if(delete_at is null, username, null))),
+    constraint uk_system_user_tenant_email
+        unique (tenant_id, (if(delete_at is null and email <> '', email, null))),
+    constraint uk_system_user_tenant_mobile
+        unique (tenant_id, (if(delete_at is null and mobile <> '', mobile, null)))
 )
     comment '用户信息表' collate = utf8mb4_unicode_ci;
 
@@ -145,6 +149,63 @@ create table t_system_user_post
 )
     comment '用户岗位表' collate = utf8mb4_unicode_ci;
 
+DROP TABLE IF EXISTS `t_system_login_log`;
+create table t_system_login_log
+(
+    id         varchar(32) comment '日志ID'
+        primary key,
+    event      varchar(32)  default 'LOGIN'           not null comment '事件类型（LOGIN登录 LOGOUT登出）',
+    user_id    varchar(32)                            null comment '用户ID，账号不存在时为空',
+    account    varchar(64)                            not null comment '登录账号',
+    status     tinyint                                not null comment '结果（0失败 1成功）',
+    reason     varchar(64)                            null comment '失败原因',
+    ip         varchar(64)                            null comment '登录IP',
+    user_agent varchar(512)                           null comment '浏览器 User-Agent',
+    created_at datetime     default CURRENT_TIMESTAMP null comment '登录时间',
+    tenant_id  varchar(32)                            not null comment '租户编号',
+    index idx_tenant_created_at (tenant_id, created_at),
+    index idx_account (account),
+    index idx_user_id (user_id),
+    index idx_created_at (created_at)
+)
+    comment '登录日志表' collate = utf8mb4_unicode_ci;
+
+DROP TABLE IF EXISTS `t_system_user_mfa`;
+create table t_system_user_mfa
+(
+    id             varchar(32) comment '编号'
+        primary key,
+    user_id        varchar(32)                            not null comment '用户ID',
+    secret         varchar(64)                            not null comment 'TOTP 密钥（base32）',
+    enabled        bit          default b'0'              not null comment '是否已启用',
+    recovery_codes json                                   null comment '恢复码哈希，使用后移除',
+    last_used_step bigint       default 0                 not null comment '最近使用的验证码时间步，防止重放',
+    enabled_at     datetime                               null comment '启用时间',
+    created_at     datetime     default CURRENT_TIMESTAMP null comment '创建时间',
+    updated_at     datetime     default CURRENT_TIMESTAMP null on update CURRENT_TIMESTAMP comment '更新时间',
+    tenant_id      varchar(32)                            not null comment '租户编号',
+    unique index uk_tenant_user (tenant_id, user_id)
+)
+    comment '用户两步验证表' collate = utf8mb4_unicode_ci;
+
+DROP TABLE IF EXISTS `t_system_casbin_rule`;
+create table t_system_casbin_rule
+(
+    id         varchar(32) comment '编号'
+        primary key,
+    ptype      varchar(16)                            not null comment '规则类型，p 或 g',
+    v0         varchar(128) default ''                not null comment '规则字段0',
+    v1         varchar(128) default ''                not null comment '规则字段1',
+    v2         varchar(256) default ''                not null comment '规则字段2',
+    v3         varchar(128) default ''                not null comment '规则字段3',
+    v4         varchar(128) default ''                not null comment '规则字段4',
+    v5         varchar(128) default ''                not null comment '规则字段5',
+    create_by  varchar(64)  default ''                null comment '创建者',
+    created_at datetime     default CURRENT_TIMESTAMP null comment '创建时间',
+    unique index systemcasbinrule_ptype_v0_v1_v2_v3_v4_v5 (ptype, v0, v1, v2, v3, v4, v5)
+)
+    comment 'casbin 规则表' charset = ascii collate = ascii_bin;
+
 
 create table t_system_dept
 (
@@ -152,6 +213,7 @@ create table t_system_dept
         primary key,
     name           varchar(32) default ''                not null comment '部门名称',
     parent_id      varchar(32)                       not null comment '父部门id',
+    ancestors      varchar(1024) default '0'             not null comment '祖级列表',
     sort           int         default 0                 not null comment '显示顺序',
     leader_user_id varchar(32)                                null comment '负责人',
     phone          varchar(16)                           null comment '联系电话',
@@ -180,6 +242,7 @@ create table t_system_tenant
     package_id      varchar(32)                                 not null comment '租户套餐编号',
     expire_time     datetime                               not null comment '过期时间',
     account_count   int                                    not null comment '账号数量',
+    mfa_required    bit          default b'0'              not null comment '是否要求两步验证',
     create_by       varchar(64)  default ''                not null comment '创建者',
     create_at       datetime     default CURRENT_TIMESTAMP not null comment '创建时间',
     update_by       varchar(64)  default ''                null comment '更新者',